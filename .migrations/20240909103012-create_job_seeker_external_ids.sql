@@ -0,0 +1,26 @@
+-- 求職者の流入元媒体でのIDを管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS job_seeker_external_ids (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	                    -- 重複しないID
+    job_seeker_id INT NOT NULL,	                                -- 求職者ID
+    agent_id INT NOT NULL,	                                    -- エージェントID
+    external_type INT NOT NULL,	                                -- 流入元媒体のタイプ(0: RAN, 1: マイナビスカウティング, 2: AMBI, 3: マイナビエージェントスカウト, 10: 提携求人媒体, 11: 自社フォーム)
+    external_id VARCHAR(255) NOT NULL,	                        -- 流入元媒体でのID
+    created_at DATETIME,                                        -- 作成日時
+    updated_at DATETIME,                                        -- 最終更新日時
+    PRIMARY KEY(id),
+    UNIQUE uq_job_seeker_external_ids_agent_id_external (agent_id, external_type, external_id),
+    INDEX idx_job_seeker_external_ids_job_seeker_id (job_seeker_id)
+);
+
+ALTER TABLE job_seeker_external_ids
+    ADD CONSTRAINT fk_job_seeker_external_ids_job_seeker_id
+    FOREIGN KEY(job_seeker_id)
+    REFERENCES job_seekers (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE job_seeker_external_ids DROP FOREIGN KEY fk_job_seeker_external_ids_job_seeker_id;
+
+DROP TABLE IF EXISTS job_seeker_external_ids;
@@ -0,0 +1,16 @@
+-- 求職者の流入元テーブルに「流入経路」を追加し、媒体IDなしの流入元（LPなど）も記録できるようにする
+-- +migrate Up
+ALTER TABLE job_seeker_external_ids
+  ADD COLUMN inflow_channel_id INT AFTER external_id; -- 流入経路
+
+ALTER TABLE job_seeker_external_ids
+  DROP INDEX uq_job_seeker_external_ids_agent_id_external,
+  ADD INDEX idx_job_seeker_external_ids_agent_id_external (agent_id, external_type, external_id);
+
+-- +migrate Down
+ALTER TABLE job_seeker_external_ids
+  DROP INDEX idx_job_seeker_external_ids_agent_id_external,
+  ADD UNIQUE uq_job_seeker_external_ids_agent_id_external (agent_id, external_type, external_id);
+
+ALTER TABLE job_seeker_external_ids
+  DROP COLUMN inflow_channel_id;
@@ -0,0 +1,37 @@
+-- 求職者の統合候補を管理するテーブル（取り込み時に重複の疑いがある求職者をスタッフが確認する）
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS job_seeker_merge_suggestions (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL,	                    -- エージェントID
+    job_seeker_id INT NOT NULL,	                -- 統合先の求職者ID（既存の求職者）
+    duplicate_job_seeker_id INT,	            -- 統合元の求職者ID（新しく取り込んだ求職者。統合後はNULL）
+    match_reason VARCHAR(255) NOT NULL,	        -- 重複と判定した理由
+    status INT NOT NULL DEFAULT 0,	            -- 対応状況（0: 未対応, 1: 統合済み, 2: 別人として登録）
+    reviewed_staff_id INT,	                    -- 対応した担当者ID
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id),
+    INDEX idx_job_seeker_merge_suggestions_agent_id_status (agent_id, status),
+    INDEX idx_job_seeker_merge_suggestions_job_seeker_id (job_seeker_id),
+    INDEX idx_job_seeker_merge_suggestions_duplicate_job_seeker_id (duplicate_job_seeker_id)
+);
+
+ALTER TABLE job_seeker_merge_suggestions
+    ADD CONSTRAINT fk_job_seeker_merge_suggestions_job_seeker_id
+    FOREIGN KEY(job_seeker_id)
+    REFERENCES job_seekers (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+ALTER TABLE job_seeker_merge_suggestions
+    ADD CONSTRAINT fk_job_seeker_merge_suggestions_duplicate_job_seeker_id
+    FOREIGN KEY(duplicate_job_seeker_id)
+    REFERENCES job_seekers (id)
+    ON DELETE SET NULL
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE job_seeker_merge_suggestions DROP FOREIGN KEY fk_job_seeker_merge_suggestions_job_seeker_id;
+ALTER TABLE job_seeker_merge_suggestions DROP FOREIGN KEY fk_job_seeker_merge_suggestions_duplicate_job_seeker_id;
+
+DROP TABLE IF EXISTS job_seeker_merge_suggestions;
@@ -0,0 +1,25 @@
+-- 新規エントリーした求職者の担当CAを自動で割り振るルールを管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS agent_assignment_rules (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL UNIQUE,	            -- エージェントID
+    assignment_type INT NOT NULL DEFAULT 0,	    -- 割り振り方法（0: ラウンドロビン, 1: 未完了タスク数, 2: 得意分野）
+    is_working_hours_aware BOOLEAN NOT NULL DEFAULT FALSE,	-- 勤務時間外の担当者を除外するか
+    is_active BOOLEAN NOT NULL DEFAULT FALSE,	-- 自動割り振りの有効/無効
+    last_assigned_staff_id INT,	                -- 最後に割り振った担当者ID（ラウンドロビン用）
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id)
+);
+
+ALTER TABLE agent_assignment_rules
+    ADD CONSTRAINT fk_agent_assignment_rules_agent_id
+    FOREIGN KEY(agent_id)
+    REFERENCES agents (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE agent_assignment_rules DROP FOREIGN KEY fk_agent_assignment_rules_agent_id;
+
+DROP TABLE IF EXISTS agent_assignment_rules;
@@ -0,0 +1,25 @@
+-- 自動割り振りの対象となる担当者と勤務時間を管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS agent_assignment_staffs (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL,	                    -- エージェントID
+    agent_staff_id INT NOT NULL,	            -- 担当者ID
+    working_start_time VARCHAR(5) NOT NULL,	    -- 勤務開始時刻（例: 09:00）
+    working_end_time VARCHAR(5) NOT NULL,	    -- 勤務終了時刻（例: 18:00）
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id),
+    UNIQUE uq_agent_assignment_staffs_agent_staff_id (agent_id, agent_staff_id)
+);
+
+ALTER TABLE agent_assignment_staffs
+    ADD CONSTRAINT fk_agent_assignment_staffs_agent_staff_id
+    FOREIGN KEY(agent_staff_id)
+    REFERENCES agent_staffs (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE agent_assignment_staffs DROP FOREIGN KEY fk_agent_assignment_staffs_agent_staff_id;
+
+DROP TABLE IF EXISTS agent_assignment_staffs;
@@ -0,0 +1,24 @@
+-- 自動割り振りの対象となる担当者の得意分野（職種・都道府県）を管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS agent_assignment_staff_specialties (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    assignment_staff_id INT NOT NULL,	        -- 割り振り対象の担当者ID（agent_assignment_staffs.id）
+    specialty_type INT NOT NULL,	            -- 得意分野の種類（0: 職種, 1: 都道府県）
+    value INT NOT NULL,	                        -- 職種・都道府県の値
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id),
+    INDEX idx_agent_assignment_staff_specialties_assignment_staff_id (assignment_staff_id)
+);
+
+ALTER TABLE agent_assignment_staff_specialties
+    ADD CONSTRAINT fk_agent_assignment_staff_specialties_assignment_staff_id
+    FOREIGN KEY(assignment_staff_id)
+    REFERENCES agent_assignment_staffs (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE agent_assignment_staff_specialties DROP FOREIGN KEY fk_agent_assignment_staff_specialties_assignment_staff_id;
+
+DROP TABLE IF EXISTS agent_assignment_staff_specialties;
@@ -0,0 +1,28 @@
+-- 求職者の担当CAの割り振り履歴（自動割り振り・手動変更）を管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS job_seeker_assignment_logs (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL,	                    -- エージェントID
+    job_seeker_id INT NOT NULL,	                -- 求職者ID
+    agent_staff_id INT NOT NULL,	            -- 割り振られた担当者ID
+    previous_agent_staff_id INT,	            -- 変更前の担当者ID
+    assignment_type INT NOT NULL,	            -- 割り振り方法（0: ラウンドロビン, 1: 未完了タスク数, 2: 得意分野, 99: 手動変更）
+    operated_staff_id INT,	                    -- 手動変更した担当者ID
+    reason VARCHAR(255) NOT NULL,	            -- 割り振り・変更の理由
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id),
+    INDEX idx_job_seeker_assignment_logs_job_seeker_id (job_seeker_id)
+);
+
+ALTER TABLE job_seeker_assignment_logs
+    ADD CONSTRAINT fk_job_seeker_assignment_logs_job_seeker_id
+    FOREIGN KEY(job_seeker_id)
+    REFERENCES job_seekers (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE job_seeker_assignment_logs DROP FOREIGN KEY fk_job_seeker_assignment_logs_job_seeker_id;
+
+DROP TABLE IF EXISTS job_seeker_assignment_logs;
@@ -0,0 +1,29 @@
+-- 新規エントリーした求職者を取り込み時に選考するルールを管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS entry_screening_rules (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL,	                    -- エージェントID
+    title VARCHAR(255) NOT NULL,	            -- ルール名
+    tag VARCHAR(255) NOT NULL,	                -- 該当した求職者に付けるタグ
+    result_phase INT,	                        -- 該当した求職者にセットするフェーズ（NULLの場合は変更しない）
+    result_user_status INT,	                    -- 該当した求職者にセットするユーザーステータス（NULLの場合は変更しない）
+    is_skip_interview_adjustment BOOLEAN NOT NULL DEFAULT TRUE,	-- 面談調整を行わないか
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,	-- ルールの有効/無効
+    is_deleted BOOLEAN NOT NULL DEFAULT FALSE,	-- 削除済みか（該当件数の集計のため論理削除）
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id),
+    INDEX idx_entry_screening_rules_agent_id (agent_id)
+);
+
+ALTER TABLE entry_screening_rules
+    ADD CONSTRAINT fk_entry_screening_rules_agent_id
+    FOREIGN KEY(agent_id)
+    REFERENCES agents (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE entry_screening_rules DROP FOREIGN KEY fk_entry_screening_rules_agent_id;
+
+DROP TABLE IF EXISTS entry_screening_rules;
@@ -0,0 +1,24 @@
+-- 選考ルールの条件を管理するテーブル（同じルールの条件はすべて満たした場合に該当）
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS entry_screening_rule_conditions (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    rule_id INT NOT NULL,	                    -- 選考ルールID
+    target INT NOT NULL,	                    -- 判定する項目（0: 年齢, 1: 都道府県, 2: 国籍, 3: 年収, 4: 就業状況）
+    operator INT NOT NULL,	                    -- 比較方法（0: 一致, 1: 不一致, 2: 以上, 3: 以下）
+    value INT NOT NULL,	                        -- 比較する値
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id)
+);
+
+ALTER TABLE entry_screening_rule_conditions
+    ADD CONSTRAINT fk_entry_screening_rule_conditions_rule_id
+    FOREIGN KEY(rule_id)
+    REFERENCES entry_screening_rules (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE entry_screening_rule_conditions DROP FOREIGN KEY fk_entry_screening_rule_conditions_rule_id;
+
+DROP TABLE IF EXISTS entry_screening_rule_conditions;
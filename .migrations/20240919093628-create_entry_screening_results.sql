@@ -0,0 +1,33 @@
+-- 選考ルールに該当した求職者を管理するテーブル（ルールごとの該当件数の集計にも使用）
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS entry_screening_results (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL,	                    -- エージェントID
+    job_seeker_id INT NOT NULL,	                -- 求職者ID
+    rule_id INT NOT NULL,	                    -- 該当した選考ルールID
+    tag VARCHAR(255) NOT NULL,	                -- 該当時に付けたタグ
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id),
+    INDEX idx_entry_screening_results_rule_id (rule_id)
+);
+
+ALTER TABLE entry_screening_results
+    ADD CONSTRAINT fk_entry_screening_results_job_seeker_id
+    FOREIGN KEY(job_seeker_id)
+    REFERENCES job_seekers (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+ALTER TABLE entry_screening_results
+    ADD CONSTRAINT fk_entry_screening_results_rule_id
+    FOREIGN KEY(rule_id)
+    REFERENCES entry_screening_rules (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE entry_screening_results DROP FOREIGN KEY fk_entry_screening_results_job_seeker_id;
+ALTER TABLE entry_screening_results DROP FOREIGN KEY fk_entry_screening_results_rule_id;
+
+DROP TABLE IF EXISTS entry_screening_results;
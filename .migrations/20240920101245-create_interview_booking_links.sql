@@ -0,0 +1,29 @@
+-- 新規エントリーした求職者に送る面談予約リンクを管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS interview_booking_links (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    uuid CHAR(36) NOT NULL UNIQUE,	            -- 予約リンクのUUID
+    agent_id INT NOT NULL,	                    -- エージェントID
+    job_seeker_id INT NOT NULL,	                -- 求職者ID
+    agent_staff_id INT NOT NULL,	            -- 面談を担当するCAのID
+    interview_adjustment_template_id INT,	    -- 送信した面談調整テンプレートID
+    expired_at DATETIME NOT NULL,	            -- 予約リンクの有効期限
+    is_booked BOOLEAN NOT NULL DEFAULT FALSE,	-- 予約済みか
+    interview_date DATETIME NOT NULL,	        -- 予約された面談日時
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id),
+    INDEX idx_interview_booking_links_job_seeker_id (job_seeker_id)
+);
+
+ALTER TABLE interview_booking_links
+    ADD CONSTRAINT fk_interview_booking_links_job_seeker_id
+    FOREIGN KEY(job_seeker_id)
+    REFERENCES job_seekers (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE interview_booking_links DROP FOREIGN KEY fk_interview_booking_links_job_seeker_id;
+
+DROP TABLE IF EXISTS interview_booking_links;
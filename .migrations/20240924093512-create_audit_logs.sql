@@ -0,0 +1,22 @@
+-- 求職者の個人情報・売上・担当者権限に対する参照・変更の監査ログを管理するテーブル（追記のみで更新・削除は行わない）
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL,	                    -- 操作した担当者のエージェントID
+    agent_staff_id INT NOT NULL,	            -- 操作した担当者ID
+    action INT NOT NULL,	                    -- 操作（0: 参照, 1: 作成, 2: 更新, 3: 削除）
+    entity_type VARCHAR(50) NOT NULL,	        -- 対象（job_seeker, job_seeker_document, sale, agent_staff）
+    entity_id INT NOT NULL,	                    -- 対象のID（作成時に特定できない場合は0）
+    diff TEXT NOT NULL,	                        -- 項目ごとの変更前後の値（JSON）
+    method VARCHAR(10) NOT NULL,	            -- HTTPメソッド
+    path VARCHAR(255) NOT NULL,	                -- リクエストパス
+    ip_address VARCHAR(45) NOT NULL,	        -- 接続元IPアドレス
+    user_agent VARCHAR(512) NOT NULL,	        -- ユーザーエージェント
+    created_at DATETIME,                        -- 作成日時
+    PRIMARY KEY(id),
+    INDEX idx_audit_logs_agent_id_created_at (agent_id, created_at),
+    INDEX idx_audit_logs_entity (entity_type, entity_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS audit_logs;
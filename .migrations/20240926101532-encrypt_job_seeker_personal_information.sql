@@ -0,0 +1,36 @@
+-- 求職者の個人情報（電話番号・メールアドレス・住所・社内限定メモ・既往歴備考）を暗号化して保存するための変更
+-- 暗号文は平文より長くなるため型をTEXTに広げ、検索・重複判定用のブラインドインデックスを別テーブルで管理する
+-- +migrate Up
+ALTER TABLE job_seekers
+    MODIFY phone_number TEXT NOT NULL,                  -- 電話番号（暗号化）
+    MODIFY email TEXT NOT NULL,                         -- メールアドレス（暗号化）
+    MODIFY address TEXT NOT NULL;                       -- 住所詳細（暗号化）
+
+CREATE TABLE IF NOT EXISTS job_seeker_blind_indexes (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    job_seeker_id INT NOT NULL,	                -- 求職者のID
+    index_type VARCHAR(50) NOT NULL,	        -- 対象の項目（phone_number, email）
+    value_hash CHAR(64) NOT NULL,	            -- 正規化した値のHMAC-SHA256（16進数）
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 最終更新日時
+    PRIMARY KEY(id),
+    UNIQUE idx_job_seeker_blind_indexes_type (job_seeker_id, index_type),
+    INDEX idx_job_seeker_blind_indexes_hash (index_type, value_hash)
+);
+
+ALTER TABLE job_seeker_blind_indexes
+    ADD CONSTRAINT fk_job_seeker_blind_indexes_job_seeker_id
+    FOREIGN KEY(job_seeker_id)
+    REFERENCES job_seekers (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE;
+
+-- +migrate Down
+ALTER TABLE job_seeker_blind_indexes DROP FOREIGN KEY fk_job_seeker_blind_indexes_job_seeker_id;
+
+DROP TABLE IF EXISTS job_seeker_blind_indexes;
+
+ALTER TABLE job_seekers
+    MODIFY phone_number CHAR(13) NOT NULL,
+    MODIFY email VARCHAR(255) NOT NULL,
+    MODIFY address VARCHAR(255) NOT NULL;
@@ -0,0 +1,11 @@
+-- 個人情報の削除依頼・保存期間の経過により求職者を匿名化した日時を管理するための変更
+-- 売上・選考の集計に使うため求職者のレコードは削除せず、個人情報の項目のみを消去する
+-- +migrate Up
+ALTER TABLE job_seekers ADD anonymized_at DATETIME; -- 匿名化した日時（未匿名化の場合はNULL）
+
+ALTER TABLE job_seekers ADD INDEX idx_job_seekers_anonymized_at_updated_at (anonymized_at, updated_at);
+
+-- +migrate Down
+ALTER TABLE job_seekers DROP INDEX idx_job_seekers_anonymized_at_updated_at;
+
+ALTER TABLE job_seekers DROP COLUMN anonymized_at;
@@ -0,0 +1,39 @@
+-- 企業・求職者に送るゲスト用リンク（署名付きトークン）と、リンクごとのアクセスログを管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS guest_links (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    uuid CHAR(36) NOT NULL UNIQUE,	            -- トークンのID（jti）
+    agent_id INT NOT NULL,	                    -- 発行した担当者のエージェントID
+    agent_staff_id INT NOT NULL,	            -- 発行した担当者ID
+    resource_type VARCHAR(50) NOT NULL,	        -- 対象（job_information, task_group, job_seeker）
+    resource_uuid CHAR(36) NOT NULL,	        -- 対象のUUID
+    permissions VARCHAR(255) NOT NULL,	        -- 許可する操作（read, writeのカンマ区切り）
+    expires_at DATETIME NOT NULL,	            -- 有効期限
+    revoked_at DATETIME,	                    -- 無効化日時（NULLの場合は有効）
+    revoked_staff_id INT,	                    -- 無効化した担当者ID
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id),
+    INDEX idx_guest_links_agent_id_created_at (agent_id, created_at),
+    INDEX idx_guest_links_resource (resource_type, resource_uuid),
+    FOREIGN KEY(agent_id) REFERENCES agents(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS guest_link_access_logs (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    guest_link_id INT,	                        -- ゲスト用リンクのID（移行期間中のUUIDのみのリンクの場合はNULL）
+    resource_type VARCHAR(50) NOT NULL,	        -- 対象（job_information, task_group, job_seeker）
+    resource_uuid CHAR(36) NOT NULL,	        -- 対象のUUID
+    result VARCHAR(50) NOT NULL,	            -- 結果（success, invalid_token, expired, revoked, resource_mismatch, sign_in_error, legacy, legacy_expired）
+    ip_address VARCHAR(45) NOT NULL,	        -- 接続元IPアドレス
+    user_agent VARCHAR(512) NOT NULL,	        -- ユーザーエージェント
+    created_at DATETIME,                        -- 作成日時
+    PRIMARY KEY(id),
+    INDEX idx_guest_link_access_logs_guest_link_id (guest_link_id),
+    INDEX idx_guest_link_access_logs_resource (resource_type, resource_uuid),
+    FOREIGN KEY(guest_link_id) REFERENCES guest_links(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS guest_link_access_logs;
+DROP TABLE IF EXISTS guest_links;
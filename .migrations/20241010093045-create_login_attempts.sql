@@ -0,0 +1,20 @@
+-- LP・ゲストのログインやパスワード再設定メールの試行回数とロック状態を管理するテーブル（複数台のサーバーで共有する）
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS login_attempts (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    scope VARCHAR(50) NOT NULL,	                -- 対象の操作（lp_login, lp_reset_password_email, reset_password_email, guest_sign_in）
+    key_type VARCHAR(20) NOT NULL,	            -- 制限の単位（ip, account）
+    key_value VARCHAR(255) NOT NULL,	        -- IPアドレス、またはアカウント（メールアドレスはブラインドインデックス、ゲストは対象のUUID）
+    attempt_count INT NOT NULL DEFAULT 0,	    -- 期間内の失敗回数（メール送信は送信回数）
+    window_started_at DATETIME NOT NULL,	    -- 回数を数え始めた日時
+    lock_count INT NOT NULL DEFAULT 0,	        -- ロックされた回数（ロックのたびにロック時間を延ばす）
+    locked_until DATETIME,	                    -- ロックの解除日時（NULLの場合はロックなし）
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id),
+    UNIQUE INDEX idx_login_attempts_key (scope, key_type, key_value),
+    INDEX idx_login_attempts_locked_until (locked_until)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS login_attempts;
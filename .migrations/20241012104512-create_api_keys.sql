@@ -0,0 +1,25 @@
+-- 外部連携（BI・提携媒体・社内スクリプト）用のAPIキーを管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS api_keys (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    uuid CHAR(36) NOT NULL UNIQUE,	            -- 重複しないUUID
+    agent_id INT NOT NULL,	                    -- 利用するエージェントID
+    agent_staff_id INT NOT NULL,	            -- 発行した担当者ID（APIキーでの操作はこの担当者として扱う）
+    name VARCHAR(255) NOT NULL,	                -- 名前（連携先）
+    key_prefix VARCHAR(20) NOT NULL,	        -- キーの先頭（一覧で識別するため）
+    key_hash CHAR(64) NOT NULL UNIQUE,	        -- キーのハッシュ（SHA-256）
+    scopes VARCHAR(255) NOT NULL,	            -- 許可する操作（job_seeker:read, entry:write, sale:readのカンマ区切り）
+    expires_at DATETIME NOT NULL,	            -- 有効期限
+    last_used_at DATETIME,	                    -- 最終利用日時
+    last_used_ip VARCHAR(45),	                -- 最終利用時の接続元IPアドレス
+    revoked_at DATETIME,	                    -- 無効化日時（NULLの場合は有効）
+    revoked_staff_id INT,	                    -- 無効化した担当者ID
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id),
+    INDEX idx_api_keys_agent_id (agent_id),
+    FOREIGN KEY(agent_id) REFERENCES agents(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,54 @@
+-- 管理者サイトの管理者アカウント・ログインセッション・操作ログを管理するテーブル
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS admin_users (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    login_id VARCHAR(255) NOT NULL UNIQUE,	    -- ログインID
+    name VARCHAR(255) NOT NULL,	                -- 名前
+    password VARCHAR(255) NOT NULL,	            -- パスワード（bcrypt）
+    totp_secret VARCHAR(255) NOT NULL DEFAULT '',	-- 二要素認証（TOTP）の秘密鍵（暗号化して保存）
+    totp_enabled_at DATETIME,	                -- 二要素認証の登録日時（NULLの場合は未登録）
+    totp_last_step BIGINT NOT NULL DEFAULT 0,	-- 最後に使用したワンタイムパスワードの時間ステップ（再利用を防ぐ）
+    last_login_at DATETIME,	                    -- 最終ログイン日時
+    is_deleted BOOLEAN NOT NULL DEFAULT false,	-- 削除フラグ
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id)
+);
+
+CREATE TABLE IF NOT EXISTS admin_sessions (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    admin_user_id INT NOT NULL,	                -- 管理者ID
+    token_hash CHAR(64) NOT NULL UNIQUE,	    -- セッショントークンのハッシュ（SHA-256）
+    mfa_verified_at DATETIME,	                -- 二要素認証の完了日時（NULLの場合はパスワード認証のみ）
+    mfa_failed_count INT NOT NULL DEFAULT 0,	-- ワンタイムパスワードの誤り回数
+    expires_at DATETIME NOT NULL,	            -- 有効期限（操作するたびに延長する）
+    revoked_at DATETIME,	                    -- ログアウト日時
+    ip_address VARCHAR(45) NOT NULL,	        -- 接続元IPアドレス
+    user_agent VARCHAR(512) NOT NULL,	        -- ユーザーエージェント
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id),
+    INDEX idx_admin_sessions_admin_user_id (admin_user_id),
+    FOREIGN KEY(admin_user_id) REFERENCES admin_users(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS admin_action_logs (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    admin_user_id INT NOT NULL,	                -- 操作した管理者ID
+    method VARCHAR(10) NOT NULL,	            -- HTTPメソッド
+    path VARCHAR(255) NOT NULL,	                -- ルート定義のパス
+    uri VARCHAR(1024) NOT NULL,	                -- リクエストURI
+    request_body TEXT NOT NULL,	                -- リクエストボディ（JSON、パスワードは伏せ字）
+    status_code INT NOT NULL,	                -- レスポンスのステータスコード
+    ip_address VARCHAR(45) NOT NULL,	        -- 接続元IPアドレス
+    user_agent VARCHAR(512) NOT NULL,	        -- ユーザーエージェント
+    created_at DATETIME,                        -- 作成日時
+    PRIMARY KEY(id),
+    INDEX idx_admin_action_logs_admin_user_id_created_at (admin_user_id, created_at),
+    INDEX idx_admin_action_logs_created_at (created_at)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS admin_action_logs;
+DROP TABLE IF EXISTS admin_sessions;
+DROP TABLE IF EXISTS admin_users;
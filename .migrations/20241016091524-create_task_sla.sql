@@ -0,0 +1,42 @@
+-- タスクの期限（SLA）管理
+-- フェーズごとの既定の期限・期限前のリマインド・期限切れ時の上長へのエスカレーションを設定し、送信した通知を記録する
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS task_sla_rules (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL,	                    -- エージェントID
+    target_type INT NOT NULL,	                -- 対象のタスク（0: 選考タスク, 1: 面談調整タスク）
+    phase_category INT NOT NULL,	            -- フェーズ
+    phase_sub_category INT,	                    -- サブフェーズ（NULLの場合はフェーズ内の全てのサブフェーズ）
+    deadline_hours INT NOT NULL,	            -- 期限が未設定のタスクの期限（作成からの営業時間。0の場合は期限を設けない）
+    reminder_hours INT NOT NULL,	            -- 期限の何営業時間前にリマインドするか（0の場合はリマインドしない）
+    escalation_hours INT NOT NULL,	            -- 期限切れから何営業時間後に上長へエスカレーションするか
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id),
+    INDEX idx_task_sla_rules_agent_id (agent_id, target_type),
+    FOREIGN KEY(agent_id) REFERENCES agents(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS task_sla_notifications (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    target_type INT NOT NULL,	                -- 対象のタスク（0: 選考タスク, 1: 面談調整タスク）
+    task_id INT NOT NULL,	                    -- タスクID（tasks.id or interview_tasks.id）
+    agent_staff_id INT NOT NULL,	            -- 通知した担当者ID
+    notification_type INT NOT NULL,	            -- 通知の種類（0: リマインド, 1: 期限切れ, 2: エスカレーション）
+    due_at DATETIME NOT NULL,	                -- 通知時点の期限（期限が変更された場合は再度通知する）
+    created_at DATETIME,                        -- 通知日時
+    PRIMARY KEY(id),
+    UNIQUE uq_task_sla_notifications (target_type, task_id, notification_type, agent_staff_id, due_at)
+);
+
+ALTER TABLE agent_staffs ADD manager_staff_id INT; -- 期限切れのタスクをエスカレーションする上長（NULLの場合は自社の管理者）
+ALTER TABLE agent_staffs ADD notification_sla_reminder BOOLEAN NOT NULL DEFAULT TRUE; -- メール通知（タスクの期限のリマインド・期限切れ・エスカレーション）
+ALTER TABLE agent_staffs ADD notification_sla_digest BOOLEAN NOT NULL DEFAULT TRUE; -- メール通知（タスクの期限の日次まとめ）
+
+-- +migrate Down
+ALTER TABLE agent_staffs DROP COLUMN notification_sla_digest;
+ALTER TABLE agent_staffs DROP COLUMN notification_sla_reminder;
+ALTER TABLE agent_staffs DROP COLUMN manager_staff_id;
+
+DROP TABLE IF EXISTS task_sla_notifications;
+DROP TABLE IF EXISTS task_sla_rules;
@@ -0,0 +1,25 @@
+-- タスクグループ（求職者×求人の選考）の活動履歴
+-- フェーズの変更・取り消し、書類の更新、選考日時の変更、評価ポイントの登録、売上の作成・更新を追記のみで記録する
+-- タスクグループの削除後も履歴を残すため、外部キーは設定しない
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS task_group_events (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    task_group_id INT NOT NULL,	                -- タスクグループID
+    job_seeker_id INT NOT NULL,	                -- 求職者ID
+    job_information_id INT NOT NULL,	        -- 求人ID
+    event_type INT NOT NULL,	                -- 種類（0: フェーズの変更, 1: フェーズの取り消し, 2: 書類の更新, 3: 選考日時の変更, 4: 評価ポイントの登録, 5: 売上の作成, 6: 売上の更新）
+    agent_staff_id INT,	                        -- 操作した担当者ID（特定できない場合はNULL）
+    task_id INT,	                            -- 対象のタスクID
+    prev_phase_category INT,	                -- 変更前のフェーズ
+    prev_phase_sub_category INT,	            -- 変更前のサブフェーズ
+    phase_category INT,	                        -- 変更後のフェーズ
+    phase_sub_category INT,	                    -- 変更後のサブフェーズ
+    diff TEXT NOT NULL,	                        -- 項目ごとの変更前後の値（JSON配列）
+    created_at DATETIME,                        -- 発生日時
+    PRIMARY KEY(id),
+    INDEX idx_task_group_events_task_group_id (task_group_id, created_at),
+    INDEX idx_task_group_events_job_seeker_id (job_seeker_id, created_at)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS task_group_events;
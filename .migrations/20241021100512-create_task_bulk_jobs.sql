@@ -0,0 +1,40 @@
+-- タスクの一括操作（フェーズの遷移・終了・担当者の変更・期限の設定）
+-- リクエスト時はジョブとして登録し、バッチで1件ずつ処理して選考（タスクグループ）ごとの結果を記録する
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS task_bulk_jobs (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL,	                    -- エージェントID
+    agent_staff_id INT NOT NULL,	            -- 依頼した担当者ID
+    action_type INT NOT NULL,	                -- 操作（0: フェーズの遷移, 1: 終了, 2: 担当者の変更, 3: 期限の設定）
+    param TEXT NOT NULL,	                    -- 操作の内容（JSON）
+    status INT NOT NULL,	                    -- 状態（0: 待機中, 1: 実行中, 2: 完了）
+    total_count INT NOT NULL DEFAULT 0,	        -- 対象の件数
+    success_count INT NOT NULL DEFAULT 0,	    -- 成功した件数
+    failure_count INT NOT NULL DEFAULT 0,	    -- 失敗した件数
+    started_at DATETIME,	                    -- 実行開始日時
+    finished_at DATETIME,	                    -- 完了日時
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id),
+    INDEX idx_task_bulk_jobs_status (status),
+    INDEX idx_task_bulk_jobs_agent_staff_id (agent_staff_id, created_at),
+    FOREIGN KEY(agent_id) REFERENCES agents(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS task_bulk_job_items (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    task_bulk_job_id INT NOT NULL,	            -- 一括操作のジョブID
+    task_group_id INT NOT NULL,	                -- 対象のタスクグループID
+    status INT NOT NULL,	                    -- 結果（0: 未処理, 1: 成功, 2: 失敗）
+    task_id INT,	                            -- 作成・更新したタスクID
+    error_message TEXT NOT NULL,	            -- 失敗した理由
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id),
+    INDEX idx_task_bulk_job_items_task_bulk_job_id (task_bulk_job_id),
+    FOREIGN KEY(task_bulk_job_id) REFERENCES task_bulk_jobs(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS task_bulk_job_items;
+DROP TABLE IF EXISTS task_bulk_jobs;
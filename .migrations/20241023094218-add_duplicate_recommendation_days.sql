@@ -0,0 +1,12 @@
+-- 重複推薦の確認
+-- 同じ人物（自社・アライアンス先の求職者を氏名・生年月日・電話番号で照合）を同じ企業へ推薦する前に、RA側のエージェントが設定した期間内の既存の選考を確認する
+-- 理由を入力して推薦を進めた場合は、活動履歴（task_group_events.event_type = 7）に理由と重複した選考を記録する
+-- +migrate Up
+ALTER TABLE agents ADD duplicate_recommendation_days INT NOT NULL DEFAULT 180; -- 重複推薦を確認する期間（日数。0の場合は確認しない）
+
+CREATE INDEX idx_job_seekers_birthday ON job_seekers (birthday);
+
+-- +migrate Down
+DROP INDEX idx_job_seekers_birthday ON job_seekers;
+
+ALTER TABLE agents DROP COLUMN duplicate_recommendation_days;
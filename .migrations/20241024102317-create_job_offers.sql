@@ -0,0 +1,58 @@
+-- 内定（オファー）管理
+-- 選考（タスクグループ）ごとに内定条件（給与の内訳・ポジション・入社日・回答期限）と状態の履歴を記録する
+-- 回答期限の前日・期限切れを担当者に通知し、送信した通知を記録する
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS job_offers (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    task_group_id INT NOT NULL,	                -- タスクグループID
+    position VARCHAR(255) NOT NULL,	            -- ポジション（役職・配属先）
+    employment_status INT,	                    -- 雇用形態（求人と同じ区分）
+    work_location VARCHAR(255) NOT NULL,	    -- 勤務地
+    annual_salary INT,	                        -- 提示年収（円。NULLの場合は月額と賞与から算出）
+    base_salary INT,	                        -- 基本給（月額・円）
+    fixed_overtime_pay INT,	                    -- 固定残業代（月額・円）
+    fixed_overtime_hours INT,	                -- 固定残業時間（月）
+    allowance INT,	                            -- 諸手当（月額・円）
+    bonus INT,	                                -- 賞与（年額・円）
+    salary_remarks TEXT NOT NULL,	            -- 給与の備考
+    start_date CHAR(10) NOT NULL,	            -- 入社日（2006-01-02）
+    answer_deadline DATETIME,	                -- 回答期限
+    status INT NOT NULL,	                    -- 状態（0: 提示中, 1: 条件交渉中, 2: 承諾, 3: 辞退, 4: 取り消し）
+    remarks TEXT NOT NULL,	                    -- 備考
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id),
+    UNIQUE uq_job_offers_task_group_id (task_group_id),
+    INDEX idx_job_offers_answer_deadline (status, answer_deadline),
+    FOREIGN KEY(task_group_id) REFERENCES task_groups(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS job_offer_status_histories (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    job_offer_id INT NOT NULL,	                -- 内定ID
+    prev_status INT,	                        -- 変更前の状態（作成時はNULL）
+    status INT NOT NULL,	                    -- 変更後の状態
+    agent_staff_id INT,	                        -- 変更した担当者ID
+    remarks TEXT NOT NULL,	                    -- 変更の理由・備考
+    created_at DATETIME,                        -- 変更日時
+    PRIMARY KEY(id),
+    INDEX idx_job_offer_status_histories_job_offer_id (job_offer_id),
+    FOREIGN KEY(job_offer_id) REFERENCES job_offers(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS job_offer_reminders (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    job_offer_id INT NOT NULL,	                -- 内定ID
+    agent_staff_id INT NOT NULL,	            -- 通知した担当者ID
+    reminder_type INT NOT NULL,	                -- 通知の種類（0: 回答期限の前日, 1: 回答期限切れ）
+    answer_deadline DATETIME NOT NULL,	        -- 通知時点の回答期限（期限が変更された場合は再度通知する）
+    created_at DATETIME,                        -- 通知日時
+    PRIMARY KEY(id),
+    UNIQUE uq_job_offer_reminders (job_offer_id, agent_staff_id, reminder_type, answer_deadline),
+    FOREIGN KEY(job_offer_id) REFERENCES job_offers(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS job_offer_reminders;
+DROP TABLE IF EXISTS job_offer_status_histories;
+DROP TABLE IF EXISTS job_offers;
@@ -0,0 +1,57 @@
+-- 売上の自動作成と返金保証期間の管理
+-- 内定承諾時に求人の手数料と内定の年収から売上の下書きを作成し、RA/CAの売上比率はRA側のエージェントの設定を使用する
+-- 入社日から返金規定の段階（入社から何日以内の退職で何%を返金するか）ごとに保証期間を管理し、退職を記録した場合は売上を減額する
+-- +migrate Up
+ALTER TABLE agents ADD ra_sales_ratio INT NOT NULL DEFAULT 50; -- 自動作成する売上のRAの売上比率（%。CAは100から引いた値）
+
+ALTER TABLE sales ADD is_draft BOOLEAN NOT NULL DEFAULT FALSE; -- 自動作成された未確認の売上か（担当者が更新すると確認済みにする）
+
+CREATE TABLE IF NOT EXISTS agent_refund_tiers (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    agent_id INT NOT NULL,	                    -- エージェントID
+    within_days INT NOT NULL,	                -- 入社から何日以内の退職に適用するか
+    refund_rate INT NOT NULL,	                -- 返金率（%）
+    created_at DATETIME,                        -- 作成日時
+    updated_at DATETIME,                        -- 更新日時
+    PRIMARY KEY(id),
+    UNIQUE uq_agent_refund_tiers (agent_id, within_days),
+    FOREIGN KEY(agent_id) REFERENCES agents(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS sale_refunds (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    sale_id INT NOT NULL,	                    -- 売上ID
+    joining_date CHAR(10) NOT NULL,	            -- 入社日
+    resignation_date CHAR(10) NOT NULL,	        -- 退職日
+    days_employed INT NOT NULL,	                -- 在籍日数
+    refund_rate INT NOT NULL,	                -- 適用した返金率（%。保証期間外の場合は0）
+    original_billing_amount INT,	            -- 減額前の請求金額
+    refund_amount INT,	                        -- 返金額
+    remarks TEXT NOT NULL,	                    -- 備考
+    agent_staff_id INT,	                        -- 記録した担当者ID
+    created_at DATETIME,                        -- 記録日時
+    PRIMARY KEY(id),
+    UNIQUE uq_sale_refunds_sale_id (sale_id),
+    FOREIGN KEY(sale_id) REFERENCES sales(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS sale_guarantee_notifications (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    sale_id INT NOT NULL,	                    -- 売上ID
+    agent_staff_id INT NOT NULL,	            -- 通知した担当者ID
+    within_days INT NOT NULL,	                -- 終了が近い返金規定の段階（入社から何日以内）
+    joining_date CHAR(10) NOT NULL,	            -- 通知時点の入社日（入社日が変更された場合は再度通知する）
+    created_at DATETIME,                        -- 通知日時
+    PRIMARY KEY(id),
+    UNIQUE uq_sale_guarantee_notifications (sale_id, agent_staff_id, within_days, joining_date),
+    FOREIGN KEY(sale_id) REFERENCES sales(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS sale_guarantee_notifications;
+DROP TABLE IF EXISTS sale_refunds;
+DROP TABLE IF EXISTS agent_refund_tiers;
+
+ALTER TABLE sales DROP COLUMN is_draft;
+
+ALTER TABLE agents DROP COLUMN ra_sales_ratio;
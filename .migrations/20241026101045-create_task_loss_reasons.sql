@@ -0,0 +1,24 @@
+-- 辞退・不合格の理由（失注理由）
+-- 辞退・不合格で終了するタスクの作成時に選択した理由を記録し、企業・求人・担当者・フェーズごとの集計に使用する
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS task_loss_reasons (
+    id INT AUTO_INCREMENT NOT NULL UNIQUE,	    -- 重複しないID
+    task_group_id INT NOT NULL,	                -- タスクグループID
+    task_id INT NOT NULL,	                    -- 終了のタスクID
+    phase_category INT NOT NULL,	            -- 終了したフェーズ
+    phase_sub_category INT NOT NULL,	        -- 終了のサブフェーズ
+    loss_type INT NOT NULL,	                    -- 失注の種類（0: 辞退, 1: 不合格・NG）
+    loss_reason INT NOT NULL,	                -- 理由（0〜: 辞退の理由, 100〜: 不合格・NGの理由, 999: その他）
+    remarks TEXT NOT NULL,	                    -- 備考（タスクの備考）
+    agent_staff_id INT,	                        -- 記録した担当者ID
+    created_at DATETIME,                        -- 終了日時
+    PRIMARY KEY(id),
+    UNIQUE uq_task_loss_reasons_task_id (task_id),
+    INDEX idx_task_loss_reasons_task_group_id (task_group_id),
+    INDEX idx_task_loss_reasons_created_at (created_at),
+    FOREIGN KEY(task_group_id) REFERENCES task_groups(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY(task_id) REFERENCES tasks(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS task_loss_reasons;
@@ -0,0 +1,34 @@
+-- 求職者の流入元テーブルの媒体IDをNULL許可にし、媒体IDごとのユニークキーを戻す
+-- 媒体IDがない流入元（LPなど）はNULLで記録し、同じ媒体IDの二重取り込みはユニークキーで防ぐ（NULLは重複とみなされない）
+-- +migrate Up
+ALTER TABLE job_seeker_external_ids
+  MODIFY COLUMN external_id VARCHAR(255); -- 流入元媒体でのID（媒体IDがない場合はNULL）
+
+UPDATE job_seeker_external_ids
+SET external_id = NULL
+WHERE external_id = '';
+
+-- ユニークキーがない間に二重に取り込まれた媒体IDは、最初に記録したもののみ残す
+DELETE duplicate
+FROM job_seeker_external_ids AS duplicate
+INNER JOIN job_seeker_external_ids AS original
+  ON original.agent_id = duplicate.agent_id
+  AND original.external_type = duplicate.external_type
+  AND original.external_id = duplicate.external_id
+  AND original.id < duplicate.id;
+
+ALTER TABLE job_seeker_external_ids
+  DROP INDEX idx_job_seeker_external_ids_agent_id_external,
+  ADD UNIQUE uq_job_seeker_external_ids_agent_id_external (agent_id, external_type, external_id);
+
+-- +migrate Down
+ALTER TABLE job_seeker_external_ids
+  DROP INDEX uq_job_seeker_external_ids_agent_id_external,
+  ADD INDEX idx_job_seeker_external_ids_agent_id_external (agent_id, external_type, external_id);
+
+UPDATE job_seeker_external_ids
+SET external_id = ''
+WHERE external_id IS NULL;
+
+ALTER TABLE job_seeker_external_ids
+  MODIFY COLUMN external_id VARCHAR(255) NOT NULL;
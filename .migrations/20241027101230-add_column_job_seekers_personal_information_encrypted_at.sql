@@ -0,0 +1,7 @@
+-- 暗号化前に登録された求職者の個人情報を移行したかを管理するための変更
+-- 氏名・フリガナが空白のみなどでブラインドインデックスを作成できない求職者も、移行済みとして移行バッチの対象から外す
+-- +migrate Up
+ALTER TABLE job_seekers ADD personal_information_encrypted_at DATETIME; -- 移行バッチで個人情報を暗号化した日時（暗号化後に登録・移行前の場合はNULL）
+
+-- +migrate Down
+ALTER TABLE job_seekers DROP COLUMN personal_information_encrypted_at;
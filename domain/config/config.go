@@ -5,15 +5,21 @@ import (
 )
 
 type Config struct {
-	App       App       `required:"true" envconfig:"APP"`
-	DB        DB        `required:"true" envconfig:"DB"`
-	Firebase  Firebase  `required:"true" envconfig:"FIREBASE"`
-	Sendgrid  Sendgrid  `required:"true" envconfig:"SENDGRID"`
-	Slack     Slack     `required:"true" envconfig:"SLACK"`
-	OneSignal OneSignal `required:"true" envconfig:"ONESIGNAL"`
-	RPA       RPA       `required:"false" envconfig:"RPA"`
-	GoogleAPI GoogleAPI `required:"false" envconfig:"GOOGLEAPI"`
-	Email     Email     `required:"false" envconfig:"EMAIL"`
+	App        App        `required:"true" envconfig:"APP"`
+	DB         DB         `required:"true" envconfig:"DB"`
+	Firebase   Firebase   `required:"true" envconfig:"FIREBASE"`
+	Sendgrid   Sendgrid   `required:"true" envconfig:"SENDGRID"`
+	Slack      Slack      `required:"true" envconfig:"SLACK"`
+	OneSignal  OneSignal  `required:"true" envconfig:"ONESIGNAL"`
+	RPA        RPA        `required:"false" envconfig:"RPA"`
+	GoogleAPI  GoogleAPI  `required:"false" envconfig:"GOOGLEAPI"`
+	Email      Email      `required:"false" envconfig:"EMAIL"`
+	Encryption Encryption `required:"false" envconfig:"ENCRYPTION"`
+	Retention  Retention  `required:"false" envconfig:"RETENTION"`
+	GuestLink  GuestLink  `required:"false" envconfig:"GUEST_LINK"`
+	Captcha    Captcha    `required:"false" envconfig:"CAPTCHA"`
+	Admin      Admin      `required:"false" envconfig:"ADMIN"`
+	SLA        SLA        `required:"false" envconfig:"SLA"`
 }
 
 func New() (Config, error) {
@@ -26,13 +32,15 @@ func New() (Config, error) {
 }
 
 type App struct {
-	Env            string   `required:"true" split_words:"true"`
-	Service        string   `required:"true" split_words:"true"`
-	BatchType      string   `required:"true" split_words:"true"`
-	Port           int      `required:"true" split_words:"true"`
-	BasicUsers     []string `required:"true" split_words:"true"`
-	BasicPasswords []string `required:"true" split_words:"true"`
-	CorsDomains    []string `required:"true" split_words:"true"`
+	Env         string   `required:"true" split_words:"true"`
+	Service     string   `required:"true" split_words:"true"`
+	BatchType   string   `required:"true" split_words:"true"`
+	Port        int      `required:"true" split_words:"true"`
+	CorsDomains []string `required:"true" split_words:"true"`
+
+	// X-Forwarded-For を信頼するロードバランサー・プロキシのIP範囲（CIDR形式のカンマ区切り）
+	// 未設定の場合はヘッダーを使わず接続元のIPアドレスをクライアントのIPアドレスとする（ロードバランサー配下の dev・prd では必須）
+	TrustedProxies []string `required:"false" split_words:"true"`
 }
 
 type DB struct {
@@ -80,3 +88,46 @@ type Email struct {
 	lAddress string `required:"true" split_words:"true"`
 	Password string `required:"true" split_words:"true"`
 }
+
+// 暗号化の鍵は utility.LoadEncryptionKeyRing で ENCRYPTION_KEY_V{n} から読み込む
+type Encryption struct {
+	RotationBatchSize uint `required:"false" split_words:"true"` // 鍵の切り替え時に1回で再暗号化する件数
+}
+
+// 個人情報の保存期間
+type Retention struct {
+	JobSeekerYears uint `required:"false" split_words:"true"` // 最終更新から指定年数が経過した求職者を匿名化する（0の場合は匿名化しない）
+}
+
+// 企業・求職者に送るゲスト用リンク
+type GuestLink struct {
+	Secret       string `required:"false" split_words:"true"` // トークンの署名に使う鍵（未設定の場合はリンクを発行できない）
+	ExpireDays   uint   `required:"false" split_words:"true"` // 有効期限を指定しない場合の日数（0の場合は30日）
+	LegacyUntil  string `required:"false" split_words:"true"` // UUIDのみのリンクでログインできる最終日（2006-01-02形式、日本時間。APIの起動時に必須）
+	SessionHours uint   `required:"false" split_words:"true"` // ログイン後のゲスト用APIのトークンの有効時間（0の場合は12時間。リンクの有効期限を超えない）
+}
+
+// ログイン試行が続いた場合に求めるCAPTCHA（reCAPTCHA・Turnstileなどsiteverify形式のAPI）
+type Captcha struct {
+	SecretKey string `required:"false" split_words:"true"` // 未設定の場合は検証を行わない
+	VerifyURL string `required:"false" split_words:"true"` // 検証APIのURL
+}
+
+// 管理者サイトのログイン
+type Admin struct {
+	SessionIdleMinutes uint `required:"false" split_words:"true"` // 操作がない場合にログアウトするまでの分数（0の場合は30分）
+	SessionMaxHours    uint `required:"false" split_words:"true"` // ログインから強制的にログアウトするまでの時間数（0の場合は8時間）
+
+	// service=create_admin_user で作成する管理者
+	LoginID  string `required:"false" split_words:"true"`
+	Name     string `required:"false" split_words:"true"`
+	Password string `required:"false" split_words:"true"`
+}
+
+// タスクの期限（SLA）の判定に使う営業時間（日本時間）
+type SLA struct {
+	BusinessStartHour uint     `required:"false" split_words:"true"` // 営業開始時刻（0の場合は9時）
+	BusinessEndHour   uint     `required:"false" split_words:"true"` // 営業終了時刻（0の場合は18時）
+	Holidays          []string `required:"false" split_words:"true"` // 土日以外の休業日（2006-01-02形式のカンマ区切り）
+	DigestAt          string   `required:"false" split_words:"true"` // 日次まとめを送る時刻（15:04形式。未設定の場合は08:00）
+}
@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// 管理者サイトの管理者
+type AdminUser struct {
+	ID            uint      `db:"id" json:"id"`
+	LoginID       string    `db:"login_id" json:"login_id"`
+	Name          string    `db:"name" json:"name"`
+	Password      string    `db:"password" json:"-"`    // bcrypt
+	TOTPSecret    string    `db:"totp_secret" json:"-"` // 暗号化して保存
+	TOTPEnabledAt null.Time `db:"totp_enabled_at" json:"totp_enabled_at"`
+	TOTPLastStep  int64     `db:"totp_last_step" json:"-"`
+	LastLoginAt   null.Time `db:"last_login_at" json:"last_login_at"`
+	IsDeleted     bool      `db:"is_deleted" json:"is_deleted"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func NewAdminUser(
+	loginID string,
+	name string,
+	password string,
+) *AdminUser {
+	return &AdminUser{
+		LoginID:  loginID,
+		Name:     name,
+		Password: password,
+	}
+}
+
+// 管理者サイトのログインセッション
+// パスワード認証の後、二要素認証を完了するまでは二要素認証のAPIのみ利用できる
+type AdminSession struct {
+	ID             uint      `db:"id" json:"id"`
+	AdminUserID    uint      `db:"admin_user_id" json:"admin_user_id"`
+	TokenHash      string    `db:"token_hash" json:"-"`
+	MFAVerifiedAt  null.Time `db:"mfa_verified_at" json:"mfa_verified_at"`
+	MFAFailedCount uint      `db:"mfa_failed_count" json:"mfa_failed_count"`
+	ExpiresAt      time.Time `db:"expires_at" json:"expires_at"`
+	RevokedAt      null.Time `db:"revoked_at" json:"revoked_at"`
+	IPAddress      string    `db:"ip_address" json:"ip_address"`
+	UserAgent      string    `db:"user_agent" json:"user_agent"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func NewAdminSession(
+	adminUserID uint,
+	tokenHash string,
+	expiresAt time.Time,
+	ipAddress string,
+	userAgent string,
+) *AdminSession {
+	return &AdminSession{
+		AdminUserID: adminUserID,
+		TokenHash:   tokenHash,
+		ExpiresAt:   expiresAt,
+		IPAddress:   ipAddress,
+		UserAgent:   userAgent,
+	}
+}
+
+// 管理者サイトのログイン後に必要な操作
+const (
+	AdminMFAStatusEnrollmentRequired   = "enrollment_required"   // 二要素認証の登録が必要
+	AdminMFAStatusVerificationRequired = "verification_required" // ワンタイムパスワードの入力が必要
+	AdminMFAStatusVerified             = "verified"              // 二要素認証済み
+)
+
+// 管理者の操作ログ（追記のみ）
+type AdminActionLog struct {
+	ID          uint      `db:"id" json:"id"`
+	AdminUserID uint      `db:"admin_user_id" json:"admin_user_id"`
+	Method      string    `db:"method" json:"method"`
+	Path        string    `db:"path" json:"path"`
+	URI         string    `db:"uri" json:"uri"`
+	RequestBody string    `db:"request_body" json:"request_body"`
+	StatusCode  int       `db:"status_code" json:"status_code"`
+	IPAddress   string    `db:"ip_address" json:"ip_address"`
+	UserAgent   string    `db:"user_agent" json:"user_agent"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+
+	// 他テーブル
+	AdminUserName string `db:"admin_user_name" json:"admin_user_name"`
+}
+
+func NewAdminActionLog(
+	adminUserID uint,
+	method string,
+	path string,
+	uri string,
+	requestBody string,
+	statusCode int,
+	ipAddress string,
+	userAgent string,
+) *AdminActionLog {
+	return &AdminActionLog{
+		AdminUserID: adminUserID,
+		Method:      method,
+		Path:        path,
+		URI:         uri,
+		RequestBody: requestBody,
+		StatusCode:  statusCode,
+		IPAddress:   ipAddress,
+		UserAgent:   userAgent,
+	}
+}
+
+// 管理者サイトのリクエスト情報
+type AdminAccess struct {
+	IPAddress string
+	UserAgent string
+}
+
+// 管理者の作成 body
+type CreateAdminUserParam struct {
+	LoginID  string `json:"login_id" validate:"required"`
+	Name     string `json:"name" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+// 管理者の操作ログの1ページの件数
+const AdminActionLogPageSize = 100
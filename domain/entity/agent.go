@@ -32,6 +32,8 @@ type Agent struct {
 	IsAllianceActive                bool      `db:"is_alliance_active" json:"is_alliance_active"`                                   // アライアンス機能の有無
 	IsSendingActive                 bool      `db:"is_sending_active" json:"is_sending_active"`                                     // 送客機能の有無
 	SendingType                     null.Int  `db:"sending_type" json:"sending_type"`                                               // 送客のタイプ（0: 通常, 1: 送客管理(アンドイーズ仕様)）
+	DuplicateRecommendationDays     uint      `db:"duplicate_recommendation_days" json:"duplicate_recommendation_days"`             // 重複推薦を確認する期間（日数。0の場合は確認しない）
+	RASalesRatio                    uint      `db:"ra_sales_ratio" json:"ra_sales_ratio"`                                           // 自動作成する売上のRAの売上比率（%。CAは100から引いた値）
 	CreatedAt                       time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt                       time.Time `db:"updated_at" json:"updated_at"`
 
@@ -117,6 +119,12 @@ type CreateOrUpdateAgentParam struct {
 }
 
 // 同意書ファイルのURLを更新
+// 重複推薦を確認する期間の更新
+type AgentDuplicateRecommendationParam struct {
+	AgentID                     uint `json:"agent_id" validate:"required"`
+	DuplicateRecommendationDays uint `json:"duplicate_recommendation_days" validate:"lte=730"` // 0の場合は確認しない
+}
+
 type AgentAgreementFileURLParam struct {
 	AgentID                 uint   `db:"agent_id" json:"agent_id" validate:"required"` // エージェントID
 	AgreementFileURL        string `json:"agreement_file_url"`                         // 同意書ファイルのURL
@@ -0,0 +1,178 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// 新規エントリーした求職者の担当CAを自動で割り振るルール（エージェントごとに1件）
+type AgentAssignmentRule struct {
+	ID                  uint      `db:"id" json:"id"`
+	AgentID             uint      `db:"agent_id" json:"agent_id"`
+	AssignmentType      null.Int  `db:"assignment_type" json:"assignment_type"`               // 割り振り方法
+	IsWorkingHoursAware bool      `db:"is_working_hours_aware" json:"is_working_hours_aware"` // 勤務時間外の担当者を除外するか
+	IsActive            bool      `db:"is_active" json:"is_active"`                           // 自動割り振りの有効/無効
+	LastAssignedStaffID null.Int  `db:"last_assigned_staff_id" json:"last_assigned_staff_id"` // 最後に割り振った担当者ID（ラウンドロビン用）
+	CreatedAt           time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt           time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	StaffList []*AgentAssignmentStaff `json:"staff_list"`
+}
+
+func NewAgentAssignmentRule(
+	agentID uint,
+	assignmentType null.Int,
+	isWorkingHoursAware bool,
+	isActive bool,
+) *AgentAssignmentRule {
+	return &AgentAssignmentRule{
+		AgentID:             agentID,
+		AssignmentType:      assignmentType,
+		IsWorkingHoursAware: isWorkingHoursAware,
+		IsActive:            isActive,
+	}
+}
+
+// 自動割り振りの対象となる担当者
+type AgentAssignmentStaff struct {
+	ID               uint      `db:"id" json:"id"`
+	AgentID          uint      `db:"agent_id" json:"agent_id"`
+	AgentStaffID     uint      `db:"agent_staff_id" json:"agent_staff_id"`
+	WorkingStartTime string    `db:"working_start_time" json:"working_start_time"` // 勤務開始時刻（例: 09:00）
+	WorkingEndTime   string    `db:"working_end_time" json:"working_end_time"`     // 勤務終了時刻（例: 18:00）
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	StaffName   string     `db:"staff_name" json:"staff_name"`
+	Occupations []null.Int `json:"occupations"` // 得意な職種
+	Prefectures []null.Int `json:"prefectures"` // 得意なエリア
+}
+
+func NewAgentAssignmentStaff(
+	agentID uint,
+	agentStaffID uint,
+	workingStartTime string,
+	workingEndTime string,
+) *AgentAssignmentStaff {
+	return &AgentAssignmentStaff{
+		AgentID:          agentID,
+		AgentStaffID:     agentStaffID,
+		WorkingStartTime: workingStartTime,
+		WorkingEndTime:   workingEndTime,
+	}
+}
+
+// 担当者の得意分野（職種・エリア）
+type AgentAssignmentStaffSpecialty struct {
+	ID                uint      `db:"id" json:"id"`
+	AssignmentStaffID uint      `db:"assignment_staff_id" json:"assignment_staff_id"`
+	SpecialtyType     null.Int  `db:"specialty_type" json:"specialty_type"` // 0: 職種, 1: 都道府県
+	Value             null.Int  `db:"value" json:"value"`
+	CreatedAt         time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func NewAgentAssignmentStaffSpecialty(
+	assignmentStaffID uint,
+	specialtyType null.Int,
+	value null.Int,
+) *AgentAssignmentStaffSpecialty {
+	return &AgentAssignmentStaffSpecialty{
+		AssignmentStaffID: assignmentStaffID,
+		SpecialtyType:     specialtyType,
+		Value:             value,
+	}
+}
+
+// 担当者ごとの未完了タスク数（未完了の面談調整を抱えている求職者数）
+type AgentStaffOpenTaskCount struct {
+	AgentStaffID uint `db:"agent_staff_id" json:"agent_staff_id"`
+	Count        uint `db:"count" json:"count"`
+}
+
+// 割り振り方法
+const (
+	AssignmentTypeRoundRobin    int64 = iota // ラウンドロビン
+	AssignmentTypeLeastOpenTask              // 未完了タスクが最も少ない担当者
+	AssignmentTypeSpecialty                  // 希望職種・都道府県が得意分野に一致する担当者（同数の場合は未完了タスクが少ない担当者）
+)
+
+// 手動で担当者を変更した場合の割り振り方法
+const AssignmentTypeOverride int64 = 99
+
+var AssignmentTypeLabel = map[int64]string{
+	AssignmentTypeRoundRobin:    "ラウンドロビン",
+	AssignmentTypeLeastOpenTask: "未完了タスク数",
+	AssignmentTypeSpecialty:     "得意分野",
+	AssignmentTypeOverride:      "手動変更",
+}
+
+// 得意分野の種類
+const (
+	AssignmentSpecialtyTypeOccupation int64 = iota // 職種
+	AssignmentSpecialtyTypePrefecture              // 都道府県
+)
+
+type UpdateAgentAssignmentRuleParam struct {
+	AgentID             uint                              `json:"agent_id" validate:"required"`
+	AssignmentType      null.Int                          `json:"assignment_type" validate:"required"`
+	IsWorkingHoursAware bool                              `json:"is_working_hours_aware"`
+	IsActive            bool                              `json:"is_active"`
+	StaffList           []UpdateAgentAssignmentStaffParam `json:"staff_list" validate:"dive"`
+}
+
+type UpdateAgentAssignmentStaffParam struct {
+	AgentStaffID     uint       `json:"agent_staff_id" validate:"required"`
+	WorkingStartTime string     `json:"working_start_time" validate:"required"` // 09:00
+	WorkingEndTime   string     `json:"working_end_time" validate:"required"`   // 18:00
+	Occupations      []null.Int `json:"occupations"`
+	Prefectures      []null.Int `json:"prefectures"`
+}
+
+// 求職者の担当CAの割り振り履歴（自動割り振り・手動変更）
+type JobSeekerAssignmentLog struct {
+	ID                   uint      `db:"id" json:"id"`
+	AgentID              uint      `db:"agent_id" json:"agent_id"`
+	JobSeekerID          uint      `db:"job_seeker_id" json:"job_seeker_id"`
+	AgentStaffID         uint      `db:"agent_staff_id" json:"agent_staff_id"`                   // 割り振られた担当者ID
+	PreviousAgentStaffID null.Int  `db:"previous_agent_staff_id" json:"previous_agent_staff_id"` // 変更前の担当者ID
+	AssignmentType       null.Int  `db:"assignment_type" json:"assignment_type"`                 // 割り振り方法
+	OperatedStaffID      null.Int  `db:"operated_staff_id" json:"operated_staff_id"`             // 手動変更した担当者ID
+	Reason               string    `db:"reason" json:"reason"`
+	CreatedAt            time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt            time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	StaffName         string `db:"staff_name" json:"staff_name"`
+	PreviousStaffName string `db:"previous_staff_name" json:"previous_staff_name"`
+	OperatedStaffName string `db:"operated_staff_name" json:"operated_staff_name"`
+}
+
+func NewJobSeekerAssignmentLog(
+	agentID uint,
+	jobSeekerID uint,
+	agentStaffID uint,
+	previousAgentStaffID null.Int,
+	assignmentType null.Int,
+	operatedStaffID null.Int,
+	reason string,
+) *JobSeekerAssignmentLog {
+	return &JobSeekerAssignmentLog{
+		AgentID:              agentID,
+		JobSeekerID:          jobSeekerID,
+		AgentStaffID:         agentStaffID,
+		PreviousAgentStaffID: previousAgentStaffID,
+		AssignmentType:       assignmentType,
+		OperatedStaffID:      operatedStaffID,
+		Reason:               reason,
+	}
+}
+
+type OverrideJobSeekerAssignmentParam struct {
+	JobSeekerID  uint   `json:"job_seeker_id" validate:"required"`
+	AgentStaffID uint   `json:"agent_staff_id" validate:"required"`
+	Reason       string `json:"reason"`
+}
@@ -22,9 +22,12 @@ type AgentStaff struct {
 	Position                        string    `db:"position" json:"position"`
 	Remarks                         string    `db:"remarks" json:"remarks"`
 	UsageStatus                     null.Int  `db:"usage_status" json:"usage_status"`
-	Notification                    null.Int  `db:"notification" json:"notification"`                       // 未使用
-	NotificationJobSeeker           bool      `db:"notification_job_seeker" json:"notification_job_seeker"` // メール通知（求職者）
-	NotificationUnwatched           bool      `db:"notification_unwatched" json:"notification_unwatched"`   // メール通知（未処理・未読）
+	Notification                    null.Int  `db:"notification" json:"notification"`                           // 未使用
+	NotificationJobSeeker           bool      `db:"notification_job_seeker" json:"notification_job_seeker"`     // メール通知（求職者）
+	NotificationUnwatched           bool      `db:"notification_unwatched" json:"notification_unwatched"`       // メール通知（未処理・未読）
+	NotificationSLAReminder         bool      `db:"notification_sla_reminder" json:"notification_sla_reminder"` // メール通知（タスクの期限のリマインド・期限切れ・エスカレーション）
+	NotificationSLADigest           bool      `db:"notification_sla_digest" json:"notification_sla_digest"`     // メール通知（タスクの期限の日次まとめ）
+	ManagerStaffID                  null.Int  `db:"manager_staff_id" json:"manager_staff_id"`                   // 期限切れのタスクをエスカレーションする上長（nullの場合は自社の管理者）
 	LastLogin                       time.Time `db:"last_login" json:"last_login"`
 	UsageStartDate                  time.Time `db:"usage_start_date" json:"usage_start_date"`
 	UsageEndDate                    time.Time `db:"usage_end_date" json:"usage_end_date"`
@@ -149,6 +152,14 @@ type UpdateAgentStaffNotificationUnwatchedParam struct {
 	NotificationUnwatched bool `json:"notification_unwatched"`
 }
 
+// タスクの期限（SLA）に関する通知設定の更新 body: {agent_staff_id, manager_staff_id, notification_sla_reminder, notification_sla_digest}
+type UpdateAgentStaffSLASettingParam struct {
+	AgentStaffID            uint     `json:"agent_staff_id" validate:"required"`
+	ManagerStaffID          null.Int `json:"manager_staff_id"`
+	NotificationSLAReminder bool     `json:"notification_sla_reminder"`
+	NotificationSLADigest   bool     `json:"notification_sla_digest"`
+}
+
 type UpdateAgentStaffAuthorityParam struct {
 	AgentStaffID uint `json:"agent_staff_id" validate:"required"`
 	Authority    uint `json:"authority"`
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 外部連携用のAPIキー（エージェント単位で発行し、キー自体はハッシュのみ保存する）
+type APIKey struct {
+	ID             uint        `db:"id" json:"id"`
+	UUID           uuid.UUID   `db:"uuid" json:"uuid"`
+	AgentID        uint        `db:"agent_id" json:"agent_id"`
+	AgentStaffID   uint        `db:"agent_staff_id" json:"agent_staff_id"` // 発行した担当者（APIキーでの操作はこの担当者として扱う）
+	Name           string      `db:"name" json:"name"`
+	KeyPrefix      string      `db:"key_prefix" json:"key_prefix"`
+	KeyHash        string      `db:"key_hash" json:"-"`
+	Scopes         string      `db:"scopes" json:"scopes"` // 許可する操作（カンマ区切り）
+	ExpiresAt      time.Time   `db:"expires_at" json:"expires_at"`
+	LastUsedAt     null.Time   `db:"last_used_at" json:"last_used_at"`
+	LastUsedIP     null.String `db:"last_used_ip" json:"last_used_ip"`
+	RevokedAt      null.Time   `db:"revoked_at" json:"revoked_at"`
+	RevokedStaffID null.Int    `db:"revoked_staff_id" json:"revoked_staff_id"`
+	CreatedAt      time.Time   `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time   `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	StaffName string `db:"staff_name" json:"staff_name"`
+
+	// 発行時のみ返す
+	Key string `db:"-" json:"key,omitempty"`
+}
+
+func NewAPIKey(
+	agentID uint,
+	agentStaffID uint,
+	name string,
+	keyPrefix string,
+	keyHash string,
+	scopes string,
+	expiresAt time.Time,
+) *APIKey {
+	return &APIKey{
+		AgentID:      agentID,
+		AgentStaffID: agentStaffID,
+		Name:         name,
+		KeyPrefix:    keyPrefix,
+		KeyHash:      keyHash,
+		Scopes:       scopes,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+// 許可する操作の一覧
+func (k *APIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return []string{}
+	}
+	return strings.Split(k.Scopes, ",")
+}
+
+const (
+	APIKeyScopeJobSeekerRead  = "job_seeker:read"  // 求職者の参照
+	APIKeyScopeEntryWrite     = "entry:write"      // エントリーの登録
+	APIKeyScopeSaleRead       = "sale:read"        // 売上の参照
+	APIKeyScopeGmailHookWrite = "gmail_hook:write" // Gmailの受信通知（スカウトサービスの返信取り込み）
+)
+
+// APIキーの有効期限の上限（日数）
+const APIKeyMaxExpireDays = 365
+
+// APIキーの発行 body
+type CreateAPIKeyParam struct {
+	Name       string   `json:"name" validate:"required"`
+	Scopes     []string `json:"scopes" validate:"required"`
+	ExpireDays uint     `json:"expire_days"` // 0の場合は90日
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"time"
+)
+
+// 求職者の個人情報・売上・担当者権限に対する参照・変更の監査ログ（追記のみ）
+type AuditLog struct {
+	ID           uint      `db:"id" json:"id"`
+	AgentID      uint      `db:"agent_id" json:"agent_id"`
+	AgentStaffID uint      `db:"agent_staff_id" json:"agent_staff_id"`
+	Action       uint      `db:"action" json:"action"`           // 操作（0: 参照, 1: 作成, 2: 更新, 3: 削除）
+	EntityType   string    `db:"entity_type" json:"entity_type"` // 対象（job_seeker, job_seeker_document, sale, agent_staff）
+	EntityID     uint      `db:"entity_id" json:"entity_id"`     // 対象のID（作成時に特定できない場合は0）
+	Diff         string    `db:"diff" json:"diff"`               // 項目ごとの変更前後の値（AuditLogFieldDiffのJSON配列）
+	Method       string    `db:"method" json:"method"`
+	Path         string    `db:"path" json:"path"`
+	IPAddress    string    `db:"ip_address" json:"ip_address"`
+	UserAgent    string    `db:"user_agent" json:"user_agent"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+
+	// 他テーブル
+	StaffName string `db:"staff_name" json:"staff_name"`
+}
+
+func NewAuditLog(
+	agentID uint,
+	agentStaffID uint,
+	action uint,
+	entityType string,
+	entityID uint,
+	diff string,
+	method string,
+	path string,
+	ipAddress string,
+	userAgent string,
+) *AuditLog {
+	return &AuditLog{
+		AgentID:      agentID,
+		AgentStaffID: agentStaffID,
+		Action:       action,
+		EntityType:   entityType,
+		EntityID:     entityID,
+		Diff:         diff,
+		Method:       method,
+		Path:         path,
+		IPAddress:    ipAddress,
+		UserAgent:    userAgent,
+	}
+}
+
+const (
+	AuditLogActionRead      uint = iota // 参照
+	AuditLogActionCreate                // 作成
+	AuditLogActionUpdate                // 更新
+	AuditLogActionDelete                // 削除
+	AuditLogActionExport                // 個人情報の開示（出力）
+	AuditLogActionAnonymize             // 個人情報の削除（匿名化）
+)
+
+const (
+	AuditLogEntityJobSeeker         = "job_seeker"          // 求職者
+	AuditLogEntityJobSeekerDocument = "job_seeker_document" // 求職者の書類
+	AuditLogEntitySale              = "sale"                // 売上
+	AuditLogEntityAgentStaff        = "agent_staff"         // 担当者（権限変更・削除）
+)
+
+// 項目ごとの変更前後の値
+type AuditLogFieldDiff struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// 監査ログの検索条件
+type SearchAuditLogParam struct {
+	AgentID      uint      // ログイン中の担当者のエージェントID（自社のログのみ検索する）
+	AgentStaffID uint      // 操作した担当者（0の場合は全員）
+	Action       string    // 操作（空の場合は全て、repositoryの絞り込み時に数値に変換）
+	EntityType   string    // 対象（空の場合は全て）
+	EntityID     uint      // 対象のID（0の場合は全て）
+	FromDate     time.Time // 期間（開始）
+	ToDate       time.Time // 期間（終了）
+	PageNumber   uint
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"time"
+)
+
+// 重複推薦の確認
+// 同じ人物を同じ企業へ、自社とアライアンス先から、または同じ企業の別求人で重複して推薦しないよう、推薦前に既存の選考を確認する
+// 別エージェントの求職者は氏名（空白を除く）と生年月日、または電話番号（ブラインドインデックス）で同一人物とみなす
+
+// 重複の可能性がある既存の選考
+type DuplicateRecommendation struct {
+	TaskGroupID      uint      `db:"task_group_id" json:"task_group_id"` // 同じ依頼内の重複の場合は0
+	JobSeekerID      uint      `db:"job_seeker_id" json:"job_seeker_id"`
+	JobInformationID uint      `db:"job_information_id" json:"job_information_id"`
+	Title            string    `db:"title" json:"title"`
+	CompanyName      string    `db:"company_name" json:"company_name"`
+	CAAgentID        uint      `db:"ca_agent_id" json:"ca_agent_id"`
+	CAAgentName      string    `db:"ca_agent_name" json:"ca_agent_name"`
+	RAAgentID        uint      `db:"ra_agent_id" json:"ra_agent_id"`
+	PhaseCategory    int64     `db:"phase_category" json:"phase_category"`
+	PhaseSubCategory int64     `db:"phase_sub_category" json:"phase_sub_category"`
+	MatchType        uint      `db:"match_type" json:"match_type"`
+	EntryAt          time.Time `db:"entry_at" json:"entry_at"`
+
+	PhaseName     string `db:"-" json:"phase_name"`
+	PhaseSubName  string `db:"-" json:"phase_sub_name"`
+	MatchTypeName string `db:"-" json:"match_type_name"`
+}
+
+const (
+	DuplicateRecommendationMatchJobSeeker       uint = iota // 同じ求職者
+	DuplicateRecommendationMatchNameAndBirthday             // 氏名と生年月日が一致
+	DuplicateRecommendationMatchPhoneNumber                 // 電話番号が一致
+	DuplicateRecommendationMatchSameRequest                 // 同じ依頼内で同じ企業の別求人
+)
+
+var DuplicateRecommendationMatchTypeName = map[uint]string{
+	DuplicateRecommendationMatchJobSeeker:       "同じ求職者",
+	DuplicateRecommendationMatchNameAndBirthday: "氏名・生年月日が一致",
+	DuplicateRecommendationMatchPhoneNumber:     "電話番号が一致",
+	DuplicateRecommendationMatchSameRequest:     "同じ依頼内の同じ企業の求人",
+}
+
+// 重複の検索条件
+type SearchDuplicateRecommendationParam struct {
+	JobSeekerID        uint      // 推薦する求職者
+	EnterpriseID       uint      // 推薦先の企業
+	Since              time.Time // この日時以降にエントリーした選考を対象とする
+	ExcludeTaskGroupID uint      // 推薦中の選考（既存の選考を進める場合に除外する）
+}
+
+// 推薦の対象（求職者×求人）
+type DuplicateRecommendationTarget struct {
+	JobSeekerID      uint `json:"job_seeker_id" validate:"required"`
+	JobInformationID uint `json:"job_information_id" validate:"required"`
+}
+
+// 推薦の対象ごとの重複の確認結果
+type DuplicateRecommendationResult struct {
+	JobSeekerID      uint                       `json:"job_seeker_id"`
+	JobInformationID uint                       `json:"job_information_id"`
+	DuplicateList    []*DuplicateRecommendation `json:"duplicate_list"`
+}
+
+// 重複の確認（推薦前の画面表示用）
+type CheckDuplicateRecommendationParam struct {
+	TaskGroupID uint                            `json:"task_group_id"` // 既存の選考を進める場合に指定
+	GroupList   []DuplicateRecommendationTarget `json:"group_list" validate:"required,dive"`
+}
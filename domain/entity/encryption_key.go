@@ -0,0 +1,37 @@
+package entity
+
+// 暗号化して保存している項目
+type EncryptedColumn struct {
+	Table  string
+	Column string
+
+	// true: 鍵のバージョンがない値も ENCRYPTION_KEY で暗号化済み（外部サービスのパスワード）
+	// false: 鍵のバージョンがない値は暗号化前の平文（求職者の個人情報、暗号化はバッチで行う）
+	IsLegacyEncrypted bool
+}
+
+// 鍵の切り替え時に再暗号化する項目
+var EncryptedColumnList = []EncryptedColumn{
+	{Table: "scout_services", Column: "password", IsLegacyEncrypted: true},
+	{Table: "initial_enterprise_importers", Column: "password", IsLegacyEncrypted: true},
+	{Table: "admin_users", Column: "totp_secret", IsLegacyEncrypted: true},
+	{Table: "job_seekers", Column: "phone_number", IsLegacyEncrypted: false},
+	{Table: "job_seekers", Column: "email", IsLegacyEncrypted: false},
+	{Table: "job_seekers", Column: "address", IsLegacyEncrypted: false},
+	{Table: "job_seekers", Column: "secret_memo", IsLegacyEncrypted: false},
+	{Table: "job_seekers", Column: "medical_history_remarks", IsLegacyEncrypted: false},
+}
+
+// 再暗号化の対象の値
+type EncryptedValue struct {
+	ID    uint   `db:"id" json:"id"`
+	Value string `db:"value" json:"value"`
+}
+
+// 鍵の切り替え結果（項目ごとの再暗号化件数）
+type EncryptionKeyRotationResult struct {
+	Table        string `json:"table"`
+	Column       string `json:"column"`
+	RotatedCount uint   `json:"rotated_count"`
+	FailedCount  uint   `json:"failed_count"`
+}
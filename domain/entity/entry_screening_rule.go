@@ -0,0 +1,138 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// 新規エントリーした求職者を取り込み時に選考するルール
+// 条件をすべて満たした求職者にタグを付け、フェーズ・ユーザーステータスを変更する
+type EntryScreeningRule struct {
+	ID                        uint      `db:"id" json:"id"`
+	AgentID                   uint      `db:"agent_id" json:"agent_id"`
+	Title                     string    `db:"title" json:"title"`
+	Tag                       string    `db:"tag" json:"tag"`                                                   // 該当した求職者に付けるタグ
+	ResultPhase               null.Int  `db:"result_phase" json:"result_phase"`                                 // 該当した求職者にセットするフェーズ
+	ResultUserStatus          null.Int  `db:"result_user_status" json:"result_user_status"`                     // 該当した求職者にセットするユーザーステータス
+	IsSkipInterviewAdjustment bool      `db:"is_skip_interview_adjustment" json:"is_skip_interview_adjustment"` // 面談調整を行わないか
+	IsActive                  bool      `db:"is_active" json:"is_active"`
+	IsDeleted                 bool      `db:"is_deleted" json:"is_deleted"`
+	CreatedAt                 time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt                 time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	ConditionList []*EntryScreeningRuleCondition `json:"condition_list"`
+	HitCount      uint                           `json:"hit_count"` // 該当した求職者の件数
+}
+
+func NewEntryScreeningRule(
+	agentID uint,
+	title string,
+	tag string,
+	resultPhase null.Int,
+	resultUserStatus null.Int,
+	isSkipInterviewAdjustment bool,
+	isActive bool,
+) *EntryScreeningRule {
+	return &EntryScreeningRule{
+		AgentID:                   agentID,
+		Title:                     title,
+		Tag:                       tag,
+		ResultPhase:               resultPhase,
+		ResultUserStatus:          resultUserStatus,
+		IsSkipInterviewAdjustment: isSkipInterviewAdjustment,
+		IsActive:                  isActive,
+	}
+}
+
+// 選考ルールの条件
+// 同じ項目の「一致」条件はいずれかに一致すれば満たしたものとする（例: 都道府県が北海道 or 沖縄）
+type EntryScreeningRuleCondition struct {
+	ID        uint      `db:"id" json:"id"`
+	RuleID    uint      `db:"rule_id" json:"rule_id"`
+	Target    null.Int  `db:"target" json:"target"`     // 判定する項目
+	Operator  null.Int  `db:"operator" json:"operator"` // 比較方法
+	Value     null.Int  `db:"value" json:"value"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func NewEntryScreeningRuleCondition(
+	ruleID uint,
+	target null.Int,
+	operator null.Int,
+	value null.Int,
+) *EntryScreeningRuleCondition {
+	return &EntryScreeningRuleCondition{
+		RuleID:   ruleID,
+		Target:   target,
+		Operator: operator,
+		Value:    value,
+	}
+}
+
+// 選考ルールに該当した求職者
+type EntryScreeningResult struct {
+	ID          uint      `db:"id" json:"id"`
+	AgentID     uint      `db:"agent_id" json:"agent_id"`
+	JobSeekerID uint      `db:"job_seeker_id" json:"job_seeker_id"`
+	RuleID      uint      `db:"rule_id" json:"rule_id"`
+	Tag         string    `db:"tag" json:"tag"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func NewEntryScreeningResult(
+	agentID uint,
+	jobSeekerID uint,
+	ruleID uint,
+	tag string,
+) *EntryScreeningResult {
+	return &EntryScreeningResult{
+		AgentID:     agentID,
+		JobSeekerID: jobSeekerID,
+		RuleID:      ruleID,
+		Tag:         tag,
+	}
+}
+
+// 選考ルールごとの該当件数
+type EntryScreeningRuleHitCount struct {
+	RuleID uint `db:"rule_id" json:"rule_id"`
+	Count  uint `db:"count" json:"count"`
+}
+
+// 選考ルールで判定する項目
+const (
+	EntryScreeningTargetAge               int64 = iota // 年齢（生年月日から算出）
+	EntryScreeningTargetPrefecture                     // 都道府県
+	EntryScreeningTargetNationality                    // 国籍
+	EntryScreeningTargetAnnualIncome                   // 年収
+	EntryScreeningTargetStateOfEmployment              // 就業状況
+)
+
+// 選考ルールの比較方法
+const (
+	EntryScreeningOperatorEqual          int64 = iota // 一致
+	EntryScreeningOperatorNotEqual                    // 不一致
+	EntryScreeningOperatorGreaterOrEqual              // 以上
+	EntryScreeningOperatorLessOrEqual                 // 以下
+)
+
+type CreateOrUpdateEntryScreeningRuleParam struct {
+	AgentID                   uint                           `json:"agent_id" validate:"required"`
+	Title                     string                         `json:"title" validate:"required"`
+	Tag                       string                         `json:"tag" validate:"required"`
+	ResultPhase               null.Int                       `json:"result_phase"`
+	ResultUserStatus          null.Int                       `json:"result_user_status"`
+	IsSkipInterviewAdjustment bool                           `json:"is_skip_interview_adjustment"`
+	IsActive                  bool                           `json:"is_active"`
+	ConditionList             []EntryScreeningConditionParam `json:"condition_list"`
+}
+
+type EntryScreeningConditionParam struct {
+	Target   null.Int `json:"target"`
+	Operator null.Int `json:"operator"`
+	Value    null.Int `json:"value"`
+}
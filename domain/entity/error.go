@@ -9,6 +9,12 @@ var (
 	ErrRequestError   = errors.New("REQUEST_ERROR")
 	ErrNotFound       = errors.New("NOT_FOUND")
 	ErrDuplicateEntry = errors.New("DUPLICATE_ENTRY")
+	ErrUnauthorized   = errors.New("UNAUTHORIZED")
+	ErrForbidden      = errors.New("FORBIDDEN")
+
+	// ログイン試行の制限
+	ErrTooManyRequests = errors.New("TOO_MANY_REQUESTS")
+	ErrCaptchaRequired = errors.New("CAPTCHA_REQUIRED")
 
 	// User
 	ErrUserNotFound = errors.New("USER_NOT_FOUND")
@@ -39,6 +45,18 @@ func ErrorInfo(err error) (code int, message string) {
 	} else if errors.Is(err, ErrDuplicateEntry) {
 		code = 409
 		message = "duplicate entry"
+	} else if errors.Is(err, ErrUnauthorized) {
+		code = 401
+		message = "unauthorized"
+	} else if errors.Is(err, ErrForbidden) {
+		code = 403
+		message = "forbidden"
+	} else if errors.Is(err, ErrTooManyRequests) {
+		code = 429
+		message = "too many requests"
+	} else if errors.Is(err, ErrCaptchaRequired) {
+		code = 400
+		message = "captcha required"
 	} else if errors.Is(err, ErrFirebaseExpiredToken) {
 		code = 400
 		message = "firebase token expired"
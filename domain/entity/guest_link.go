@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 企業・求職者に送るゲスト用リンク（担当者が発行し、署名付きトークンをURLに含める）
+type GuestLink struct {
+	ID             uint      `db:"id" json:"id"`
+	UUID           uuid.UUID `db:"uuid" json:"uuid"` // トークンのID（jti）
+	AgentID        uint      `db:"agent_id" json:"agent_id"`
+	AgentStaffID   uint      `db:"agent_staff_id" json:"agent_staff_id"`
+	ResourceType   string    `db:"resource_type" json:"resource_type"` // 対象（job_information, task_group, job_seeker）
+	ResourceUUID   uuid.UUID `db:"resource_uuid" json:"resource_uuid"`
+	Permissions    string    `db:"permissions" json:"permissions"` // 許可する操作（read, writeのカンマ区切り）
+	ExpiresAt      time.Time `db:"expires_at" json:"expires_at"`
+	RevokedAt      null.Time `db:"revoked_at" json:"revoked_at"`
+	RevokedStaffID null.Int  `db:"revoked_staff_id" json:"revoked_staff_id"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	StaffName   string `db:"staff_name" json:"staff_name"`
+	AccessCount uint   `db:"access_count" json:"access_count"` // ログインに成功した回数
+
+	// 発行時のみ返す
+	Token string `db:"-" json:"token,omitempty"`
+}
+
+func NewGuestLink(
+	agentID uint,
+	agentStaffID uint,
+	resourceType string,
+	resourceUUID uuid.UUID,
+	permissions string,
+	expiresAt time.Time,
+) *GuestLink {
+	return &GuestLink{
+		AgentID:      agentID,
+		AgentStaffID: agentStaffID,
+		ResourceType: resourceType,
+		ResourceUUID: resourceUUID,
+		Permissions:  permissions,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+// 許可する操作の一覧
+func (l *GuestLink) PermissionList() []string {
+	if l.Permissions == "" {
+		return []string{}
+	}
+	return strings.Split(l.Permissions, ",")
+}
+
+const (
+	GuestLinkResourceJobInformation = "job_information" // 企業（求人票の確認・修正）
+	GuestLinkResourceTaskGroup      = "task_group"      // 企業（選考の確認・結果の入力）
+	GuestLinkResourceJobSeeker      = "job_seeker"      // 求職者（マイページ）
+)
+
+const (
+	GuestLinkPermissionRead  = "read"  // 閲覧
+	GuestLinkPermissionWrite = "write" // 入力・更新
+)
+
+// ゲスト用リンクの有効期限の上限（日数）
+const GuestLinkMaxExpireDays = 180
+
+// ゲスト用APIのトークンの有効時間（config.GuestLink.SessionHours が未設定の場合）
+const GuestSessionDefaultHours = 12
+
+// ゲスト用APIの対象
+// 対象のUUIDをトークンの対象と照合する（選考後アンケートは回答する求職者で照合する）
+const GuestAccessTargetSelectionQuestionnaire = "selection_questionnaire"
+
+type GuestAccessTarget struct {
+	ResourceType string    // job_information, task_group, job_seeker, selection_questionnaire
+	ResourceUUID uuid.UUID // uuid.Nil の場合は対象を問わない（同じ種類のトークンであれば許可する）
+	JobSeekerID  uint      // リクエストボディで指定された求職者ID（指定なしの場合は0）
+	IsWrite      bool
+}
+
+// ゲスト用リンクのアクセスログ
+type GuestLinkAccessLog struct {
+	ID           uint      `db:"id" json:"id"`
+	GuestLinkID  null.Int  `db:"guest_link_id" json:"guest_link_id"` // UUIDのみのリンクの場合はNULL
+	ResourceType string    `db:"resource_type" json:"resource_type"`
+	ResourceUUID uuid.UUID `db:"resource_uuid" json:"resource_uuid"`
+	Result       string    `db:"result" json:"result"`
+	IPAddress    string    `db:"ip_address" json:"ip_address"`
+	UserAgent    string    `db:"user_agent" json:"user_agent"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+}
+
+func NewGuestLinkAccessLog(
+	guestLinkID null.Int,
+	resourceType string,
+	resourceUUID uuid.UUID,
+	result string,
+	ipAddress string,
+	userAgent string,
+) *GuestLinkAccessLog {
+	return &GuestLinkAccessLog{
+		GuestLinkID:  guestLinkID,
+		ResourceType: resourceType,
+		ResourceUUID: resourceUUID,
+		Result:       result,
+		IPAddress:    ipAddress,
+		UserAgent:    userAgent,
+	}
+}
+
+const (
+	GuestLinkAccessResultSuccess          = "success"           // ログイン成功
+	GuestLinkAccessResultInvalidToken     = "invalid_token"     // 署名が不正
+	GuestLinkAccessResultExpired          = "expired"           // 有効期限切れ
+	GuestLinkAccessResultRevoked          = "revoked"           // 無効化済み
+	GuestLinkAccessResultResourceMismatch = "resource_mismatch" // URLの対象とトークンの対象が一致しない
+	GuestLinkAccessResultSignInError      = "sign_in_error"     // パスワード誤りなどでログインに失敗
+	GuestLinkAccessResultLegacy           = "legacy"            // UUIDのみのリンクでログイン（移行期間中）
+	GuestLinkAccessResultLegacyExpired    = "legacy_expired"    // UUIDのみのリンクでログイン（移行期間後のため拒否）
+)
+
+// ゲストログイン時のリクエスト情報
+type GuestLinkAccess struct {
+	Token     string // URLに含まれるトークン（UUIDのみのリンクの場合は空文字）
+	IPAddress string
+	UserAgent string
+}
+
+// ゲスト用リンクの発行 body
+type CreateGuestLinkParam struct {
+	ResourceType string    `json:"resource_type" validate:"required"`
+	ResourceUUID uuid.UUID `json:"resource_uuid" validate:"required"`
+	Permissions  []string  `json:"permissions" validate:"required"`
+	ExpireDays   uint      `json:"expire_days"` // 0の場合は設定値（config.GuestLink.ExpireDays）
+}
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+/*
+提携求人媒体・自社フォームからの応募者取り込み用のパラメータ
+
+POST /api/inbound_entry/job_seeker/create
+
+	{
+		"external_type": 10,              // 流入元媒体のタイプ（JobSeekerExternalType）
+		"external_id": "partner-0001",    // 流入元媒体での応募者ID（同一媒体内で一意）
+		"inflow_channel_id": 1,           // 流入経路（エージェントの流入経路マスタID）
+		"last_name": "山田",
+		"first_name": "太郎",
+		"last_furigana": "ヤマダ",
+		"first_furigana": "タロウ",
+		"gender": 0,
+		"birthday": "1995-04-01",
+		"phone_number": "09012345678",
+		"email": "taro@example.com",
+		"post_code": "1500001",
+		"prefecture": 13,
+		"address": "渋谷区神宮前1-1-1",
+		"state_of_employment": 0,
+		"annual_income": 400,
+		"desired_annual_income": 500,
+		"job_change": 1,
+		"nationality": 0,
+		"job_summary": "法人営業として...",
+		"memo": "媒体側の備考など",
+		"student_histories": [{ "school_category": 0, "school_name": "〇〇大学", "subject": "経済学部", "graduation_year": "2018-03" }],
+		"work_histories": [{ "company_name": "株式会社〇〇", "employment_status": 0, "joining_year": "2018-04", "retire_year": "" }],
+		"licenses": [4803],
+		"desired_industries": [1],
+		"desired_occupations": [2],
+		"desired_work_locations": [13]
+	}
+*/
+type CreateJobSeekerFromInboundEntryParam struct {
+	ExternalType        null.Int `json:"external_type" validate:"required"` // 流入元媒体のタイプ
+	ExternalID          string   `json:"external_id" validate:"required"`   // 流入元媒体での応募者ID
+	InflowChannelID     null.Int `json:"inflow_channel_id"`                 // 流入経路
+	LastName            string   `json:"last_name" validate:"required"`
+	FirstName           string   `json:"first_name" validate:"required"`
+	LastFurigana        string   `json:"last_furigana"`
+	FirstFurigana       string   `json:"first_furigana"`
+	Gender              null.Int `json:"gender"`
+	Birthday            string   `json:"birthday"` // 生年月日（1999-06-10）
+	PhoneNumber         string   `json:"phone_number"`
+	Email               string   `json:"email"`
+	PostCode            string   `json:"post_code"`
+	Prefecture          null.Int `json:"prefecture"`
+	Address             string   `json:"address"`
+	StateOfEmployment   null.Int `json:"state_of_employment"`   // 就業状況
+	AnnualIncome        null.Int `json:"annual_income"`         // 直近の年収
+	DesiredAnnualIncome null.Int `json:"desired_annual_income"` // 希望年収
+	JobChange           null.Int `json:"job_change"`            // 転職回数
+	Nationality         null.Int `json:"nationality"`           // 国籍
+	JobSummary          string   `json:"job_summary"`           // 職務要約
+	Memo                string   `json:"memo"`                  // 媒体側の備考（メモに記録）
+
+	// 経歴
+	StudentHistories []InboundEntryStudentHistory `json:"student_histories" validate:"dive"`
+	WorkHistories    []InboundEntryWorkHistory    `json:"work_histories" validate:"dive"`
+	Licenses         []null.Int                   `json:"licenses"`
+
+	// 希望条件
+	DesiredIndustries    []null.Int `json:"desired_industries"`
+	DesiredOccupations   []null.Int `json:"desired_occupations"`
+	DesiredWorkLocations []null.Int `json:"desired_work_locations"`
+}
+
+// 学歴
+type InboundEntryStudentHistory struct {
+	SchoolCategory null.Int `json:"school_category"`
+	SchoolName     string   `json:"school_name" validate:"required"`
+	Subject        string   `json:"subject"`
+	EntranceYear   string   `json:"entrance_year"`   // 入学年月（2014-04）
+	GraduationYear string   `json:"graduation_year"` // 卒業年月（2018-03）
+}
+
+// 職歴
+type InboundEntryWorkHistory struct {
+	CompanyName      string   `json:"company_name" validate:"required"`
+	EmploymentStatus null.Int `json:"employment_status"`
+	JoiningYear      string   `json:"joining_year"` // 入社年月（2018-04）
+	RetireYear       string   `json:"retire_year"`  // 退職年月（在職中の場合は空文字）
+	JobDescription   string   `json:"job_description"`
+}
+
+// 取り込み結果
+type InboundEntryResult struct {
+	JobSeekerID uint      `json:"job_seeker_id"`
+	UUID        uuid.UUID `json:"uuid"`
+	IsDuplicate bool      `json:"is_duplicate"` // 既存の求職者に紐付けた場合はtrue
+}
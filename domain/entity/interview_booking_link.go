@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 新規エントリーした求職者に送る面談予約リンク
+type InterviewBookingLink struct {
+	ID                            uint      `db:"id" json:"id"`
+	UUID                          uuid.UUID `db:"uuid" json:"uuid"`
+	AgentID                       uint      `db:"agent_id" json:"agent_id"`
+	JobSeekerID                   uint      `db:"job_seeker_id" json:"job_seeker_id"`
+	AgentStaffID                  uint      `db:"agent_staff_id" json:"agent_staff_id"`                                     // 面談を担当するCA
+	InterviewAdjustmentTemplateID null.Int  `db:"interview_adjustment_template_id" json:"interview_adjustment_template_id"` // 送信した面談調整テンプレート
+	ExpiredAt                     time.Time `db:"expired_at" json:"expired_at"`
+	IsBooked                      bool      `db:"is_booked" json:"is_booked"`
+	InterviewDate                 time.Time `db:"interview_date" json:"interview_date"` // 予約された面談日時
+	CreatedAt                     time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt                     time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	LastName  string `db:"last_name" json:"last_name"`
+	FirstName string `db:"first_name" json:"first_name"`
+	StaffName string `db:"staff_name" json:"staff_name"`
+	AgentName string `db:"agent_name" json:"agent_name"`
+
+	// 予約可能な面談枠
+	SlotList []*InterviewBookingSlot `db:"-" json:"slot_list"`
+}
+
+func NewInterviewBookingLink(
+	agentID uint,
+	jobSeekerID uint,
+	agentStaffID uint,
+	interviewAdjustmentTemplateID null.Int,
+	expiredAt time.Time,
+	interviewDate time.Time,
+) *InterviewBookingLink {
+	return &InterviewBookingLink{
+		AgentID:                       agentID,
+		JobSeekerID:                   jobSeekerID,
+		AgentStaffID:                  agentStaffID,
+		InterviewAdjustmentTemplateID: interviewAdjustmentTemplateID,
+		ExpiredAt:                     expiredAt,
+		InterviewDate:                 interviewDate,
+	}
+}
+
+// 面談枠
+type InterviewBookingSlot struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// 面談予約
+type BookInterviewParam struct {
+	StartTime time.Time `json:"start_time" validate:"required"`
+}
+
+const (
+	InterviewBookingSlotMinutes    = 60      // 面談枠の長さ（分）
+	InterviewBookingPeriodDays     = 14      // 予約リンクの有効期間・予約できる期間（日）
+	InterviewBookingDefaultStartAt = "10:00" // 勤務時間が未設定の場合の面談開始時刻
+	InterviewBookingDefaultEndAt   = "19:00" // 勤務時間が未設定の場合の面談終了時刻
+)
@@ -0,0 +1,194 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// 内定（オファー）
+// 選考（タスクグループ）ごとに1件、提示された条件と回答期限・状態を記録する
+// 通知書・労働条件通知書・内定承諾書のURLはタスクに保存し、ここでは条件を項目ごとに管理する
+type JobOffer struct {
+	ID                 uint      `db:"id" json:"id"`
+	TaskGroupID        uint      `db:"task_group_id" json:"task_group_id"`
+	Position           string    `db:"position" json:"position"`                         // ポジション（役職・配属先）
+	EmploymentStatus   null.Int  `db:"employment_status" json:"employment_status"`       // 雇用形態（求人と同じ区分）
+	WorkLocation       string    `db:"work_location" json:"work_location"`               // 勤務地
+	AnnualSalary       null.Int  `db:"annual_salary" json:"annual_salary"`               // 提示年収（円。nullの場合は月額と賞与から算出）
+	BaseSalary         null.Int  `db:"base_salary" json:"base_salary"`                   // 基本給（月額・円）
+	FixedOvertimePay   null.Int  `db:"fixed_overtime_pay" json:"fixed_overtime_pay"`     // 固定残業代（月額・円）
+	FixedOvertimeHours null.Int  `db:"fixed_overtime_hours" json:"fixed_overtime_hours"` // 固定残業時間（月）
+	Allowance          null.Int  `db:"allowance" json:"allowance"`                       // 諸手当（月額・円）
+	Bonus              null.Int  `db:"bonus" json:"bonus"`                               // 賞与（年額・円）
+	SalaryRemarks      string    `db:"salary_remarks" json:"salary_remarks"`             // 給与の備考
+	StartDate          string    `db:"start_date" json:"start_date"`                     // 入社日（承諾時にタスクグループの入社日へ反映する）
+	AnswerDeadline     null.Time `db:"answer_deadline" json:"answer_deadline"`           // 回答期限
+	Status             int64     `db:"status" json:"status"`                             // 状態（0: 提示中, 1: 条件交渉中, 2: 承諾, 3: 辞退, 4: 取り消し）
+	Remarks            string    `db:"remarks" json:"remarks"`
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	JobSeekerID      uint   `db:"job_seeker_id" json:"job_seeker_id"`
+	JobInformationID uint   `db:"job_information_id" json:"job_information_id"`
+	LastName         string `db:"last_name" json:"last_name"`
+	FirstName        string `db:"first_name" json:"first_name"`
+	Title            string `db:"title" json:"title"`
+	CompanyName      string `db:"company_name" json:"company_name"`
+	CAStaffID        uint   `db:"ca_staff_id" json:"ca_staff_id"`
+	RAStaffID        uint   `db:"ra_staff_id" json:"ra_staff_id"`
+	CAAgentID        uint   `db:"ca_agent_id" json:"ca_agent_id"`
+	RAAgentID        uint   `db:"ra_agent_id" json:"ra_agent_id"`
+
+	// 表示用
+	StatusName            string                   `db:"-" json:"status_name"`
+	EstimatedAnnualSalary int64                    `db:"-" json:"estimated_annual_salary"` // 比較に使う年収（円）
+	IsHighestSalary       bool                     `db:"-" json:"is_highest_salary"`       // 比較中の内定で年収が最も高いか
+	IsEarliestDeadline    bool                     `db:"-" json:"is_earliest_deadline"`    // 比較中の回答待ちの内定で回答期限が最も早いか
+	StatusHistoryList     []*JobOfferStatusHistory `db:"-" json:"status_history_list"`
+}
+
+func NewJobOffer(
+	taskGroupID uint,
+	position string,
+	employmentStatus null.Int,
+	workLocation string,
+	annualSalary null.Int,
+	baseSalary null.Int,
+	fixedOvertimePay null.Int,
+	fixedOvertimeHours null.Int,
+	allowance null.Int,
+	bonus null.Int,
+	salaryRemarks string,
+	startDate string,
+	answerDeadline null.Time,
+	status int64,
+	remarks string,
+) *JobOffer {
+	return &JobOffer{
+		TaskGroupID:        taskGroupID,
+		Position:           position,
+		EmploymentStatus:   employmentStatus,
+		WorkLocation:       workLocation,
+		AnnualSalary:       annualSalary,
+		BaseSalary:         baseSalary,
+		FixedOvertimePay:   fixedOvertimePay,
+		FixedOvertimeHours: fixedOvertimeHours,
+		Allowance:          allowance,
+		Bonus:              bonus,
+		SalaryRemarks:      salaryRemarks,
+		StartDate:          startDate,
+		AnswerDeadline:     answerDeadline,
+		Status:             status,
+		Remarks:            remarks,
+	}
+}
+
+// 内定の状態の変更履歴（追記のみ）
+type JobOfferStatusHistory struct {
+	ID           uint      `db:"id" json:"id"`
+	JobOfferID   uint      `db:"job_offer_id" json:"job_offer_id"`
+	PrevStatus   null.Int  `db:"prev_status" json:"prev_status"` // 変更前の状態（作成時はnull）
+	Status       int64     `db:"status" json:"status"`
+	AgentStaffID null.Int  `db:"agent_staff_id" json:"agent_staff_id"` // 変更した担当者
+	Remarks      string    `db:"remarks" json:"remarks"`               // 変更の理由・備考
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+
+	// 他テーブル
+	StaffName string `db:"staff_name" json:"staff_name"`
+
+	// 表示用
+	PrevStatusName string `db:"-" json:"prev_status_name"`
+	StatusName     string `db:"-" json:"status_name"`
+}
+
+func NewJobOfferStatusHistory(
+	jobOfferID uint,
+	prevStatus null.Int,
+	status int64,
+	agentStaffID null.Int,
+	remarks string,
+) *JobOfferStatusHistory {
+	return &JobOfferStatusHistory{
+		JobOfferID:   jobOfferID,
+		PrevStatus:   prevStatus,
+		Status:       status,
+		AgentStaffID: agentStaffID,
+		Remarks:      remarks,
+	}
+}
+
+// 送信した回答期限の通知（同じ回答期限に対して同じ通知を重複して送らないために記録する）
+type JobOfferReminder struct {
+	ID             uint      `db:"id" json:"id"`
+	JobOfferID     uint      `db:"job_offer_id" json:"job_offer_id"`
+	AgentStaffID   uint      `db:"agent_staff_id" json:"agent_staff_id"`   // 通知した担当者ID
+	ReminderType   int64     `db:"reminder_type" json:"reminder_type"`     // 通知の種類（0: 回答期限の前日, 1: 回答期限切れ）
+	AnswerDeadline time.Time `db:"answer_deadline" json:"answer_deadline"` // 通知時点の回答期限
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+}
+
+func NewJobOfferReminder(
+	jobOfferID uint,
+	agentStaffID uint,
+	reminderType int64,
+	answerDeadline time.Time,
+) *JobOfferReminder {
+	return &JobOfferReminder{
+		JobOfferID:     jobOfferID,
+		AgentStaffID:   agentStaffID,
+		ReminderType:   reminderType,
+		AnswerDeadline: answerDeadline,
+	}
+}
+
+// 内定の状態
+const (
+	JobOfferStatusOffered     int64 = iota // 提示中（回答待ち）
+	JobOfferStatusNegotiating              // 条件交渉中
+	JobOfferStatusAccepted                 // 承諾
+	JobOfferStatusDeclined                 // 辞退
+	JobOfferStatusWithdrawn                // 取り消し（企業側）
+)
+
+var JobOfferStatusName = map[int64]string{
+	JobOfferStatusOffered:     "提示中",
+	JobOfferStatusNegotiating: "条件交渉中",
+	JobOfferStatusAccepted:    "承諾",
+	JobOfferStatusDeclined:    "辞退",
+	JobOfferStatusWithdrawn:   "取り消し",
+}
+
+// 回答期限の通知の種類
+const (
+	JobOfferReminderBeforeDeadline int64 = iota // 回答期限の前日（24時間前）
+	JobOfferReminderOverdue                     // 回答期限切れ
+)
+
+// 回答期限の何時間前に通知するか
+const JobOfferReminderHours = 24
+
+// 内定の作成・更新 body
+// 状態は作成時に提示中とし、変更は UpdateJobOfferStatusParam で行う
+type CreateOrUpdateJobOfferParam struct {
+	Position           string    `json:"position" validate:"required,max=255"`
+	EmploymentStatus   null.Int  `json:"employment_status"`
+	WorkLocation       string    `json:"work_location" validate:"max=255"`
+	AnnualSalary       null.Int  `json:"annual_salary"`
+	BaseSalary         null.Int  `json:"base_salary"`
+	FixedOvertimePay   null.Int  `json:"fixed_overtime_pay"`
+	FixedOvertimeHours null.Int  `json:"fixed_overtime_hours"`
+	Allowance          null.Int  `json:"allowance"`
+	Bonus              null.Int  `json:"bonus"`
+	SalaryRemarks      string    `json:"salary_remarks"`
+	StartDate          string    `json:"start_date"` // 2006-01-02
+	AnswerDeadline     null.Time `json:"answer_deadline"`
+	Remarks            string    `json:"remarks"`
+}
+
+// 内定の状態の変更 body
+type UpdateJobOfferStatusParam struct {
+	Status  int64  `json:"status"`
+	Remarks string `json:"remarks"` // 変更の理由（辞退・取り消しの理由など）
+}
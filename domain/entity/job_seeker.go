@@ -91,6 +91,10 @@ type JobSeeker struct {
 	MedicalHistoryRemarks string `db:"medical_history_remarks" json:"medical_history_remarks"` // 既往歴 ありを選択→既往歴備考（フリーテキスト）を表示
 	AcceptancePoints      string `db:"acceptance_points" json:"acceptance_points"`             // 応募承諾のポイント
 
+	AnonymizedAt null.Time `db:"anonymized_at" json:"anonymized_at"` // 個人情報を削除（匿名化）した日時
+
+	PersonalInformationEncryptedAt null.Time `db:"personal_information_encrypted_at" json:"-"` // 移行バッチで個人情報を暗号化した日時
+
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 
@@ -465,6 +469,14 @@ const (
 	QuitedAfterInterview                  // サービス終了/転職活動終了
 )
 
+// 個人情報の検索・重複判定用ブラインドインデックスの項目
+const (
+	JobSeekerBlindIndexPhoneNumber = "phone_number"
+	JobSeekerBlindIndexEmail       = "email"
+	JobSeekerBlindIndexName        = "name"     // 姓名を連結した氏名（重複判定のみに使用）
+	JobSeekerBlindIndexFurigana    = "furigana" // 姓名を連結したフリガナ（重複判定のみに使用）
+)
+
 // 求職者・求人で共通
 type SearchType string
 
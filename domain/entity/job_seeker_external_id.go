@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// 求職者の流入元媒体でのIDを管理するテーブル
+type JobSeekerExternalID struct {
+	ID              uint        `db:"id" json:"id"`
+	JobSeekerID     uint        `db:"job_seeker_id" json:"job_seeker_id"`
+	AgentID         uint        `db:"agent_id" json:"agent_id"`
+	ExternalType    null.Int    `db:"external_type" json:"external_type"`         // 流入元媒体のタイプ
+	ExternalID      null.String `db:"external_id" json:"external_id"`             // 流入元媒体でのID（LPなど媒体IDがない場合はNULL）
+	InflowChannelID null.Int    `db:"inflow_channel_id" json:"inflow_channel_id"` // 流入経路
+	CreatedAt       time.Time   `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time   `db:"updated_at" json:"-"`
+}
+
+func NewJobSeekerExternalID(
+	jobSeekerID uint,
+	agentID uint,
+	externalType null.Int,
+	externalID string,
+	inflowChannelID null.Int,
+) *JobSeekerExternalID {
+	return &JobSeekerExternalID{
+		JobSeekerID:     jobSeekerID,
+		AgentID:         agentID,
+		ExternalType:    externalType,
+		ExternalID:      null.NewString(externalID, externalID != ""),
+		InflowChannelID: inflowChannelID,
+	}
+}
+
+// 流入元媒体のタイプ（0〜3はScoutServiceTypeと共通）
+const (
+	JobSeekerExternalTypePartnerBoard int64 = 10 // 提携求人媒体
+	JobSeekerExternalTypeOwnForm      int64 = 11 // 自社フォーム
+	JobSeekerExternalTypeLP           int64 = 12 // LP
+)
+
+var JobSeekerExternalTypeLabel = map[int64]string{
+	ScoutServiceTypeRan:               "RAN",
+	ScoutServiceTypeMynaviScouting:    "マイナビスカウティング",
+	ScoutServiceTypeAmbi:              "AMBI",
+	ScoutServiceTypeMynaviAgentScout:  "マイナビエージェントスカウト",
+	JobSeekerExternalTypePartnerBoard: "提携求人媒体",
+	JobSeekerExternalTypeOwnForm:      "自社フォーム",
+	JobSeekerExternalTypeLP:           "LP",
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 求職者の統合候補（取り込み時に重複の疑いがある求職者）
+type JobSeekerMergeSuggestion struct {
+	ID                   uint      `db:"id" json:"id"`
+	AgentID              uint      `db:"agent_id" json:"agent_id"`
+	JobSeekerID          uint      `db:"job_seeker_id" json:"job_seeker_id"`                     // 統合先の求職者ID（既存の求職者）
+	DuplicateJobSeekerID null.Int  `db:"duplicate_job_seeker_id" json:"duplicate_job_seeker_id"` // 統合元の求職者ID（新しく取り込んだ求職者）
+	MatchReason          string    `db:"match_reason" json:"match_reason"`                       // 重複と判定した理由
+	Status               null.Int  `db:"status" json:"status"`                                   // 対応状況
+	ReviewedStaffID      null.Int  `db:"reviewed_staff_id" json:"reviewed_staff_id"`             // 対応した担当者ID
+	CreatedAt            time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt            time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	JobSeekerUUID                   uuid.UUID `db:"job_seeker_uuid" json:"job_seeker_uuid"`
+	JobSeekerLastName               string    `db:"job_seeker_last_name" json:"job_seeker_last_name"`
+	JobSeekerFirstName              string    `db:"job_seeker_first_name" json:"job_seeker_first_name"`
+	JobSeekerLastFurigana           string    `db:"job_seeker_last_furigana" json:"job_seeker_last_furigana"`
+	JobSeekerFirstFurigana          string    `db:"job_seeker_first_furigana" json:"job_seeker_first_furigana"`
+	JobSeekerEmail                  string    `db:"job_seeker_email" json:"job_seeker_email"`
+	JobSeekerPhoneNumber            string    `db:"job_seeker_phone_number" json:"job_seeker_phone_number"`
+	DuplicateJobSeekerUUID          uuid.UUID `db:"duplicate_job_seeker_uuid" json:"duplicate_job_seeker_uuid"`
+	DuplicateJobSeekerLastName      string    `db:"duplicate_job_seeker_last_name" json:"duplicate_job_seeker_last_name"`
+	DuplicateJobSeekerFirstName     string    `db:"duplicate_job_seeker_first_name" json:"duplicate_job_seeker_first_name"`
+	DuplicateJobSeekerLastFurigana  string    `db:"duplicate_job_seeker_last_furigana" json:"duplicate_job_seeker_last_furigana"`
+	DuplicateJobSeekerFirstFurigana string    `db:"duplicate_job_seeker_first_furigana" json:"duplicate_job_seeker_first_furigana"`
+	DuplicateJobSeekerEmail         string    `db:"duplicate_job_seeker_email" json:"duplicate_job_seeker_email"`
+	DuplicateJobSeekerPhoneNumber   string    `db:"duplicate_job_seeker_phone_number" json:"duplicate_job_seeker_phone_number"`
+}
+
+func NewJobSeekerMergeSuggestion(
+	agentID uint,
+	jobSeekerID uint,
+	duplicateJobSeekerID uint,
+	matchReason string,
+) *JobSeekerMergeSuggestion {
+	return &JobSeekerMergeSuggestion{
+		AgentID:              agentID,
+		JobSeekerID:          jobSeekerID,
+		DuplicateJobSeekerID: null.NewInt(int64(duplicateJobSeekerID), true),
+		MatchReason:          matchReason,
+		Status:               null.NewInt(JobSeekerMergeSuggestionStatusUnhandled, true),
+	}
+}
+
+// 統合候補の対応状況
+const (
+	JobSeekerMergeSuggestionStatusUnhandled int64 = iota // 未対応
+	JobSeekerMergeSuggestionStatusMerged                 // 統合済み
+	JobSeekerMergeSuggestionStatusDismissed              // 別人として登録
+)
+
+// 取り込み時の重複判定の結果
+type JobSeekerDuplicateMatchType int64
+
+const (
+	JobSeekerDuplicateMatchNone      JobSeekerDuplicateMatchType = iota // 重複なし
+	JobSeekerDuplicateMatchSuspected                                    // 重複の疑いあり（統合候補としてスタッフが確認）
+	JobSeekerDuplicateMatchCertain                                      // 同一人物（既存の求職者に流入元を紐付ける）
+)
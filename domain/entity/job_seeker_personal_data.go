@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// 個人情報の開示請求で出力する求職者のデータ（personal_data.jsonとして出力する）
+type JobSeekerPersonalData struct {
+	ExportedAt           time.Time                   `json:"exported_at"`           // 出力日時
+	JobSeeker            *JobSeeker                  `json:"job_seeker"`            // 求職者情報（学歴・職歴・希望条件などの子テーブルを含む）
+	InitialQuestionnaire *InitialQuestionnaire       `json:"initial_questionnaire"` // 面談前アンケート
+	ExternalIDList       []*JobSeekerExternalID      `json:"external_id_list"`      // 外部媒体のID
+	TaskGroupList        []*TaskGroup                `json:"task_group_list"`       // 選考状況
+	ScheduleList         []*JobSeekerSchedule        `json:"schedule_list"`         // 面談・面接の日程
+	ChatMessageList      []*ChatMessageWithJobSeeker `json:"chat_message_list"`     // LINEのメッセージ
+	EmailList            []*EmailWithJobSeeker       `json:"email_list"`            // 送信したメール
+	FileList             []JobSeekerPersonalDataFile `json:"file_list"`             // 応募書類・添付ファイル
+}
+
+// 個人情報の開示請求で出力するファイル
+type JobSeekerPersonalDataFile struct {
+	URL      string `json:"url"`       // 保存先のURL
+	FileName string `json:"file_name"` // zipファイル内のファイル名（取得できなかった場合は空文字）
+}
+
+// 匿名化した求職者の氏名
+const (
+	JobSeekerAnonymizedLastName  = "削除済み"
+	JobSeekerAnonymizedFirstName = "求職者"
+)
@@ -96,4 +96,5 @@ const (
 	ScheduleTypeByCA                      // CA調整（選考の候補日時）
 	ScheduleTypeByEnterprise              // 企業調整（選考の確定日時）
 	ScheduleTypeByReschedule              // リスケ
+	ScheduleTypeByInterview               // CA面談（面談予約リンクからの予約）
 )
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// LP・ゲストのログインやパスワード再設定メールの試行回数とロック状態
+type LoginAttempt struct {
+	ID              uint      `db:"id" json:"id"`
+	Scope           string    `db:"scope" json:"scope"`                 // 対象の操作
+	KeyType         string    `db:"key_type" json:"key_type"`           // 制限の単位（ip, account）
+	KeyValue        string    `db:"key_value" json:"key_value"`         // IPアドレス、またはアカウント（メールアドレスはブラインドインデックス）
+	AttemptCount    uint      `db:"attempt_count" json:"attempt_count"` // 期間内の失敗回数（メール送信は送信回数）
+	WindowStartedAt time.Time `db:"window_started_at" json:"window_started_at"`
+	LockCount       uint      `db:"lock_count" json:"lock_count"` // ロックされた回数
+	LockedUntil     null.Time `db:"locked_until" json:"locked_until"`
+	CreatedAt       time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func NewLoginAttempt(
+	scope string,
+	keyType string,
+	keyValue string,
+	now time.Time,
+) *LoginAttempt {
+	return &LoginAttempt{
+		Scope:           scope,
+		KeyType:         keyType,
+		KeyValue:        keyValue,
+		WindowStartedAt: now,
+	}
+}
+
+const (
+	LoginAttemptScopeLPLogin              = "lp_login"                // LPのログイン
+	LoginAttemptScopeLPResetPasswordEmail = "lp_reset_password_email" // LPのパスワード再設定メール
+	LoginAttemptScopeResetPasswordEmail   = "reset_password_email"    // マイページのパスワード再設定メール
+	LoginAttemptScopeGuestSignIn          = "guest_sign_in"           // ゲスト企業・ゲスト求職者のログイン
+	LoginAttemptScopeAdminLogin           = "admin_login"             // 管理者サイトのログイン
+)
+
+const (
+	LoginAttemptKeyIP      = "ip"      // IPアドレスごと
+	LoginAttemptKeyAccount = "account" // アカウントごと
+)
+
+// ロック中の試行状態の解除 body（IDかメールアドレスのどちらかを指定）
+type UnlockLoginAttemptParam struct {
+	LoginAttemptID uint   `json:"login_attempt_id"`
+	Email          string `json:"email"` // 指定したメールアドレスのアカウント単位のロックを全て解除する
+}
@@ -33,8 +33,11 @@ var TaskPhaseSubDocument = map[uint]string{
 	3:    "エントリー依頼",
 	4:    "応募書類準備",
 	5:    "応募辞退",
+	6:    "終了通知",
+	7:    "辞退処理依頼",
 	90:   "エントリー辞退終了",
 	91:   "不合格終了",
+	97:   "中断終了",
 	98:   "辞退終了",
 	99:   "終了",
 	100:  "辞退依頼",
@@ -63,6 +66,8 @@ var TaskPhaseSubSelection = map[uint]string{
 	16:   "日程確定（詳細案内済み）",
 	17:   "終了通知",
 	18:   "辞退処理依頼",
+	19:   "選考所感の回収（所感回収アンケートなし）",
+	20:   "選考所感の回収（所感回収アンケートあり）",
 	90:   "不合格終了",
 	97:   "中断終了",
 	98:   "辞退終了",
@@ -99,6 +104,8 @@ var TaskPhaseSubFinal = map[uint]string{
 	16:   "日程確定（詳細案内済み）",
 	17:   "終了通知",
 	18:   "辞退処理依頼",
+	19:   "選考所感の回収（所感回収アンケートなし）",
+	20:   "選考所感の回収（所感回収アンケートあり）",
 	90:   "不合格終了",
 	97:   "中断終了",
 	98:   "辞退終了",
@@ -0,0 +1,316 @@
+package entity
+
+// タスクのフェーズ・サブフェーズ
+type TaskPhaseState struct {
+	Phase    int64 `json:"phase_category"`
+	PhaseSub int64 `json:"phase_sub_category"`
+}
+
+func NewTaskPhaseState(phase TaskCategory, phaseSub int64) TaskPhaseState {
+	return TaskPhaseState{
+		Phase:    int64(phase),
+		PhaseSub: phaseSub,
+	}
+}
+
+// ○次選考・最終選考か
+func (s TaskPhaseState) IsSelection() bool {
+	return int64(FirstSelection) <= s.Phase && s.Phase <= int64(FinalSelection)
+}
+
+// 終了したタスクか（90番台のサブフェーズと内定承諾の決定終了）
+func (s TaskPhaseState) IsClosed() bool {
+	if s.Phase == int64(AcceptJobOffer) && s.PhaseSub == Decision {
+		return true
+	}
+
+	return 90 <= s.PhaseSub && s.PhaseSub <= 99
+}
+
+// 遷移の定義で使用するフェーズの特別な値
+const (
+	TaskPhaseAnySelection  TaskCategory = 1000 // ○次選考・最終選考のいずれか
+	TaskPhaseSameAsFrom    TaskCategory = 1001 // 遷移元と同じフェーズ（遷移先のみ）
+	TaskPhaseNextSelection TaskCategory = 1002 // 遷移元より後の○次選考・最終選考のいずれか（遷移先のみ）
+)
+
+// 遷移の定義で使用するサブフェーズ・担当者タイプの特別な値
+const (
+	TaskPhaseSubAny  int64     = -1 // 終了していない全てのサブフェーズ（遷移元のみ）
+	TaskStaffTypeAny StaffType = -1 // RA・CAのどちらでも実行できる
+)
+
+// 遷移に伴う処理（画面での案内にも使用する）
+// 評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成は、タスクの個別作成・一括操作ともにこの定義に従って実行する
+// 候補日時・確定日時の登録やメール・メッセージの送信は、リクエストの入力を使って各フェーズのタスク作成で行う
+const (
+	TaskPhaseSideEffectEvaluationPass        = "evaluation_pass"        // 評価点（合格）の登録
+	TaskPhaseSideEffectEvaluationFail        = "evaluation_fail"        // 評価点（不合格）の登録
+	TaskPhaseSideEffectEvaluationReInterview = "evaluation_reinterview" // 評価点（再選考）の登録
+	TaskPhaseSideEffectPossibleDates         = "possible_dates"         // 選考の候補日時の登録
+	TaskPhaseSideEffectSelectionDate         = "selection_date"         // 選考の確定日時の登録
+	TaskPhaseSideEffectReschedule            = "reschedule"             // 登録済みの日程を削除して再調整
+	TaskPhaseSideEffectMailToEnterprise      = "mail_to_enterprise"     // 企業への推薦・打診メールの送信
+	TaskPhaseSideEffectMessageToJobSeeker    = "message_to_job_seeker"  // 求職者へのメッセージ（LINE or メール）の送信
+	TaskPhaseSideEffectSaleAccept            = "sale_accept"            // ヨミを「内定承諾」に更新
+	TaskPhaseSideEffectSaleFailure           = "sale_failure"           // ヨミを「失注」に更新
+	TaskPhaseSideEffectJoiningDate           = "joining_date"           // 入社日の登録
+	TaskPhaseSideEffectDraftSale             = "draft_sale"             // 売上の下書きを作成（作成済みの場合は請求月を入社日に合わせて更新）
+	TaskPhaseSideEffectContinueSelection     = "continue_selection"     // 辞退処理前のタスクに戻る
+	TaskPhaseSideEffectLossReason            = "loss_reason"            // 辞退・不合格の理由の登録（TaskLossCloseList の終了に付与する）
+)
+
+// フェーズ・サブフェーズの遷移
+type TaskPhaseTransition struct {
+	FromPhase      TaskCategory
+	FromPhaseSub   int64
+	ToPhase        TaskCategory
+	ToPhaseSub     int64
+	StaffType      StaffType // 遷移先のタスクを実行する担当者
+	Label          string    // 画面に表示する操作名
+	SideEffectList []string  // 遷移に伴う処理（TaskPhaseSideEffect〜）
+}
+
+// 遷移先の操作（APIのレスポンス用）
+type TaskPhaseAction struct {
+	PhaseCategory    int64    `json:"phase_category"`
+	PhaseSubCategory int64    `json:"phase_sub_category"`
+	PhaseName        string   `json:"phase_name"`
+	PhaseSubName     string   `json:"phase_sub_name"`
+	StaffType        int64    `json:"staff_type"` // -1: RA・CAのどちらでも実行できる
+	Label            string   `json:"label"`
+	SideEffectList   []string `json:"side_effect_list"`
+}
+
+/****************************************************************************************/
+/// フェーズ・サブフェーズの遷移の定義
+//
+// 定義にない遷移のタスクは作成できない
+// ○次選考・最終選考は共通の定義（TaskPhaseAnySelection）を使用する
+//
+var TaskPhaseTransitionList = []TaskPhaseTransition{
+	/************ エントリー **************/
+	{Entry, int64(SoundOutMask), Entry, int64(CollectResultOfMask), RA, "マスクレジュメを打診", []string{TaskPhaseSideEffectMailToEnterprise}},
+	{Entry, int64(SoundOutMask), Entry, int64(InHouseNG), CA, "社内NG", nil},
+	{Entry, int64(SoundOutMask), Entry, int64(WithoutSoundOut), RA, "打診せず終了", nil},
+	{Entry, int64(CollectResultOfMask), Entry, int64(SoundOutJobInformation), CA, "マスクレジュメ合格", []string{TaskPhaseSideEffectEvaluationPass}},
+	{Entry, int64(CollectResultOfMask), Entry, int64(EnterpriseNG), CA, "マスクレジュメ不合格", []string{TaskPhaseSideEffectEvaluationFail}},
+
+	// 求人打診・応募意思確認・エントリー保留からは、エントリー or 辞退 or 保留
+	{Entry, int64(SoundOutJobInformation), Entry, int64(ConfirmApplicationIntention), CA, "応募意思を確認", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{Entry, int64(SoundOutJobInformation), Entry, int64(NotAcceptEntry), CA, "応諾にならず終了", nil},
+	{Entry, int64(SoundOutJobInformation), Entry, int64(DeclineEntry), CA, "エントリー辞退", nil},
+	{Entry, int64(SoundOutJobInformation), Entry, int64(HoldEntry), CA, "エントリー保留", nil},
+	{Entry, int64(SoundOutJobInformation), DocumentSelection, int64(RequestRecommendations), RA, "書類推薦を依頼", []string{TaskPhaseSideEffectPossibleDates}},
+	{Entry, int64(SoundOutJobInformation), DocumentSelection, int64(RequestEntry), TaskStaffTypeAny, "エントリーを依頼", nil},
+	{Entry, int64(SoundOutJobInformation), DocumentSelection, int64(PrepareDocument), CA, "応募書類を準備", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{Entry, int64(ConfirmApplicationIntention), Entry, int64(NotAcceptEntry), CA, "応諾にならず終了", nil},
+	{Entry, int64(ConfirmApplicationIntention), Entry, int64(DeclineEntry), CA, "エントリー辞退", nil},
+	{Entry, int64(ConfirmApplicationIntention), Entry, int64(HoldEntry), CA, "エントリー保留", nil},
+	{Entry, int64(ConfirmApplicationIntention), DocumentSelection, int64(RequestRecommendations), RA, "書類推薦を依頼", []string{TaskPhaseSideEffectPossibleDates}},
+	{Entry, int64(ConfirmApplicationIntention), DocumentSelection, int64(RequestEntry), TaskStaffTypeAny, "エントリーを依頼", nil},
+	{Entry, int64(ConfirmApplicationIntention), DocumentSelection, int64(PrepareDocument), CA, "応募書類を準備", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{Entry, int64(HoldEntry), Entry, int64(ConfirmApplicationIntention), CA, "応募意思を確認", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{Entry, int64(HoldEntry), Entry, int64(NotAcceptEntry), CA, "応諾にならず終了", nil},
+	{Entry, int64(HoldEntry), Entry, int64(DeclineEntry), CA, "エントリー辞退", nil},
+	{Entry, int64(HoldEntry), DocumentSelection, int64(RequestRecommendations), RA, "書類推薦を依頼", []string{TaskPhaseSideEffectPossibleDates}},
+	{Entry, int64(HoldEntry), DocumentSelection, int64(RequestEntry), TaskStaffTypeAny, "エントリーを依頼", nil},
+	{Entry, int64(HoldEntry), DocumentSelection, int64(PrepareDocument), CA, "応募書類を準備", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{Entry, int64(DeclineEntry), Entry, CloseEntryPhaseForDeclineEntry, TaskStaffTypeAny, "エントリー辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+
+	// シェア依頼
+	{Entry, int64(RequestShareJobSeeker), Entry, int64(SoundOutMask), RA, "マスクレジュメ打診を依頼", nil},
+	{Entry, int64(RequestShareJobSeeker), Entry, int64(SoundOutJobInformation), CA, "求人打診を依頼", nil},
+	{Entry, int64(RequestShareJobSeeker), Entry, int64(ConfirmPossibility), RA, "企業に合格可能性を確認", nil},
+	{Entry, int64(RequestShareJobSeeker), Entry, int64(WithoutSoundOut), RA, "打診せず終了", nil},
+	{Entry, int64(RequestShareJobInformation), Entry, int64(SoundOutMask), RA, "マスクレジュメ打診を依頼", nil},
+	{Entry, int64(RequestShareJobInformation), Entry, int64(SoundOutJobInformation), CA, "求人打診を依頼", nil},
+	{Entry, int64(RequestShareJobInformation), Entry, int64(ConfirmPossibility), RA, "企業に合格可能性を確認", nil},
+	{Entry, int64(RequestShareJobInformation), Entry, int64(WithoutSoundOut), RA, "打診せず終了", nil},
+	{Entry, int64(ConfirmPossibility), Entry, int64(SoundOutJobInformation), CA, "求人打診を依頼", nil},
+	{Entry, int64(ConfirmPossibility), Entry, int64(SoundOutMask), RA, "マスクレジュメ打診を依頼", nil},
+	{Entry, int64(ConfirmPossibility), Entry, int64(Unlikely), CA, "合格可能性が低いためNG", nil},
+	{Entry, int64(Unlikely), Entry, CloseEntryPhaseForUnlikely, CA, "合格可能性が低いためNG終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{Entry, int64(WithoutSoundOut), Entry, CloseEntryPhaseForWithoutSoundOut, TaskStaffTypeAny, "打診せず終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{Entry, TaskPhaseSubAny, Entry, CloseEntryPhase, TaskStaffTypeAny, "終了", []string{TaskPhaseSideEffectSaleFailure}},
+
+	/************ 書類選考 **************/
+	{DocumentSelection, int64(RequestRecommendations), DocumentSelection, int64(CollectResultOfDocumentSelection), RA, "書類を推薦", []string{TaskPhaseSideEffectMailToEnterprise}},
+	{DocumentSelection, int64(RequestEntry), DocumentSelection, int64(PrepareDocument), CA, "応募書類を準備", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{DocumentSelection, int64(RequestEntry), DocumentSelection, int64(RequestRecommendations), RA, "書類推薦を依頼", []string{TaskPhaseSideEffectPossibleDates}},
+	{DocumentSelection, int64(RequestEntry), DocumentSelection, int64(CollectResultOfDocumentSelection), RA, "エントリー済み（結果回収）", nil},
+	{DocumentSelection, int64(PrepareDocument), DocumentSelection, int64(RequestRecommendations), RA, "書類推薦を依頼", []string{TaskPhaseSideEffectPossibleDates}},
+	{DocumentSelection, int64(PrepareDocument), DocumentSelection, int64(RequestEntry), TaskStaffTypeAny, "エントリーを依頼", nil},
+
+	// 書類選考の結果
+	{DocumentSelection, int64(CollectResultOfDocumentSelection), TaskPhaseAnySelection, int64(RequestCollectionOfSchedule), CA, "書類選考合格（候補日回収を依頼）", []string{TaskPhaseSideEffectEvaluationPass}},
+	{DocumentSelection, int64(CollectResultOfDocumentSelection), TaskPhaseAnySelection, int64(RequestScheduleAdjustmentForSelection), RA, "書類選考合格（日程調整を依頼）", []string{TaskPhaseSideEffectPossibleDates}},
+	{DocumentSelection, int64(CollectResultOfDocumentSelection), TaskPhaseAnySelection, int64(RequestConfirmScheduleForSelection), CA, "書類選考合格（日程案内を依頼）", []string{TaskPhaseSideEffectEvaluationPass, TaskPhaseSideEffectSelectionDate}},
+	{DocumentSelection, int64(CollectResultOfDocumentSelection), TaskPhaseAnySelection, int64(RequestGuidance), CA, "書類選考合格（案内を依頼）", []string{TaskPhaseSideEffectEvaluationPass}},
+	{DocumentSelection, int64(CollectResultOfDocumentSelection), TaskPhaseAnySelection, int64(RequestConfirmScheduleAndSelectionDetail), CA, "書類選考合格（日程・詳細案内を依頼）", []string{TaskPhaseSideEffectEvaluationPass, TaskPhaseSideEffectSelectionDate}},
+	{DocumentSelection, int64(CollectResultOfDocumentSelection), DocumentSelection, int64(FailingNotification), CA, "書類選考不合格", []string{TaskPhaseSideEffectEvaluationFail}},
+	{DocumentSelection, int64(FailingNotification), DocumentSelection, CloseDocumentPhaseForFailingNotice, CA, "不合格で終了", []string{TaskPhaseSideEffectSaleFailure}},
+
+	// 応募辞退・辞退処理
+	{DocumentSelection, TaskPhaseSubAny, DocumentSelection, int64(NotAcceptDocumentSelection), CA, "応募辞退", nil},
+	{DocumentSelection, int64(NotAcceptDocumentSelection), DocumentSelection, CloseDocumentPhaseForDeclineEntry, TaskStaffTypeAny, "応募辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{DocumentSelection, int64(ConfirmDeclineIntention), DocumentSelection, int64(RequestDeclineProcessForDocumentPhase), RA, "辞退処理を依頼", nil},
+	{DocumentSelection, int64(RequestDeclineProcessForDocumentPhase), DocumentSelection, int64(DeclineNotificationForDocumentPhase), CA, "終了を通知", nil},
+	{DocumentSelection, int64(RequestDeclineProcessForDocumentPhase), DocumentSelection, CloseDocumentPhaseForRequestDecline, TaskStaffTypeAny, "辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{DocumentSelection, int64(DeclineNotificationForDocumentPhase), DocumentSelection, CloseDocumentPhaseForRequestDecline, CA, "辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{DocumentSelection, TaskPhaseSubAny, DocumentSelection, CloseDocumentPhaseForDrop, TaskStaffTypeAny, "中断で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{DocumentSelection, TaskPhaseSubAny, DocumentSelection, CloseDocumentPhase, TaskStaffTypeAny, "終了", []string{TaskPhaseSideEffectSaleFailure}},
+
+	/************ ○次選考・最終選考 **************/
+	// 候補日回収 → 日程調整 → 日程案内 → 日程確定 → 詳細案内 → 前日確認
+	{TaskPhaseAnySelection, int64(RequestCollectionOfSchedule), TaskPhaseSameAsFrom, int64(CollectingSchedule), CA, "候補日を回収", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{TaskPhaseAnySelection, int64(CollectingSchedule), TaskPhaseSameAsFrom, int64(RequestScheduleAdjustmentForSelection), RA, "日程調整を依頼", []string{TaskPhaseSideEffectPossibleDates}},
+	{TaskPhaseAnySelection, int64(RequestScheduleAdjustmentForSelection), TaskPhaseSameAsFrom, int64(EnterpriseAdjustmentToScheduleForSelection), RA, "企業と日程を調整", nil},
+	{TaskPhaseAnySelection, int64(EnterpriseAdjustmentToScheduleForSelection), TaskPhaseSameAsFrom, int64(RequestConfirmScheduleForSelection), CA, "日程案内を依頼", []string{TaskPhaseSideEffectSelectionDate}},
+	{TaskPhaseAnySelection, int64(EnterpriseAdjustmentToScheduleForSelection), TaskPhaseSameAsFrom, int64(RequestConfirmScheduleAndSelectionDetail), CA, "日程・詳細案内を依頼", []string{TaskPhaseSideEffectSelectionDate}},
+	{TaskPhaseAnySelection, int64(EnterpriseAdjustmentToScheduleForSelection), TaskPhaseSameAsFrom, int64(RequestCollectionOfSchedule), CA, "候補日の再回収を依頼", nil},
+	{TaskPhaseAnySelection, int64(RequestConfirmScheduleForSelection), TaskPhaseSameAsFrom, int64(JobSeekerConfirmsScheduleForSelection), CA, "日程を案内", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{TaskPhaseAnySelection, int64(JobSeekerConfirmsScheduleForSelection), TaskPhaseSameAsFrom, int64(ScheduleConfirmedForSelection), RA, "日程確定", nil},
+	{TaskPhaseAnySelection, int64(ScheduleConfirmedForSelection), TaskPhaseSameAsFrom, int64(RequestDetailedInformation), CA, "詳細案内を依頼", []string{TaskPhaseSideEffectSelectionDate}},
+	{TaskPhaseAnySelection, int64(RequestDetailedInformation), TaskPhaseSameAsFrom, int64(ConfirmDayBefore), CA, "詳細を案内", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{TaskPhaseAnySelection, int64(RequestConfirmScheduleAndSelectionDetail), TaskPhaseSameAsFrom, int64(JobSeekerConfirmsScheduleAndSelectionDetail), CA, "日程・詳細を案内", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{TaskPhaseAnySelection, int64(JobSeekerConfirmsScheduleAndSelectionDetail), TaskPhaseSameAsFrom, int64(ScheduleConfirmedAndGuidedDetail), RA, "日程確定（詳細案内済み）", nil},
+	{TaskPhaseAnySelection, int64(ScheduleConfirmedAndGuidedDetail), TaskPhaseSameAsFrom, int64(ConfirmDayBefore), CA, "前日確認を依頼", nil},
+
+	// 前日確認・案内 → 選考所感の回収 → 選考結果の回収
+	{TaskPhaseAnySelection, int64(ConfirmDayBefore), TaskPhaseSameAsFrom, int64(CollectSelectionThought), CA, "前日確認", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{TaskPhaseAnySelection, int64(ConfirmDayBefore), TaskPhaseSameAsFrom, int64(SkipSelectionThoughtQuestionnaireText), CA, "前日確認（所感回収アンケートなし）", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{TaskPhaseAnySelection, int64(ConfirmDayBefore), TaskPhaseSameAsFrom, int64(SendSelectionThoughtQuestionnaireText), CA, "前日確認（所感回収アンケートあり）", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{TaskPhaseAnySelection, int64(RequestGuidance), TaskPhaseSameAsFrom, int64(JobSeekerSupport), CA, "選考を案内", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{TaskPhaseAnySelection, int64(JobSeekerSupport), TaskPhaseSameAsFrom, int64(CollectSelectionThought), CA, "選考所感を回収", nil},
+	{TaskPhaseAnySelection, int64(JobSeekerSupport), TaskPhaseSameAsFrom, int64(RequestCollectSelectionThought), RA, "選考結果の回収を依頼", nil},
+	{TaskPhaseAnySelection, int64(CollectSelectionThought), TaskPhaseSameAsFrom, int64(RequestCollectSelectionThought), RA, "選考結果の回収を依頼", nil},
+	{TaskPhaseAnySelection, int64(SkipSelectionThoughtQuestionnaireText), TaskPhaseSameAsFrom, int64(RequestCollectSelectionThought), RA, "選考結果の回収を依頼", nil},
+	{TaskPhaseAnySelection, int64(SendSelectionThoughtQuestionnaireText), TaskPhaseSameAsFrom, int64(RequestCollectSelectionThought), RA, "選考結果の回収を依頼", nil},
+
+	// 選考結果（合格は次の選考 or 内定、再選考は同じ選考の最初から）
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), TaskPhaseNextSelection, int64(RequestCollectionOfSchedule), CA, "選考合格（候補日回収を依頼）", []string{TaskPhaseSideEffectEvaluationPass}},
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), TaskPhaseNextSelection, int64(RequestScheduleAdjustmentForSelection), RA, "選考合格（日程調整を依頼）", []string{TaskPhaseSideEffectEvaluationPass, TaskPhaseSideEffectPossibleDates}},
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), TaskPhaseNextSelection, int64(RequestConfirmScheduleForSelection), CA, "選考合格（日程案内を依頼）", []string{TaskPhaseSideEffectEvaluationPass, TaskPhaseSideEffectSelectionDate}},
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), TaskPhaseNextSelection, int64(RequestGuidance), CA, "選考合格（案内を依頼）", []string{TaskPhaseSideEffectEvaluationPass}},
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), TaskPhaseNextSelection, int64(RequestConfirmScheduleAndSelectionDetail), CA, "選考合格（日程・詳細案内を依頼）", []string{TaskPhaseSideEffectEvaluationPass, TaskPhaseSideEffectSelectionDate}},
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), HoldJobOffer, int64(RequestJobOfferNotification), CA, "内定", []string{TaskPhaseSideEffectEvaluationPass}},
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), TaskPhaseSameAsFrom, int64(RequestCollectionOfSchedule), CA, "再選考（候補日回収を依頼）", []string{TaskPhaseSideEffectEvaluationReInterview}},
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), TaskPhaseSameAsFrom, int64(RequestConfirmScheduleForSelection), CA, "再選考（日程案内を依頼）", []string{TaskPhaseSideEffectEvaluationReInterview, TaskPhaseSideEffectSelectionDate}},
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), TaskPhaseSameAsFrom, int64(RequestGuidance), CA, "再選考（案内を依頼）", []string{TaskPhaseSideEffectEvaluationReInterview}},
+	{TaskPhaseAnySelection, int64(RequestCollectSelectionThought), TaskPhaseSameAsFrom, int64(FailingNoticeOfSelection), CA, "選考不合格", []string{TaskPhaseSideEffectEvaluationFail}},
+	{TaskPhaseAnySelection, int64(FailingNoticeOfSelection), TaskPhaseSameAsFrom, CloseSelectionPhaseForFailingNoticeOfSelection, CA, "不合格で終了", []string{TaskPhaseSideEffectSaleFailure}},
+
+	// キャンセル・リスケ
+	{TaskPhaseAnySelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestReScheduleToCA), CA, "キャンセル・リスケを依頼（CA）", nil},
+	{TaskPhaseAnySelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestReScheduleToRA), RA, "キャンセル・リスケを依頼（RA）", nil},
+	{TaskPhaseAnySelection, int64(RequestReScheduleToCA), TaskPhaseSameAsFrom, int64(ConfirmReScheduleToCA), CA, "キャンセル・リスケを確認", nil},
+	{TaskPhaseAnySelection, int64(RequestReScheduleToRA), TaskPhaseSameAsFrom, int64(ConfirmReScheduleToRA), RA, "キャンセル・リスケを確認", nil},
+	{TaskPhaseAnySelection, int64(ConfirmReScheduleToCA), TaskPhaseSameAsFrom, int64(CompleteConfirmReSchedule), TaskStaffTypeAny, "キャンセル・リスケ確認完了", nil},
+	{TaskPhaseAnySelection, int64(ConfirmReScheduleToRA), TaskPhaseSameAsFrom, int64(CompleteConfirmReSchedule), TaskStaffTypeAny, "キャンセル・リスケ確認完了", nil},
+	{TaskPhaseAnySelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestCollectionOfSchedule), CA, "日程を再調整（候補日回収を依頼）", []string{TaskPhaseSideEffectReschedule}},
+	{TaskPhaseAnySelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestScheduleAdjustmentForSelection), RA, "日程を再調整（日程調整を依頼）", []string{TaskPhaseSideEffectReschedule, TaskPhaseSideEffectPossibleDates}},
+	{TaskPhaseAnySelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestConfirmScheduleForSelection), CA, "日程を再調整（日程案内を依頼）", []string{TaskPhaseSideEffectReschedule, TaskPhaseSideEffectSelectionDate}},
+	{TaskPhaseAnySelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestConfirmScheduleAndSelectionDetail), CA, "日程を再調整（日程・詳細案内を依頼）", []string{TaskPhaseSideEffectReschedule, TaskPhaseSideEffectSelectionDate}},
+
+	// 辞退処理
+	{TaskPhaseAnySelection, int64(ConfirmDeclineIntention), TaskPhaseSameAsFrom, int64(RequestDeclineProcess), RA, "辞退処理を依頼", nil},
+	{TaskPhaseAnySelection, int64(RequestDeclineProcess), TaskPhaseSameAsFrom, int64(DeclineNotification), CA, "終了を通知", nil},
+	{TaskPhaseAnySelection, int64(RequestDeclineProcess), TaskPhaseSameAsFrom, CloseSelectionPhaseForRequestDecline, TaskStaffTypeAny, "辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{TaskPhaseAnySelection, int64(DeclineNotification), TaskPhaseSameAsFrom, CloseSelectionPhaseForRequestDecline, CA, "辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{TaskPhaseAnySelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, CloseSelectionPhaseForDrop, TaskStaffTypeAny, "中断で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{TaskPhaseAnySelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, CloseSelectionPhase, TaskStaffTypeAny, "終了", []string{TaskPhaseSideEffectSaleFailure}},
+
+	/************ 内定保留 **************/
+	{HoldJobOffer, int64(RequestJobOfferNotification), HoldJobOffer, int64(AcceptJobOfferMind), CA, "内定を通知", nil},
+	{HoldJobOffer, int64(AcceptJobOfferMind), AcceptJobOffer, int64(Accept), CA, "内定承諾", []string{TaskPhaseSideEffectSaleAccept, TaskPhaseSideEffectDraftSale}},
+	{HoldJobOffer, int64(AcceptJobOfferMind), HoldJobOffer, int64(RequestCollectionOfScheduleForHold), CA, "オファー面談の候補日回収を依頼", nil},
+	{HoldJobOffer, int64(AcceptJobOfferMind), HoldJobOffer, int64(RequestCollectionOfInformation), RA, "情報回収を依頼", nil},
+	{HoldJobOffer, int64(AcceptJobOfferMind), HoldJobOffer, int64(DeclineJobOffer), RA, "内定辞退", nil},
+
+	// オファー面談
+	{HoldJobOffer, int64(RequestCollectionOfScheduleForHold), HoldJobOffer, int64(CollectionOfSchedule), CA, "オファー面談の候補日を回収", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{HoldJobOffer, int64(CollectionOfSchedule), HoldJobOffer, int64(RequestScheduleAdjustment), RA, "オファー面談の日程調整を依頼", []string{TaskPhaseSideEffectPossibleDates}},
+	{HoldJobOffer, int64(RequestScheduleAdjustment), HoldJobOffer, int64(AdjustmentToSchedule), RA, "企業とオファー面談の日程を調整", nil},
+	{HoldJobOffer, int64(AdjustmentToSchedule), HoldJobOffer, int64(RequestConfirmSchedule), CA, "オファー面談の日程確認を依頼", nil},
+	{HoldJobOffer, int64(RequestConfirmSchedule), HoldJobOffer, int64(ConfirmsSchedule), CA, "オファー面談の日程・詳細を案内", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+	{HoldJobOffer, int64(ConfirmsSchedule), HoldJobOffer, int64(ScheduleConfirmed), RA, "オファー面談の日程確定", nil},
+	{HoldJobOffer, int64(ScheduleConfirmed), HoldJobOffer, int64(RequestDetailedInformationForHold), CA, "オファー面談の詳細案内を依頼", []string{TaskPhaseSideEffectSelectionDate}},
+	{HoldJobOffer, int64(RequestDetailedInformationForHold), HoldJobOffer, int64(AcceptJobOfferMind), CA, "オファー面談の詳細を案内", []string{TaskPhaseSideEffectMessageToJobSeeker}},
+
+	// 情報回収・内定辞退
+	{HoldJobOffer, int64(RequestCollectionOfInformation), HoldJobOffer, int64(CollectingInformation), RA, "情報を回収", nil},
+	{HoldJobOffer, int64(CollectingInformation), HoldJobOffer, int64(AcceptJobOfferMind), CA, "承諾意思を確認", nil},
+	{HoldJobOffer, int64(DeclineJobOffer), HoldJobOffer, CloseHoldJobOfferPhaseForDeclineJobOffer, TaskStaffTypeAny, "内定辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+
+	// キャンセル・リスケ
+	{HoldJobOffer, TaskPhaseSubAny, HoldJobOffer, int64(RequestReScheduleToCA), CA, "キャンセル・リスケを依頼（CA）", nil},
+	{HoldJobOffer, TaskPhaseSubAny, HoldJobOffer, int64(RequestReScheduleToRA), RA, "キャンセル・リスケを依頼（RA）", nil},
+	{HoldJobOffer, int64(RequestReScheduleToCA), HoldJobOffer, int64(ConfirmReScheduleToCA), CA, "キャンセル・リスケを確認", nil},
+	{HoldJobOffer, int64(RequestReScheduleToRA), HoldJobOffer, int64(ConfirmReScheduleToRA), RA, "キャンセル・リスケを確認", nil},
+	{HoldJobOffer, int64(ConfirmReScheduleToCA), HoldJobOffer, int64(CompleteConfirmReSchedule), TaskStaffTypeAny, "キャンセル・リスケ確認完了", nil},
+	{HoldJobOffer, int64(ConfirmReScheduleToRA), HoldJobOffer, int64(CompleteConfirmReSchedule), TaskStaffTypeAny, "キャンセル・リスケ確認完了", nil},
+	{HoldJobOffer, TaskPhaseSubAny, HoldJobOffer, int64(RequestCollectionOfScheduleForHold), CA, "オファー面談の日程を再調整（候補日回収を依頼）", []string{TaskPhaseSideEffectReschedule}},
+	{HoldJobOffer, TaskPhaseSubAny, HoldJobOffer, int64(RequestScheduleAdjustment), RA, "オファー面談の日程を再調整（日程調整を依頼）", []string{TaskPhaseSideEffectReschedule, TaskPhaseSideEffectPossibleDates}},
+	{HoldJobOffer, TaskPhaseSubAny, HoldJobOffer, CloseHoldJobOfferPhaseForRequestDeclineOfSelection, TaskStaffTypeAny, "辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{HoldJobOffer, TaskPhaseSubAny, HoldJobOffer, HoldJobOfferPhaseClose, TaskStaffTypeAny, "終了", []string{TaskPhaseSideEffectSaleFailure}},
+
+	/************ 内定承諾 **************/
+	{AcceptJobOffer, int64(Accept), AcceptJobOffer, int64(FollowJoiningCompany), TaskStaffTypeAny, "入社フォロー", nil},
+	{AcceptJobOffer, int64(Accept), AcceptJobOffer, int64(RequestConfirmJoiningDay), TaskStaffTypeAny, "入社可能日の確認を依頼", nil},
+	{AcceptJobOffer, int64(FollowJoiningCompany), AcceptJobOffer, int64(RequestConfirmJoiningDay), TaskStaffTypeAny, "入社可能日の確認を依頼", nil},
+	{AcceptJobOffer, int64(FollowJoiningCompany), AcceptJobOffer, int64(RequestSupport), TaskStaffTypeAny, "対応を依頼", nil},
+	{AcceptJobOffer, int64(FollowJoiningCompany), AcceptJobOffer, int64(ConfirmJoiningCompany), TaskStaffTypeAny, "入社確認", []string{TaskPhaseSideEffectJoiningDate, TaskPhaseSideEffectDraftSale}},
+	{AcceptJobOffer, int64(FollowJoiningCompany), AcceptJobOffer, int64(RequestConfirmJoiningCompany), TaskStaffTypeAny, "入社確認を依頼", nil},
+	{AcceptJobOffer, int64(RequestConfirmJoiningDay), AcceptJobOffer, int64(ConfirmingJoiningCompany), TaskStaffTypeAny, "入社可能日を確認", nil},
+	{AcceptJobOffer, int64(ConfirmingJoiningCompany), AcceptJobOffer, int64(RequestJoiningCompanyAdjustment), TaskStaffTypeAny, "入社日の調整を依頼", nil},
+	{AcceptJobOffer, int64(RequestJoiningCompanyAdjustment), AcceptJobOffer, int64(RequestGuidanceForAccept), TaskStaffTypeAny, "案内を依頼", nil},
+	{AcceptJobOffer, int64(RequestGuidanceForAccept), AcceptJobOffer, int64(ConfirmingWithJobSeeker), TaskStaffTypeAny, "求職者に確認", nil},
+	{AcceptJobOffer, int64(ConfirmingWithJobSeeker), AcceptJobOffer, int64(FollowJoiningCompany), TaskStaffTypeAny, "入社フォロー", nil},
+	{AcceptJobOffer, int64(ConfirmingWithJobSeeker), AcceptJobOffer, int64(ConfirmJoiningCompany), TaskStaffTypeAny, "入社確認", []string{TaskPhaseSideEffectJoiningDate, TaskPhaseSideEffectDraftSale}},
+	{AcceptJobOffer, int64(RequestSupport), AcceptJobOffer, int64(Supporting), TaskStaffTypeAny, "対応", nil},
+	{AcceptJobOffer, int64(Supporting), AcceptJobOffer, int64(FollowJoiningCompany), TaskStaffTypeAny, "入社フォロー", nil},
+	{AcceptJobOffer, int64(RequestConfirmJoiningCompany), AcceptJobOffer, int64(ConfirmJoiningCompany), TaskStaffTypeAny, "入社確認", []string{TaskPhaseSideEffectJoiningDate, TaskPhaseSideEffectDraftSale}},
+	{AcceptJobOffer, int64(ConfirmJoiningCompany), AcceptJobOffer, int64(RequestConfirmJoiningCompany), TaskStaffTypeAny, "入社確認を依頼", nil},
+	{AcceptJobOffer, int64(ConfirmJoiningCompany), AcceptJobOffer, int64(InvoiceInvoice), TaskStaffTypeAny, "請求書を発行", nil},
+	{AcceptJobOffer, int64(ConfirmJoiningCompany), AcceptJobOffer, Decision, TaskStaffTypeAny, "決定で終了", nil},
+	{AcceptJobOffer, int64(InvoiceInvoice), AcceptJobOffer, Decision, TaskStaffTypeAny, "決定で終了", nil},
+	{AcceptJobOffer, TaskPhaseSubAny, AcceptJobOffer, AcceptJobOfferPhaseClose, TaskStaffTypeAny, "承諾後辞退で終了", nil},
+	{AcceptJobOffer, TaskPhaseSubAny, Decline, 0, TaskStaffTypeAny, "入社後の状況確認を依頼", nil},
+
+	/************ 辞退（入社後の状況確認〜返金） **************/
+	{Decline, 0, Decline, 1, TaskStaffTypeAny, "状況を確認", nil},
+	{Decline, 1, Decline, 2, TaskStaffTypeAny, "状況確認を依頼", nil},
+	{Decline, 2, Decline, 3, TaskStaffTypeAny, "状況を確認", nil},
+	{Decline, 3, Decline, 4, TaskStaffTypeAny, "状況確認完了", nil},
+	{Decline, 4, Decline, 5, TaskStaffTypeAny, "報告の確認を依頼", nil},
+	{Decline, 5, Decline, 6, TaskStaffTypeAny, "請求書の回収を依頼", nil},
+	{Decline, 6, Decline, 7, TaskStaffTypeAny, "請求書の支払を依頼", nil},
+	{Decline, 7, Decline, 8, TaskStaffTypeAny, "支払完了", nil},
+	{Decline, TaskPhaseSubAny, Decline, int64(DeclinePhaseClose), TaskStaffTypeAny, "終了", nil},
+
+	/************ 辞退依頼〜選考継続（エントリー〜内定承諾で共通） **************/
+	{Entry, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestDecline), CA, "辞退を依頼", nil},
+	{DocumentSelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestDecline), CA, "辞退を依頼", nil},
+	{TaskPhaseAnySelection, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestDecline), CA, "辞退を依頼", nil},
+	{AcceptJobOffer, TaskPhaseSubAny, TaskPhaseSameAsFrom, int64(RequestDecline), CA, "辞退を依頼", nil},
+	{Entry, int64(RequestDecline), TaskPhaseSameAsFrom, int64(RequestHoldBackDecline), TaskStaffTypeAny, "辞退の引き止めを依頼", nil},
+	{DocumentSelection, int64(RequestDecline), TaskPhaseSameAsFrom, int64(RequestHoldBackDecline), TaskStaffTypeAny, "辞退の引き止めを依頼", nil},
+	{TaskPhaseAnySelection, int64(RequestDecline), TaskPhaseSameAsFrom, int64(RequestHoldBackDecline), TaskStaffTypeAny, "辞退の引き止めを依頼", nil},
+	{AcceptJobOffer, int64(RequestDecline), TaskPhaseSameAsFrom, int64(RequestHoldBackDecline), TaskStaffTypeAny, "辞退の引き止めを依頼", nil},
+	{Entry, int64(RequestDecline), TaskPhaseSameAsFrom, int64(ConfirmDeclineIntention), CA, "辞退意思を確認", nil},
+	{DocumentSelection, int64(RequestDecline), TaskPhaseSameAsFrom, int64(ConfirmDeclineIntention), CA, "辞退意思を確認", nil},
+	{TaskPhaseAnySelection, int64(RequestDecline), TaskPhaseSameAsFrom, int64(ConfirmDeclineIntention), CA, "辞退意思を確認", nil},
+	{AcceptJobOffer, int64(RequestDecline), TaskPhaseSameAsFrom, int64(ConfirmDeclineIntention), CA, "辞退意思を確認", nil},
+	{Entry, int64(RequestHoldBackDecline), TaskPhaseSameAsFrom, int64(ConfirmDeclineIntention), CA, "辞退意思を確認", nil},
+	{DocumentSelection, int64(RequestHoldBackDecline), TaskPhaseSameAsFrom, int64(ConfirmDeclineIntention), CA, "辞退意思を確認", nil},
+	{TaskPhaseAnySelection, int64(RequestHoldBackDecline), TaskPhaseSameAsFrom, int64(ConfirmDeclineIntention), CA, "辞退意思を確認", nil},
+	{AcceptJobOffer, int64(RequestHoldBackDecline), TaskPhaseSameAsFrom, int64(ConfirmDeclineIntention), CA, "辞退意思を確認", nil},
+	{Entry, int64(ConfirmDeclineIntention), TaskPhaseSameAsFrom, ContinueSelection, TaskStaffTypeAny, "選考を継続", []string{TaskPhaseSideEffectContinueSelection}},
+	{DocumentSelection, int64(ConfirmDeclineIntention), TaskPhaseSameAsFrom, ContinueSelection, TaskStaffTypeAny, "選考を継続", []string{TaskPhaseSideEffectContinueSelection}},
+	{TaskPhaseAnySelection, int64(ConfirmDeclineIntention), TaskPhaseSameAsFrom, ContinueSelection, TaskStaffTypeAny, "選考を継続", []string{TaskPhaseSideEffectContinueSelection}},
+	{Entry, int64(ConfirmDeclineIntention), TaskPhaseSameAsFrom, CloseEntryPhaseForRequestDecline, TaskStaffTypeAny, "辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{DocumentSelection, int64(ConfirmDeclineIntention), TaskPhaseSameAsFrom, CloseDocumentPhaseForRequestDecline, TaskStaffTypeAny, "辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{TaskPhaseAnySelection, int64(ConfirmDeclineIntention), TaskPhaseSameAsFrom, CloseSelectionPhaseForRequestDecline, TaskStaffTypeAny, "辞退で終了", []string{TaskPhaseSideEffectSaleFailure}},
+	{AcceptJobOffer, int64(ConfirmDeclineIntention), TaskPhaseSameAsFrom, AcceptJobOfferPhaseClose, TaskStaffTypeAny, "承諾後辞退で終了", nil},
+}
@@ -0,0 +1,67 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+)
+
+type AdminAuthorize struct {
+	Token     string            `json:"token"`
+	MFAStatus string            `json:"mfa_status"` // enrollment_required, verification_required
+	ExpiresAt time.Time         `json:"expires_at"`
+	AdminUser *entity.AdminUser `json:"admin_user"`
+}
+
+func NewAdminAuthorize(token, mfaStatus string, expiresAt time.Time, adminUser *entity.AdminUser) AdminAuthorize {
+	return AdminAuthorize{
+		Token:     token,
+		MFAStatus: mfaStatus,
+		ExpiresAt: expiresAt,
+		AdminUser: adminUser,
+	}
+}
+
+type AdminTOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"` // 認証アプリに登録するURI（otpauth://）
+}
+
+func NewAdminTOTPEnrollment(secret, uri string) AdminTOTPEnrollment {
+	return AdminTOTPEnrollment{
+		Secret: secret,
+		URI:    uri,
+	}
+}
+
+type AdminSession struct {
+	MFAStatus string    `json:"mfa_status"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewAdminSession(mfaStatus string, expiresAt time.Time) AdminSession {
+	return AdminSession{
+		MFAStatus: mfaStatus,
+		ExpiresAt: expiresAt,
+	}
+}
+
+type AdminUser struct {
+	AdminUser *entity.AdminUser `json:"admin_user"`
+}
+
+func NewAdminUser(adminUser *entity.AdminUser) AdminUser {
+	return AdminUser{
+		AdminUser: adminUser,
+	}
+}
+
+type AdminActionLogList struct {
+	ActionLogList []*entity.AdminActionLog `json:"action_log_list"`
+}
+
+func NewAdminActionLogList(actionLogList []*entity.AdminActionLog) AdminActionLogList {
+	return AdminActionLogList{
+		ActionLogList: actionLogList,
+	}
+}
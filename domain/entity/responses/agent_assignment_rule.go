@@ -0,0 +1,23 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type AgentAssignmentRule struct {
+	AssignmentRule *entity.AgentAssignmentRule `json:"assignment_rule"`
+}
+
+func NewAgentAssignmentRule(assignmentRule *entity.AgentAssignmentRule) AgentAssignmentRule {
+	return AgentAssignmentRule{
+		AssignmentRule: assignmentRule,
+	}
+}
+
+type JobSeekerAssignmentLogList struct {
+	AssignmentLogList []*entity.JobSeekerAssignmentLog `json:"assignment_log_list"`
+}
+
+func NewJobSeekerAssignmentLogList(assignmentLogList []*entity.JobSeekerAssignmentLog) JobSeekerAssignmentLogList {
+	return JobSeekerAssignmentLogList{
+		AssignmentLogList: assignmentLogList,
+	}
+}
@@ -0,0 +1,23 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type APIKey struct {
+	APIKey *entity.APIKey `json:"api_key"`
+}
+
+func NewAPIKey(apiKey *entity.APIKey) APIKey {
+	return APIKey{
+		APIKey: apiKey,
+	}
+}
+
+type APIKeyList struct {
+	APIKeyList []*entity.APIKey `json:"api_key_list"`
+}
+
+func NewAPIKeyList(apiKeyList []*entity.APIKey) APIKeyList {
+	return APIKeyList{
+		APIKeyList: apiKeyList,
+	}
+}
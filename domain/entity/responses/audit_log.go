@@ -0,0 +1,15 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type AuditLogListAndMaxPage struct {
+	MaxPageNumber uint               `json:"max_page_number"`
+	AuditLogList  []*entity.AuditLog `json:"audit_log_list"`
+}
+
+func NewAuditLogListAndMaxPage(auditLogList []*entity.AuditLog, maxPageNumber uint) AuditLogListAndMaxPage {
+	return AuditLogListAndMaxPage{
+		MaxPageNumber: maxPageNumber,
+		AuditLogList:  auditLogList,
+	}
+}
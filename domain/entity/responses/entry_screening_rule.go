@@ -0,0 +1,23 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type EntryScreeningRule struct {
+	ScreeningRule *entity.EntryScreeningRule `json:"screening_rule"`
+}
+
+func NewEntryScreeningRule(screeningRule *entity.EntryScreeningRule) EntryScreeningRule {
+	return EntryScreeningRule{
+		ScreeningRule: screeningRule,
+	}
+}
+
+type EntryScreeningRuleList struct {
+	ScreeningRuleList []*entity.EntryScreeningRule `json:"screening_rule_list"`
+}
+
+func NewEntryScreeningRuleList(screeningRuleList []*entity.EntryScreeningRule) EntryScreeningRuleList {
+	return EntryScreeningRuleList{
+		ScreeningRuleList: screeningRuleList,
+	}
+}
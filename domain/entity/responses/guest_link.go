@@ -0,0 +1,33 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type GuestLink struct {
+	GuestLink *entity.GuestLink `json:"guest_link"`
+}
+
+func NewGuestLink(guestLink *entity.GuestLink) GuestLink {
+	return GuestLink{
+		GuestLink: guestLink,
+	}
+}
+
+type GuestLinkList struct {
+	GuestLinkList []*entity.GuestLink `json:"guest_link_list"`
+}
+
+func NewGuestLinkList(guestLinkList []*entity.GuestLink) GuestLinkList {
+	return GuestLinkList{
+		GuestLinkList: guestLinkList,
+	}
+}
+
+type GuestLinkAccessLogList struct {
+	AccessLogList []*entity.GuestLinkAccessLog `json:"access_log_list"`
+}
+
+func NewGuestLinkAccessLogList(accessLogList []*entity.GuestLinkAccessLog) GuestLinkAccessLogList {
+	return GuestLinkAccessLogList{
+		AccessLogList: accessLogList,
+	}
+}
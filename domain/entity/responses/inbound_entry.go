@@ -0,0 +1,13 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type InboundEntryResult struct {
+	Result entity.InboundEntryResult `json:"result"`
+}
+
+func NewInboundEntryResult(result entity.InboundEntryResult) InboundEntryResult {
+	return InboundEntryResult{
+		Result: result,
+	}
+}
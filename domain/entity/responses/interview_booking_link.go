@@ -0,0 +1,13 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type InterviewBookingLink struct {
+	BookingLink *entity.InterviewBookingLink `json:"booking_link"`
+}
+
+func NewInterviewBookingLink(bookingLink *entity.InterviewBookingLink) InterviewBookingLink {
+	return InterviewBookingLink{
+		BookingLink: bookingLink,
+	}
+}
@@ -0,0 +1,23 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type JobOffer struct {
+	JobOffer *entity.JobOffer `json:"job_offer"`
+}
+
+func NewJobOffer(jobOffer *entity.JobOffer) JobOffer {
+	return JobOffer{
+		JobOffer: jobOffer,
+	}
+}
+
+type JobOfferList struct {
+	JobOfferList []*entity.JobOffer `json:"job_offer_list"` // 比較に使う年収の高い順
+}
+
+func NewJobOfferList(jobOfferList []*entity.JobOffer) JobOfferList {
+	return JobOfferList{
+		JobOfferList: jobOfferList,
+	}
+}
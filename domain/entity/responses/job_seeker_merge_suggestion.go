@@ -0,0 +1,13 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type JobSeekerMergeSuggestionList struct {
+	MergeSuggestionList []*entity.JobSeekerMergeSuggestion `json:"merge_suggestion_list"`
+}
+
+func NewJobSeekerMergeSuggestionList(mergeSuggestionList []*entity.JobSeekerMergeSuggestion) JobSeekerMergeSuggestionList {
+	return JobSeekerMergeSuggestionList{
+		MergeSuggestionList: mergeSuggestionList,
+	}
+}
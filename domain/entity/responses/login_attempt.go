@@ -0,0 +1,13 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type LoginAttemptList struct {
+	LoginAttemptList []*entity.LoginAttempt `json:"login_attempt_list"`
+}
+
+func NewLoginAttemptList(loginAttemptList []*entity.LoginAttempt) LoginAttemptList {
+	return LoginAttemptList{
+		LoginAttemptList: loginAttemptList,
+	}
+}
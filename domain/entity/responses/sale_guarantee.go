@@ -0,0 +1,33 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type SalePolicy struct {
+	SalePolicy *entity.SalePolicy `json:"sale_policy"`
+}
+
+func NewSalePolicy(salePolicy *entity.SalePolicy) SalePolicy {
+	return SalePolicy{
+		SalePolicy: salePolicy,
+	}
+}
+
+type SaleGuaranteeList struct {
+	SaleGuaranteeList []*entity.SaleGuarantee `json:"sale_guarantee_list"` // 入社日の古い順
+}
+
+func NewSaleGuaranteeList(saleGuaranteeList []*entity.SaleGuarantee) SaleGuaranteeList {
+	return SaleGuaranteeList{
+		SaleGuaranteeList: saleGuaranteeList,
+	}
+}
+
+type SaleRefund struct {
+	SaleRefund *entity.SaleRefund `json:"sale_refund"`
+}
+
+func NewSaleRefund(saleRefund *entity.SaleRefund) SaleRefund {
+	return SaleRefund{
+		SaleRefund: saleRefund,
+	}
+}
@@ -95,3 +95,27 @@ func NewJobSeekerTaskList(tasks []*entity.JobSeekerTask) JobSeekerTaskList {
 		TaskList: tasks,
 	}
 }
+
+type TaskPhaseActionList struct {
+	Task       *entity.Task             `json:"task"`
+	IsLatest   bool                     `json:"is_latest"`
+	ActionList []entity.TaskPhaseAction `json:"action_list"`
+}
+
+type DuplicateRecommendationResultList struct {
+	ResultList []*entity.DuplicateRecommendationResult `json:"result_list"` // 推薦の対象の指定順
+}
+
+func NewDuplicateRecommendationResultList(resultList []*entity.DuplicateRecommendationResult) DuplicateRecommendationResultList {
+	return DuplicateRecommendationResultList{
+		ResultList: resultList,
+	}
+}
+
+func NewTaskPhaseActionList(task *entity.Task, isLatest bool, actionList []entity.TaskPhaseAction) TaskPhaseActionList {
+	return TaskPhaseActionList{
+		Task:       task,
+		IsLatest:   isLatest,
+		ActionList: actionList,
+	}
+}
@@ -0,0 +1,23 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type TaskBulkJob struct {
+	Job *entity.TaskBulkJob `json:"job"`
+}
+
+func NewTaskBulkJob(job *entity.TaskBulkJob) TaskBulkJob {
+	return TaskBulkJob{
+		Job: job,
+	}
+}
+
+type TaskBulkJobList struct {
+	JobList []*entity.TaskBulkJob `json:"job_list"` // 新しい順
+}
+
+func NewTaskBulkJobList(jobList []*entity.TaskBulkJob) TaskBulkJobList {
+	return TaskBulkJobList{
+		JobList: jobList,
+	}
+}
@@ -0,0 +1,13 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type TaskGroupEventList struct {
+	EventList []*entity.TaskGroupEvent `json:"event_list"` // 発生順
+}
+
+func NewTaskGroupEventList(eventList []*entity.TaskGroupEvent) TaskGroupEventList {
+	return TaskGroupEventList{
+		EventList: eventList,
+	}
+}
@@ -0,0 +1,35 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type TaskLossReasonMasterList struct {
+	MasterList []entity.TaskLossReasonMaster `json:"master_list"`
+}
+
+func NewTaskLossReasonMasterList(masterList []entity.TaskLossReasonMaster) TaskLossReasonMasterList {
+	return TaskLossReasonMasterList{
+		MasterList: masterList,
+	}
+}
+
+type TaskLossReasonList struct {
+	LossReasonList []*entity.TaskLossReason `json:"loss_reason_list"`
+}
+
+func NewTaskLossReasonList(lossReasonList []*entity.TaskLossReason) TaskLossReasonList {
+	return TaskLossReasonList{
+		LossReasonList: lossReasonList,
+	}
+}
+
+type TaskLossReasonAnalytics struct {
+	AnalyticsList []*entity.TaskLossReasonAnalytics `json:"analytics_list"` // 件数の多い順
+	Summary       *entity.TaskLossReasonAnalytics   `json:"summary"`        // 全体の内訳
+}
+
+func NewTaskLossReasonAnalytics(analyticsList []*entity.TaskLossReasonAnalytics, summary *entity.TaskLossReasonAnalytics) TaskLossReasonAnalytics {
+	return TaskLossReasonAnalytics{
+		AnalyticsList: analyticsList,
+		Summary:       summary,
+	}
+}
@@ -0,0 +1,41 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type TaskPipeline struct {
+	PhaseList  []*entity.TaskPipelinePhase `json:"phase_list"` // フェーズの昇順
+	TotalCount uint                        `json:"total_count"`
+}
+
+func NewTaskPipeline(phaseList []*entity.TaskPipelinePhase, totalCount uint) TaskPipeline {
+	return TaskPipeline{
+		PhaseList:  phaseList,
+		TotalCount: totalCount,
+	}
+}
+
+type TaskPipelineCardList struct {
+	CardList      []*entity.TaskPipelineCard `json:"card_list"` // 現在のフェーズになった日時の新しい順
+	TotalCount    uint                       `json:"total_count"`
+	MaxPageNumber uint                       `json:"max_page_number"`
+}
+
+func NewTaskPipelineCardList(cardList []*entity.TaskPipelineCard, totalCount, maxPageNumber uint) TaskPipelineCardList {
+	return TaskPipelineCardList{
+		CardList:      cardList,
+		TotalCount:    totalCount,
+		MaxPageNumber: maxPageNumber,
+	}
+}
+
+type TaskPipelineApplicantList struct {
+	ApplicantList []*entity.TaskPipelineApplicant `json:"applicant_list"` // フェーズの進んでいる順
+	TotalCount    uint                            `json:"total_count"`
+}
+
+func NewTaskPipelineApplicantList(applicantList []*entity.TaskPipelineApplicant, totalCount uint) TaskPipelineApplicantList {
+	return TaskPipelineApplicantList{
+		ApplicantList: applicantList,
+		TotalCount:    totalCount,
+	}
+}
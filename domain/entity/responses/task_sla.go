@@ -0,0 +1,25 @@
+package responses
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity"
+
+type TaskSLARule struct {
+	Rule *entity.TaskSLARule `json:"rule"`
+}
+
+func NewTaskSLARule(rule *entity.TaskSLARule) TaskSLARule {
+	return TaskSLARule{
+		Rule: rule,
+	}
+}
+
+type TaskSLARuleList struct {
+	RuleList        []*entity.TaskSLARule `json:"rule_list"`
+	DefaultRuleList []entity.TaskSLARule  `json:"default_rule_list"` // 設定がないフェーズに適用する初期設定
+}
+
+func NewTaskSLARuleList(ruleList []*entity.TaskSLARule, defaultRuleList []entity.TaskSLARule) TaskSLARuleList {
+	return TaskSLARuleList{
+		RuleList:        ruleList,
+		DefaultRuleList: defaultRuleList,
+	}
+}
@@ -20,6 +20,7 @@ type Sale struct {
 	CAStaffID           uint       `db:"ca_staff_id" json:"ca_staff_id"`
 	RaSalesRatio        null.Float `db:"ra_sales_ratio" json:"ra_sales_ratio"`
 	CaSalesRatio        null.Float `db:"ca_sales_ratio" json:"ca_sales_ratio"`
+	IsDraft             bool       `db:"is_draft" json:"is_draft"` // 内定承諾時に自動作成された未確認の売上か（更新すると確認済みにする）
 	CreatedAt           time.Time  `db:"created_at" json:"created_at"`
 	UpdatedAt           time.Time  `db:"updated_at" json:"updated_at"`
 
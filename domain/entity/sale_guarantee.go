@@ -0,0 +1,181 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// 返金規定の段階（RA側のエージェントごとに設定し、設定がない場合は DefaultAgentRefundTierList を使用する）
+// 入社から within_days 日以内の退職に refund_rate %を返金する
+type AgentRefundTier struct {
+	ID         uint      `db:"id" json:"id"`
+	AgentID    uint      `db:"agent_id" json:"agent_id"`
+	WithinDays uint      `db:"within_days" json:"within_days"` // 入社から何日以内の退職に適用するか
+	RefundRate uint      `db:"refund_rate" json:"refund_rate"` // 返金率（%）
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func NewAgentRefundTier(
+	agentID uint,
+	withinDays uint,
+	refundRate uint,
+) *AgentRefundTier {
+	return &AgentRefundTier{
+		AgentID:    agentID,
+		WithinDays: withinDays,
+		RefundRate: refundRate,
+	}
+}
+
+// 早期退職による返金の記録（売上ごとに1件）
+type SaleRefund struct {
+	ID                    uint       `db:"id" json:"id"`
+	SaleID                uint       `db:"sale_id" json:"sale_id"`
+	JoiningDate           string     `db:"joining_date" json:"joining_date"`
+	ResignationDate       string     `db:"resignation_date" json:"resignation_date"`
+	DaysEmployed          uint       `db:"days_employed" json:"days_employed"`                     // 在籍日数
+	RefundRate            uint       `db:"refund_rate" json:"refund_rate"`                         // 適用した返金率（%。保証期間外の場合は0）
+	OriginalBillingAmount null.Float `db:"original_billing_amount" json:"original_billing_amount"` // 減額前の請求金額
+	RefundAmount          null.Float `db:"refund_amount" json:"refund_amount"`
+	Remarks               string     `db:"remarks" json:"remarks"`
+	AgentStaffID          null.Int   `db:"agent_staff_id" json:"agent_staff_id"` // 記録した担当者
+	CreatedAt             time.Time  `db:"created_at" json:"created_at"`
+}
+
+func NewSaleRefund(
+	saleID uint,
+	joiningDate string,
+	resignationDate string,
+	daysEmployed uint,
+	refundRate uint,
+	originalBillingAmount null.Float,
+	refundAmount null.Float,
+	remarks string,
+	agentStaffID null.Int,
+) *SaleRefund {
+	return &SaleRefund{
+		SaleID:                saleID,
+		JoiningDate:           joiningDate,
+		ResignationDate:       resignationDate,
+		DaysEmployed:          daysEmployed,
+		RefundRate:            refundRate,
+		OriginalBillingAmount: originalBillingAmount,
+		RefundAmount:          refundAmount,
+		Remarks:               remarks,
+		AgentStaffID:          agentStaffID,
+	}
+}
+
+// 送信した返金保証期間の通知（同じ入社日・同じ段階に対して重複して送らないために記録する）
+type SaleGuaranteeNotification struct {
+	ID           uint      `db:"id" json:"id"`
+	SaleID       uint      `db:"sale_id" json:"sale_id"`
+	AgentStaffID uint      `db:"agent_staff_id" json:"agent_staff_id"`
+	WithinDays   uint      `db:"within_days" json:"within_days"`   // 終了が近い返金規定の段階
+	JoiningDate  string    `db:"joining_date" json:"joining_date"` // 通知時点の入社日
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+}
+
+func NewSaleGuaranteeNotification(
+	saleID uint,
+	agentStaffID uint,
+	withinDays uint,
+	joiningDate string,
+) *SaleGuaranteeNotification {
+	return &SaleGuaranteeNotification{
+		SaleID:       saleID,
+		AgentStaffID: agentStaffID,
+		WithinDays:   withinDays,
+		JoiningDate:  joiningDate,
+	}
+}
+
+// 返金保証期間の対象の売上（入社日が登録された選考の売上）
+type SaleGuarantee struct {
+	SaleID           uint       `db:"sale_id" json:"sale_id"`
+	TaskGroupID      uint       `db:"task_group_id" json:"task_group_id"`
+	JobSeekerID      uint       `db:"job_seeker_id" json:"job_seeker_id"`
+	JobInformationID uint       `db:"job_information_id" json:"job_information_id"`
+	BillingAmount    null.Float `db:"billing_amount" json:"billing_amount"`
+	IsDraft          bool       `db:"is_draft" json:"is_draft"`
+	JoiningDate      string     `db:"joining_date" json:"joining_date"`
+	RAStaffID        uint       `db:"ra_staff_id" json:"ra_staff_id"`
+	CAStaffID        uint       `db:"ca_staff_id" json:"ca_staff_id"`
+	RAAgentID        uint       `db:"ra_agent_id" json:"ra_agent_id"`
+	CAAgentID        uint       `db:"ca_agent_id" json:"ca_agent_id"`
+	RAStaffName      string     `db:"ra_staff_name" json:"ra_staff_name"`
+	CAStaffName      string     `db:"ca_staff_name" json:"ca_staff_name"`
+	LastName         string     `db:"last_name" json:"last_name"`
+	FirstName        string     `db:"first_name" json:"first_name"`
+	Title            string     `db:"title" json:"title"`
+	CompanyName      string     `db:"company_name" json:"company_name"`
+
+	// 返金の記録（退職が記録されていない場合はnull）
+	ResignationDate null.String `db:"resignation_date" json:"resignation_date"`
+	RefundRate      null.Int    `db:"refund_rate" json:"refund_rate"`
+	RefundAmount    null.Float  `db:"refund_amount" json:"refund_amount"`
+
+	// 表示用
+	Status            int64  `db:"-" json:"status"` // 状態（0: 入社前, 1: 保証期間中, 2: 保証期間終了, 3: 早期退職）
+	StatusName        string `db:"-" json:"status_name"`
+	GuaranteeEndDate  string `db:"-" json:"guarantee_end_date"`  // 保証期間の最終日
+	CurrentRefundRate uint   `db:"-" json:"current_refund_rate"` // 本日退職した場合の返金率（%）
+	NextTierEndDate   string `db:"-" json:"next_tier_end_date"`  // 現在の段階の最終日（返金率が下がる前日）
+}
+
+// 返金保証期間の状態
+const (
+	SaleGuaranteeStatusBeforeJoining int64 = iota // 入社前
+	SaleGuaranteeStatusActive                     // 保証期間中
+	SaleGuaranteeStatusEnded                      // 保証期間終了
+	SaleGuaranteeStatusResigned                   // 早期退職
+)
+
+var SaleGuaranteeStatusName = map[int64]string{
+	SaleGuaranteeStatusBeforeJoining: "入社前",
+	SaleGuaranteeStatusActive:        "保証期間中",
+	SaleGuaranteeStatusEnded:         "保証期間終了",
+	SaleGuaranteeStatusResigned:      "早期退職",
+}
+
+// エージェントの設定がない場合の返金規定（入社から1ヶ月以内は80%、3ヶ月以内は50%）
+var DefaultAgentRefundTierList = []AgentRefundTier{
+	{WithinDays: 30, RefundRate: 80},
+	{WithinDays: 90, RefundRate: 50},
+}
+
+// 返金規定の段階が終わる何日前に通知するか
+const SaleGuaranteeAlertDays = 7
+
+// 設定できる保証期間の上限（日数）
+const SaleGuaranteeMaxDays = 365
+
+// 自動作成する売上のRAの売上比率の初期値（%）
+const DefaultRASalesRatio = 50
+
+// 売上の自動作成・返金規定の設定
+type SalePolicy struct {
+	RASalesRatio        uint              `json:"ra_sales_ratio"`         // RAの売上比率（%。CAは100から引いた値）
+	RefundTierList      []AgentRefundTier `json:"refund_tier_list"`       // 入社からの日数の短い順
+	IsDefaultRefundTier bool              `json:"is_default_refund_tier"` // 設定がないため初期設定の返金規定を使用しているか
+}
+
+// 売上の自動作成・返金規定の更新 body
+// refund_tier_list を空にした場合は初期設定の返金規定を使用する
+type UpdateSalePolicyParam struct {
+	RASalesRatio   uint                   `json:"ra_sales_ratio" validate:"lte=100"`
+	RefundTierList []AgentRefundTierParam `json:"refund_tier_list"`
+}
+
+type AgentRefundTierParam struct {
+	WithinDays uint `json:"within_days"`
+	RefundRate uint `json:"refund_rate"`
+}
+
+// 退職の記録 body
+type RecordSaleResignationParam struct {
+	ResignationDate string `json:"resignation_date" validate:"required"` // 2006-01-02
+	Remarks         string `json:"remarks"`
+}
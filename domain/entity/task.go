@@ -171,6 +171,7 @@ type NextTaskParam struct {
 	DeadlineTime                null.Int            `json:"deadline_time" validate:"required"`
 	GoodPoint                   string              `json:"good_point"`
 	NGPoint                     string              `json:"ng_point"`
+	LossReason                  null.Int            `json:"loss_reason"` // 辞退・不合格の理由（辞退・不合格で終了する場合は必須）
 	Document1URL                string              `json:"document1_url"`
 	Document2URL                string              `json:"document2_url"`
 	Document3URL                string              `json:"document3_url"`
@@ -212,6 +213,8 @@ type CreateTaskInBatchProcessingParam struct {
 	DeadlineTime           null.Int    `json:"deadline_time" validate:"required"`
 	PrevStaffType          null.Int    `json:"prev_staff_type" validate:"required"`
 	TaskList               []TaskParam `json:"task_list"`
+
+	DuplicateOverrideReason string `json:"duplicate_override_reason"` // 同じ企業への重複推薦を進める理由
 }
 
 type TaskParam struct {
@@ -225,6 +228,7 @@ type TaskParam struct {
 	Remarks                     string              `json:"remarks"`
 	GoodPoint                   string              `json:"good_point"`
 	NGPoint                     string              `json:"ng_point"`
+	LossReason                  null.Int            `json:"loss_reason"` // 辞退・不合格の理由（辞退・不合格で終了する場合は必須）
 	Document1URL                string              `json:"document1_url"`
 	Document2URL                string              `json:"document2_url"`
 	Document3URL                string              `json:"document3_url"`
@@ -500,6 +504,7 @@ type SoundOutForJobInformationParam struct {
 	TalkAboutInInterview        string          `json:"talk_about_in_interview"`
 	ScheduleCollectionCondition string          `json:"schedule_collection_condition"`
 	ExamGuideContent            string          `json:"exam_guide_content"`
+	DuplicateOverrideReason     string          `json:"duplicate_override_reason"` // 同じ企業への重複推薦を進める理由
 }
 
 type SendJobListingMessages struct {
@@ -521,6 +526,7 @@ type SoundOutForJobInformationForSendMessageParam struct {
 	ScheduleCollectionCondition string                   `json:"schedule_collection_condition"`
 	ExamGuideContent            string                   `json:"exam_guide_content"`
 	Messages                    []SendJobListingMessages `json:"messages" validate:"required"`
+	DuplicateOverrideReason     string                   `json:"duplicate_override_reason"` // 同じ企業への重複推薦を進める理由
 }
 
 type JobSeekerTask struct {
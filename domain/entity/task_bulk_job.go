@@ -0,0 +1,137 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// タスクの一括操作のジョブ
+// 複数の選考（タスクグループ）に同じ操作を行う。リクエスト時は登録のみ行い、バッチで1件ずつ処理する
+type TaskBulkJob struct {
+	ID           uint      `db:"id" json:"id"`
+	AgentID      uint      `db:"agent_id" json:"agent_id"`
+	AgentStaffID uint      `db:"agent_staff_id" json:"agent_staff_id"` // 依頼した担当者
+	ActionType   int64     `db:"action_type" json:"action_type"`       // 操作（0: フェーズの遷移, 1: 終了, 2: 担当者の変更, 3: 期限の設定）
+	Param        string    `db:"param" json:"param"`                   // 操作の内容（CreateTaskBulkJobParamのJSON）
+	Status       int64     `db:"status" json:"status"`                 // 状態（0: 待機中, 1: 実行中, 2: 完了）
+	TotalCount   uint      `db:"total_count" json:"total_count"`
+	SuccessCount uint      `db:"success_count" json:"success_count"`
+	FailureCount uint      `db:"failure_count" json:"failure_count"`
+	StartedAt    null.Time `db:"started_at" json:"started_at"`
+	FinishedAt   null.Time `db:"finished_at" json:"finished_at"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	StaffName string `db:"staff_name" json:"staff_name"`
+
+	ItemList []*TaskBulkJobItem `db:"-" json:"item_list"`
+}
+
+func NewTaskBulkJob(
+	agentID uint,
+	agentStaffID uint,
+	actionType int64,
+	param string,
+	totalCount uint,
+) *TaskBulkJob {
+	return &TaskBulkJob{
+		AgentID:      agentID,
+		AgentStaffID: agentStaffID,
+		ActionType:   actionType,
+		Param:        param,
+		Status:       TaskBulkJobStatusPending,
+		TotalCount:   totalCount,
+	}
+}
+
+// タスクの一括操作の選考ごとの結果
+type TaskBulkJobItem struct {
+	ID            uint      `db:"id" json:"id"`
+	TaskBulkJobID uint      `db:"task_bulk_job_id" json:"task_bulk_job_id"`
+	TaskGroupID   uint      `db:"task_group_id" json:"task_group_id"`
+	Status        int64     `db:"status" json:"status"`               // 結果（0: 未処理, 1: 成功, 2: 失敗）
+	TaskID        null.Int  `db:"task_id" json:"task_id"`             // 作成・更新したタスク
+	ErrorMessage  string    `db:"error_message" json:"error_message"` // 失敗した理由
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
+
+	// 他テーブル
+	LastName    string `db:"last_name" json:"last_name"`
+	FirstName   string `db:"first_name" json:"first_name"`
+	Title       string `db:"title" json:"title"`
+	CompanyName string `db:"company_name" json:"company_name"`
+}
+
+func NewTaskBulkJobItem(
+	taskBulkJobID uint,
+	taskGroupID uint,
+) *TaskBulkJobItem {
+	return &TaskBulkJobItem{
+		TaskBulkJobID: taskBulkJobID,
+		TaskGroupID:   taskGroupID,
+		Status:        TaskBulkJobItemStatusPending,
+	}
+}
+
+// 一括操作の内容 body
+// action_type により使用する項目が異なる
+//   - 0: フェーズの遷移 phase_category, phase_sub_category（必須）, staff_type, remarks, good_point, ng_point, deadline_day, deadline_time
+//   - 1: 終了 phase_category, phase_sub_category（終了のサブフェーズのみ）, remarks
+//   - 2: 担当者の変更 ra_staff_id, ca_staff_id（どちらか必須）, is_confirmed_reassign_scope（必須）
+//     CAは求職者、RAは求人の請求先の担当者を変更するため、同じ求職者・請求先の選択していない選考にも反映される
+//   - 3: 期限の設定 deadline_day（必須）, deadline_time
+type CreateTaskBulkJobParam struct {
+	ActionType       int64    `json:"action_type"`
+	TaskGroupIDList  []uint   `json:"task_group_id_list" validate:"required"`
+	PhaseCategory    null.Int `json:"phase_category"`
+	PhaseSubCategory null.Int `json:"phase_sub_category"`
+	StaffType        null.Int `json:"staff_type"` // 次のタスクを実行する担当者（RA or CA）
+	Remarks          string   `json:"remarks"`
+	GoodPoint        string   `json:"good_point"` // 評価点（合格・不合格の遷移のみ）
+	NGPoint          string   `json:"ng_point"`
+	LossReason       null.Int `json:"loss_reason"`   // 辞退・不合格の理由（辞退・不合格で終了する場合は必須）
+	DeadlineDay      string   `json:"deadline_day"`  // fmt: yyyy-mm-dd
+	DeadlineTime     null.Int `json:"deadline_time"` // 0~23時
+	RAStaffID        null.Int `json:"ra_staff_id"`   // 求人の請求先のRA担当者
+	CAStaffID        null.Int `json:"ca_staff_id"`   // 求職者のCA担当者
+
+	// 担当者の変更が選択した選考以外にも反映されることを確認したか
+	IsConfirmedReassignScope bool `json:"is_confirmed_reassign_scope"`
+}
+
+// 操作
+const (
+	TaskBulkActionTransition int64 = iota // フェーズの遷移
+	TaskBulkActionClose                   // 終了
+	TaskBulkActionReassign                // 担当者の変更
+	TaskBulkActionDeadline                // 期限の設定
+)
+
+var TaskBulkActionName = map[int64]string{
+	TaskBulkActionTransition: "フェーズの遷移",
+	TaskBulkActionClose:      "終了",
+	TaskBulkActionReassign:   "担当者の変更",
+	TaskBulkActionDeadline:   "期限の設定",
+}
+
+// ジョブの状態
+const (
+	TaskBulkJobStatusPending int64 = iota // 待機中
+	TaskBulkJobStatusRunning              // 実行中
+	TaskBulkJobStatusDone                 // 完了
+)
+
+// 選考ごとの結果
+const (
+	TaskBulkJobItemStatusPending int64 = iota // 未処理
+	TaskBulkJobItemStatusSuccess              // 成功
+	TaskBulkJobItemStatusFailure              // 失敗
+)
+
+// 1回の一括操作で指定できる選考の上限
+const TaskBulkJobMaxItemCount = 500
+
+// 1回のバッチで処理するジョブの上限
+const TaskBulkJobBatchLimit = 5
@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// タスクグループ（求職者×求人の選考）の活動履歴（追記のみ）
+// タスクは次のタスクを新しい行として作成するため、誰がいつどのフェーズに進めたかをイベントとして残す
+type TaskGroupEvent struct {
+	ID                   uint      `db:"id" json:"id"`
+	TaskGroupID          uint      `db:"task_group_id" json:"task_group_id"`
+	JobSeekerID          uint      `db:"job_seeker_id" json:"job_seeker_id"`
+	JobInformationID     uint      `db:"job_information_id" json:"job_information_id"`
+	EventType            uint      `db:"event_type" json:"event_type"`                           // 種類（0: フェーズの変更, 1: フェーズの取り消し, 2: 書類の更新, 3: 選考日時の変更, 4: 評価ポイントの登録, 5: 売上の作成, 6: 売上の更新, 7: 重複推薦の承認, 8: 内定の状態の変更）
+	AgentStaffID         null.Int  `db:"agent_staff_id" json:"agent_staff_id"`                   // 操作した担当者（特定できない場合はnull）
+	TaskID               null.Int  `db:"task_id" json:"task_id"`                                 // 対象のタスク
+	PrevPhaseCategory    null.Int  `db:"prev_phase_category" json:"prev_phase_category"`         // 変更前のフェーズ
+	PrevPhaseSubCategory null.Int  `db:"prev_phase_sub_category" json:"prev_phase_sub_category"` // 変更前のサブフェーズ
+	PhaseCategory        null.Int  `db:"phase_category" json:"phase_category"`                   // 変更後のフェーズ
+	PhaseSubCategory     null.Int  `db:"phase_sub_category" json:"phase_sub_category"`           // 変更後のサブフェーズ
+	Diff                 string    `db:"diff" json:"diff"`                                       // 項目ごとの変更前後の値（AuditLogFieldDiffのJSON配列）
+	CreatedAt            time.Time `db:"created_at" json:"created_at"`
+
+	// 他テーブル
+	StaffName   string `db:"staff_name" json:"staff_name"`
+	Title       string `db:"title" json:"title"`
+	CompanyName string `db:"company_name" json:"company_name"`
+
+	// 表示用
+	EventName        string `json:"event_name"`
+	PrevPhaseName    string `json:"prev_phase_name"`
+	PrevPhaseSubName string `json:"prev_phase_sub_name"`
+	PhaseName        string `json:"phase_name"`
+	PhaseSubName     string `json:"phase_sub_name"`
+}
+
+// タスクグループは taskGroupID、または求職者IDと求人IDの組み合わせで特定する
+func NewTaskGroupEvent(
+	taskGroupID uint,
+	jobSeekerID uint,
+	jobInformationID uint,
+	eventType uint,
+	agentStaffID null.Int,
+	taskID null.Int,
+	prevPhaseCategory null.Int,
+	prevPhaseSubCategory null.Int,
+	phaseCategory null.Int,
+	phaseSubCategory null.Int,
+	diff string,
+) *TaskGroupEvent {
+	return &TaskGroupEvent{
+		TaskGroupID:          taskGroupID,
+		JobSeekerID:          jobSeekerID,
+		JobInformationID:     jobInformationID,
+		EventType:            eventType,
+		AgentStaffID:         agentStaffID,
+		TaskID:               taskID,
+		PrevPhaseCategory:    prevPhaseCategory,
+		PrevPhaseSubCategory: prevPhaseSubCategory,
+		PhaseCategory:        phaseCategory,
+		PhaseSubCategory:     phaseSubCategory,
+		Diff:                 diff,
+	}
+}
+
+const (
+	TaskGroupEventPhaseChange          uint = iota // フェーズの変更（タスクの作成）
+	TaskGroupEventPhaseCancel                      // フェーズの取り消し（タスクの削除）
+	TaskGroupEventDocumentUpdate                   // 書類の更新
+	TaskGroupEventSelectionDateChange              // 選考日時の変更
+	TaskGroupEventEvaluationPoint                  // 評価ポイントの登録
+	TaskGroupEventSaleCreate                       // 売上の作成
+	TaskGroupEventSaleUpdate                       // 売上の更新
+	TaskGroupEventDuplicateOverride                // 重複推薦の承認（理由を入力して推薦を進めた）
+	TaskGroupEventJobOfferStatusChange             // 内定の状態の変更（提示・承諾・辞退など）
+)
+
+var TaskGroupEventName = map[uint]string{
+	TaskGroupEventPhaseChange:          "フェーズの変更",
+	TaskGroupEventPhaseCancel:          "フェーズの取り消し",
+	TaskGroupEventDocumentUpdate:       "書類の更新",
+	TaskGroupEventSelectionDateChange:  "選考日時の変更",
+	TaskGroupEventEvaluationPoint:      "評価ポイントの登録",
+	TaskGroupEventSaleCreate:           "売上の作成",
+	TaskGroupEventSaleUpdate:           "売上の更新",
+	TaskGroupEventDuplicateOverride:    "重複推薦の承認",
+	TaskGroupEventJobOfferStatusChange: "内定の状態の変更",
+}
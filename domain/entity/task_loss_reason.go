@@ -0,0 +1,195 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// 辞退・不合格の理由（失注理由）
+// 辞退・不合格で終了するタスクの作成時に理由を選択して記録し、企業・求人・担当者・フェーズごとに集計する
+type TaskLossReason struct {
+	ID               uint      `db:"id" json:"id"`
+	TaskGroupID      uint      `db:"task_group_id" json:"task_group_id"`
+	TaskID           uint      `db:"task_id" json:"task_id"` // 終了のタスク
+	PhaseCategory    int64     `db:"phase_category" json:"phase_category"`
+	PhaseSubCategory int64     `db:"phase_sub_category" json:"phase_sub_category"`
+	LossType         int64     `db:"loss_type" json:"loss_type"`     // 0: 辞退, 1: 不合格・NG
+	LossReason       int64     `db:"loss_reason" json:"loss_reason"` // TaskLossReasonMasterList の理由
+	Remarks          string    `db:"remarks" json:"remarks"`
+	AgentStaffID     null.Int  `db:"agent_staff_id" json:"agent_staff_id"` // 記録した担当者（タスクの実行者）
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+
+	// 集計用
+	JobInformationID uint     `db:"job_information_id" json:"job_information_id"`
+	EnterpriseID     null.Int `db:"enterprise_id" json:"enterprise_id"`
+	CompanyName      string   `db:"company_name" json:"company_name"`
+	Title            string   `db:"title" json:"title"`
+	CAStaffID        null.Int `db:"ca_staff_id" json:"ca_staff_id"`
+	RAStaffID        null.Int `db:"ra_staff_id" json:"ra_staff_id"`
+	CAStaffName      string   `db:"ca_staff_name" json:"ca_staff_name"`
+	RAStaffName      string   `db:"ra_staff_name" json:"ra_staff_name"`
+
+	// 表示用
+	LossTypeName   string `db:"-" json:"loss_type_name"`
+	LossReasonName string `db:"-" json:"loss_reason_name"`
+}
+
+func NewTaskLossReason(
+	taskGroupID uint,
+	taskID uint,
+	phaseCategory int64,
+	phaseSubCategory int64,
+	lossType int64,
+	lossReason int64,
+	remarks string,
+	agentStaffID null.Int,
+) *TaskLossReason {
+	return &TaskLossReason{
+		TaskGroupID:      taskGroupID,
+		TaskID:           taskID,
+		PhaseCategory:    phaseCategory,
+		PhaseSubCategory: phaseSubCategory,
+		LossType:         lossType,
+		LossReason:       lossReason,
+		Remarks:          remarks,
+		AgentStaffID:     agentStaffID,
+	}
+}
+
+// 失注の種類
+const (
+	TaskLossTypeDecline   int64 = iota // 辞退（求職者側）
+	TaskLossTypeRejection              // 不合格・NG（企業側）
+)
+
+var TaskLossTypeName = map[int64]string{
+	TaskLossTypeDecline:   "辞退",
+	TaskLossTypeRejection: "不合格・NG",
+}
+
+// 辞退の理由
+const (
+	TaskLossReasonSalary         int64 = iota // 年収・給与の不一致
+	TaskLossReasonLocation                    // 勤務地・転勤
+	TaskLossReasonCompetingOffer              // 他社の内定・選考
+	TaskLossReasonJobContent                  // 仕事内容の不一致
+	TaskLossReasonImpression                  // 社風・面接の印象
+	TaskLossReasonWorkStyle                   // 働き方・休日
+	TaskLossReasonPersonal                    // 家庭・健康の事情
+	TaskLossReasonStayCurrentJob              // 現職に残留
+	TaskLossReasonStopJobHunting              // 転職活動の中止・延期
+	TaskLossReasonNoContact                   // 連絡不通
+)
+
+// 不合格・NGの理由
+const (
+	TaskLossReasonSkill          int64 = 100 + iota // スキル・経験不足
+	TaskLossReasonCareer                            // 年齢・経歴の不一致
+	TaskLossReasonPersonality                       // 人物面・コミュニケーション
+	TaskLossReasonMotivation                        // 志望動機・意欲
+	TaskLossReasonDesiredSalary                     // 希望年収の不一致
+	TaskLossReasonOtherCandidate                    // 他の候補者を採用
+	TaskLossReasonPositionClosed                    // 募集の終了
+)
+
+// その他（辞退・不合格のどちらでも選択できる）
+const TaskLossReasonOther int64 = 999
+
+// 理由の定義で使用する失注の種類の特別な値
+const TaskLossTypeAny int64 = -1 // 辞退・不合格のどちらでも選択できる
+
+// 理由の定義
+type TaskLossReasonMaster struct {
+	LossReason int64          `json:"loss_reason"`
+	LossType   int64          `json:"loss_type"` // -1: 辞退・不合格のどちらでも選択できる
+	Name       string         `json:"name"`
+	PhaseList  []TaskCategory `json:"-"` // 選択できるフェーズ（nilの場合は全てのフェーズ）
+}
+
+// 選考〜内定承諾のフェーズ（面接を経ている場合のみ選択できる理由に使用する）
+var taskLossPhaseAfterInterview = []TaskCategory{TaskPhaseAnySelection, HoldJobOffer, AcceptJobOffer}
+
+var TaskLossReasonMasterList = []TaskLossReasonMaster{
+	/************ 辞退 **************/
+	{TaskLossReasonSalary, TaskLossTypeDecline, "年収・給与の不一致", nil},
+	{TaskLossReasonLocation, TaskLossTypeDecline, "勤務地・転勤", nil},
+	{TaskLossReasonCompetingOffer, TaskLossTypeDecline, "他社の内定・選考", nil},
+	{TaskLossReasonJobContent, TaskLossTypeDecline, "仕事内容の不一致", nil},
+	{TaskLossReasonImpression, TaskLossTypeDecline, "社風・面接の印象", taskLossPhaseAfterInterview},
+	{TaskLossReasonWorkStyle, TaskLossTypeDecline, "働き方・休日", nil},
+	{TaskLossReasonPersonal, TaskLossTypeDecline, "家庭・健康の事情", nil},
+	{TaskLossReasonStayCurrentJob, TaskLossTypeDecline, "現職に残留", nil},
+	{TaskLossReasonStopJobHunting, TaskLossTypeDecline, "転職活動の中止・延期", nil},
+	{TaskLossReasonNoContact, TaskLossTypeDecline, "連絡不通", nil},
+
+	/************ 不合格・NG **************/
+	{TaskLossReasonSkill, TaskLossTypeRejection, "スキル・経験不足", nil},
+	{TaskLossReasonCareer, TaskLossTypeRejection, "年齢・経歴の不一致", nil},
+	{TaskLossReasonPersonality, TaskLossTypeRejection, "人物面・コミュニケーション", []TaskCategory{TaskPhaseAnySelection}},
+	{TaskLossReasonMotivation, TaskLossTypeRejection, "志望動機・意欲", []TaskCategory{TaskPhaseAnySelection}},
+	{TaskLossReasonDesiredSalary, TaskLossTypeRejection, "希望年収の不一致", nil},
+	{TaskLossReasonOtherCandidate, TaskLossTypeRejection, "他の候補者を採用", nil},
+	{TaskLossReasonPositionClosed, TaskLossTypeRejection, "募集の終了", nil},
+
+	{TaskLossReasonOther, TaskLossTypeAny, "その他", nil},
+}
+
+// 理由の記録が必要な終了のサブフェーズ
+type TaskLossClose struct {
+	Phase    TaskCategory // ○次選考・最終選考は TaskPhaseAnySelection
+	PhaseSub int64
+	LossType int64
+}
+
+// 辞退・不合格で終了するサブフェーズ（中断終了・終了は対象外）
+var TaskLossCloseList = []TaskLossClose{
+	{Entry, CloseEntryPhaseForUnlikely, TaskLossTypeRejection},
+	{Entry, CloseEntryPhaseForDeclineEntry, TaskLossTypeDecline},
+	{Entry, CloseEntryPhaseForRequestDecline, TaskLossTypeDecline},
+	{DocumentSelection, CloseDocumentPhaseForFailingNotice, TaskLossTypeRejection},
+	{DocumentSelection, CloseDocumentPhaseForDeclineEntry, TaskLossTypeDecline},
+	{DocumentSelection, CloseDocumentPhaseForRequestDecline, TaskLossTypeDecline},
+	{TaskPhaseAnySelection, CloseSelectionPhaseForFailingNoticeOfSelection, TaskLossTypeRejection},
+	{TaskPhaseAnySelection, CloseSelectionPhaseForRequestDecline, TaskLossTypeDecline},
+	{HoldJobOffer, CloseHoldJobOfferPhaseForDeclineJobOffer, TaskLossTypeDecline},
+	{HoldJobOffer, CloseHoldJobOfferPhaseForRequestDeclineOfSelection, TaskLossTypeDecline},
+	{AcceptJobOffer, AcceptJobOfferPhaseClose, TaskLossTypeDecline},
+}
+
+// 集計の単位
+const (
+	TaskLossGroupByEnterprise     = "enterprise"      // 企業
+	TaskLossGroupByJobInformation = "job_information" // 求人
+	TaskLossGroupByCAStaff        = "ca_staff"        // CA担当者
+	TaskLossGroupByRAStaff        = "ra_staff"        // RA担当者
+	TaskLossGroupByPhase          = "phase"           // 終了したフェーズ
+)
+
+// 辞退・不合格の理由の集計条件
+// 0・空の項目は絞り込まない
+type SearchTaskLossReasonParam struct {
+	AgentID  uint      // ログイン中の担当者のエージェントID（自社がCA・RAのいずれかで関わる選考のみ集計する）
+	GroupBy  string    // 集計の単位
+	LossType null.Int  // 辞退 or 不合格・NG
+	FromDate time.Time // 終了日の期間（開始）
+	ToDate   time.Time // 終了日の期間（終了）
+}
+
+// 理由ごとの件数
+type TaskLossReasonCount struct {
+	LossReason     int64   `json:"loss_reason"`
+	LossReasonName string  `json:"loss_reason_name"`
+	Count          uint    `json:"count"`
+	Rate           float64 `json:"rate"` // 集計単位の件数に占める割合（%）
+}
+
+// 集計単位ごとの理由の内訳
+type TaskLossReasonAnalytics struct {
+	GroupID         int64                  `json:"group_id"` // 企業・求人・担当者のID、フェーズ（未設定の場合は0）
+	GroupName       string                 `json:"group_name"`
+	TotalCount      uint                   `json:"total_count"`
+	DeclineCount    uint                   `json:"decline_count"`
+	RejectionCount  uint                   `json:"rejection_count"`
+	ReasonCountList []*TaskLossReasonCount `json:"reason_count_list"` // 件数の多い順
+}
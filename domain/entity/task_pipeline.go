@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// パイプライン（選考の進捗ボード）
+// 選考（タスクグループ）ごとの最新タスクのフェーズ・サブフェーズで件数を集計し、列ごとにカードを表示する
+
+// サブフェーズごとの件数
+type TaskPipelineCount struct {
+	PhaseCategory    int64  `db:"phase_category" json:"phase_category"`
+	PhaseSubCategory int64  `db:"phase_sub_category" json:"phase_sub_category"`
+	Count            uint   `db:"count" json:"count"`
+	PhaseSubName     string `json:"phase_sub_name"`
+}
+
+// フェーズごとの件数（ボードの列）
+type TaskPipelinePhase struct {
+	PhaseCategory int64                `json:"phase_category"`
+	PhaseName     string               `json:"phase_name"`
+	Count         uint                 `json:"count"`
+	SubList       []*TaskPipelineCount `json:"sub_list"`
+}
+
+// ボードに表示するカード（一覧表示に必要な項目のみ）
+type TaskPipelineCard struct {
+	TaskGroupID      uint      `db:"task_group_id" json:"task_group_id"`
+	TaskID           uint      `db:"task_id" json:"task_id"`
+	JobSeekerID      uint      `db:"job_seeker_id" json:"job_seeker_id"`
+	JobInformationID uint      `db:"job_information_id" json:"job_information_id"`
+	PhaseCategory    int64     `db:"phase_category" json:"phase_category"`
+	PhaseSubCategory int64     `db:"phase_sub_category" json:"phase_sub_category"`
+	StaffType        null.Int  `db:"staff_type" json:"staff_type"` // 次に対応する担当者（RA or CA）
+	DeadlineDay      string    `db:"deadline_day" json:"deadline_day"`
+	DeadlineTime     null.Int  `db:"deadline_time" json:"deadline_time"`
+	IsDoubleSided    bool      `db:"is_double_sided" json:"is_double_sided"`
+	LastName         string    `db:"last_name" json:"last_name"`
+	FirstName        string    `db:"first_name" json:"first_name"`
+	Title            string    `db:"title" json:"title"`
+	CompanyName      string    `db:"company_name" json:"company_name"`
+	CAAgentID        uint      `db:"ca_agent_id" json:"ca_agent_id"`
+	RAAgentID        uint      `db:"ra_agent_id" json:"ra_agent_id"`
+	CAStaffID        null.Int  `db:"ca_staff_id" json:"ca_staff_id"`
+	RAStaffID        null.Int  `db:"ra_staff_id" json:"ra_staff_id"`
+	CAStaffName      string    `db:"ca_staff_name" json:"ca_staff_name"`
+	RAStaffName      string    `db:"ra_staff_name" json:"ra_staff_name"`
+	PhaseChangedAt   time.Time `db:"phase_changed_at" json:"phase_changed_at"` // 現在のフェーズになった日時（最新タスクの作成日時）
+	EntryAt          time.Time `db:"entry_at" json:"entry_at"`                 // エントリー日時（タスクグループの作成日時）
+}
+
+// パイプラインの検索条件
+// 0・空の項目は絞り込まない
+type SearchTaskPipelineParam struct {
+	AgentID          uint      // ログイン中の担当者のエージェントID（自社がCA・RAのいずれかで関わる選考のみ集計する）
+	AgentStaffID     uint      // CA・RAのいずれかの担当者
+	RAStaffID        uint      // RA担当者（求人の請求先の担当者）
+	CAStaffID        uint      // CA担当者（求職者の担当者）
+	EnterpriseID     uint      // 企業
+	JobInformationID uint      // 求人
+	AllianceAgentID  uint      // アライアンス先のエージェント（自社と相手のエージェントの組み合わせが一致する選考）
+	FromDate         time.Time // エントリー日の期間（開始）
+	ToDate           time.Time // エントリー日の期間（終了）
+	IncludeClosed    bool      // 終了した選考を含めるか
+	PhaseCategory    null.Int  // カードを取得する列（フェーズ）
+	PhaseSubCategory null.Int  // カードを取得する列（サブフェーズ、nullの場合はフェーズ内の全て）
+	PageNumber       uint
+}
+
+// 1ページあたりのカードの件数
+const TaskPipelineCardPerPage = 20
+
+// 求人ごとの応募者（選考中の求職者）
+// 選考ごとの最新タスクと最新の評価点を表示する
+type TaskPipelineApplicant struct {
+	TaskGroupID      uint        `db:"task_group_id" json:"task_group_id"`
+	TaskID           uint        `db:"task_id" json:"task_id"`
+	JobSeekerID      uint        `db:"job_seeker_id" json:"job_seeker_id"`
+	JobInformationID uint        `db:"job_information_id" json:"job_information_id"`
+	Title            string      `db:"title" json:"title"`
+	LastName         string      `db:"last_name" json:"last_name"`
+	FirstName        string      `db:"first_name" json:"first_name"`
+	PhaseCategory    int64       `db:"phase_category" json:"phase_category"`
+	PhaseSubCategory int64       `db:"phase_sub_category" json:"phase_sub_category"`
+	StaffType        null.Int    `db:"staff_type" json:"staff_type"` // 次に対応する担当者（RA or CA）
+	DeadlineDay      string      `db:"deadline_day" json:"deadline_day"`
+	DeadlineTime     null.Int    `db:"deadline_time" json:"deadline_time"`
+	CAAgentID        uint        `db:"ca_agent_id" json:"ca_agent_id"`
+	CAAgentName      string      `db:"ca_agent_name" json:"ca_agent_name"`
+	RAAgentID        uint        `db:"ra_agent_id" json:"ra_agent_id"`
+	CAStaffID        null.Int    `db:"ca_staff_id" json:"ca_staff_id"`
+	CAStaffName      string      `db:"ca_staff_name" json:"ca_staff_name"`
+	RAStaffID        null.Int    `db:"ra_staff_id" json:"ra_staff_id"`
+	RAStaffName      string      `db:"ra_staff_name" json:"ra_staff_name"`
+	PhaseChangedAt   time.Time   `db:"phase_changed_at" json:"phase_changed_at"` // 現在のフェーズになった日時（最新タスクの作成日時）
+	EntryAt          time.Time   `db:"entry_at" json:"entry_at"`                 // エントリー日時（タスクグループの作成日時）
+	IsPassed         null.Bool   `db:"is_passed" json:"is_passed"`               // 最新の評価点（未登録の場合はnull）
+	IsReInterview    null.Bool   `db:"is_re_interview" json:"is_re_interview"`
+	GoodPoint        null.String `db:"good_point" json:"good_point"`
+	NGPoint          null.String `db:"ng_point" json:"ng_point"`
+	EvaluatedAt      null.Time   `db:"evaluated_at" json:"evaluated_at"`
+
+	PhaseName           string `db:"-" json:"phase_name"`
+	PhaseSubName        string `db:"-" json:"phase_sub_name"`
+	DaysInPhase         uint   `db:"-" json:"days_in_phase"`          // 現在のフェーズの滞留日数
+	IsAlliance          bool   `db:"-" json:"is_alliance"`            // CAがアライアンス先のエージェントか
+	NextActionStaffName string `db:"-" json:"next_action_staff_name"` // 次に対応する担当者名
+}
@@ -0,0 +1,155 @@
+package entity
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// タスクの期限（SLA）の設定
+// エージェントごとにフェーズ単位で設定し、設定がないフェーズは TaskSLADefaultRuleList を使用する
+type TaskSLARule struct {
+	ID               uint      `db:"id" json:"id"`
+	AgentID          uint      `db:"agent_id" json:"agent_id"`
+	TargetType       int64     `db:"target_type" json:"target_type"`               // 対象のタスク（0: 選考タスク, 1: 面談調整タスク）
+	PhaseCategory    int64     `db:"phase_category" json:"phase_category"`         // フェーズ
+	PhaseSubCategory null.Int  `db:"phase_sub_category" json:"phase_sub_category"` // サブフェーズ（nullの場合はフェーズ内の全てのサブフェーズ）
+	DeadlineHours    uint      `db:"deadline_hours" json:"deadline_hours"`         // 期限が未設定のタスクの期限（作成からの営業時間。0の場合は期限を設けない）
+	ReminderHours    uint      `db:"reminder_hours" json:"reminder_hours"`         // 期限の何営業時間前にリマインドするか（0の場合はリマインドしない）
+	EscalationHours  uint      `db:"escalation_hours" json:"escalation_hours"`     // 期限切れから何営業時間後に上長へエスカレーションするか
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func NewTaskSLARule(
+	agentID uint,
+	targetType int64,
+	phaseCategory int64,
+	phaseSubCategory null.Int,
+	deadlineHours uint,
+	reminderHours uint,
+	escalationHours uint,
+) *TaskSLARule {
+	return &TaskSLARule{
+		AgentID:          agentID,
+		TargetType:       targetType,
+		PhaseCategory:    phaseCategory,
+		PhaseSubCategory: phaseSubCategory,
+		DeadlineHours:    deadlineHours,
+		ReminderHours:    reminderHours,
+		EscalationHours:  escalationHours,
+	}
+}
+
+// 送信したタスクの期限の通知（同じ期限に対して同じ通知を重複して送らないために記録する）
+type TaskSLANotification struct {
+	ID               uint      `db:"id" json:"id"`
+	TargetType       int64     `db:"target_type" json:"target_type"`             // 対象のタスク（0: 選考タスク, 1: 面談調整タスク）
+	TaskID           uint      `db:"task_id" json:"task_id"`                     // タスクID（tasks.id or interview_tasks.id）
+	AgentStaffID     uint      `db:"agent_staff_id" json:"agent_staff_id"`       // 通知した担当者ID
+	NotificationType int64     `db:"notification_type" json:"notification_type"` // 通知の種類（0: リマインド, 1: 期限切れ, 2: エスカレーション）
+	DueAt            time.Time `db:"due_at" json:"due_at"`                       // 通知時点の期限
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+}
+
+func NewTaskSLANotification(
+	targetType int64,
+	taskID uint,
+	agentStaffID uint,
+	notificationType int64,
+	dueAt time.Time,
+) *TaskSLANotification {
+	return &TaskSLANotification{
+		TargetType:       targetType,
+		TaskID:           taskID,
+		AgentStaffID:     agentStaffID,
+		NotificationType: notificationType,
+		DueAt:            dueAt,
+	}
+}
+
+// 対象のタスク
+const (
+	TaskSLATargetTask          int64 = iota // 選考タスク
+	TaskSLATargetInterviewTask              // 面談調整タスク
+)
+
+// 通知の種類
+const (
+	TaskSLANotificationReminder   int64 = iota // 期限前のリマインド
+	TaskSLANotificationOverdue                 // 期限切れ
+	TaskSLANotificationEscalation              // 上長へのエスカレーション
+)
+
+// 期限の判定に使う営業時間のカレンダー（日本時間。土日と休業日は営業時間に含めない）
+type TaskSLACalendar struct {
+	Location  *time.Location
+	StartHour int
+	EndHour   int
+	Holidays  map[string]bool // 休業日（2006-01-02形式）
+}
+
+// 営業時間の初期値
+const (
+	TaskSLADefaultStartHour = 9
+	TaskSLADefaultEndHour   = 18
+)
+
+// 設定できる営業時間の上限（約3ヶ月分）
+const TaskSLAMaxHours = 9 * 60
+
+// エージェントの設定がないフェーズの期限
+// 期限が未設定のタスクは作成から deadline_hours 営業時間後を期限とする
+var TaskSLADefaultRuleList = []TaskSLARule{
+	// 選考タスク
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(Entry), DeadlineHours: 18, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(DocumentSelection), DeadlineHours: 27, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(FirstSelection), DeadlineHours: 18, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(SecondSelection), DeadlineHours: 18, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(ThirdSelection), DeadlineHours: 18, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(FourthSelection), DeadlineHours: 18, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(FifthSelection), DeadlineHours: 18, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(FinalSelection), DeadlineHours: 18, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(HoldJobOffer), DeadlineHours: 18, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetTask, PhaseCategory: int64(AcceptJobOffer), DeadlineHours: 27, ReminderHours: 3, EscalationHours: 18},
+
+	// 面談調整タスク
+	{TargetType: TaskSLATargetInterviewTask, PhaseCategory: int64(EntryInterview), DeadlineHours: 3, ReminderHours: 1, EscalationHours: 6},
+	{TargetType: TaskSLATargetInterviewTask, PhaseCategory: int64(InvitationInterview), DeadlineHours: 18, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetInterviewTask, PhaseCategory: int64(ReservationInterview), DeadlineHours: 9, ReminderHours: 3, EscalationHours: 9},
+	{TargetType: TaskSLATargetInterviewTask, PhaseCategory: int64(WaitingInterview), DeadlineHours: 9, ReminderHours: 3, EscalationHours: 9},
+}
+
+// タスクの期限の状況（日次まとめ・画面表示用）
+type TaskSLAStatus struct {
+	TargetType       int64     `json:"target_type"`
+	TaskID           uint      `json:"task_id"`
+	AgentStaffID     uint      `json:"agent_staff_id"` // 担当者
+	StaffName        string    `json:"staff_name"`
+	PhaseCategory    int64     `json:"phase_category"`
+	PhaseSubCategory int64     `json:"phase_sub_category"`
+	PhaseName        string    `json:"phase_name"`
+	JobSeekerName    string    `json:"job_seeker_name"`
+	Title            string    `json:"title"`      // 求人タイトル（面談調整タスクの場合は空文字）
+	DueAt            time.Time `json:"due_at"`     // 期限
+	IsDefault        bool      `json:"is_default"` // 期限が未設定のため既定の期限を使用しているか
+}
+
+// タスクの期限の設定 body
+// phase_sub_category を指定しない場合はフェーズ内の全てのサブフェーズに適用する
+type CreateOrUpdateTaskSLARuleParam struct {
+	TargetType       int64    `json:"target_type"`
+	PhaseCategory    int64    `json:"phase_category"`
+	PhaseSubCategory null.Int `json:"phase_sub_category"`
+	DeadlineHours    uint     `json:"deadline_hours"`
+	ReminderHours    uint     `json:"reminder_hours"`
+	EscalationHours  uint     `json:"escalation_hours"`
+}
+
+// 面談調整タスクのフェーズ名（期限の通知に使用する対応中のフェーズのみ）
+var TaskSLAInterviewPhaseName = map[int64]string{
+	int64(EntryInterview):       "エントリー",
+	int64(InvitationInterview):  "面談案内済み（面談調整中）",
+	int64(ReservationInterview): "面談予約完了",
+	int64(WaitingInterview):     "面談実施待ち",
+}
@@ -63,6 +63,12 @@ func NewUser(
 type GuestEnterpriseUser struct {
 	JobInformationUUID uuid.UUID `db:"job_information_uuid" json:"job_information_uuid"`
 	CompanyName        string    `db:"company_name" json:"company_name"`
+
+	// ゲスト用リンクで許可されている操作（UUIDのみのリンクの場合は全て）
+	Permissions []string `db:"-" json:"permissions"`
+
+	// ゲスト用APIのトークン（X-Guest-Token ヘッダーに指定する）
+	GuestToken string `db:"-" json:"guest_token"`
 }
 
 func NewGuestEnterpriseUser(
@@ -72,6 +78,7 @@ func NewGuestEnterpriseUser(
 	return &GuestEnterpriseUser{
 		JobInformationUUID: jobInformationUUID,
 		CompanyName:        companyName,
+		Permissions:        []string{GuestLinkPermissionRead, GuestLinkPermissionWrite},
 	}
 }
 
@@ -84,6 +91,12 @@ type GuestJobSeekerUser struct {
 	AgentID            uint      `db:"agent_id" json:"agent_id"`
 	Phase              null.Int  `db:"phase" json:"phase"`
 	CanViewMatchingJob bool      `db:"can_view_matching_job" json:"can_view_matching_job"`
+
+	// ゲスト用リンクで許可されている操作（UUIDのみのリンク・LPからのログインの場合は全て）
+	Permissions []string `db:"-" json:"permissions"`
+
+	// ゲスト用APIのトークン（X-Guest-Token ヘッダーに指定する）
+	GuestToken string `db:"-" json:"guest_token"`
 }
 
 func NewGuestJobSeekerUser(
@@ -105,5 +118,6 @@ func NewGuestJobSeekerUser(
 		AgentID:            agentID,
 		Phase:              phase,
 		CanViewMatchingJob: canViewMatchingJob,
+		Permissions:        []string{GuestLinkPermissionRead, GuestLinkPermissionWrite},
 	}
 }
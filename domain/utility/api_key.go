@@ -0,0 +1,42 @@
+package utility
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// 外部連携用のAPIキー
+// 「ask_」+ ランダムな32バイトのbase64url の形式で発行し、DBにはSHA-256のハッシュのみを保存する
+// （キー自体が十分に長いランダム値のため、ブラインドインデックスの鍵の切り替えに影響されないよう鍵なしのハッシュにする）
+
+const APIKeyPrefix = "ask_"
+
+// 画面で識別するために保存するキーの先頭の文字数
+const apiKeyDisplayLength = 12
+
+// APIキーを発行する（戻り値のdisplayPrefixは一覧で識別するためのキーの先頭部分）
+func GenerateAPIKey() (key, displayPrefix string, err error) {
+	b := make([]byte, 32)
+	_, err = rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	return key, key[:apiKeyDisplayLength], nil
+}
+
+// APIキーのハッシュ
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(key)))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIキーの形式か
+func IsAPIKey(key string) bool {
+	return strings.HasPrefix(strings.TrimSpace(key), APIKeyPrefix)
+}
@@ -0,0 +1,378 @@
+package utility
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 個人情報・外部サービスのパスワードの暗号化・復号化（AES-GCM）
+// 暗号文は「v{鍵のバージョン}:」+ base64(nonce + 暗号文) の形式で保存し、鍵を切り替えた後も古い鍵で復号できるようにする
+//
+// 環境変数
+//   ENCRYPTION_KEY_VERSION: 暗号化に使う鍵のバージョン（未設定の場合は1）
+//   ENCRYPTION_KEY_V{n}:    バージョンnの鍵（v1は未設定の場合 ENCRYPTION_KEY を使う）
+//   ENCRYPTION_KEY:         鍵のバージョン導入前の鍵（バージョンのないパスワードの復号に使う）
+//   BLIND_INDEX_KEY:        ブラインドインデックスの鍵（未設定の場合 ENCRYPTION_KEY を使う）
+
+var ErrEncryptionKeyNotFound = errors.New("暗号化の鍵が設定されていません")
+
+// 暗号化済みの値の先頭につける鍵のバージョン
+const encryptedStringPrefix = "v"
+
+// 鍵のバージョンごとの環境変数名（ENCRYPTION_KEY_V{n}）
+var encryptionKeyEnvRegexp = regexp.MustCompile(`^ENCRYPTION_KEY_V([0-9]+)$`)
+
+// 鍵束
+// 暗号化は現在のバージョンの鍵で行い、復号は暗号文のバージョンの鍵で行う
+type EncryptionKeyRing struct {
+	CurrentVersion int
+	keyMap         map[int][]byte
+	legacyKey      []byte // バージョンのない暗号文の鍵
+}
+
+// 起動時に読み込んだ鍵束（未読み込みの場合は呼び出しのたびに環境変数から作る）
+var encryptionKeyRing *EncryptionKeyRing
+
+// 環境変数から鍵束を作る
+// 現在のバージョンの鍵がない場合、AESの鍵として使えない長さの鍵がある場合はエラーを返す
+func NewEncryptionKeyRingFromEnv() (*EncryptionKeyRing, error) {
+	ring := &EncryptionKeyRing{
+		CurrentVersion: 1,
+		keyMap:         map[int][]byte{},
+		legacyKey:      []byte(os.Getenv("ENCRYPTION_KEY")),
+	}
+
+	if versionText := os.Getenv("ENCRYPTION_KEY_VERSION"); versionText != "" {
+		version, err := strconv.Atoi(versionText)
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("ENCRYPTION_KEY_VERSIONが不正です:%s", versionText)
+		}
+		ring.CurrentVersion = version
+	}
+
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+
+		match := encryptionKeyEnvRegexp.FindStringSubmatch(name)
+		if match == nil || value == "" {
+			continue
+		}
+
+		version, err := strconv.Atoi(match[1])
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("鍵のバージョンが不正です:%s", name)
+		}
+		ring.keyMap[version] = []byte(value)
+	}
+
+	if _, ok := ring.keyMap[1]; !ok && len(ring.legacyKey) > 0 {
+		ring.keyMap[1] = ring.legacyKey
+	}
+
+	if !ring.HasVersion(ring.CurrentVersion) {
+		return nil, fmt.Errorf("%w:v%d", ErrEncryptionKeyNotFound, ring.CurrentVersion)
+	}
+
+	for _, version := range ring.VersionList() {
+		if !isValidAESKeyLength(ring.keyMap[version]) {
+			return nil, fmt.Errorf("鍵の長さが不正です（16, 24, 32バイトのいずれか）:v%d", version)
+		}
+	}
+
+	if len(ring.legacyKey) > 0 && !isValidAESKeyLength(ring.legacyKey) {
+		return nil, errors.New("ENCRYPTION_KEYの長さが不正です（16, 24, 32バイトのいずれか）")
+	}
+
+	return ring, nil
+}
+
+// 環境変数から鍵束を読み込む（起動時に一度だけ実行する）
+func LoadEncryptionKeyRing() error {
+	ring, err := NewEncryptionKeyRingFromEnv()
+	if err != nil {
+		return err
+	}
+
+	encryptionKeyRing = ring
+	return nil
+}
+
+// 現在の鍵束
+func GetEncryptionKeyRing() (*EncryptionKeyRing, error) {
+	if encryptionKeyRing != nil {
+		return encryptionKeyRing, nil
+	}
+
+	return NewEncryptionKeyRingFromEnv()
+}
+
+// 指定バージョンの鍵があるか
+func (ring *EncryptionKeyRing) HasVersion(version int) bool {
+	_, ok := ring.keyMap[version]
+	return ok
+}
+
+// 鍵のバージョン一覧（昇順）
+func (ring *EncryptionKeyRing) VersionList() []int {
+	var versionList []int
+	for version := range ring.keyMap {
+		versionList = append(versionList, version)
+	}
+	sort.Ints(versionList)
+
+	return versionList
+}
+
+// 指定バージョンの鍵
+func (ring *EncryptionKeyRing) key(version int) ([]byte, error) {
+	key, ok := ring.keyMap[version]
+	if !ok {
+		return nil, fmt.Errorf("%w:v%d", ErrEncryptionKeyNotFound, version)
+	}
+
+	return key, nil
+}
+
+func isValidAESKeyLength(key []byte) bool {
+	switch len(key) {
+	case 16, 24, 32:
+		return true
+	default:
+		return false
+	}
+}
+
+// 現在の鍵のバージョン
+func CurrentEncryptionKeyVersion() int {
+	ring, err := GetEncryptionKeyRing()
+	if err != nil {
+		return 1
+	}
+
+	return ring.CurrentVersion
+}
+
+// 現在のバージョンの鍵で暗号化する（空文字はそのまま返す）
+func EncryptString(plainText string) (string, error) {
+	if plainText == "" {
+		return "", nil
+	}
+
+	ring, err := GetEncryptionKeyRing()
+	if err != nil {
+		return "", err
+	}
+
+	key, err := ring.key(ring.CurrentVersion)
+	if err != nil {
+		return "", err
+	}
+
+	cipherText, err := EncryptWithKey(key, plainText)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%d:%s", encryptedStringPrefix, ring.CurrentVersion, cipherText), nil
+}
+
+// 暗号化済みの値を復号する
+// 暗号化前に登録された値（バージョンのない値）はそのまま返す
+func DecryptString(text string) (string, error) {
+	version, cipherText, ok := splitEncryptedString(text)
+	if !ok {
+		return text, nil
+	}
+
+	return decryptWithVersion(version, cipherText)
+}
+
+// 暗号化済みのパスワードなどを復号する
+// バージョンのない値は鍵のバージョン導入前に ENCRYPTION_KEY で暗号化された値として復号する
+func DecryptSecret(text string) (string, error) {
+	version, cipherText, ok := splitEncryptedString(text)
+	if ok {
+		return decryptWithVersion(version, cipherText)
+	}
+
+	ring, err := GetEncryptionKeyRing()
+	if err != nil {
+		return "", err
+	}
+
+	if len(ring.legacyKey) == 0 {
+		return "", fmt.Errorf("%w:%s", ErrEncryptionKeyNotFound, "ENCRYPTION_KEY")
+	}
+
+	return DecryptWithKey(ring.legacyKey, text)
+}
+
+func decryptWithVersion(version int, cipherText string) (string, error) {
+	ring, err := GetEncryptionKeyRing()
+	if err != nil {
+		return "", err
+	}
+
+	key, err := ring.key(version)
+	if err != nil {
+		return "", err
+	}
+
+	return DecryptWithKey(key, cipherText)
+}
+
+// 暗号化済みの値か
+func IsEncryptedString(text string) bool {
+	_, _, ok := splitEncryptedString(text)
+	return ok
+}
+
+// 暗号化済みの値の鍵のバージョン（バージョンのない値はfalse）
+func EncryptionKeyVersionOf(text string) (int, bool) {
+	version, _, ok := splitEncryptedString(text)
+	return version, ok
+}
+
+// 暗号化済みの値を鍵のバージョンと暗号文に分ける
+func splitEncryptedString(text string) (int, string, bool) {
+	if !strings.HasPrefix(text, encryptedStringPrefix) {
+		return 0, "", false
+	}
+
+	versionText, cipherText, found := strings.Cut(strings.TrimPrefix(text, encryptedStringPrefix), ":")
+	if !found || cipherText == "" {
+		return 0, "", false
+	}
+
+	version, err := strconv.Atoi(versionText)
+	if err != nil || version < 1 {
+		return 0, "", false
+	}
+
+	// 平文が偶然「v1:」で始まる場合を除くため、base64として読めるかも確認する
+	_, err = base64.StdEncoding.DecodeString(cipherText)
+	if err != nil {
+		return 0, "", false
+	}
+
+	return version, cipherText, true
+}
+
+// 指定の鍵で暗号化し、base64(nonce + 暗号文) を返す
+func EncryptWithKey(key []byte, plainText string) (string, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	encrypted := gcm.Seal(nonce, nonce, []byte(plainText), nil)
+
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// 指定の鍵で base64(nonce + 暗号文) を復号する
+func DecryptWithKey(key []byte, cipherText string) (string, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(cipherText)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(decoded) < nonceSize {
+		return "", errors.New("暗号文が短すぎます")
+	}
+
+	nonce, encrypted := decoded[:nonceSize], decoded[nonceSize:]
+	decrypted, err := gcm.Open(nil, nonce, encrypted, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(decrypted), nil
+}
+
+/****************************************************************************************/
+// ブラインドインデックス
+//
+// 暗号化した項目を復号せずに検索・重複判定するため、正規化した値のHMAC-SHA256を保存する
+// 電話番号はハイフンや国番号の有無、メールアドレスは大文字/小文字の違いを吸収する
+// 氏名・フリガナは媒体ごとの全角/半角、スペース、ひらがな/カタカナの違いを吸収する
+
+// 電話番号のブラインドインデックス（空の場合は空文字）
+func BlindIndexPhoneNumber(phoneNumber string) string {
+	return blindIndex("phone_number", NormalizePhoneNumber(phoneNumber))
+}
+
+// メールアドレスのブラインドインデックス（空の場合は空文字）
+func BlindIndexEmail(email string) string {
+	return blindIndex("email", NormalizeEmail(email))
+}
+
+// 氏名のブラインドインデックス（空の場合は空文字）
+func BlindIndexName(name string) string {
+	return blindIndex("name", NormalizeName(name))
+}
+
+// フリガナのブラインドインデックス（空の場合は空文字）
+func BlindIndexKana(kana string) string {
+	return blindIndex("furigana", NormalizeKana(kana))
+}
+
+// ブラインドインデックスの鍵が設定されているか
+// 鍵を変えると既存のインデックスと一致しなくなるため、暗号化の鍵の切り替えとは別に管理する
+func HasBlindIndexKey() bool {
+	return getBlindIndexKey() != ""
+}
+
+func getBlindIndexKey() string {
+	key := os.Getenv("BLIND_INDEX_KEY")
+	if key == "" {
+		key = os.Getenv("ENCRYPTION_KEY")
+	}
+
+	return key
+}
+
+func blindIndex(indexType, normalized string) string {
+	if normalized == "" {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, []byte(getBlindIndexKey()))
+	mac.Write([]byte(indexType + ":" + normalized))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
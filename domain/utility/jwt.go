@@ -1,6 +1,8 @@
 package utility
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -21,4 +23,114 @@ func NewJWT(userID uint, secret string) (string, error) {
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(secret))
-}
\ No newline at end of file
+}
+
+// ゲスト用リンクのトークン
+type GuestLinkClaims struct {
+	ResourceType string   `json:"resource_type"`
+	ResourceUUID string   `json:"resource_uuid"`
+	Permissions  []string `json:"permissions"`
+	jwt.StandardClaims
+}
+
+// ゲスト用リンクのトークンを発行する（Idにはguest_links.uuidを入れる）
+func NewGuestLinkJWT(linkUUID, resourceType, resourceUUID string, permissions []string, issuedAt, expiresAt time.Time, secret string) (string, error) {
+	if secret == "" {
+		return "", errors.New("guest link secret is empty")
+	}
+
+	claims := &GuestLinkClaims{
+		resourceType,
+		resourceUUID,
+		permissions,
+		jwt.StandardClaims{
+			Id:        linkUUID,
+			IssuedAt:  issuedAt.Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(secret))
+}
+
+// ゲスト用リンクのトークンの署名を検証して内容を取得する
+// 有効期限はguest_linksの値で判定するため、ここでは署名と形式のみ確認する
+func ParseGuestLinkJWT(tokenString, secret string) (*GuestLinkClaims, error) {
+	if secret == "" {
+		return nil, errors.New("guest link secret is empty")
+	}
+
+	claims := &GuestLinkClaims{}
+	parser := &jwt.Parser{SkipClaimsValidation: true}
+	token, err := parser.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(secret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Valid || claims.Id == "" {
+		return nil, errors.New("invalid guest link token")
+	}
+
+	return claims, nil
+}
+
+// ゲストログイン後にゲスト用APIで使うトークン
+// リンクでログインした場合はGuestLinkUUIDにguest_links.uuidを入れ、無効化・有効期限をAPIごとに確認する
+type GuestSessionClaims struct {
+	ResourceType  string   `json:"resource_type"`
+	ResourceUUID  string   `json:"resource_uuid"`
+	Permissions   []string `json:"permissions"`
+	GuestLinkUUID string   `json:"guest_link_uuid"` // UUIDのみのリンク・LPからのログインの場合は空文字
+	jwt.StandardClaims
+}
+
+// ゲスト用APIのトークンを発行する
+func NewGuestSessionJWT(resourceType, resourceUUID string, permissions []string, guestLinkUUID string, issuedAt, expiresAt time.Time, secret string) (string, error) {
+	if secret == "" {
+		return "", errors.New("guest link secret is empty")
+	}
+
+	claims := &GuestSessionClaims{
+		resourceType,
+		resourceUUID,
+		permissions,
+		guestLinkUUID,
+		jwt.StandardClaims{
+			Subject:   "guest_session",
+			IssuedAt:  issuedAt.Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(secret))
+}
+
+// ゲスト用APIのトークンの署名と有効期限を検証して内容を取得する
+func ParseGuestSessionJWT(tokenString, secret string) (*GuestSessionClaims, error) {
+	if secret == "" {
+		return nil, errors.New("guest link secret is empty")
+	}
+
+	claims := &GuestSessionClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(secret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// リンクのトークンをゲスト用APIのトークンとして使うことはできない
+	if !token.Valid || claims.Subject != "guest_session" || claims.ResourceType == "" {
+		return nil, errors.New("invalid guest session token")
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,74 @@
+package utility
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// 求職者の重複判定用の正規化処理
+// 媒体ごとに全角/半角、スペース、ハイフン、ひらがな/カタカナの表記が揺れるため、比較前に揃える
+
+// 氏名の正規化（全角英数字を半角に、半角カナを全角に揃えてスペースを除去）
+func NormalizeName(name string) string {
+	normalized := foldWidth(name)
+	return removeSpace(normalized)
+}
+
+// フリガナの正規化（ひらがなをカタカナに揃えてスペースを除去）
+func NormalizeKana(kana string) string {
+	normalized := foldWidth(kana)
+
+	var builder strings.Builder
+	for _, r := range normalized {
+		// ひらがな（ぁ〜ゖ）はカタカナと0x60ずれている
+		if 'ぁ' <= r && r <= 'ゖ' {
+			r += 0x60
+		}
+		builder.WriteRune(r)
+	}
+
+	return removeSpace(builder.String())
+}
+
+// 電話番号の正規化（数字のみを残し、国番号+81は0に置き換える）
+func NormalizePhoneNumber(phoneNumber string) string {
+	normalized := width.Fold.String(phoneNumber)
+
+	if strings.HasPrefix(normalized, "+81") {
+		normalized = "0" + strings.TrimPrefix(normalized, "+81")
+	}
+
+	var builder strings.Builder
+	for _, r := range normalized {
+		if '0' <= r && r <= '9' {
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
+
+// メールアドレスの正規化（小文字に揃えて前後の空白を除去）
+func NormalizeEmail(email string) string {
+	normalized := width.Fold.String(email)
+	return strings.ToLower(strings.TrimSpace(normalized))
+}
+
+// 全角/半角を揃える
+// 半角カナの濁点・半濁点（ﾀﾞ）は全角にすると結合文字になるため、合成して1文字（ダ）にする
+func foldWidth(str string) string {
+	return norm.NFC.String(width.Fold.String(str))
+}
+
+// 全角・半角スペースを除去
+func removeSpace(str string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, str)
+}
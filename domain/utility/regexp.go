@@ -9,4 +9,5 @@ var (
 	RegexpForAmbiUserID           = regexp.MustCompile(`会員No\.(\d+)`)
 	RegexpForMynaviScoutingUserID = regexp.MustCompile(`会員No\.[\s　]*：[\s　]*(\d+)`) // 全角スペースを含む正規表現（直接全角スペースを入力）
 	RegexpForMynaviAgentScoutUserID = regexp.MustCompile(`求職者ID]\s*(\d+)`)
+	RegexpForRanUserID              = regexp.MustCompile(`会員番号(\d+)`)
 )
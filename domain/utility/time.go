@@ -19,4 +19,19 @@ func EarliestTime() (t time.Time) {
 	t = time.Date(1, time.January, 1, 1, 0, 0, 0, time.UTC)
 	return t
 }
- 
\ No newline at end of file
+
+// 生年月日（2006-01-02形式）から基準日時点の満年齢を算出
+// 生年月日が未入力・形式不正の場合はfalseを返す
+func GetAgeFromBirthday(birthday string, now time.Time) (int, bool) {
+	birthdayTime, err := time.Parse("2006-1-2", birthday)
+	if err != nil {
+		return 0, false
+	}
+
+	age := now.Year() - birthdayTime.Year()
+	if now.Month() < birthdayTime.Month() || (now.Month() == birthdayTime.Month() && now.Day() < birthdayTime.Day()) {
+		age--
+	}
+
+	return age, true
+}
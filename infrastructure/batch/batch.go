@@ -347,6 +347,207 @@ func (b *Batch) SetUp() *Batch {
 		batchEntryUser.Tag("batchEntryUser")
 	}
 
+	/*
+		求職者の個人情報の暗号化
+		暗号化前に登録された求職者の個人情報を暗号化し、検索用のブラインドインデックスを作成する
+		毎日3時に実行（対象がない場合は何もしない）
+	*/
+	batchEncryptJobSeeker, err := b.scheduler.
+		Every(1).
+		Day().
+		At("03:00").
+		Do(
+			func() {
+				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60))
+				log.Println("batchEncryptJobSeekerPersonalInformation開始 現在時刻(JST):", now)
+				err := b.batchEncryptJobSeekerPersonalInformation(now)
+				// slack通知
+				if (b.cfg.App.Env == "prd" || b.cfg.App.Env == "dev") && err != nil {
+					b.notifyError(err)
+				}
+				log.Println("batchEncryptJobSeekerPersonalInformation処理終了")
+			},
+		)
+	if err != nil {
+		log.Println("err:", err)
+		panic(err)
+	}
+
+	batchEncryptJobSeeker.Tag("batchEncryptJobSeekerPersonalInformation")
+
+	/*
+		保存期間を過ぎた求職者の匿名化
+		RETENTION_JOB_SEEKER_YEARS年のあいだ求職者・選考・LINEの更新がない求職者の個人情報を削除する
+		毎日4時に実行（RETENTION_JOB_SEEKER_YEARSが未設定の場合は何もしない）
+	*/
+	batchAnonymizeJobSeeker, err := b.scheduler.
+		Every(1).
+		Day().
+		At("04:00").
+		Do(
+			func() {
+				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60))
+				log.Println("batchAnonymizeInactiveJobSeeker開始 現在時刻(JST):", now)
+				err := b.batchAnonymizeInactiveJobSeeker(now)
+				// slack通知
+				if (b.cfg.App.Env == "prd" || b.cfg.App.Env == "dev") && err != nil {
+					b.notifyError(err)
+				}
+				log.Println("batchAnonymizeInactiveJobSeeker処理終了")
+			},
+		)
+	if err != nil {
+		log.Println("err:", err)
+		panic(err)
+	}
+
+	batchAnonymizeJobSeeker.Tag("batchAnonymizeInactiveJobSeeker")
+
+	/*
+		タスクの期限（SLA）の通知
+		期限前のリマインド・期限切れを担当者へ、期限切れが続くタスクを上長へ通知する
+		1時間おきに実行（営業時間外は何もしない）
+	*/
+	batchNotifyTaskSLA, err := b.scheduler.
+		Every(1).
+		Hour().
+		StartAt(firstStartTime).
+		Do(
+			func() {
+				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60))
+				log.Println("batchNotifyTaskSLA開始 現在時刻(JST):", now)
+				err := b.batchNotifyTaskSLA(now)
+				// slack通知
+				if (b.cfg.App.Env == "prd" || b.cfg.App.Env == "dev") && err != nil {
+					b.notifyError(err)
+				}
+				log.Println("batchNotifyTaskSLA処理終了")
+			},
+		)
+	if err != nil {
+		log.Println("err:", err)
+		panic(err)
+	}
+
+	batchNotifyTaskSLA.Tag("batchNotifyTaskSLA")
+
+	/*
+		内定の回答期限の通知
+		回答期限の24時間前と期限切れをCA・RAの担当者へ通知する
+		1時間おきに実行
+	*/
+	batchNotifyJobOfferDeadline, err := b.scheduler.
+		Every(1).
+		Hour().
+		StartAt(firstStartTime).
+		Do(
+			func() {
+				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60))
+				log.Println("batchNotifyJobOfferDeadline開始 現在時刻(JST):", now)
+				err := b.batchNotifyJobOfferDeadline(now)
+				// slack通知
+				if (b.cfg.App.Env == "prd" || b.cfg.App.Env == "dev") && err != nil {
+					b.notifyError(err)
+				}
+				log.Println("batchNotifyJobOfferDeadline処理終了")
+			},
+		)
+	if err != nil {
+		log.Println("err:", err)
+		panic(err)
+	}
+
+	batchNotifyJobOfferDeadline.Tag("batchNotifyJobOfferDeadline")
+
+	/*
+		返金保証期間の通知
+		返金規定の段階の終了が近い売上をCA・RAの担当者へ通知する
+		毎日9時に実行
+	*/
+	batchNotifySaleGuarantee, err := b.scheduler.
+		Every(1).
+		Day().
+		At("09:00").
+		Do(
+			func() {
+				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60))
+				log.Println("batchNotifySaleGuarantee開始 現在時刻(JST):", now)
+				err := b.batchNotifySaleGuarantee(now)
+				// slack通知
+				if (b.cfg.App.Env == "prd" || b.cfg.App.Env == "dev") && err != nil {
+					b.notifyError(err)
+				}
+				log.Println("batchNotifySaleGuarantee処理終了")
+			},
+		)
+	if err != nil {
+		log.Println("err:", err)
+		panic(err)
+	}
+
+	batchNotifySaleGuarantee.Tag("batchNotifySaleGuarantee")
+
+	/*
+		タスクの期限（SLA）の日次まとめ
+		担当者ごとに期限切れ・本日が期限のタスクを、上長にはメンバーの期限切れのタスクを通知する
+		毎日SLA_DIGEST_AT（未設定の場合は8時）に実行（休業日は何もしない）
+	*/
+	digestAt := b.cfg.SLA.DigestAt
+	if digestAt == "" {
+		digestAt = "08:00"
+	}
+
+	batchNotifyTaskSLADigest, err := b.scheduler.
+		Every(1).
+		Day().
+		At(digestAt).
+		Do(
+			func() {
+				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60))
+				log.Println("batchNotifyTaskSLADigest開始 現在時刻(JST):", now)
+				err := b.batchNotifyTaskSLADigest(now)
+				// slack通知
+				if (b.cfg.App.Env == "prd" || b.cfg.App.Env == "dev") && err != nil {
+					b.notifyError(err)
+				}
+				log.Println("batchNotifyTaskSLADigest処理終了")
+			},
+		)
+	if err != nil {
+		log.Println("err:", err)
+		panic(err)
+	}
+
+	batchNotifyTaskSLADigest.Tag("batchNotifyTaskSLADigest")
+
+	/*
+		タスクの一括操作
+		登録された一括操作を選考ごとに処理し、結果を記録する
+		1分おきに実行
+	*/
+	batchProcessTaskBulkJob, err := b.scheduler.
+		Every(1).
+		Minute().
+		Do(
+			func() {
+				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60))
+				log.Println("batchProcessTaskBulkJob開始 現在時刻(JST):", now)
+				err := b.batchProcessTaskBulkJob(now)
+				// slack通知
+				if (b.cfg.App.Env == "prd" || b.cfg.App.Env == "dev") && err != nil {
+					b.notifyError(err)
+				}
+				log.Println("batchProcessTaskBulkJob処理終了")
+			},
+		)
+	if err != nil {
+		log.Println("err:", err)
+		panic(err)
+	}
+
+	batchProcessTaskBulkJob.Tag("batchProcessTaskBulkJob")
+	batchProcessTaskBulkJob.SingletonMode()
+
 	// 非同期で実行。実行中の処理をブロックせずに処理を実行する
 	b.scheduler.StartAsync()
 
@@ -449,6 +650,126 @@ func (b *Batch) batchScout(now time.Time) error {
 	return nil
 }
 
+// 暗号化前に登録された求職者の個人情報を暗号化
+func (b *Batch) batchEncryptJobSeekerPersonalInformation(now time.Time) error {
+	h := di.InitializeJobSeekerHandler(b.firebase, b.db, b.cfg.Sendgrid, b.cfg.OneSignal, b.cfg.Slack)
+	_, err := h.BatchEncryptJobSeekerPersonalInformation()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// 保存期間を過ぎた求職者を匿名化
+func (b *Batch) batchAnonymizeInactiveJobSeeker(now time.Time) error {
+	h := di.InitializeJobSeekerHandler(b.firebase, b.db, b.cfg.Sendgrid, b.cfg.OneSignal, b.cfg.Slack)
+	_, err := h.BatchAnonymizeInactiveJobSeeker(now, b.cfg.Retention.JobSeekerYears)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// タスクの期限のリマインド・期限切れ・エスカレーションを通知
+func (b *Batch) batchNotifyTaskSLA(now time.Time) error {
+	h := di.InitializeTaskSLAHandler(b.db, b.cfg.Sendgrid, b.cfg.SLA)
+	_, err := h.BatchNotifyTaskSLA(now)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// 内定の回答期限の前日・期限切れを通知
+func (b *Batch) batchNotifyJobOfferDeadline(now time.Time) error {
+	h := di.InitializeJobOfferHandler(b.db, b.cfg.Sendgrid)
+	_, err := h.BatchNotifyJobOfferDeadline(now)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// 返金規定の段階の終了が近い売上を通知
+func (b *Batch) batchNotifySaleGuarantee(now time.Time) error {
+	h := di.InitializeSaleGuaranteeHandler(b.db, b.cfg.Sendgrid)
+	_, err := h.BatchNotifySaleGuarantee(now)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// タスクの期限の日次まとめを通知
+func (b *Batch) batchNotifyTaskSLADigest(now time.Time) error {
+	h := di.InitializeTaskSLAHandler(b.db, b.cfg.Sendgrid, b.cfg.SLA)
+	_, err := h.BatchNotifyTaskSLADigest(now)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// タスクの一括操作を処理
+// 選考1件ごとにトランザクションを分け、失敗した選考はロールバックしてから失敗として記録する
+func (b *Batch) batchProcessTaskBulkJob(now time.Time) error {
+	h := di.InitializeTaskBulkJobHandler(b.db, b.cfg.OneSignal)
+	jobList, err := h.BatchStartTaskBulkJobList(now)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobList {
+		for _, item := range job.ItemList {
+			if item.Status != entity.TaskBulkJobItemStatusPending {
+				continue
+			}
+
+			err = b.processTaskBulkJobItem(job, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = h.BatchFinishTaskBulkJob(job.ID, time.Now().In(time.UTC))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// タスクの一括操作の選考1件をトランザクション内で処理
+func (b *Batch) processTaskBulkJobItem(job *entity.TaskBulkJob, item *entity.TaskBulkJobItem) error {
+	tx, err := b.db.Begin()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	_, itemErr := di.InitializeTaskBulkJobHandler(tx, b.cfg.OneSignal).BatchProcessTaskBulkJobItem(job, item)
+	if itemErr == nil {
+		return tx.Commit()
+	}
+
+	tx.Rollback()
+	log.Println("タスクの一括操作に失敗", job.ID, item.TaskGroupID, itemErr)
+
+	_, err = di.InitializeTaskBulkJobHandler(b.db, b.cfg.OneSignal).BatchFailTaskBulkJobItem(item, itemErr)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // // 各エージェントの求人管理媒体から求人企業を一括インポート
 // func (b *Batch) batchInitialEnterpriseImporter(now time.Time) error {
 // 	tx, err := b.db.Begin()
@@ -24,13 +24,13 @@ var wireSet = wire.NewSet(
 **/
 
 // Seesion
-func InitializeSessionHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid) (h handler.SessionHandler) {
+func InitializeSessionHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, guestLink config.GuestLink) (h handler.SessionHandler) {
 	wire.Build(wireSet)
 	return
 }
 
 // Admin
-func InitializeAdminHandler(db interfaces.SQLExecuter, appConfig config.App) (h handler.AdminHandler) {
+func InitializeAdminHandler(db interfaces.SQLExecuter, adminConfig config.Admin) (h handler.AdminHandler) {
 	wire.Build(wireSet)
 	return
 }
@@ -221,18 +221,144 @@ func InitializeGoogleAuthenticationHandler(fb usecase.Firebase, db interfaces.SQ
 	return
 }
 
+// InboundEntry
+func InitializeInboundEntryHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, oneSignal config.OneSignal) (h handler.InboundEntryHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// JobSeekerMergeSuggestion
+func InitializeJobSeekerMergeSuggestionHandler(fb usecase.Firebase, db interfaces.SQLExecuter) (h handler.JobSeekerMergeSuggestionHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// AgentAssignmentRule
+func InitializeAgentAssignmentRuleHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, oneSignal config.OneSignal) (h handler.AgentAssignmentRuleHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// EntryScreeningRule
+func InitializeEntryScreeningRuleHandler(fb usecase.Firebase, db interfaces.SQLExecuter) (h handler.EntryScreeningRuleHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// InterviewBooking
+func InitializeInterviewBookingHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, oneSignal config.OneSignal) (h handler.InterviewBookingHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// AuditLog
+func InitializeAuditLogHandler(db interfaces.SQLExecuter) (h handler.AuditLogHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// GuestLink
+func InitializeGuestLinkHandler(db interfaces.SQLExecuter, guestLink config.GuestLink) (h handler.GuestLinkHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// APIKey
+func InitializeAPIKeyHandler(db interfaces.SQLExecuter) (h handler.APIKeyHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// TaskSLA
+func InitializeTaskSLAHandler(db interfaces.SQLExecuter, sendgrid config.Sendgrid, sla config.SLA) (h handler.TaskSLAHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// TaskGroupEvent
+func InitializeTaskGroupEventHandler(db interfaces.SQLExecuter) (h handler.TaskGroupEventHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// TaskBulkJob
+func InitializeTaskBulkJobHandler(db interfaces.SQLExecuter, oneSignal config.OneSignal) (h handler.TaskBulkJobHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// TaskPipeline
+func InitializeTaskPipelineHandler(db interfaces.SQLExecuter) (h handler.TaskPipelineHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// JobOffer
+func InitializeJobOfferHandler(db interfaces.SQLExecuter, sendgrid config.Sendgrid) (h handler.JobOfferHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// SaleGuarantee
+func InitializeSaleGuaranteeHandler(db interfaces.SQLExecuter, sendgrid config.Sendgrid) (h handler.SaleGuaranteeHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// TaskLossReason
+func InitializeTaskLossReasonHandler(db interfaces.SQLExecuter) (h handler.TaskLossReasonHandler) {
+	wire.Build(wireSet)
+	return
+}
+
+// LoginAttempt
+func InitializeLoginAttemptHandler(db interfaces.SQLExecuter, captcha usecase.Captcha) (h handler.LoginAttemptHandler) {
+	wire.Build(wireSet)
+	return
+}
+
 /**
 	Interactor
 **/
 
 // Session
-func InitializeSessionInteractor(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid) (i interactor.SessionInteractor) {
+func InitializeSessionInteractor(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, guestLink config.GuestLink) (i interactor.SessionInteractor) {
+	wire.Build(wireSet)
+	return
+}
+
+// Authorization
+func InitializeAuthorizationInteractor(db interfaces.SQLExecuter) (i interactor.AuthorizationInteractor) {
+	wire.Build(wireSet)
+	return
+}
+
+// AuditLog
+func InitializeAuditLogInteractor(db interfaces.SQLExecuter) (i interactor.AuditLogInteractor) {
+	wire.Build(wireSet)
+	return
+}
+
+// APIKey
+func InitializeAPIKeyInteractor(db interfaces.SQLExecuter) (i interactor.APIKeyInteractor) {
+	wire.Build(wireSet)
+	return
+}
+
+// LoginAttempt
+func InitializeLoginAttemptInteractor(db interfaces.SQLExecuter, captcha usecase.Captcha) (i interactor.LoginAttemptInteractor) {
+	wire.Build(wireSet)
+	return
+}
+
+// EncryptionKey
+func InitializeEncryptionKeyInteractor(db interfaces.SQLExecuter) (i interactor.EncryptionKeyInteractor) {
 	wire.Build(wireSet)
 	return
 }
 
 // Admin
-func InitializeAdminInteractor(db interfaces.SQLExecuter, appConfig config.App) (i interactor.AdminInteractor) {
+func InitializeAdminInteractor(db interfaces.SQLExecuter, adminConfig config.Admin) (i interactor.AdminInteractor) {
 	wire.Build(wireSet)
 	return
 }
@@ -19,7 +19,7 @@ import (
 // Injectors from wire.go:
 
 // Seesion
-func InitializeSessionHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid) handler.SessionHandler {
+func InitializeSessionHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, guestLink config.GuestLink) handler.SessionHandler {
 	agentRepository := repository.NewAgentRepositoryImpl(db)
 	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
 	enterpriseProfileRepository := repository.NewEnterpriseProfileRepositoryImpl(db)
@@ -27,14 +27,20 @@ func InitializeSessionHandler(fb usecase.Firebase, db interfaces.SQLExecuter, se
 	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
 	jobSeekerLPLoginTokenRepository := repository.NewJobSeekerLPLoginTokenRepositoryImpl(db)
 	agentAllianceRepository := repository.NewAgentAllianceRepositoryImpl(db)
-	sessionInteractor := interactor.NewSessionInteractorImpl(fb, sendgrid, agentRepository, agentStaffRepository, enterpriseProfileRepository, jobInformationRepository, jobSeekerRepository, jobSeekerLPLoginTokenRepository, agentAllianceRepository)
+	guestLinkRepository := repository.NewGuestLinkRepositoryImpl(db)
+	guestLinkAccessLogRepository := repository.NewGuestLinkAccessLogRepositoryImpl(db)
+	selectionQuestionnaireRepository := repository.NewSelectionQuestionnaireRepositoryImpl(db)
+	sessionInteractor := interactor.NewSessionInteractorImpl(fb, sendgrid, agentRepository, agentStaffRepository, enterpriseProfileRepository, jobInformationRepository, jobSeekerRepository, jobSeekerLPLoginTokenRepository, agentAllianceRepository, guestLink, guestLinkRepository, guestLinkAccessLogRepository, selectionQuestionnaireRepository)
 	sessionHandler := handler.NewSessionHandlerImpl(sessionInteractor)
 	return sessionHandler
 }
 
 // Admin
-func InitializeAdminHandler(db interfaces.SQLExecuter, appConfig config.App) handler.AdminHandler {
-	adminInteractor := interactor.NewAdminInteractorImpl(appConfig)
+func InitializeAdminHandler(db interfaces.SQLExecuter, adminConfig config.Admin) handler.AdminHandler {
+	adminUserRepository := repository.NewAdminUserRepositoryImpl(db)
+	adminSessionRepository := repository.NewAdminSessionRepositoryImpl(db)
+	adminActionLogRepository := repository.NewAdminActionLogRepositoryImpl(db)
+	adminInteractor := interactor.NewAdminInteractorImpl(adminConfig, adminUserRepository, adminSessionRepository, adminActionLogRepository)
 	adminHandler := handler.NewAdminHandlerImpl(adminInteractor)
 	return adminHandler
 }
@@ -260,7 +266,20 @@ func InitializeJobSeekerHandler(fb usecase.Firebase, db interfaces.SQLExecuter,
 	taskRepository := repository.NewTaskRepositoryImpl(db)
 	interviewTaskRepository := repository.NewInterviewTaskRepositoryImpl(db)
 	interviewTaskGroupRepository := repository.NewInterviewTaskGroupRepositoryImpl(db)
-	jobSeekerInteractor := interactor.NewJobSeekerInteractorImpl(fb, sendgrid, oneSignal, slack, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, jobSeekerExperienceJobRepository, jobSeekerLPLoginTokenRepository, jobInformationRepository, jobInformationTargetRepository, jobInformationFeatureRepository, jobInformationPrefectureRepository, jobInformationWorkCharmPointRepository, jobInformationEmploymentStatusRepository, jobInformationRequiredLicenseRepository, jobInformationRequiredPCToolRepository, jobInformationRequiredLanguageRepository, jobInformationRequiredExperienceDevelopmentRepository, jobInformationRequiredExperienceJobRepository, jobInformationRequiredExperienceIndustryRepository, jobInformationRequiredExperienceOccupationRepository, jobInformationRequiredSocialExperienceRepository, jobInformationSelectionFlowPatternRepository, jobInformationSelectionInformationRepository, jobInformationOccupationRepository, jobInformationRequiredConditionRepository, jobInformationRequiredLanguageTypeRepository, jobInformationRequiredExperienceDevelopmentTypeRepository, agentRepository, agentStaffRepository, agentAllianceRepository, agentInflowChannelOptionRepository, enterpriseProfileRepository, enterpriseIndustryRepository, enterpriseReferenceMaterialRepository, chatGroupWithJobSeekerRepository, chatMessageWithJobSeekerRepository, initialQuestionnaireRepository, initialQuestionnaireDesiredIndustryRepository, initialQuestionnaireDesiredOccupationRepository, initialQuestionnaireDesiredWorkLocationRepository, taskGroupRepository, taskRepository, interviewTaskRepository, interviewTaskGroupRepository)
+	jobSeekerExternalIDRepository := repository.NewJobSeekerExternalIDRepositoryImpl(db)
+	jobSeekerMergeSuggestionRepository := repository.NewJobSeekerMergeSuggestionRepositoryImpl(db)
+	agentAssignmentRuleRepository := repository.NewAgentAssignmentRuleRepositoryImpl(db)
+	agentAssignmentStaffRepository := repository.NewAgentAssignmentStaffRepositoryImpl(db)
+	agentAssignmentStaffSpecialtyRepository := repository.NewAgentAssignmentStaffSpecialtyRepositoryImpl(db)
+	jobSeekerAssignmentLogRepository := repository.NewJobSeekerAssignmentLogRepositoryImpl(db)
+	entryScreeningRuleRepository := repository.NewEntryScreeningRuleRepositoryImpl(db)
+	entryScreeningRuleConditionRepository := repository.NewEntryScreeningRuleConditionRepositoryImpl(db)
+	entryScreeningResultRepository := repository.NewEntryScreeningResultRepositoryImpl(db)
+	emailWithJobSeekerRepository := repository.NewEmailWithJobSeekerRepositoryImpl(db)
+	jobSeekerScheduleRepository := repository.NewJobSeekerScheduleRepositoryImpl(db)
+	jobSeekerPersonalDataRepository := repository.NewJobSeekerPersonalDataRepositoryImpl(db)
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	jobSeekerInteractor := interactor.NewJobSeekerInteractorImpl(fb, sendgrid, oneSignal, slack, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, jobSeekerExperienceJobRepository, jobSeekerLPLoginTokenRepository, jobInformationRepository, jobInformationTargetRepository, jobInformationFeatureRepository, jobInformationPrefectureRepository, jobInformationWorkCharmPointRepository, jobInformationEmploymentStatusRepository, jobInformationRequiredLicenseRepository, jobInformationRequiredPCToolRepository, jobInformationRequiredLanguageRepository, jobInformationRequiredExperienceDevelopmentRepository, jobInformationRequiredExperienceJobRepository, jobInformationRequiredExperienceIndustryRepository, jobInformationRequiredExperienceOccupationRepository, jobInformationRequiredSocialExperienceRepository, jobInformationSelectionFlowPatternRepository, jobInformationSelectionInformationRepository, jobInformationOccupationRepository, jobInformationRequiredConditionRepository, jobInformationRequiredLanguageTypeRepository, jobInformationRequiredExperienceDevelopmentTypeRepository, agentRepository, agentStaffRepository, agentAllianceRepository, agentInflowChannelOptionRepository, enterpriseProfileRepository, enterpriseIndustryRepository, enterpriseReferenceMaterialRepository, chatGroupWithJobSeekerRepository, chatMessageWithJobSeekerRepository, initialQuestionnaireRepository, initialQuestionnaireDesiredIndustryRepository, initialQuestionnaireDesiredOccupationRepository, initialQuestionnaireDesiredWorkLocationRepository, taskGroupRepository, taskRepository, interviewTaskRepository, interviewTaskGroupRepository, jobSeekerExternalIDRepository, jobSeekerMergeSuggestionRepository, agentAssignmentRuleRepository, agentAssignmentStaffRepository, agentAssignmentStaffSpecialtyRepository, jobSeekerAssignmentLogRepository, entryScreeningRuleRepository, entryScreeningRuleConditionRepository, entryScreeningResultRepository, emailWithJobSeekerRepository, jobSeekerScheduleRepository, jobSeekerPersonalDataRepository, taskGroupEventRepository)
 	jobSeekerHandler := handler.NewJobSeekerHandlerImpl(jobSeekerInteractor)
 	return jobSeekerHandler
 }
@@ -308,7 +327,10 @@ func InitializeTaskHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendg
 	chatMessageToUserWithAgentRepository := repository.NewChatMessageToUserWithAgentRepositoryImpl(db)
 	emailWithJobSeekerRepository := repository.NewEmailWithJobSeekerRepositoryImpl(db)
 	jobSeekerInterestedJobListingRepository := repository.NewJobSeekerInterestedJobListingRepositoryImpl(db)
-	taskInteractor := interactor.NewTaskInteractorImpl(fb, sendgrid, oneSignal, taskRepository, taskGroupRepository, taskGroupDocumentRepository, agentRepository, agentStaffRepository, jobInformationRepository, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, chatGroupWithJobSeekerRepository, evaluationPointRepository, chatMessageWithJobSeekerRepository, selectionQuestionnaireRepository, selectionQuestionnaireMyRankingRepository, jobInformationSelectionFlowPatternRepository, jobInformationSelectionInformationRepository, saleRepository, taskIsRecommendDocumentRepository, jobSeekerScheduleRepository, jobSeekerRescheduleRepository, chatGroupWithAgentRepository, chatThreadWithAgentRepository, chatMessageWithAgentRepository, chatMessageToUserWithAgentRepository, emailWithJobSeekerRepository, jobSeekerInterestedJobListingRepository)
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	jobOfferRepository := repository.NewJobOfferRepositoryImpl(db)
+	taskLossReasonRepository := repository.NewTaskLossReasonRepositoryImpl(db)
+	taskInteractor := interactor.NewTaskInteractorImpl(fb, sendgrid, oneSignal, taskRepository, taskGroupRepository, taskGroupDocumentRepository, agentRepository, agentStaffRepository, jobInformationRepository, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, chatGroupWithJobSeekerRepository, evaluationPointRepository, chatMessageWithJobSeekerRepository, selectionQuestionnaireRepository, selectionQuestionnaireMyRankingRepository, jobInformationSelectionFlowPatternRepository, jobInformationSelectionInformationRepository, saleRepository, taskIsRecommendDocumentRepository, jobSeekerScheduleRepository, jobSeekerRescheduleRepository, chatGroupWithAgentRepository, chatThreadWithAgentRepository, chatMessageWithAgentRepository, chatMessageToUserWithAgentRepository, emailWithJobSeekerRepository, jobSeekerInterestedJobListingRepository, taskGroupEventRepository, jobOfferRepository, taskLossReasonRepository)
 	taskHandler := handler.NewTaskHandlerImpl(taskInteractor)
 	return taskHandler
 }
@@ -444,7 +466,17 @@ func InitializeScoutServiceHandler(fb usecase.Firebase, db interfaces.SQLExecute
 	googleAuthenticationRepository := repository.NewGoogleAuthenticationRepositoryImpl(db)
 	emailWithJobSeekerRepository := repository.NewEmailWithJobSeekerRepositoryImpl(db)
 	userEntryRepository := repository.NewUserEntryRepositoryImpl(db)
-	scoutServiceInteractor := interactor.NewScoutServiceInteractorImpl(fb, sendgrid, oneSignal, appVar, googleAPI, slackAPI, scoutServiceRepository, scoutServiceTemplateRepository, scoutServiceGetEntryTimeRepository, agentRobotRepository, agentStaffRepository, agentRepository, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, chatGroupWithJobSeekerRepository, interviewTaskGroupRepository, interviewTaskRepository, interviewAdjustmentTemplateRepository, googleAuthenticationRepository, emailWithJobSeekerRepository, userEntryRepository)
+	jobSeekerExternalIDRepository := repository.NewJobSeekerExternalIDRepositoryImpl(db)
+	jobSeekerMergeSuggestionRepository := repository.NewJobSeekerMergeSuggestionRepositoryImpl(db)
+	agentAssignmentRuleRepository := repository.NewAgentAssignmentRuleRepositoryImpl(db)
+	agentAssignmentStaffRepository := repository.NewAgentAssignmentStaffRepositoryImpl(db)
+	agentAssignmentStaffSpecialtyRepository := repository.NewAgentAssignmentStaffSpecialtyRepositoryImpl(db)
+	jobSeekerAssignmentLogRepository := repository.NewJobSeekerAssignmentLogRepositoryImpl(db)
+	entryScreeningRuleRepository := repository.NewEntryScreeningRuleRepositoryImpl(db)
+	entryScreeningRuleConditionRepository := repository.NewEntryScreeningRuleConditionRepositoryImpl(db)
+	entryScreeningResultRepository := repository.NewEntryScreeningResultRepositoryImpl(db)
+	interviewBookingLinkRepository := repository.NewInterviewBookingLinkRepositoryImpl(db)
+	scoutServiceInteractor := interactor.NewScoutServiceInteractorImpl(fb, sendgrid, oneSignal, appVar, googleAPI, slackAPI, scoutServiceRepository, scoutServiceTemplateRepository, scoutServiceGetEntryTimeRepository, agentRobotRepository, agentStaffRepository, agentRepository, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, chatGroupWithJobSeekerRepository, interviewTaskGroupRepository, interviewTaskRepository, interviewAdjustmentTemplateRepository, googleAuthenticationRepository, emailWithJobSeekerRepository, userEntryRepository, jobSeekerExternalIDRepository, jobSeekerMergeSuggestionRepository, agentAssignmentRuleRepository, agentAssignmentStaffRepository, agentAssignmentStaffSpecialtyRepository, jobSeekerAssignmentLogRepository, entryScreeningRuleRepository, entryScreeningRuleConditionRepository, entryScreeningResultRepository, interviewBookingLinkRepository)
 	scoutServiceHandler := handler.NewScoutServiceHandlerImpl(scoutServiceInteractor)
 	return scoutServiceHandler
 }
@@ -456,7 +488,8 @@ func InitializeSaleHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendg
 	chatGroupWithJobSeekerRepository := repository.NewChatGroupWithJobSeekerRepositoryImpl(db)
 	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
 	chatMessageWithJobSeekerRepository := repository.NewChatMessageWithJobSeekerRepositoryImpl(db)
-	saleInteractor := interactor.NewSaleInteractorImpl(fb, sendgrid, oneSignal, saleRepository, jobSeekerRepository, chatGroupWithJobSeekerRepository, agentStaffRepository, chatMessageWithJobSeekerRepository)
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	saleInteractor := interactor.NewSaleInteractorImpl(fb, sendgrid, oneSignal, saleRepository, jobSeekerRepository, chatGroupWithJobSeekerRepository, agentStaffRepository, chatMessageWithJobSeekerRepository, taskGroupEventRepository)
 	saleHandler := handler.NewSaleHandlerImpl(saleInteractor)
 	return saleHandler
 }
@@ -631,8 +664,225 @@ func InitializeGoogleAuthenticationHandler(fb usecase.Firebase, db interfaces.SQ
 	return googleAuthenticationHandler
 }
 
+// InboundEntry
+func InitializeInboundEntryHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, oneSignal config.OneSignal) handler.InboundEntryHandler {
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	jobSeekerExternalIDRepository := repository.NewJobSeekerExternalIDRepositoryImpl(db)
+	jobSeekerMergeSuggestionRepository := repository.NewJobSeekerMergeSuggestionRepositoryImpl(db)
+	jobSeekerStudentHistoryRepository := repository.NewJobSeekerStudentHistoryRepositoryImpl(db)
+	jobSeekerWorkHistoryRepository := repository.NewJobSeekerWorkHistoryRepositoryImpl(db)
+	jobSeekerDepartmentHistoryRepository := repository.NewJobSeekerDepartmentHistoryRepositoryImpl(db)
+	jobSeekerLicenseRepository := repository.NewJobSeekerLicenseRepositoryImpl(db)
+	jobSeekerDesiredIndustryRepository := repository.NewJobSeekerDesiredIndustryRepositoryImpl(db)
+	jobSeekerDesiredOccupationRepository := repository.NewJobSeekerDesiredOccupationRepositoryImpl(db)
+	jobSeekerDesiredWorkLocationRepository := repository.NewJobSeekerDesiredWorkLocationRepositoryImpl(db)
+	jobSeekerDocumentRepository := repository.NewJobSeekerDocumentRepositoryImpl(db)
+	chatGroupWithJobSeekerRepository := repository.NewChatGroupWithJobSeekerRepositoryImpl(db)
+	interviewTaskGroupRepository := repository.NewInterviewTaskGroupRepositoryImpl(db)
+	interviewTaskRepository := repository.NewInterviewTaskRepositoryImpl(db)
+	userEntryRepository := repository.NewUserEntryRepositoryImpl(db)
+	agentAssignmentRuleRepository := repository.NewAgentAssignmentRuleRepositoryImpl(db)
+	agentAssignmentStaffRepository := repository.NewAgentAssignmentStaffRepositoryImpl(db)
+	agentAssignmentStaffSpecialtyRepository := repository.NewAgentAssignmentStaffSpecialtyRepositoryImpl(db)
+	jobSeekerAssignmentLogRepository := repository.NewJobSeekerAssignmentLogRepositoryImpl(db)
+	entryScreeningRuleRepository := repository.NewEntryScreeningRuleRepositoryImpl(db)
+	entryScreeningRuleConditionRepository := repository.NewEntryScreeningRuleConditionRepositoryImpl(db)
+	entryScreeningResultRepository := repository.NewEntryScreeningResultRepositoryImpl(db)
+	inboundEntryInteractor := interactor.NewInboundEntryInteractorImpl(fb, sendgrid, oneSignal, agentStaffRepository, jobSeekerRepository, jobSeekerExternalIDRepository, jobSeekerMergeSuggestionRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerDepartmentHistoryRepository, jobSeekerLicenseRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDocumentRepository, chatGroupWithJobSeekerRepository, interviewTaskGroupRepository, interviewTaskRepository, userEntryRepository, agentAssignmentRuleRepository, agentAssignmentStaffRepository, agentAssignmentStaffSpecialtyRepository, jobSeekerAssignmentLogRepository, entryScreeningRuleRepository, entryScreeningRuleConditionRepository, entryScreeningResultRepository)
+	inboundEntryHandler := handler.NewInboundEntryHandlerImpl(inboundEntryInteractor)
+	return inboundEntryHandler
+}
+
+// JobSeekerMergeSuggestion
+func InitializeJobSeekerMergeSuggestionHandler(fb usecase.Firebase, db interfaces.SQLExecuter) handler.JobSeekerMergeSuggestionHandler {
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	jobSeekerExternalIDRepository := repository.NewJobSeekerExternalIDRepositoryImpl(db)
+	jobSeekerMergeSuggestionRepository := repository.NewJobSeekerMergeSuggestionRepositoryImpl(db)
+	taskGroupRepository := repository.NewTaskGroupRepositoryImpl(db)
+	jobSeekerMergeSuggestionInteractor := interactor.NewJobSeekerMergeSuggestionInteractorImpl(fb, agentStaffRepository, jobSeekerRepository, jobSeekerExternalIDRepository, jobSeekerMergeSuggestionRepository, taskGroupRepository)
+	jobSeekerMergeSuggestionHandler := handler.NewJobSeekerMergeSuggestionHandlerImpl(jobSeekerMergeSuggestionInteractor)
+	return jobSeekerMergeSuggestionHandler
+}
+
+// AgentAssignmentRule
+func InitializeAgentAssignmentRuleHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, oneSignal config.OneSignal) handler.AgentAssignmentRuleHandler {
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	agentAssignmentRuleRepository := repository.NewAgentAssignmentRuleRepositoryImpl(db)
+	agentAssignmentStaffRepository := repository.NewAgentAssignmentStaffRepositoryImpl(db)
+	agentAssignmentStaffSpecialtyRepository := repository.NewAgentAssignmentStaffSpecialtyRepositoryImpl(db)
+	jobSeekerAssignmentLogRepository := repository.NewJobSeekerAssignmentLogRepositoryImpl(db)
+	agentAssignmentRuleInteractor := interactor.NewAgentAssignmentRuleInteractorImpl(fb, sendgrid, oneSignal, agentStaffRepository, jobSeekerRepository, agentAssignmentRuleRepository, agentAssignmentStaffRepository, agentAssignmentStaffSpecialtyRepository, jobSeekerAssignmentLogRepository)
+	agentAssignmentRuleHandler := handler.NewAgentAssignmentRuleHandlerImpl(agentAssignmentRuleInteractor)
+	return agentAssignmentRuleHandler
+}
+
+// EntryScreeningRule
+func InitializeEntryScreeningRuleHandler(fb usecase.Firebase, db interfaces.SQLExecuter) handler.EntryScreeningRuleHandler {
+	entryScreeningRuleRepository := repository.NewEntryScreeningRuleRepositoryImpl(db)
+	entryScreeningRuleConditionRepository := repository.NewEntryScreeningRuleConditionRepositoryImpl(db)
+	entryScreeningResultRepository := repository.NewEntryScreeningResultRepositoryImpl(db)
+	entryScreeningRuleInteractor := interactor.NewEntryScreeningRuleInteractorImpl(fb, entryScreeningRuleRepository, entryScreeningRuleConditionRepository, entryScreeningResultRepository)
+	entryScreeningRuleHandler := handler.NewEntryScreeningRuleHandlerImpl(entryScreeningRuleInteractor)
+	return entryScreeningRuleHandler
+}
+
+// InterviewBooking
+func InitializeInterviewBookingHandler(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, oneSignal config.OneSignal) handler.InterviewBookingHandler {
+	interviewBookingLinkRepository := repository.NewInterviewBookingLinkRepositoryImpl(db)
+	interviewAdjustmentTemplateRepository := repository.NewInterviewAdjustmentTemplateRepositoryImpl(db)
+	scoutServiceRepository := repository.NewScoutServiceRepositoryImpl(db)
+	agentRepository := repository.NewAgentRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	agentAssignmentStaffRepository := repository.NewAgentAssignmentStaffRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	jobSeekerScheduleRepository := repository.NewJobSeekerScheduleRepositoryImpl(db)
+	interviewTaskGroupRepository := repository.NewInterviewTaskGroupRepositoryImpl(db)
+	interviewTaskRepository := repository.NewInterviewTaskRepositoryImpl(db)
+	chatGroupWithJobSeekerRepository := repository.NewChatGroupWithJobSeekerRepositoryImpl(db)
+	chatMessageWithJobSeekerRepository := repository.NewChatMessageWithJobSeekerRepositoryImpl(db)
+	interviewBookingInteractor := interactor.NewInterviewBookingInteractorImpl(fb, sendgrid, oneSignal, interviewBookingLinkRepository, interviewAdjustmentTemplateRepository, scoutServiceRepository, agentRepository, agentStaffRepository, agentAssignmentStaffRepository, jobSeekerRepository, jobSeekerScheduleRepository, interviewTaskGroupRepository, interviewTaskRepository, chatGroupWithJobSeekerRepository, chatMessageWithJobSeekerRepository)
+	interviewBookingHandler := handler.NewInterviewBookingHandlerImpl(interviewBookingInteractor)
+	return interviewBookingHandler
+}
+
+// AuditLog
+func InitializeAuditLogHandler(db interfaces.SQLExecuter) handler.AuditLogHandler {
+	auditLogRepository := repository.NewAuditLogRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	jobSeekerDocumentRepository := repository.NewJobSeekerDocumentRepositoryImpl(db)
+	saleRepository := repository.NewSaleRepositoryImpl(db)
+	auditLogInteractor := interactor.NewAuditLogInteractorImpl(auditLogRepository, agentStaffRepository, jobSeekerRepository, jobSeekerDocumentRepository, saleRepository)
+	auditLogHandler := handler.NewAuditLogHandlerImpl(auditLogInteractor)
+	return auditLogHandler
+}
+
+// GuestLink
+func InitializeGuestLinkHandler(db interfaces.SQLExecuter, guestLink config.GuestLink) handler.GuestLinkHandler {
+	guestLinkRepository := repository.NewGuestLinkRepositoryImpl(db)
+	guestLinkAccessLogRepository := repository.NewGuestLinkAccessLogRepositoryImpl(db)
+	jobInformationRepository := repository.NewJobInformationRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	guestLinkInteractor := interactor.NewGuestLinkInteractorImpl(guestLink, guestLinkRepository, guestLinkAccessLogRepository, jobInformationRepository, jobSeekerRepository)
+	guestLinkHandler := handler.NewGuestLinkHandlerImpl(guestLinkInteractor)
+	return guestLinkHandler
+}
+
+// APIKey
+func InitializeAPIKeyHandler(db interfaces.SQLExecuter) handler.APIKeyHandler {
+	apiKeyRepository := repository.NewAPIKeyRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	agentRepository := repository.NewAgentRepositoryImpl(db)
+	apiKeyInteractor := interactor.NewAPIKeyInteractorImpl(apiKeyRepository, agentStaffRepository, agentRepository)
+	apiKeyHandler := handler.NewAPIKeyHandlerImpl(apiKeyInteractor)
+	return apiKeyHandler
+}
+
+// TaskSLA
+func InitializeTaskSLAHandler(db interfaces.SQLExecuter, sendgrid config.Sendgrid, sla config.SLA) handler.TaskSLAHandler {
+	taskSLARuleRepository := repository.NewTaskSLARuleRepositoryImpl(db)
+	taskSLANotificationRepository := repository.NewTaskSLANotificationRepositoryImpl(db)
+	taskRepository := repository.NewTaskRepositoryImpl(db)
+	interviewTaskRepository := repository.NewInterviewTaskRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	taskSLAInteractor := interactor.NewTaskSLAInteractorImpl(sendgrid, sla, taskSLARuleRepository, taskSLANotificationRepository, taskRepository, interviewTaskRepository, agentStaffRepository)
+	taskSLAHandler := handler.NewTaskSLAHandlerImpl(taskSLAInteractor)
+	return taskSLAHandler
+}
+
+// TaskGroupEvent
+func InitializeTaskGroupEventHandler(db interfaces.SQLExecuter) handler.TaskGroupEventHandler {
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	taskGroupRepository := repository.NewTaskGroupRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	taskGroupEventInteractor := interactor.NewTaskGroupEventInteractorImpl(taskGroupEventRepository, taskGroupRepository, jobSeekerRepository)
+	taskGroupEventHandler := handler.NewTaskGroupEventHandlerImpl(taskGroupEventInteractor)
+	return taskGroupEventHandler
+}
+
+// TaskBulkJob
+func InitializeTaskBulkJobHandler(db interfaces.SQLExecuter, oneSignal config.OneSignal) handler.TaskBulkJobHandler {
+	taskBulkJobRepository := repository.NewTaskBulkJobRepositoryImpl(db)
+	taskBulkJobItemRepository := repository.NewTaskBulkJobItemRepositoryImpl(db)
+	taskGroupRepository := repository.NewTaskGroupRepositoryImpl(db)
+	taskRepository := repository.NewTaskRepositoryImpl(db)
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	evaluationPointRepository := repository.NewEvaluationPointRepositoryImpl(db)
+	saleRepository := repository.NewSaleRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	jobInformationRepository := repository.NewJobInformationRepositoryImpl(db)
+	billingAddressRepository := repository.NewBillingAddressRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	taskLossReasonRepository := repository.NewTaskLossReasonRepositoryImpl(db)
+	jobOfferRepository := repository.NewJobOfferRepositoryImpl(db)
+	agentRepository := repository.NewAgentRepositoryImpl(db)
+	taskBulkJobInteractor := interactor.NewTaskBulkJobInteractorImpl(oneSignal, taskBulkJobRepository, taskBulkJobItemRepository, taskGroupRepository, taskRepository, taskGroupEventRepository, evaluationPointRepository, saleRepository, jobSeekerRepository, jobInformationRepository, billingAddressRepository, agentStaffRepository, taskLossReasonRepository, jobOfferRepository, agentRepository)
+	taskBulkJobHandler := handler.NewTaskBulkJobHandlerImpl(taskBulkJobInteractor)
+	return taskBulkJobHandler
+}
+
+// TaskPipeline
+func InitializeTaskPipelineHandler(db interfaces.SQLExecuter) handler.TaskPipelineHandler {
+	taskRepository := repository.NewTaskRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	jobInformationRepository := repository.NewJobInformationRepositoryImpl(db)
+	billingAddressRepository := repository.NewBillingAddressRepositoryImpl(db)
+	taskPipelineInteractor := interactor.NewTaskPipelineInteractorImpl(taskRepository, agentStaffRepository, jobInformationRepository, billingAddressRepository)
+	taskPipelineHandler := handler.NewTaskPipelineHandlerImpl(taskPipelineInteractor)
+	return taskPipelineHandler
+}
+
+// JobOffer
+func InitializeJobOfferHandler(db interfaces.SQLExecuter, sendgrid config.Sendgrid) handler.JobOfferHandler {
+	jobOfferRepository := repository.NewJobOfferRepositoryImpl(db)
+	jobOfferStatusHistoryRepository := repository.NewJobOfferStatusHistoryRepositoryImpl(db)
+	jobOfferReminderRepository := repository.NewJobOfferReminderRepositoryImpl(db)
+	taskGroupRepository := repository.NewTaskGroupRepositoryImpl(db)
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	jobOfferInteractor := interactor.NewJobOfferInteractorImpl(sendgrid, jobOfferRepository, jobOfferStatusHistoryRepository, jobOfferReminderRepository, taskGroupRepository, taskGroupEventRepository, jobSeekerRepository, agentStaffRepository)
+	jobOfferHandler := handler.NewJobOfferHandlerImpl(jobOfferInteractor)
+	return jobOfferHandler
+}
+
+// SaleGuarantee
+func InitializeSaleGuaranteeHandler(db interfaces.SQLExecuter, sendgrid config.Sendgrid) handler.SaleGuaranteeHandler {
+	saleRepository := repository.NewSaleRepositoryImpl(db)
+	saleRefundRepository := repository.NewSaleRefundRepositoryImpl(db)
+	saleGuaranteeNotificationRepository := repository.NewSaleGuaranteeNotificationRepositoryImpl(db)
+	agentRefundTierRepository := repository.NewAgentRefundTierRepositoryImpl(db)
+	agentRepository := repository.NewAgentRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	taskGroupRepository := repository.NewTaskGroupRepositoryImpl(db)
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	saleGuaranteeInteractor := interactor.NewSaleGuaranteeInteractorImpl(sendgrid, saleRepository, saleRefundRepository, saleGuaranteeNotificationRepository, agentRefundTierRepository, agentRepository, agentStaffRepository, taskGroupRepository, taskGroupEventRepository)
+	saleGuaranteeHandler := handler.NewSaleGuaranteeHandlerImpl(saleGuaranteeInteractor)
+	return saleGuaranteeHandler
+}
+
+// TaskLossReason
+func InitializeTaskLossReasonHandler(db interfaces.SQLExecuter) handler.TaskLossReasonHandler {
+	taskLossReasonRepository := repository.NewTaskLossReasonRepositoryImpl(db)
+	taskGroupRepository := repository.NewTaskGroupRepositoryImpl(db)
+	taskLossReasonInteractor := interactor.NewTaskLossReasonInteractorImpl(taskLossReasonRepository, taskGroupRepository)
+	taskLossReasonHandler := handler.NewTaskLossReasonHandlerImpl(taskLossReasonInteractor)
+	return taskLossReasonHandler
+}
+
+// LoginAttempt
+func InitializeLoginAttemptHandler(db interfaces.SQLExecuter, captcha usecase.Captcha) handler.LoginAttemptHandler {
+	loginAttemptRepository := repository.NewLoginAttemptRepositoryImpl(db)
+	loginAttemptInteractor := interactor.NewLoginAttemptInteractorImpl(captcha, loginAttemptRepository)
+	loginAttemptHandler := handler.NewLoginAttemptHandlerImpl(loginAttemptInteractor)
+	return loginAttemptHandler
+}
+
 // Session
-func InitializeSessionInteractor(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid) interactor.SessionInteractor {
+func InitializeSessionInteractor(fb usecase.Firebase, db interfaces.SQLExecuter, sendgrid config.Sendgrid, guestLink config.GuestLink) interactor.SessionInteractor {
 	agentRepository := repository.NewAgentRepositoryImpl(db)
 	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
 	enterpriseProfileRepository := repository.NewEnterpriseProfileRepositoryImpl(db)
@@ -640,13 +890,83 @@ func InitializeSessionInteractor(fb usecase.Firebase, db interfaces.SQLExecuter,
 	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
 	jobSeekerLPLoginTokenRepository := repository.NewJobSeekerLPLoginTokenRepositoryImpl(db)
 	agentAllianceRepository := repository.NewAgentAllianceRepositoryImpl(db)
-	sessionInteractor := interactor.NewSessionInteractorImpl(fb, sendgrid, agentRepository, agentStaffRepository, enterpriseProfileRepository, jobInformationRepository, jobSeekerRepository, jobSeekerLPLoginTokenRepository, agentAllianceRepository)
+	guestLinkRepository := repository.NewGuestLinkRepositoryImpl(db)
+	guestLinkAccessLogRepository := repository.NewGuestLinkAccessLogRepositoryImpl(db)
+	selectionQuestionnaireRepository := repository.NewSelectionQuestionnaireRepositoryImpl(db)
+	sessionInteractor := interactor.NewSessionInteractorImpl(fb, sendgrid, agentRepository, agentStaffRepository, enterpriseProfileRepository, jobInformationRepository, jobSeekerRepository, jobSeekerLPLoginTokenRepository, agentAllianceRepository, guestLink, guestLinkRepository, guestLinkAccessLogRepository, selectionQuestionnaireRepository)
 	return sessionInteractor
 }
 
+// Authorization
+func InitializeAuthorizationInteractor(db interfaces.SQLExecuter) interactor.AuthorizationInteractor {
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	agentAllianceRepository := repository.NewAgentAllianceRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	jobSeekerHideToAgentRepository := repository.NewJobSeekerHideToAgentRepositoryImpl(db)
+	jobInformationRepository := repository.NewJobInformationRepositoryImpl(db)
+	jobInformationHideToAgentRepository := repository.NewJobInformationHideToAgentRepositoryImpl(db)
+	taskRepository := repository.NewTaskRepositoryImpl(db)
+	taskGroupRepository := repository.NewTaskGroupRepositoryImpl(db)
+	enterpriseProfileRepository := repository.NewEnterpriseProfileRepositoryImpl(db)
+	billingAddressRepository := repository.NewBillingAddressRepositoryImpl(db)
+	saleRepository := repository.NewSaleRepositoryImpl(db)
+	jobSeekerScheduleRepository := repository.NewJobSeekerScheduleRepositoryImpl(db)
+	selectionQuestionnaireRepository := repository.NewSelectionQuestionnaireRepositoryImpl(db)
+	jobInformationSelectionFlowPatternRepository := repository.NewJobInformationSelectionFlowPatternRepositoryImpl(db)
+	interviewTaskGroupRepository := repository.NewInterviewTaskGroupRepositoryImpl(db)
+	chatGroupWithJobSeekerRepository := repository.NewChatGroupWithJobSeekerRepositoryImpl(db)
+	chatGroupWithAgentRepository := repository.NewChatGroupWithAgentRepositoryImpl(db)
+	chatThreadWithAgentRepository := repository.NewChatThreadWithAgentRepositoryImpl(db)
+	agentRobotRepository := repository.NewAgentRobotRepositoryImpl(db)
+	scoutServiceRepository := repository.NewScoutServiceRepositoryImpl(db)
+	agentInflowChannelOptionRepository := repository.NewAgentInflowChannelOptionRepositoryImpl(db)
+	interviewAdjustmentTemplateRepository := repository.NewInterviewAdjustmentTemplateRepositoryImpl(db)
+	messageTemplateRepository := repository.NewMessageTemplateRepositoryImpl(db)
+	agentSaleManagementRepository := repository.NewAgentSaleManagementRepositoryImpl(db)
+	authorizationInteractor := interactor.NewAuthorizationInteractorImpl(agentStaffRepository, agentAllianceRepository, jobSeekerRepository, jobSeekerHideToAgentRepository, jobInformationRepository, jobInformationHideToAgentRepository, taskRepository, taskGroupRepository, enterpriseProfileRepository, billingAddressRepository, saleRepository, jobSeekerScheduleRepository, selectionQuestionnaireRepository, jobInformationSelectionFlowPatternRepository, interviewTaskGroupRepository, chatGroupWithJobSeekerRepository, chatGroupWithAgentRepository, chatThreadWithAgentRepository, agentRobotRepository, scoutServiceRepository, agentInflowChannelOptionRepository, interviewAdjustmentTemplateRepository, messageTemplateRepository, agentSaleManagementRepository)
+	return authorizationInteractor
+}
+
+// AuditLog
+func InitializeAuditLogInteractor(db interfaces.SQLExecuter) interactor.AuditLogInteractor {
+	auditLogRepository := repository.NewAuditLogRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	jobSeekerRepository := repository.NewJobSeekerRepositoryImpl(db)
+	jobSeekerDocumentRepository := repository.NewJobSeekerDocumentRepositoryImpl(db)
+	saleRepository := repository.NewSaleRepositoryImpl(db)
+	auditLogInteractor := interactor.NewAuditLogInteractorImpl(auditLogRepository, agentStaffRepository, jobSeekerRepository, jobSeekerDocumentRepository, saleRepository)
+	return auditLogInteractor
+}
+
+// APIKey
+func InitializeAPIKeyInteractor(db interfaces.SQLExecuter) interactor.APIKeyInteractor {
+	apiKeyRepository := repository.NewAPIKeyRepositoryImpl(db)
+	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
+	agentRepository := repository.NewAgentRepositoryImpl(db)
+	apiKeyInteractor := interactor.NewAPIKeyInteractorImpl(apiKeyRepository, agentStaffRepository, agentRepository)
+	return apiKeyInteractor
+}
+
+// LoginAttempt
+func InitializeLoginAttemptInteractor(db interfaces.SQLExecuter, captcha usecase.Captcha) interactor.LoginAttemptInteractor {
+	loginAttemptRepository := repository.NewLoginAttemptRepositoryImpl(db)
+	loginAttemptInteractor := interactor.NewLoginAttemptInteractorImpl(captcha, loginAttemptRepository)
+	return loginAttemptInteractor
+}
+
+// EncryptionKey
+func InitializeEncryptionKeyInteractor(db interfaces.SQLExecuter) interactor.EncryptionKeyInteractor {
+	encryptionKeyRepository := repository.NewEncryptionKeyRepositoryImpl(db)
+	encryptionKeyInteractor := interactor.NewEncryptionKeyInteractorImpl(encryptionKeyRepository)
+	return encryptionKeyInteractor
+}
+
 // Admin
-func InitializeAdminInteractor(db interfaces.SQLExecuter, appConfig config.App) interactor.AdminInteractor {
-	adminInteractor := interactor.NewAdminInteractorImpl(appConfig)
+func InitializeAdminInteractor(db interfaces.SQLExecuter, adminConfig config.Admin) interactor.AdminInteractor {
+	adminUserRepository := repository.NewAdminUserRepositoryImpl(db)
+	adminSessionRepository := repository.NewAdminSessionRepositoryImpl(db)
+	adminActionLogRepository := repository.NewAdminActionLogRepositoryImpl(db)
+	adminInteractor := interactor.NewAdminInteractorImpl(adminConfig, adminUserRepository, adminSessionRepository, adminActionLogRepository)
 	return adminInteractor
 }
 
@@ -864,7 +1184,20 @@ func InitializeJobSeekerInteractor(fb usecase.Firebase, db interfaces.SQLExecute
 	taskRepository := repository.NewTaskRepositoryImpl(db)
 	interviewTaskRepository := repository.NewInterviewTaskRepositoryImpl(db)
 	interviewTaskGroupRepository := repository.NewInterviewTaskGroupRepositoryImpl(db)
-	jobSeekerInteractor := interactor.NewJobSeekerInteractorImpl(fb, sendgrid, oneSignal, slack, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, jobSeekerExperienceJobRepository, jobSeekerLPLoginTokenRepository, jobInformationRepository, jobInformationTargetRepository, jobInformationFeatureRepository, jobInformationPrefectureRepository, jobInformationWorkCharmPointRepository, jobInformationEmploymentStatusRepository, jobInformationRequiredLicenseRepository, jobInformationRequiredPCToolRepository, jobInformationRequiredLanguageRepository, jobInformationRequiredExperienceDevelopmentRepository, jobInformationRequiredExperienceJobRepository, jobInformationRequiredExperienceIndustryRepository, jobInformationRequiredExperienceOccupationRepository, jobInformationRequiredSocialExperienceRepository, jobInformationSelectionFlowPatternRepository, jobInformationSelectionInformationRepository, jobInformationOccupationRepository, jobInformationRequiredConditionRepository, jobInformationRequiredLanguageTypeRepository, jobInformationRequiredExperienceDevelopmentTypeRepository, agentRepository, agentStaffRepository, agentAllianceRepository, agentInflowChannelOptionRepository, enterpriseProfileRepository, enterpriseIndustryRepository, enterpriseReferenceMaterialRepository, chatGroupWithJobSeekerRepository, chatMessageWithJobSeekerRepository, initialQuestionnaireRepository, initialQuestionnaireDesiredIndustryRepository, initialQuestionnaireDesiredOccupationRepository, initialQuestionnaireDesiredWorkLocationRepository, taskGroupRepository, taskRepository, interviewTaskRepository, interviewTaskGroupRepository)
+	jobSeekerExternalIDRepository := repository.NewJobSeekerExternalIDRepositoryImpl(db)
+	jobSeekerMergeSuggestionRepository := repository.NewJobSeekerMergeSuggestionRepositoryImpl(db)
+	agentAssignmentRuleRepository := repository.NewAgentAssignmentRuleRepositoryImpl(db)
+	agentAssignmentStaffRepository := repository.NewAgentAssignmentStaffRepositoryImpl(db)
+	agentAssignmentStaffSpecialtyRepository := repository.NewAgentAssignmentStaffSpecialtyRepositoryImpl(db)
+	jobSeekerAssignmentLogRepository := repository.NewJobSeekerAssignmentLogRepositoryImpl(db)
+	entryScreeningRuleRepository := repository.NewEntryScreeningRuleRepositoryImpl(db)
+	entryScreeningRuleConditionRepository := repository.NewEntryScreeningRuleConditionRepositoryImpl(db)
+	entryScreeningResultRepository := repository.NewEntryScreeningResultRepositoryImpl(db)
+	emailWithJobSeekerRepository := repository.NewEmailWithJobSeekerRepositoryImpl(db)
+	jobSeekerScheduleRepository := repository.NewJobSeekerScheduleRepositoryImpl(db)
+	jobSeekerPersonalDataRepository := repository.NewJobSeekerPersonalDataRepositoryImpl(db)
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	jobSeekerInteractor := interactor.NewJobSeekerInteractorImpl(fb, sendgrid, oneSignal, slack, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, jobSeekerExperienceJobRepository, jobSeekerLPLoginTokenRepository, jobInformationRepository, jobInformationTargetRepository, jobInformationFeatureRepository, jobInformationPrefectureRepository, jobInformationWorkCharmPointRepository, jobInformationEmploymentStatusRepository, jobInformationRequiredLicenseRepository, jobInformationRequiredPCToolRepository, jobInformationRequiredLanguageRepository, jobInformationRequiredExperienceDevelopmentRepository, jobInformationRequiredExperienceJobRepository, jobInformationRequiredExperienceIndustryRepository, jobInformationRequiredExperienceOccupationRepository, jobInformationRequiredSocialExperienceRepository, jobInformationSelectionFlowPatternRepository, jobInformationSelectionInformationRepository, jobInformationOccupationRepository, jobInformationRequiredConditionRepository, jobInformationRequiredLanguageTypeRepository, jobInformationRequiredExperienceDevelopmentTypeRepository, agentRepository, agentStaffRepository, agentAllianceRepository, agentInflowChannelOptionRepository, enterpriseProfileRepository, enterpriseIndustryRepository, enterpriseReferenceMaterialRepository, chatGroupWithJobSeekerRepository, chatMessageWithJobSeekerRepository, initialQuestionnaireRepository, initialQuestionnaireDesiredIndustryRepository, initialQuestionnaireDesiredOccupationRepository, initialQuestionnaireDesiredWorkLocationRepository, taskGroupRepository, taskRepository, interviewTaskRepository, interviewTaskGroupRepository, jobSeekerExternalIDRepository, jobSeekerMergeSuggestionRepository, agentAssignmentRuleRepository, agentAssignmentStaffRepository, agentAssignmentStaffSpecialtyRepository, jobSeekerAssignmentLogRepository, entryScreeningRuleRepository, entryScreeningRuleConditionRepository, entryScreeningResultRepository, emailWithJobSeekerRepository, jobSeekerScheduleRepository, jobSeekerPersonalDataRepository, taskGroupEventRepository)
 	return jobSeekerInteractor
 }
 
@@ -911,7 +1244,10 @@ func InitializeTaskInteractor(fb usecase.Firebase, db interfaces.SQLExecuter, se
 	chatMessageToUserWithAgentRepository := repository.NewChatMessageToUserWithAgentRepositoryImpl(db)
 	emailWithJobSeekerRepository := repository.NewEmailWithJobSeekerRepositoryImpl(db)
 	jobSeekerInterestedJobListingRepository := repository.NewJobSeekerInterestedJobListingRepositoryImpl(db)
-	taskInteractor := interactor.NewTaskInteractorImpl(fb, sendgrid, oneSignal, taskRepository, taskGroupRepository, taskGroupDocumentRepository, agentRepository, agentStaffRepository, jobInformationRepository, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, chatGroupWithJobSeekerRepository, evaluationPointRepository, chatMessageWithJobSeekerRepository, selectionQuestionnaireRepository, selectionQuestionnaireMyRankingRepository, jobInformationSelectionFlowPatternRepository, jobInformationSelectionInformationRepository, saleRepository, taskIsRecommendDocumentRepository, jobSeekerScheduleRepository, jobSeekerRescheduleRepository, chatGroupWithAgentRepository, chatThreadWithAgentRepository, chatMessageWithAgentRepository, chatMessageToUserWithAgentRepository, emailWithJobSeekerRepository, jobSeekerInterestedJobListingRepository)
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	jobOfferRepository := repository.NewJobOfferRepositoryImpl(db)
+	taskLossReasonRepository := repository.NewTaskLossReasonRepositoryImpl(db)
+	taskInteractor := interactor.NewTaskInteractorImpl(fb, sendgrid, oneSignal, taskRepository, taskGroupRepository, taskGroupDocumentRepository, agentRepository, agentStaffRepository, jobInformationRepository, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, chatGroupWithJobSeekerRepository, evaluationPointRepository, chatMessageWithJobSeekerRepository, selectionQuestionnaireRepository, selectionQuestionnaireMyRankingRepository, jobInformationSelectionFlowPatternRepository, jobInformationSelectionInformationRepository, saleRepository, taskIsRecommendDocumentRepository, jobSeekerScheduleRepository, jobSeekerRescheduleRepository, chatGroupWithAgentRepository, chatThreadWithAgentRepository, chatMessageWithAgentRepository, chatMessageToUserWithAgentRepository, emailWithJobSeekerRepository, jobSeekerInterestedJobListingRepository, taskGroupEventRepository, jobOfferRepository, taskLossReasonRepository)
 	return taskInteractor
 }
 
@@ -1030,7 +1366,17 @@ func InitializeScoutServiceInteractor(fb usecase.Firebase, db interfaces.SQLExec
 	googleAuthenticationRepository := repository.NewGoogleAuthenticationRepositoryImpl(db)
 	emailWithJobSeekerRepository := repository.NewEmailWithJobSeekerRepositoryImpl(db)
 	userEntryRepository := repository.NewUserEntryRepositoryImpl(db)
-	scoutServiceInteractor := interactor.NewScoutServiceInteractorImpl(fb, sendgrid, oneSignal, appVar, googleAPI, slackAPI, scoutServiceRepository, scoutServiceTemplateRepository, scoutServiceGetEntryTimeRepository, agentRobotRepository, agentStaffRepository, agentRepository, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, chatGroupWithJobSeekerRepository, interviewTaskGroupRepository, interviewTaskRepository, interviewAdjustmentTemplateRepository, googleAuthenticationRepository, emailWithJobSeekerRepository, userEntryRepository)
+	jobSeekerExternalIDRepository := repository.NewJobSeekerExternalIDRepositoryImpl(db)
+	jobSeekerMergeSuggestionRepository := repository.NewJobSeekerMergeSuggestionRepositoryImpl(db)
+	agentAssignmentRuleRepository := repository.NewAgentAssignmentRuleRepositoryImpl(db)
+	agentAssignmentStaffRepository := repository.NewAgentAssignmentStaffRepositoryImpl(db)
+	agentAssignmentStaffSpecialtyRepository := repository.NewAgentAssignmentStaffSpecialtyRepositoryImpl(db)
+	jobSeekerAssignmentLogRepository := repository.NewJobSeekerAssignmentLogRepositoryImpl(db)
+	entryScreeningRuleRepository := repository.NewEntryScreeningRuleRepositoryImpl(db)
+	entryScreeningRuleConditionRepository := repository.NewEntryScreeningRuleConditionRepositoryImpl(db)
+	entryScreeningResultRepository := repository.NewEntryScreeningResultRepositoryImpl(db)
+	interviewBookingLinkRepository := repository.NewInterviewBookingLinkRepositoryImpl(db)
+	scoutServiceInteractor := interactor.NewScoutServiceInteractorImpl(fb, sendgrid, oneSignal, appVar, googleAPI, slackAPI, scoutServiceRepository, scoutServiceTemplateRepository, scoutServiceGetEntryTimeRepository, agentRobotRepository, agentStaffRepository, agentRepository, jobSeekerRepository, jobSeekerStudentHistoryRepository, jobSeekerWorkHistoryRepository, jobSeekerExperienceIndustryRepository, jobSeekerExperienceOccupationRepository, jobSeekerLicenseRepository, jobSeekerSelfPromotionRepository, jobSeekerDocumentRepository, jobSeekerDesiredIndustryRepository, jobSeekerDesiredOccupationRepository, jobSeekerDesiredWorkLocationRepository, jobSeekerDesiredHolidayTypeRepository, jobSeekerDevelopmentSkillRepository, jobSeekerLanguageSkillRepository, jobSeekerPCToolRepository, jobSeekerHideToAgentRepository, jobSeekerDepartmentHistoryRepository, jobSeekerDesiredCompanyScaleRepository, chatGroupWithJobSeekerRepository, interviewTaskGroupRepository, interviewTaskRepository, interviewAdjustmentTemplateRepository, googleAuthenticationRepository, emailWithJobSeekerRepository, userEntryRepository, jobSeekerExternalIDRepository, jobSeekerMergeSuggestionRepository, agentAssignmentRuleRepository, agentAssignmentStaffRepository, agentAssignmentStaffSpecialtyRepository, jobSeekerAssignmentLogRepository, entryScreeningRuleRepository, entryScreeningRuleConditionRepository, entryScreeningResultRepository, interviewBookingLinkRepository)
 	return scoutServiceInteractor
 }
 
@@ -1041,7 +1387,8 @@ func InitializeSaleInteractor(fb usecase.Firebase, db interfaces.SQLExecuter, se
 	chatGroupWithJobSeekerRepository := repository.NewChatGroupWithJobSeekerRepositoryImpl(db)
 	agentStaffRepository := repository.NewAgentStaffRepositoryImpl(db)
 	chatMessageWithJobSeekerRepository := repository.NewChatMessageWithJobSeekerRepositoryImpl(db)
-	saleInteractor := interactor.NewSaleInteractorImpl(fb, sendgrid, oneSignal, saleRepository, jobSeekerRepository, chatGroupWithJobSeekerRepository, agentStaffRepository, chatMessageWithJobSeekerRepository)
+	taskGroupEventRepository := repository.NewTaskGroupEventRepositoryImpl(db)
+	saleInteractor := interactor.NewSaleInteractorImpl(fb, sendgrid, oneSignal, saleRepository, jobSeekerRepository, chatGroupWithJobSeekerRepository, agentStaffRepository, chatMessageWithJobSeekerRepository, taskGroupEventRepository)
 	return saleInteractor
 }
 
@@ -0,0 +1,66 @@
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+// siteverify形式（reCAPTCHA・Turnstile）のCAPTCHA検証
+// 鍵が未設定の場合は検証を行わない（CAPTCHAを求めない）
+type CaptchaImpl struct {
+	secretKey string
+	verifyURL string
+	client    *http.Client
+}
+
+func NewCaptchaImpl(captchaConfig config.Captcha) usecase.Captcha {
+	return &CaptchaImpl{
+		secretKey: captchaConfig.SecretKey,
+		verifyURL: captchaConfig.VerifyURL,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (c *CaptchaImpl) IsEnabled() bool {
+	return c.secretKey != "" && c.verifyURL != ""
+}
+
+// CAPTCHAのトークンを検証する
+func (c *CaptchaImpl) Verify(token, remoteIP string) (bool, error) {
+	if !c.IsEnabled() {
+		return true, nil
+	}
+
+	if token == "" {
+		return false, nil
+	}
+
+	resp, err := c.client.PostForm(c.verifyURL, url.Values{
+		"secret":   {c.secretKey},
+		"response": {token},
+		"remoteip": {remoteIP},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		fmt.Println(err)
+		return false, err
+	}
+
+	return result.Success, nil
+}
@@ -341,6 +341,73 @@ func (d *FirebaseImpl) UploadToStorageForAgentLine(fileHeader *multipart.FileHea
 	return fileURL, err
 }
 
+// CloudStorageに保存したファイルを取得する（個人情報の開示請求で使用）
+func (d *FirebaseImpl) DownloadFromStorage(fileURL string) ([]byte, error) {
+	ctx := gcContext.Background()
+
+	objectName, ok := d.getStorageObjectName(fileURL)
+	if !ok {
+		return nil, errors.Wrap(entity.ErrRequestError, "自社のCloudStorageのURLではありません")
+	}
+
+	r, err := d.client.Bucket(d.bucketName).Object(objectName).NewReader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(entity.ErrServerError, err.Error())
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(entity.ErrServerError, err.Error())
+	}
+
+	return data, nil
+}
+
+// CloudStorageに保存したファイルを削除する（個人情報の削除で使用）
+// 自社のバケット以外のURL、削除済みのファイルは何もしない
+func (d *FirebaseImpl) DeleteFromStorage(fileURL string) error {
+	ctx := gcContext.Background()
+
+	objectName, ok := d.getStorageObjectName(fileURL)
+	if !ok {
+		return nil
+	}
+
+	err := d.client.Bucket(d.bucketName).Object(objectName).Delete(ctx)
+	if err != nil && err != gcStorage.ErrObjectNotExist {
+		return errors.Wrap(entity.ErrServerError, err.Error())
+	}
+
+	return nil
+}
+
+// ファイルのURLからCloudStorageのファイルパスを取得する
+// 形式: https://firebasestorage.googleapis.com/v0/b/バケット名/o/ファイルパス?alt=media&token=xxxxx
+// 形式: https://storage.googleapis.com/バケット名/ファイルパス
+func (d *FirebaseImpl) getStorageObjectName(fileURL string) (string, bool) {
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return "", false
+	}
+
+	var objectName string
+	switch u.Host {
+	case "firebasestorage.googleapis.com":
+		objectName = strings.TrimPrefix(u.Path, fmt.Sprintf("/v0/b/%s/o/", d.bucketName))
+	case "storage.googleapis.com":
+		objectName = strings.TrimPrefix(u.Path, fmt.Sprintf("/%s/", d.bucketName))
+	default:
+		return "", false
+	}
+
+	if objectName == "" || objectName == u.Path {
+		return "", false
+	}
+
+	return objectName, true
+}
+
 func (d *FirebaseImpl) SignOut(uid string) error {
 	// ユーザーのリフレッシュトークンを無効化してログアウトさせる
 	err := d.auth.RevokeRefreshTokens(context.Background(), uid)
@@ -0,0 +1,114 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/router/routes"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+// 操作ログに記録しないリクエストボディの項目（部分一致）
+var adminMaskedBodyKeyList = []string{"password", "secret", "token", "code"}
+
+// 管理者サイトの認証ミドルウェア
+// セッショントークン（AdminAuthorization）から管理者を取得してリクエストのコンテキストに設定し、認証できない場合は401を返す
+// requireMFAがfalseのルートは二要素認証前のセッションでも利用できる（二要素認証の登録・検証・ログアウト）
+// GET以外の操作は結果に関わらず操作ログに記録する
+func adminAuthMiddleware(db *database.DB, adminConfig config.Admin, requireMFA bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			i := di.InitializeAdminInteractor(db, adminConfig)
+			output, err := i.AuthenticateAdminSession(interactor.AuthenticateAdminSessionInput{
+				Token:      routes.GetAdminToken(c),
+				RequireMFA: requireMFA,
+			})
+			if err != nil {
+				p := presenter.NewErrorJSONPresenter(err)
+				return c.JSON(p.StatusCode(), p.Data())
+			}
+
+			c.Set(routes.ContextKeyAdminUser, output.AdminUser)
+			c.Set(routes.ContextKeyAdminSession, output.Session)
+
+			if c.Request().Method == http.MethodGet {
+				return next(c)
+			}
+
+			body := readAuditRequestBody(c)
+			err = next(c)
+
+			// 操作ログの記録に失敗してもリクエスト自体の結果はそのまま返す
+			_, recordErr := i.RecordAdminAction(interactor.RecordAdminActionInput{
+				AdminUser:   output.AdminUser,
+				Method:      c.Request().Method,
+				Path:        c.Path(),
+				URI:         c.Request().RequestURI,
+				RequestBody: maskAdminRequestBody(body),
+				StatusCode:  c.Response().Status,
+				Access:      routes.NewAdminAccess(c),
+			})
+			if recordErr != nil {
+				fmt.Println(recordErr)
+			}
+
+			return err
+		}
+	}
+}
+
+// パスワードなどの項目を伏せ字にしたリクエストボディ（JSON）
+func maskAdminRequestBody(body map[string]interface{}) string {
+	if body == nil {
+		return "{}"
+	}
+
+	b, err := json.Marshal(maskAdminRequestValue(body))
+	if err != nil {
+		fmt.Println(err)
+		return "{}"
+	}
+
+	return string(b)
+}
+
+func maskAdminRequestValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		masked := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			if isAdminMaskedBodyKey(key) {
+				masked[key] = "***"
+				continue
+			}
+			masked[key] = maskAdminRequestValue(child)
+		}
+		return masked
+
+	case []interface{}:
+		masked := make([]interface{}, len(v))
+		for i, child := range v {
+			masked[i] = maskAdminRequestValue(child)
+		}
+		return masked
+	}
+
+	return value
+}
+
+func isAdminMaskedBodyKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, masked := range adminMaskedBodyKeyList {
+		if strings.Contains(key, masked) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+)
+
+// APIキーで利用できるルートと必要な操作
+type apiKeyScopeTarget struct {
+	Method string
+	Path   string
+	Scope  string
+}
+
+// 一覧にないルートはAPIキーでは利用できない（APIキーの管理・担当者の操作など）
+var apiKeyScopeTargetList = []apiKeyScopeTarget{
+	// 求職者の参照
+	{http.MethodGet, "/api/job_seeker/:job_seeker_id", entity.APIKeyScopeJobSeekerRead},
+	{http.MethodGet, "/api/job_seeker/list/search/:agent_id", entity.APIKeyScopeJobSeekerRead},
+	{http.MethodGet, "/api/job_seeker/active/list/search/:agent_id", entity.APIKeyScopeJobSeekerRead},
+	{http.MethodGet, "/api/job_seeker/search_list/page/agent/:agent_id/type", entity.APIKeyScopeJobSeekerRead},
+
+	// エントリーの登録
+	{http.MethodPost, "/api/inbound_entry/job_seeker/create", entity.APIKeyScopeEntryWrite},
+
+	// 売上の参照（売上IDは authMiddleware でRA・CAの担当エージェントかを判定する）
+	{http.MethodGet, "/api/sales/:sale_id", entity.APIKeyScopeSaleRead},
+	{http.MethodGet, "/api/sales/job_seeker/:job_seeker_id", entity.APIKeyScopeSaleRead},
+	{http.MethodGet, "/api/sales/accuracy/search/list", entity.APIKeyScopeSaleRead},
+
+	// Gmailの受信通知（RPA）
+	{http.MethodPost, "/api/rpa/gmail_hook", entity.APIKeyScopeGmailHookWrite},
+}
+
+// ルートに必要なAPIキーの操作（APIキーで利用できないルートの場合は空文字）
+func findAPIKeyScope(method, path string) string {
+	path = "/" + strings.TrimPrefix(path, "/")
+
+	for _, target := range apiKeyScopeTargetList {
+		if target.Method == method && target.Path == path {
+			return target.Scope
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,196 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/router/routes"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+// 監査ログを記録するルート
+type auditTarget struct {
+	Method     string
+	Path       string
+	Action     uint
+	EntityType string
+	IDParam    string // 対象のIDを持つパスパラメータ
+	IDBodyKey  string // 対象のIDを持つリクエストボディの項目（パスパラメータにない場合）
+}
+
+// 求職者の詳細参照と、求職者・書類・売上・担当者権限への書き込みを対象とする
+var auditTargetList = []auditTarget{
+	// 求職者
+	{http.MethodGet, "/api/job_seeker/:job_seeker_id", entity.AuditLogActionRead, entity.AuditLogEntityJobSeeker, "job_seeker_id", ""},
+	{http.MethodPost, "/api/job_seeker/create/:agent_staff_id", entity.AuditLogActionCreate, entity.AuditLogEntityJobSeeker, "", ""},
+	{http.MethodPut, "/api/job_seeker/update/:job_seeker_id/:agent_staff_id", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeeker, "job_seeker_id", ""},
+	{http.MethodPut, "/api/job_seeker/update/activity_memo/:job_seeker_id", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeeker, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/delete", entity.AuditLogActionDelete, entity.AuditLogEntityJobSeeker, "", "id"},
+	{http.MethodGet, "/api/job_seeker/:job_seeker_id/personal_data/export", entity.AuditLogActionExport, entity.AuditLogEntityJobSeeker, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/personal_data/delete", entity.AuditLogActionAnonymize, entity.AuditLogEntityJobSeeker, "job_seeker_id", ""},
+
+	// 求職者の書類
+	{http.MethodGet, "/api/job_seeker/document/:job_seeker_id", entity.AuditLogActionRead, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodPost, "/api/job_seeker/document", entity.AuditLogActionCreate, entity.AuditLogEntityJobSeekerDocument, "", "job_seeker_id"},
+	{http.MethodPut, "/api/job_seeker/document/:job_seeker_id", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/resume_pdf_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/resume_origin_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/cv_pdf_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/cv_origin_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/recommendation_pdf_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/recommendation_origin_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/id_photo_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/other_document1_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/other_document2_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+	{http.MethodDelete, "/api/job_seeker/:job_seeker_id/document/other_document3_url/delete", entity.AuditLogActionUpdate, entity.AuditLogEntityJobSeekerDocument, "job_seeker_id", ""},
+
+	// 売上
+	{http.MethodGet, "/api/sales/:sale_id", entity.AuditLogActionRead, entity.AuditLogEntitySale, "sale_id", ""},
+	{http.MethodPost, "/api/sales/create", entity.AuditLogActionCreate, entity.AuditLogEntitySale, "", ""},
+	{http.MethodPut, "/api/sales/update/:sale_id", entity.AuditLogActionUpdate, entity.AuditLogEntitySale, "sale_id", ""},
+
+	// 担当者の権限
+	{http.MethodPut, "/api/agent_staff/update/authority", entity.AuditLogActionUpdate, entity.AuditLogEntityAgentStaff, "", "agent_staff_id"},
+	{http.MethodPut, "/api/agent_staff/delete", entity.AuditLogActionDelete, entity.AuditLogEntityAgentStaff, "", "agent_staff_id"},
+}
+
+// 監査ログの記録ミドルウェア
+// 認証ミドルウェアの後に実行し、対象ルートの処理が成功した場合に操作した担当者と変更前後の値を記録する
+func auditMiddleware(db *database.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			target := findAuditTarget(c.Request().Method, c.Path())
+			operator := routes.GetAuthenticatedAgentStaff(c)
+			if target == nil || operator == nil {
+				return next(c)
+			}
+
+			var (
+				i        = di.InitializeAuditLogInteractor(db)
+				body     = readAuditRequestBody(c)
+				entityID = getAuditEntityID(c, target, body)
+				before   map[string]interface{}
+			)
+
+			// 変更前の値を取得
+			if target.Action == entity.AuditLogActionUpdate || target.Action == entity.AuditLogActionDelete {
+				output, err := i.GetAuditSnapshot(interactor.GetAuditSnapshotInput{
+					EntityType: target.EntityType,
+					EntityID:   entityID,
+				})
+				if err != nil {
+					fmt.Println(err)
+				}
+				before = output.Snapshot
+			}
+
+			err := next(c)
+			if err != nil || c.Response().Status >= http.StatusBadRequest {
+				return err
+			}
+
+			// 変更後の値を取得（作成時は対象のIDが分からない場合があるためリクエストボディを記録する）
+			var after map[string]interface{}
+			switch target.Action {
+			case entity.AuditLogActionCreate:
+				after = body
+			case entity.AuditLogActionUpdate, entity.AuditLogActionDelete:
+				output, err := i.GetAuditSnapshot(interactor.GetAuditSnapshotInput{
+					EntityType: target.EntityType,
+					EntityID:   entityID,
+				})
+				if err != nil {
+					fmt.Println(err)
+				}
+				after = output.Snapshot
+			}
+
+			// 監査ログの記録に失敗してもリクエスト自体は成功として扱う
+			_, err = i.RecordAuditLog(interactor.RecordAuditLogInput{
+				Operator:   operator,
+				Action:     target.Action,
+				EntityType: target.EntityType,
+				EntityID:   entityID,
+				Before:     before,
+				After:      after,
+				Method:     c.Request().Method,
+				Path:       c.Request().URL.Path,
+				IPAddress:  c.RealIP(),
+				UserAgent:  c.Request().UserAgent(),
+			})
+			if err != nil {
+				fmt.Println(err)
+			}
+
+			return nil
+		}
+	}
+}
+
+// 監査ログを記録するルートか
+func findAuditTarget(method, path string) *auditTarget {
+	path = "/" + strings.TrimPrefix(path, "/")
+
+	for i, target := range auditTargetList {
+		if target.Method == method && target.Path == path {
+			return &auditTargetList[i]
+		}
+	}
+
+	return nil
+}
+
+// リクエストボディを読み取り、後続のハンドラーでも読めるように戻す
+func readAuditRequestBody(c echo.Context) map[string]interface{} {
+	var body map[string]interface{}
+
+	if c.Request().Body == nil {
+		return nil
+	}
+
+	b, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	c.Request().Body = io.NopCloser(bytes.NewReader(b))
+
+	if len(b) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(b, &body)
+	if err != nil {
+		return nil
+	}
+
+	return body
+}
+
+// 対象のIDをパスパラメータまたはリクエストボディから取得する
+func getAuditEntityID(c echo.Context, target *auditTarget, body map[string]interface{}) uint {
+	if target.IDParam != "" {
+		id, err := strconv.ParseUint(c.Param(target.IDParam), 10, 64)
+		if err != nil {
+			return 0
+		}
+		return uint(id)
+	}
+
+	if target.IDBodyKey != "" {
+		if id, ok := body[target.IDBodyKey].(float64); ok && id > 0 {
+			return uint(id)
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,476 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/router/routes"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+// 担当者の認証を行わないルート（LP・ゲスト・Webhookなど担当者以外が利用するもの）
+// 末尾が「/*」のものは前方一致、それ以外はルート定義と完全一致で判定する
+var authAllowList = []string{
+	"/api/healthz",
+
+	// ログイン処理（トークンの検証と利用状況の判定は各APIで行う）
+	"/api/signin",
+	"/api/signout",
+	"/api/signin/user",
+
+	// Webhook
+	"/api/line",
+
+	// ゲスト・LP
+	"/api/guest/*",
+	"/api/lp/*",
+	"/api/interview_booking/*",
+
+	// エージェント（ゲスト求職者のLINE連携）
+	"/api/agent/uuid/:agent_uuid",
+	"/api/agent/line_login_channel_id/uuid/:agent_uuid",
+
+	// 管理者サイトから利用するAPI（管理者のセッションで認証する）
+	"/api/agent/admin/update",
+	"/api/agent/:agent_id/staff/signup",
+
+	// 求職者（ゲスト求職者のマイページ・面談前アンケート）
+	"/api/job_seeker/create/initial_questionnaire",
+	"/api/job_seeker/check/uuid/name",
+	"/api/job_seeker/send/reset_password_email",
+	"/api/job_seeker/send/contact",
+	"/api/job_seeker/initial_step/uuid/:job_seeker_uuid",
+
+	// 求人（ゲスト求職者・ゲスト企業の求人閲覧）
+	"/api/job_information/list/search/job_seeker_uuid",
+	"/api/job_information/list/interested_type/job_seeker_uuid",
+	"/api/job_information/job_listing/job_information_uuid/:job_information_uuid",
+	"/api/job_information/job_listing/for_job_seeker",
+}
+
+// ゲストログイン後に利用するルート
+// ゲスト用APIのトークン（X-Guest-Token）の対象と一致するデータのみ許可する（トークンがない場合は担当者の認証を行う）
+type guestSessionRoute struct {
+	Path            string
+	ResourceType    string
+	UUIDParam       string // 対象のUUIDを持つパスパラメータ
+	UUIDQuery       string // 対象のUUIDを持つクエリパラメータ（パスパラメータにない場合）
+	UUIDBody        string // 対象のUUIDを持つリクエストボディの項目（パス・クエリパラメータにない場合）
+	BodyJobSeekerID bool   // リクエストボディの job_seeker_id も照合するか
+}
+
+var guestSessionRouteList = []guestSessionRoute{
+	// 求職者のマイページ
+	{"/api/job_seeker/uuid/:job_seeker_uuid", entity.GuestLinkResourceJobSeeker, "job_seeker_uuid", "", "", false},
+	{"/api/job_seeker/document/uuid/:job_seeker_uuid", entity.GuestLinkResourceJobSeeker, "job_seeker_uuid", "", "", false},
+	{"/api/job_seeker/document/job_seeker/:job_seeker_uuid/job_information/:job_information_uuid", entity.GuestLinkResourceJobSeeker, "job_seeker_uuid", "", "", false},
+	{"/api/job_seeker/guest/uuid/:job_seeker_uuid", entity.GuestLinkResourceJobSeeker, "job_seeker_uuid", "", "", false},
+	{"/api/job_seeker/guest/desired/uuid/:job_seeker_uuid", entity.GuestLinkResourceJobSeeker, "job_seeker_uuid", "", "", false},
+	{"/api/job_information/job_listing/for/job_seeker/:job_seeker_uuid", entity.GuestLinkResourceJobSeeker, "job_seeker_uuid", "", "", false},
+	{"/api/job_information/list/job_seeker/:job_seeker_uuid/for_diagnosis", entity.GuestLinkResourceJobSeeker, "job_seeker_uuid", "", "", false},
+
+	// 選考後アンケート（ゲスト求職者の回答）
+	{"/api/selection_questionnaire/create/:questionnaire_uuid", entity.GuestAccessTargetSelectionQuestionnaire, "questionnaire_uuid", "", "", true},
+	{"/api/selection_questionnaire/or_null/uuid/:questionnaire_uuid", entity.GuestAccessTargetSelectionQuestionnaire, "questionnaire_uuid", "", "", false},
+	{"/api/selection_questionnaire/generate/uuid", entity.GuestLinkResourceJobSeeker, "", "", "", false},
+	{"/api/selection_questionnaire/unanswer/for_job_seeker/:job_seeker_uuid", entity.GuestLinkResourceJobSeeker, "job_seeker_uuid", "", "", false},
+	{"/api/selection_questionnaire/for_job_seeker", entity.GuestLinkResourceJobSeeker, "", "job_seeker_uuid", "", false},
+
+	// 求職者のマイページからの更新（LINE連携・パスワード再設定・面談日程の登録）
+	{"/api/job_seeker/update/line_id", entity.GuestLinkResourceJobSeeker, "", "", "job_seeker_uuid", false},
+	{"/api/job_seeker/update/password", entity.GuestLinkResourceJobSeeker, "", "", "job_seeker_uuid", false},
+	{"/api/job_seeker/update/interview_date", entity.GuestLinkResourceJobSeeker, "", "", "", true},
+	{"/api/job_seeker/uuid/agent_id/:job_seeker_uuid", entity.GuestLinkResourceJobSeeker, "job_seeker_uuid", "", "", false},
+
+	// タスク（マイページのマッチ求人からエントリー）
+	{"/api/task/entry/create/matchin_job", entity.GuestLinkResourceJobSeeker, "", "", "job_seeker_uuid", false},
+
+	// 企業（選考の確認・求人票の確認）
+	{"/api/job_seeker/task_group/:task_group_uuid", entity.GuestLinkResourceTaskGroup, "task_group_uuid", "", "", false},
+	{"/api/job_information/uuid/:job_information_uuid", entity.GuestLinkResourceJobInformation, "job_information_uuid", "", "", false},
+}
+
+// パラメータ名だけでは対象のリソースを特定できないパスパラメータの認可対象
+// ルート定義の前方一致で判定し、同じパラメータ名でもAPIごとに対象のリソースを切り替える
+type authorizeParamRoute struct {
+	PathPrefix string
+	Param      string
+	Resource   string
+}
+
+const (
+	authorizeResourceTaskGroup              = "task_group"
+	authorizeResourceInterviewTaskGroup     = "interview_task_group"
+	authorizeResourceChatGroupWithJobSeeker = "chat_group_with_job_seeker"
+	authorizeResourceChatGroupWithAgent     = "chat_group_with_agent"
+	authorizeResourceChatThreadWithAgent    = "chat_thread_with_agent"
+	authorizeResourceInterviewTemplate      = "interview_template"
+	authorizeResourceMessageTemplate        = "message_template"
+	authorizeResourceSelectionFlowPattern   = "selection_flow_pattern"
+	authorizeResourceJobSeeker              = "job_seeker"
+	authorizeResourceJobInformation         = "job_information"
+	authorizeResourceSale                   = "sale"
+)
+
+var authorizeParamRouteList = []authorizeParamRoute{
+	{"/api/task/", "group_id", authorizeResourceTaskGroup},
+	{"/api/task/", "selection_id", authorizeResourceSelectionFlowPattern},
+	{"/api/interview_task/", "group_id", authorizeResourceInterviewTaskGroup},
+	{"/api/chat_group_with_job_seeker/", "id", authorizeResourceChatGroupWithJobSeeker},
+	{"/api/chat_group_with_job_seeker/", "group_id", authorizeResourceChatGroupWithJobSeeker},
+	{"/api/chat_message_with_job_seeker/", "group_id", authorizeResourceChatGroupWithJobSeeker},
+	{"/api/chat_group_with_agent/", "id", authorizeResourceChatGroupWithAgent},
+	{"/api/chat_group_with_agent/", "group_id", authorizeResourceChatGroupWithAgent},
+	{"/api/chat_thread_with_agent/", "thread_id", authorizeResourceChatThreadWithAgent},
+	{"/api/chat_message_with_agent/", "thread_id", authorizeResourceChatThreadWithAgent},
+	{"/api/interview_template/", "template_id", authorizeResourceInterviewTemplate},
+	{"/api/template/", "template_id", authorizeResourceMessageTemplate},
+}
+
+// クエリのIDのリスト（id_list[]）で一覧を取得するルートの認可対象
+var authorizeIDListRouteList = map[string]string{
+	"/api/job_seeker/list/by/id_list":      authorizeResourceJobSeeker,
+	"/api/job_information/list/by/id_list": authorizeResourceJobInformation,
+	"/api/sales/list/by/id_list":           authorizeResourceSale,
+}
+
+// 担当者APIの認証ミドルウェア
+// FirebaseのトークンからAgentStaffとAgentを取得してリクエストのコンテキストに設定し、認証できない場合は401を返す
+// 外部連携のAPIキー（X-API-Key）の場合は、APIキーに許可された操作のAPIのみ利用できる
+func authMiddleware(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			// ゲスト用APIのトークンがある場合はゲストとして認証する
+			if route := findGuestSessionRoute(c.Path()); route != nil && routes.GetGuestToken(c) != "" {
+				err := authorizeGuestSession(db, firebase, sendgrid, guestLink, c, route)
+				if err != nil {
+					p := presenter.NewErrorJSONPresenter(err)
+					return c.JSON(p.StatusCode(), p.Data())
+				}
+
+				return next(c)
+			}
+
+			if isAuthAllowListPath(c.Path()) {
+				return next(c)
+			}
+
+			var (
+				agentStaff *entity.AgentStaff
+				agent      *entity.Agent
+			)
+
+			// 外部連携のAPIキーがある場合はAPIキーで認証し、発行した担当者として扱う
+			if key := routes.GetAPIKey(c); key != "" {
+				output, err := di.InitializeAPIKeyInteractor(db).AuthenticateAPIKey(interactor.AuthenticateAPIKeyInput{
+					Key:       key,
+					Scope:     findAPIKeyScope(c.Request().Method, c.Path()),
+					IPAddress: c.RealIP(),
+				})
+				if err != nil {
+					p := presenter.NewErrorJSONPresenter(err)
+					return c.JSON(p.StatusCode(), p.Data())
+				}
+
+				agentStaff = output.AgentStaff
+				agent = output.Agent
+				c.Set(routes.ContextKeyAPIKey, output.APIKey)
+			} else {
+				i := di.InitializeSessionInteractor(firebase, db, sendgrid, guestLink)
+				output, err := i.AuthenticateAgentStaff(interactor.AuthenticateAgentStaffInput{
+					Token: routes.GetFirebaseToken(c),
+				})
+				if err != nil {
+					p := presenter.NewErrorJSONPresenter(err)
+					return c.JSON(p.StatusCode(), p.Data())
+				}
+
+				agentStaff = output.AgentStaff
+				agent = output.Agent
+			}
+
+			c.Set(routes.ContextKeyAgentStaff, agentStaff)
+			c.Set(routes.ContextKeyAgent, agent)
+
+			// URLで指定されたリソースがログイン中の担当者のアクセス範囲内かを判定する
+			authorizationInteractor := di.InitializeAuthorizationInteractor(db)
+			authorizeInput := interactor.AuthorizeResourceInput{
+				Operator:         agentStaff,
+				IsWrite:          c.Request().Method != http.MethodGet,
+				AgentID:          parseAuthorizeParamID(c, "agent_id"),
+				AgentStaffID:     parseAuthorizeParamID(c, "agent_staff_id"),
+				JobSeekerID:      parseAuthorizeParamID(c, "job_seeker_id"),
+				JobInformationID: parseAuthorizeParamID(c, "job_information_id"),
+				TaskID:           parseAuthorizeParamID(c, "task_id"),
+				TaskGroupID:      parseAuthorizeParamID(c, "task_group_id"),
+				EnterpriseID:     parseAuthorizeParamID(c, "enterprise_id"),
+				BillingAddressID: parseAuthorizeParamID(c, "billing_address_id"),
+				SaleID:           parseAuthorizeParamID(c, "sale_id"),
+
+				JobSeekerScheduleID:        parseAuthorizeParamID(c, "schedule_id"),
+				SelectionQuestionnaireID:   parseAuthorizeParamID(c, "questionnaire_id"),
+				SelectionFlowPatternID:     parseAuthorizeParamID(c, "selection_flow_id"),
+				AgentRobotID:               parseAuthorizeParamID(c, "agent_robot_id"),
+				ScoutServiceID:             parseAuthorizeParamID(c, "scout_service_id"),
+				AgentAllianceID:            parseAuthorizeParamID(c, "agent_alliance_id"),
+				AgentInflowChannelOptionID: parseAuthorizeParamID(c, "agent_inflow_channel_option_id"),
+				SaleManagementID:           parseAuthorizeParamID(c, "management_id"),
+			}
+
+			err := setAuthorizeRouteParamInput(c, &authorizeInput)
+			if err != nil {
+				p := presenter.NewErrorJSONPresenter(err)
+				return c.JSON(p.StatusCode(), p.Data())
+			}
+
+			_, err = authorizationInteractor.AuthorizeResource(authorizeInput)
+			if err != nil {
+				p := presenter.NewErrorJSONPresenter(err)
+				return c.JSON(p.StatusCode(), p.Data())
+			}
+
+			// リクエストボディで指定されたリソースも参照できる範囲内かを判定する
+			// （アライアンス先の求職者・求人を選考に紐づけるなど、ボディのIDは参照先として扱う）
+			bodyInput, err := parseAuthorizeBodyInput(c)
+			if err != nil {
+				p := presenter.NewErrorJSONPresenter(err)
+				return c.JSON(p.StatusCode(), p.Data())
+			}
+			bodyInput.Operator = agentStaff
+
+			_, err = authorizationInteractor.AuthorizeResource(bodyInput)
+			if err != nil {
+				p := presenter.NewErrorJSONPresenter(err)
+				return c.JSON(p.StatusCode(), p.Data())
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// 認可対象のパスパラメータをIDとして取得する（指定なし・不正な値の場合は0を返し、判定はハンドラーに委ねる）
+func parseAuthorizeParamID(c echo.Context, name string) uint {
+	for _, paramName := range c.ParamNames() {
+		if paramName != name {
+			continue
+		}
+
+		id, err := strconv.ParseUint(c.Param(name), 10, 64)
+		if err != nil {
+			return 0
+		}
+		return uint(id)
+	}
+
+	return 0
+}
+
+// ルートによって対象が変わるパスパラメータと、クエリのIDのリストを認可対象に設定する
+func setAuthorizeRouteParamInput(c echo.Context, input *interactor.AuthorizeResourceInput) error {
+	path := "/" + strings.TrimPrefix(c.Path(), "/")
+
+	for _, route := range authorizeParamRouteList {
+		if !strings.HasPrefix(path, route.PathPrefix) {
+			continue
+		}
+
+		id := parseAuthorizeParamID(c, route.Param)
+		if id == 0 {
+			continue
+		}
+
+		switch route.Resource {
+		case authorizeResourceTaskGroup:
+			input.TaskGroupID = id
+		case authorizeResourceSelectionFlowPattern:
+			input.SelectionFlowPatternID = id
+		case authorizeResourceInterviewTaskGroup:
+			input.InterviewTaskGroupID = id
+		case authorizeResourceChatGroupWithJobSeeker:
+			input.ChatGroupWithJobSeekerID = id
+		case authorizeResourceChatGroupWithAgent:
+			input.ChatGroupWithAgentID = id
+		case authorizeResourceChatThreadWithAgent:
+			input.ChatThreadWithAgentID = id
+		case authorizeResourceInterviewTemplate:
+			input.InterviewTemplateID = id
+		case authorizeResourceMessageTemplate:
+			input.MessageTemplateID = id
+		}
+	}
+
+	resource, ok := authorizeIDListRouteList[path]
+	if !ok {
+		return nil
+	}
+
+	idList := make([]uint, 0, len(c.QueryParams()["id_list[]"]))
+	for _, idStr := range c.QueryParams()["id_list[]"] {
+		id, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "IDのリストが正しくありません")
+		}
+		idList = append(idList, uint(id))
+	}
+
+	switch resource {
+	case authorizeResourceJobSeeker:
+		input.JobSeekerIDList = idList
+	case authorizeResourceJobInformation:
+		input.JobInformationIDList = idList
+	case authorizeResourceSale:
+		input.SaleIDList = idList
+	}
+
+	return nil
+}
+
+// 認可対象のIDをJSONのリクエストボディ（トップレベルの項目）から取得する
+// リクエストボディはハンドラーでも読むため、読み取った内容で戻しておく
+func parseAuthorizeBodyInput(c echo.Context) (interactor.AuthorizeResourceInput, error) {
+	var (
+		input interactor.AuthorizeResourceInput
+		req   = c.Request()
+	)
+
+	if req.Method == http.MethodGet || req.Body == nil ||
+		!strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return input, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return input, fmt.Errorf("%w:%s", entity.ErrRequestError, err.Error())
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	// 配列など、オブジェクト以外のボディは判定の対象外とする
+	var param map[string]json.RawMessage
+	if json.Unmarshal(body, &param) != nil {
+		return input, nil
+	}
+
+	bodyID := func(name string) uint {
+		var id uint
+		if raw, ok := param[name]; ok && json.Unmarshal(raw, &id) == nil {
+			return id
+		}
+		return 0
+	}
+
+	input.AgentID = bodyID("agent_id")
+	input.AgentStaffID = bodyID("agent_staff_id")
+	input.JobSeekerID = bodyID("job_seeker_id")
+	input.JobInformationID = bodyID("job_information_id")
+	input.TaskID = bodyID("task_id")
+	input.TaskGroupID = bodyID("task_group_id")
+	input.EnterpriseID = bodyID("enterprise_id")
+	input.BillingAddressID = bodyID("billing_address_id")
+	input.SaleID = bodyID("sale_id")
+	input.AgentRobotID = bodyID("agent_robot_id")
+	input.ScoutServiceID = bodyID("scout_service_id")
+
+	return input, nil
+}
+
+// 認証を行わないルートか
+func isAuthAllowListPath(path string) bool {
+	path = "/" + strings.TrimPrefix(path, "/")
+
+	for _, allowPath := range authAllowList {
+		if strings.HasSuffix(allowPath, "/*") {
+			if strings.HasPrefix(path, strings.TrimSuffix(allowPath, "*")) {
+				return true
+			}
+			continue
+		}
+
+		if path == allowPath {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ゲストログイン後に利用するルートか
+func findGuestSessionRoute(path string) *guestSessionRoute {
+	path = "/" + strings.TrimPrefix(path, "/")
+
+	for i := range guestSessionRouteList {
+		if guestSessionRouteList[i].Path == path {
+			return &guestSessionRouteList[i]
+		}
+	}
+
+	return nil
+}
+
+// ゲスト用APIのトークンでリクエストの対象への操作を許可するかを判定する
+func authorizeGuestSession(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink, c echo.Context, route *guestSessionRoute) error {
+	target := entity.GuestAccessTarget{
+		ResourceType: route.ResourceType,
+		IsWrite:      c.Request().Method != http.MethodGet,
+	}
+
+	// リクエストボディはハンドラーでも読むため、読み取った内容で戻しておく
+	var body map[string]json.RawMessage
+	if (route.UUIDBody != "" || route.BodyJobSeekerID) && c.Request().Body != nil {
+		raw, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, err.Error())
+		}
+		c.Request().Body = io.NopCloser(bytes.NewReader(raw))
+
+		if len(raw) > 0 && json.Unmarshal(raw, &body) != nil {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "リクエストの形式が正しくありません")
+		}
+	}
+
+	var uuidStr string
+	switch {
+	case route.UUIDParam != "":
+		uuidStr = c.Param(route.UUIDParam)
+	case route.UUIDQuery != "":
+		uuidStr = c.QueryParam(route.UUIDQuery)
+	case route.UUIDBody != "":
+		if json.Unmarshal(body[route.UUIDBody], &uuidStr) != nil {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "リクエストの形式が正しくありません")
+		}
+	}
+
+	if route.UUIDParam != "" || route.UUIDQuery != "" || route.UUIDBody != "" {
+		resourceUUID, err := uuid.Parse(uuidStr)
+		if err != nil {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "URLが正しくありません")
+		}
+		target.ResourceUUID = resourceUUID
+	}
+
+	if route.BodyJobSeekerID {
+		if raw, ok := body["job_seeker_id"]; ok && json.Unmarshal(raw, &target.JobSeekerID) != nil {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "リクエストの形式が正しくありません")
+		}
+	}
+
+	_, err := di.InitializeSessionInteractor(firebase, db, sendgrid, guestLink).AuthorizeGuestAccess(interactor.AuthorizeGuestAccessInput{
+		Token:  routes.GetGuestToken(c),
+		Target: target,
+	})
+
+	return err
+}
@@ -0,0 +1,138 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+// 試行回数を制限するルート
+type loginAttemptTarget struct {
+	Method         string
+	Path           string
+	Scope          string
+	AccountParam   string // アカウントを持つパスパラメータ
+	AccountBodyKey string // アカウントを持つリクエストボディの項目（パスパラメータにない場合）
+	IsEmailAccount bool   // アカウントがメールアドレスか
+	CountsSuccess  bool   // 成功した場合も回数に含めるか（メール送信など）
+}
+
+// LP・ゲスト・管理者サイトのログインとパスワード再設定メールの送信を対象とする
+var loginAttemptTargetList = []loginAttemptTarget{
+	// LP
+	{http.MethodPost, "/api/lp/job_seeker/login", entity.LoginAttemptScopeLPLogin, "", "email", true, false},
+	{http.MethodPost, "/api/lp/job_seeker/send/reset_password_email", entity.LoginAttemptScopeLPResetPasswordEmail, "", "email", true, true},
+
+	// マイページ
+	{http.MethodPost, "/api/job_seeker/send/reset_password_email", entity.LoginAttemptScopeResetPasswordEmail, "", "job_seeker_uuid", false, true},
+
+	// ゲスト
+	{http.MethodPut, "/api/guest/signin/for/enterprise/:job_information_uuid", entity.LoginAttemptScopeGuestSignIn, "job_information_uuid", "", false, false},
+	{http.MethodPut, "/api/guest/signin/for/enterprise/task_group/:task_group_uuid", entity.LoginAttemptScopeGuestSignIn, "task_group_uuid", "", false, false},
+	{http.MethodPut, "/api/guest/signin/for/job_seeker/:job_seeker_uuid", entity.LoginAttemptScopeGuestSignIn, "job_seeker_uuid", "", false, false},
+	{http.MethodPut, "/api/guest/signin/from_lp/for/job_seeker/:job_seeker_uuid", entity.LoginAttemptScopeGuestSignIn, "job_seeker_uuid", "", false, false},
+
+	// 管理者サイト
+	{http.MethodPut, "/admin/authorize", entity.LoginAttemptScopeAdminLogin, "", "login_id", false, false},
+}
+
+// ログイン試行の制限ミドルウェア
+// 認証ミドルウェアの前に実行し、ロック中の場合は処理を行わずに429を返す
+// 処理後に結果を記録する（複数台で動かしても回数がずれないようDBのトランザクション内で記録する）
+func loginAttemptMiddleware(db *database.DB, captcha usecase.Captcha) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			target := findLoginAttemptTarget(c.Request().Method, c.Path())
+			if target == nil {
+				return next(c)
+			}
+
+			var (
+				ipAddress = c.RealIP()
+				account   = getLoginAttemptAccount(c, target)
+			)
+
+			_, err := di.InitializeLoginAttemptInteractor(db, captcha).CheckLoginAttempt(interactor.CheckLoginAttemptInput{
+				Scope:          target.Scope,
+				IPAddress:      ipAddress,
+				Account:        account,
+				IsEmailAccount: target.IsEmailAccount,
+				CaptchaToken:   c.Request().Header.Get("X-Captcha-Token"),
+			})
+			if err != nil {
+				p := presenter.NewErrorJSONPresenter(err)
+				return c.JSON(p.StatusCode(), p.Data())
+			}
+
+			err = next(c)
+
+			// 記録に失敗してもリクエスト自体の結果はそのまま返す
+			recordErr := recordLoginAttempt(db, captcha, interactor.RecordLoginAttemptInput{
+				Scope:          target.Scope,
+				IPAddress:      ipAddress,
+				Account:        account,
+				IsEmailAccount: target.IsEmailAccount,
+				Succeeded:      err == nil && c.Response().Status < http.StatusBadRequest,
+				CountsSuccess:  target.CountsSuccess,
+			})
+			if recordErr != nil {
+				fmt.Println(recordErr)
+			}
+
+			return err
+		}
+	}
+}
+
+// 試行回数を制限するルートか
+func findLoginAttemptTarget(method, path string) *loginAttemptTarget {
+	path = "/" + strings.TrimPrefix(path, "/")
+
+	for i, target := range loginAttemptTargetList {
+		if target.Method == method && target.Path == path {
+			return &loginAttemptTargetList[i]
+		}
+	}
+
+	return nil
+}
+
+// アカウントをパスパラメータまたはリクエストボディから取得する
+func getLoginAttemptAccount(c echo.Context, target *loginAttemptTarget) string {
+	if target.AccountParam != "" {
+		return c.Param(target.AccountParam)
+	}
+
+	if target.AccountBodyKey != "" {
+		body := readAuditRequestBody(c)
+		if account, ok := body[target.AccountBodyKey].(string); ok {
+			return account
+		}
+	}
+
+	return ""
+}
+
+// トランザクション内で試行の結果を記録する
+func recordLoginAttempt(db *database.DB, captcha usecase.Captcha, input interactor.RecordLoginAttemptInput) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	_, err = di.InitializeLoginAttemptInteractor(tx, captcha).RecordLoginAttempt(input)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -3,6 +3,7 @@ package router
 import (
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"strings"
 
@@ -33,15 +34,47 @@ func bodyDumpHandler(c echo.Context, reqBody, resBody []byte) {
 	fmt.Printf("Response Body: %v\n", string(resBody))
 }
 
+// クライアントのIPアドレスの取得方法
+// 信頼するプロキシが設定されている場合のみ X-Forwarded-For を参照し、設定したIP範囲から転送された分だけ遡る
+// dev・prd はロードバランサー配下のため、未設定だと全員がロードバランサーのIPアドレスでログイン試行の制限を共有するので起動を中止する
+func newIPExtractor(env string, trustedProxies []string) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		if env == "dev" || env == "prd" {
+			panic("APP_TRUSTED_PROXIES が設定されていません。APP_ENV: " + env)
+		}
+		return echo.ExtractIPDirect()
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+
+	for _, proxy := range trustedProxies {
+		_, ipRange, err := net.ParseCIDR(strings.TrimSpace(proxy))
+		if err != nil {
+			log.Fatalf("APP_TRUSTED_PROXIES のIP範囲が正しくありません: %s", proxy)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
 func (r *Router) SetUp() *Router {
 	var (
 		db       = database.NewDB(r.cfg.DB, true)
 		firebase = driver.NewFirebaseImpl(r.cfg.Firebase)
+		captcha  = driver.NewCaptchaImpl(r.cfg.Captcha)
 	)
 
 	r.Engine.HidePort = true
 	r.Engine.HideBanner = true
 
+	// ログイン試行の制限・監査ログなどで使う c.RealIP() がクライアントの指定したヘッダーで偽装されないようにする
+	r.Engine.IPExtractor = newIPExtractor(r.cfg.App.Env, r.cfg.App.TrustedProxies)
+
 	r.Engine.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{
 		Skipper:           middleware.DefaultSkipper,
 		StackSize:         4 << 10, // 4 KB
@@ -81,6 +114,9 @@ func (r *Router) SetUp() *Router {
 			echo.HeaderAccessControlAllowOrigin,
 			"FirebaseAuthorization",
 			"LineAuthorization",
+			"X-Captcha-Token",
+			"AdminAuthorization",
+			"X-Guest-Token",
 		},
 		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.OPTIONS},
 	})))
@@ -115,56 +151,75 @@ func (r *Router) SetUp() *Router {
 	}
 
 	/****************************************************************************************/
-	/// No Auth API
+	/// API
 	//
-	noAuthAPI := api.Group("api")
+	// 許可リスト（auth.go）のルート以外は担当者のFirebase認証を必須とする
+	// 個人情報・売上・担当者権限へのアクセスは監査ログ（audit.go）に記録する
+	authAPI := api.Group("api", loginAttemptMiddleware(db, captcha), authMiddleware(db, firebase, r.cfg.Sendgrid, r.cfg.GuestLink), auditMiddleware(db))
 	{
-		noAuthAPI.GET("/healthz", func(c echo.Context) error {
+		authAPI.GET("/healthz", func(c echo.Context) error {
 			return c.NoContent(http.StatusOK)
 		})
 
 		// ユーザーのログイン処理（ログイン状況やログイン時間の更新）
-		noAuthAPI.PUT("/signin", routes.SignIn(db, firebase, r.cfg.Sendgrid))
+		authAPI.PUT("/signin", routes.SignIn(db, firebase, r.cfg.Sendgrid, r.cfg.GuestLink))
 
 		// ユーザーのログアウト処理（ログイン状況やログアウト時間の更新）
-		noAuthAPI.PUT("/signout", routes.SignOut(db, firebase, r.cfg.Sendgrid))
+		authAPI.PUT("/signout", routes.SignOut(db, firebase, r.cfg.Sendgrid, r.cfg.GuestLink))
 
 		// ユーザーのログイン情報を取得
-		noAuthAPI.GET("/signin/user", routes.GetSignInUser(db, firebase, r.cfg.Sendgrid))
+		authAPI.GET("/signin/user", routes.GetSignInUser(db, firebase, r.cfg.Sendgrid, r.cfg.GuestLink))
 
 		// LINEのメッセージ受信
-		noAuthAPI.POST("/line", routes.LineWebHook(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+		authAPI.POST("/line", routes.LineWebHook(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 
 		// google認証のURLを発行
-		noAuthAPI.GET("/auth_code_url", routes.GetGoogleAuthCodeURL(db, firebase, r.cfg.Sendgrid, r.cfg.GoogleAPI))
+		authAPI.GET("/auth_code_url", routes.GetGoogleAuthCodeURL(db, firebase, r.cfg.Sendgrid, r.cfg.GoogleAPI))
 
 		// google認証のURLを発行
-		noAuthAPI.PUT("/google_certification", routes.UpdateGoogleOauthToken(db, firebase, r.cfg.Sendgrid, r.cfg.GoogleAPI))
+		authAPI.PUT("/google_certification", routes.UpdateGoogleOauthToken(db, firebase, r.cfg.Sendgrid, r.cfg.GoogleAPI))
 	}
 	/****************************************************************************************/
 	/// AgentStaff API
 	//
-	guestAPI := noAuthAPI.Group("/guest")
+	guestAPI := authAPI.Group("/guest")
 	{
 		// ゲストユーザーのログイン
 		{
 			// ゲスト企業
-			guestAPI.PUT("/signin/for/enterprise/:job_information_uuid", routes.SignInForGuestEnterprise(db, firebase, r.cfg.Sendgrid))
+			guestAPI.PUT("/signin/for/enterprise/:job_information_uuid", routes.SignInForGuestEnterprise(db, firebase, r.cfg.Sendgrid, r.cfg.GuestLink))
 
 			// タスクグループのuuidでゲスト企業のログイン
-			guestAPI.PUT("/signin/for/enterprise/task_group/:task_group_uuid", routes.SignInForGuestEnterpriseByTaskGroupUUID(db, firebase, r.cfg.Sendgrid))
+			guestAPI.PUT("/signin/for/enterprise/task_group/:task_group_uuid", routes.SignInForGuestEnterpriseByTaskGroupUUID(db, firebase, r.cfg.Sendgrid, r.cfg.GuestLink))
 
 			// ゲスト求職者
-			guestAPI.PUT("/signin/for/job_seeker/:job_seeker_uuid", routes.SignInForGuestJobSeeker(db, firebase, r.cfg.Sendgrid))
+			guestAPI.PUT("/signin/for/job_seeker/:job_seeker_uuid", routes.SignInForGuestJobSeeker(db, firebase, r.cfg.Sendgrid, r.cfg.GuestLink))
 
 			// マイページログイン（LPからログイントークンを使ってログイン）
-			guestAPI.PUT("/signin/from_lp/for/job_seeker/:job_seeker_uuid", routes.SignInForGuestJobSeekerFromLP(db, firebase, r.cfg.Sendgrid))
+			guestAPI.PUT("/signin/from_lp/for/job_seeker/:job_seeker_uuid", routes.SignInForGuestJobSeekerFromLP(db, firebase, r.cfg.Sendgrid, r.cfg.GuestLink))
 		}
 	}
 	/****************************************************************************************/
+	/// GuestLink API
+	//
+	guestLinkAPI := authAPI.Group("/guest_link")
+	{
+		// ゲスト用リンクを発行
+		guestLinkAPI.POST("/create", routes.CreateGuestLink(db, r.cfg.GuestLink))
+
+		// ゲスト用リンクを無効化
+		guestLinkAPI.PUT("/revoke/:guest_link_id", routes.RevokeGuestLink(db, r.cfg.GuestLink))
+
+		// 自社のゲスト用リンク一覧を取得
+		guestLinkAPI.GET("/list", routes.GetGuestLinkList(db, r.cfg.GuestLink))
+
+		// ゲスト用リンクのアクセスログを取得
+		guestLinkAPI.GET("/:guest_link_id/access_log", routes.GetGuestLinkAccessLogList(db, r.cfg.GuestLink))
+	}
+	/****************************************************************************************/
 	/// AgentStaff API
 	//
-	userAPI := noAuthAPI.Group("/agent_staff")
+	userAPI := authAPI.Group("/agent_staff")
 	{
 		/************************************** POSTメソッド **************************************/
 
@@ -191,6 +246,9 @@ func (r *Router) SetUp() *Router {
 		// メール通知（未処理・未読）の更新 body: {agent_staff_id, notification_unwatched}
 		userAPI.PUT("/update/notification_unwatched", routes.UpdateAgentStaffNotificationUnwatched(db, firebase, r.cfg.Sendgrid))
 
+		// タスクの期限に関する通知設定の更新 body: {agent_staff_id, manager_staff_id, notification_sla_reminder, notification_sla_digest}
+		userAPI.PUT("/update/sla_setting", routes.UpdateAgentStaffSLASetting(db, firebase, r.cfg.Sendgrid))
+
 		// 管理権限の更新 body: {agent_staff_id, authority}
 		userAPI.PUT("/update/authority", routes.UpdateAgentStaffAuthority(db, firebase, r.cfg.Sendgrid))
 
@@ -227,11 +285,11 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// Agent API
 	//
-	agentAPI := noAuthAPI.Group("/agent")
+	agentAPI := authAPI.Group("/agent")
 	{
 		/************************************** POSTメソッド **************************************/
 		// エージェントの担当者アカウントの作成
-		agentAPI.POST("/:agent_id/staff/signup", routes.AgentStaffSignUp(db, firebase, r.cfg.Sendgrid))
+		agentAPI.POST("/:agent_id/staff/signup", routes.AgentStaffSignUp(db, firebase, r.cfg.Sendgrid), adminAuthMiddleware(db, r.cfg.Admin, true))
 
 		// エージェントのアカウント作成
 		agentAPI.POST("/agent_and_agent_staff", routes.AgentAndAgentStaffSignUp(db, firebase, r.cfg.Sendgrid))
@@ -246,8 +304,11 @@ func (r *Router) SetUp() *Router {
 		// 同意書ファイルのURLを更新
 		agentAPI.PUT("/agreement_file/update", routes.UpdateAgentAgreementFileURL(db, firebase, r.cfg.Sendgrid))
 
+		// 重複推薦を確認する期間を更新（自社の管理者のみ）
+		agentAPI.PUT("/duplicate_recommendation/update", routes.UpdateAgentDuplicateRecommendation(db, firebase, r.cfg.Sendgrid))
+
 		// CRM機能を更新
-		agentAPI.PUT("/admin/update", routes.UpdateAgentForAdmin(db, firebase, r.cfg.Sendgrid))
+		agentAPI.PUT("/admin/update", routes.UpdateAgentForAdmin(db, firebase, r.cfg.Sendgrid), adminAuthMiddleware(db, r.cfg.Admin, true))
 
 		/************************************** GETメソッド **************************************/
 		// エージェント情報の取得
@@ -281,7 +342,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// AgentAlliance API
 	//
-	agentAllianceAPI := noAuthAPI.Group("/agent_alliance")
+	agentAllianceAPI := authAPI.Group("/agent_alliance")
 	{
 		/************************************** POSTメソッド **************************************/
 		// エージェントアライアンス情報の作成
@@ -308,7 +369,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	// JobSeekerSchedule API
 	//
-	jobSeekerScheduleAPI := noAuthAPI.Group("/job_seeker_schedule")
+	jobSeekerScheduleAPI := authAPI.Group("/job_seeker_schedule")
 	{
 		/************************************** POSTメソッド **************************************/
 
@@ -340,7 +401,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// AgentRobot API
 	//
-	agentRobotAPI := noAuthAPI.Group("/agent_robot")
+	agentRobotAPI := authAPI.Group("/agent_robot")
 	{
 		// RPA用エージェントロボット情報の作成
 		agentRobotAPI.POST("/create", routes.CreateAgentRobot(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
@@ -358,7 +419,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// エージェントの流入経路マスタ API
 	//
-	agentInflowChannelOptionAPI := noAuthAPI.Group("/agent_inflow_channel_option")
+	agentInflowChannelOptionAPI := authAPI.Group("/agent_inflow_channel_option")
 	{
 		// エージェントの流入経路マスタの作成
 		agentInflowChannelOptionAPI.POST("/create", routes.CreateAgentInflowChannelOption(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
@@ -373,10 +434,151 @@ func (r *Router) SetUp() *Router {
 		agentInflowChannelOptionAPI.GET("/list/agent/:agent_id", routes.GetAgentInflowChannelOptionListByAgentID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 	}
 
+	/****************************************************************************************/
+	/// 担当CAの自動割り振り API
+	//
+	agentAssignmentRuleAPI := authAPI.Group("/agent_assignment_rule")
+	{
+		// 担当CAの自動割り振りルールの更新
+		agentAssignmentRuleAPI.PUT("/update", routes.UpdateAgentAssignmentRule(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+
+		// 求職者の担当CAを手動で変更
+		agentAssignmentRuleAPI.PUT("/override", routes.OverrideJobSeekerAssignment(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+
+		// エージェントIDから担当CAの自動割り振りルールを取得
+		agentAssignmentRuleAPI.GET("/agent/:agent_id", routes.GetAgentAssignmentRuleByAgentID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+
+		// 求職者IDから担当CAの割り振り履歴を取得
+		agentAssignmentRuleAPI.GET("/log/job_seeker/:job_seeker_id", routes.GetJobSeekerAssignmentLogListByJobSeekerID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+	}
+
+	/****************************************************************************************/
+	/// 新規エントリーの選考ルール API
+	//
+	entryScreeningRuleAPI := authAPI.Group("/entry_screening_rule")
+	{
+		// 選考ルールの作成
+		entryScreeningRuleAPI.POST("/create", routes.CreateEntryScreeningRule(db, firebase))
+
+		// 選考ルールの更新
+		entryScreeningRuleAPI.PUT("/update/:entry_screening_rule_id", routes.UpdateEntryScreeningRule(db, firebase))
+
+		// 選考ルールの削除
+		entryScreeningRuleAPI.DELETE("/delete/:entry_screening_rule_id", routes.DeleteEntryScreeningRule(db, firebase))
+
+		// エージェントIDから選考ルール一覧を取得（ルールごとの該当件数を含む）
+		entryScreeningRuleAPI.GET("/list/agent/:agent_id", routes.GetEntryScreeningRuleListByAgentID(db, firebase))
+	}
+
+	/****************************************************************************************/
+	/// 面談予約 API（エントリー直後に送る面談予約リンクから求職者が利用）
+	//
+	interviewBookingAPI := authAPI.Group("/interview_booking")
+	{
+		// 面談予約リンクの情報と予約可能な面談枠を取得
+		interviewBookingAPI.GET("/:booking_uuid", routes.GetInterviewBookingByUUID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+
+		// 面談枠を予約する
+		interviewBookingAPI.PUT("/book/:booking_uuid", routes.BookInterview(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+	}
+
+	/****************************************************************************************/
+	/// 監査ログ API（管理者のみ）
+	//
+	auditLogAPI := authAPI.Group("/audit_log")
+	{
+		// 自社の監査ログを検索
+		auditLogAPI.GET("/search", routes.GetSearchAuditLogList(db))
+
+		// 自社の監査ログをcsvファイルで出力
+		auditLogAPI.GET("/export_csv", routes.ExportAuditLogCSV(db))
+	}
+
+	/****************************************************************************************/
+	/// ログイン試行の制限 API（管理者のみ）
+	//
+	loginAttemptAPI := authAPI.Group("/login_attempt")
+	{
+		// ロック中のログイン試行を取得
+		loginAttemptAPI.GET("/locked/list", routes.GetLockedLoginAttemptList(db, captcha))
+
+		// ログイン試行のロックを解除
+		loginAttemptAPI.PUT("/unlock", routes.UnlockLoginAttempt(db, captcha))
+	}
+
+	/****************************************************************************************/
+	/// 外部連携用のAPIキー API（管理者のみ）
+	//
+	apiKeyAPI := authAPI.Group("/api_key")
+	{
+		// APIキーを発行 {name, scopes, expire_days}
+		apiKeyAPI.POST("/create", routes.CreateAPIKey(db))
+
+		// APIキーを無効化
+		apiKeyAPI.PUT("/revoke/:api_key_id", routes.RevokeAPIKey(db))
+
+		// 自社のAPIキー一覧を取得
+		apiKeyAPI.GET("/list", routes.GetAPIKeyList(db))
+	}
+
+	/****************************************************************************************/
+	/// タスクの期限（SLA）の設定 API（作成・更新・削除は管理者のみ）
+	//
+	taskSLAAPI := authAPI.Group("/task_sla")
+	{
+		// 自社の期限の設定一覧と初期設定を取得
+		taskSLAAPI.GET("/rule/list", routes.GetTaskSLARuleList(db, r.cfg.Sendgrid, r.cfg.SLA))
+
+		// 期限の設定を作成 {target_type, phase_category, phase_sub_category, deadline_hours, reminder_hours, escalation_hours}
+		taskSLAAPI.POST("/rule/create", routes.CreateTaskSLARule(db, r.cfg.Sendgrid, r.cfg.SLA))
+
+		// 期限の設定を更新 {target_type, phase_category, phase_sub_category, deadline_hours, reminder_hours, escalation_hours}
+		taskSLAAPI.PUT("/rule/update/:rule_id", routes.UpdateTaskSLARule(db, r.cfg.Sendgrid, r.cfg.SLA))
+
+		// 期限の設定を削除（初期設定に戻す）
+		taskSLAAPI.DELETE("/rule/delete/:rule_id", routes.DeleteTaskSLARule(db, r.cfg.Sendgrid, r.cfg.SLA))
+	}
+
+	/****************************************************************************************/
+	/// 内定 API（選考のCA側・RA側のエージェントの担当者のみ）
+	//
+	jobOfferAPI := authAPI.Group("/job_offer")
+	{
+		// 選考の内定を作成 {position, employment_status, work_location, annual_salary, base_salary, fixed_overtime_pay, fixed_overtime_hours, allowance, bonus, salary_remarks, start_date, answer_deadline, remarks}
+		jobOfferAPI.POST("/create/:task_group_id", routes.CreateJobOffer(db, r.cfg.Sendgrid))
+
+		// 選考の内定の条件を更新（承諾済みの場合は入社日を選考へ反映） {position, employment_status, work_location, annual_salary, base_salary, fixed_overtime_pay, fixed_overtime_hours, allowance, bonus, salary_remarks, start_date, answer_deadline, remarks}
+		jobOfferAPI.PUT("/update/:task_group_id", routes.UpdateJobOffer(db, r.cfg.Sendgrid))
+
+		// 選考の内定の状態を変更（承諾の場合は入社日を選考へ反映） {status, remarks}
+		jobOfferAPI.PUT("/status/:task_group_id", routes.UpdateJobOfferStatus(db, r.cfg.Sendgrid))
+
+		// 選考の内定と状態の変更履歴を取得
+		jobOfferAPI.GET("/task_group/:task_group_id", routes.GetJobOfferByTaskGroupID(db, r.cfg.Sendgrid))
+
+		// 求職者の内定を年収の高い順に比較（求職者を担当するエージェントの担当者のみ）
+		jobOfferAPI.GET("/comparison/job_seeker/:job_seeker_id", routes.GetJobOfferComparisonByJobSeekerID(db, r.cfg.Sendgrid))
+	}
+
+	/****************************************************************************************/
+	/// 辞退・不合格の理由 API
+	//
+	taskLossReasonAPI := authAPI.Group("/loss_reason")
+	{
+		// 辞退・不合格の理由の一覧（フェーズの指定がある場合は、その終了で選択できる理由のみ） {phase_category, phase_sub_category}
+		taskLossReasonAPI.GET("/master", routes.GetTaskLossReasonMasterList(db))
+
+		// 選考の辞退・不合格の理由を取得（選考のCA側・RA側のエージェントの担当者のみ）
+		taskLossReasonAPI.GET("/task_group/:task_group_id", routes.GetTaskLossReasonListByTaskGroupID(db))
+
+		// 辞退・不合格の理由を企業・求人・担当者・フェーズごとに集計 {group_by, loss_type, from_date, to_date}
+		taskLossReasonAPI.GET("/analytics", routes.GetTaskLossReasonAnalytics(db))
+	}
+
 	/****************************************************************************************/
 	/// 求職者 API
 	//
-	jobSeekerAPI := noAuthAPI.Group("/job_seeker")
+	jobSeekerAPI := authAPI.Group("/job_seeker")
 	{
 		/************************************** POSTメソッド **************************************/
 
@@ -450,6 +652,9 @@ func (r *Router) SetUp() *Router {
 		// 求職者IDから求職者資料の取得
 		jobSeekerAPI.GET("/document/:job_seeker_id", routes.GetJobSeekerDocumentByJobSeekerID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal, r.cfg.Slack))
 
+		// 求職者の個人情報をzipファイルで出力（開示請求の対応、管理者のみ）
+		jobSeekerAPI.GET("/:job_seeker_id/personal_data/export", routes.ExportJobSeekerPersonalData(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal, r.cfg.Slack))
+
 		// 求職者の絞り込み検索（全ての自社求職者）
 		jobSeekerAPI.GET("/list/search/:agent_id", routes.GetSearchJobSeekerListByAgentID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal, r.cfg.Slack))
 
@@ -491,6 +696,9 @@ func (r *Router) SetUp() *Router {
 		// 求職者情報の削除
 		jobSeekerAPI.DELETE("/delete", routes.DeleteJobSeeker(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal, r.cfg.Slack))
 
+		// 求職者の個人情報を削除して匿名化（削除請求の対応、管理者のみ）
+		jobSeekerAPI.DELETE("/:job_seeker_id/personal_data/delete", routes.DeleteJobSeekerPersonalData(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal, r.cfg.Slack))
+
 		// 履歴書PDFの削除（resume_pdf_urlカラムを空文字で更新）
 		jobSeekerAPI.DELETE("/:job_seeker_id/document/resume_pdf_url/delete", routes.DeleteJobSeekerResumePDFURL(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal, r.cfg.Slack))
 
@@ -525,7 +733,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// 企業 API
 	//
-	enterpriseAPI := noAuthAPI.Group("/enterprise")
+	enterpriseAPI := authAPI.Group("/enterprise")
 	{
 		/************************************** POSTメソッド **************************************/
 
@@ -603,7 +811,7 @@ func (r *Router) SetUp() *Router {
 
 	/// 請求先 API
 	//
-	billingAddressAPI := noAuthAPI.Group("/billing_address")
+	billingAddressAPI := authAPI.Group("/billing_address")
 	{
 		/************************************** POSTメソッド **************************************/
 
@@ -642,7 +850,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// 求人 API
 	//
-	jobInformationAPI := noAuthAPI.Group("/job_information")
+	jobInformationAPI := authAPI.Group("/job_information")
 	{
 		/************************************** POSTメソッド **************************************/
 
@@ -739,14 +947,48 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// task API
 	//
-	taskAPI := noAuthAPI.Group("/task")
+	taskAPI := authAPI.Group("/task")
 	{
 		// タスクの取得
 		taskAPI.GET("/:task_id", routes.GetTaskByID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 
+		// タスクから次に実行できる操作（遷移先のフェーズ・実行する担当者・処理）の一覧を取得
+		taskAPI.GET("/phase_action/:task_id", routes.GetTaskPhaseActionList(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+
+		// 推薦前に同じ企業への重複推薦（自社・アライアンス先の同一人物の選考）を確認
+		taskAPI.POST("/duplicate_recommendation/check", routes.CheckDuplicateRecommendation(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+
 		// タスクグループの取得（自分が関わっているタスク）
 		taskAPI.GET("/group/:task_group_id", routes.GetTaskGroupByID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 
+		// タスクグループの活動履歴（フェーズの変更・書類・選考日時・評価ポイント・売上）を発生順に取得
+		taskAPI.GET("/group/timeline/:task_group_id", routes.GetTaskGroupTimeline(db))
+
+		// 求職者の全ての選考の活動履歴を発生順に取得
+		taskAPI.GET("/timeline/job_seeker/:job_seeker_id", routes.GetJobSeekerTimeline(db))
+
+		// 自分が登録したタスクの一括操作の一覧を取得
+		taskAPI.GET("/bulk/list", routes.GetTaskBulkJobList(db, r.cfg.OneSignal))
+
+		// タスクの一括操作のジョブと選考ごとの結果を取得
+		taskAPI.GET("/bulk/:job_id", routes.GetTaskBulkJobByID(db, r.cfg.OneSignal))
+
+		// パイプライン（選考の進捗ボード）のフェーズ・サブフェーズごとの件数を取得（エージェント・担当者・求人ごと）
+		taskAPI.GET("/pipeline/agent/:agent_id", routes.GetTaskPipelineByAgentID(db))
+		taskAPI.GET("/pipeline/agent_staff/:agent_staff_id", routes.GetTaskPipelineByAgentStaffID(db))
+		taskAPI.GET("/pipeline/job_information/:job_information_id", routes.GetTaskPipelineByJobInformationID(db))
+
+		// パイプラインの列（フェーズ・サブフェーズ）のカードを取得
+		taskAPI.GET("/pipeline/card/agent/:agent_id", routes.GetTaskPipelineCardList(db))
+
+		// 求人・請求先ごとの選考中の応募者一覧を取得（RA向け）
+		taskAPI.GET("/pipeline/applicant/job_information/:job_information_id", routes.GetTaskPipelineApplicantListByJobInformationID(db))
+		taskAPI.GET("/pipeline/applicant/billing_address/:billing_address_id", routes.GetTaskPipelineApplicantListByBillingAddressID(db))
+
+		// 求人・請求先ごとの選考中の応募者一覧をcsvファイルで出力
+		taskAPI.GET("/pipeline/applicant/job_information/:job_information_id/csv", routes.ExportTaskPipelineApplicantCSVByJobInformationID(db))
+		taskAPI.GET("/pipeline/applicant/billing_address/:billing_address_id/csv", routes.ExportTaskPipelineApplicantCSVByBillingAddressID(db))
+
 		// タスクグループの一覧取得（エージェントが関わっているタスク）
 		taskAPI.GET("/list/agent/page/:agent_id", routes.GetTaskListByAgentIDAndPage(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 
@@ -804,6 +1046,9 @@ func (r *Router) SetUp() *Router {
 		// 次のタスク作成（現在のタスクがEntryの場合）
 		taskAPI.POST("/create/batch_processing", routes.CreateTaskInBatchProcessing(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 
+		// 複数の選考にフェーズの遷移・終了・担当者の変更・期限の設定を一括で行う（バッチで処理し、結果は /bulk/:job_id で確認する）
+		taskAPI.POST("/bulk/create", routes.CreateTaskBulkJob(db, r.cfg.OneSignal))
+
 		// 次のタスク作成（現在のタスクがEntryの場合）
 		taskAPI.POST("/entry/create/:agent_staff_id", routes.CreateNextTaskAfterEntryPhase(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 
@@ -868,7 +1113,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// 面談調整タスク API
 	//
-	interviewTaskAPI := noAuthAPI.Group("/interview_task")
+	interviewTaskAPI := authAPI.Group("/interview_task")
 	{
 		/************************************** PUTメソッド **************************************/
 		// 最終閲覧時間を更新
@@ -903,7 +1148,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	// 面談調整用のテンプレート API
 	//
-	interviewTemplateAPI := noAuthAPI.Group("/interview_template")
+	interviewTemplateAPI := authAPI.Group("/interview_template")
 	{
 		// 面談調整メッセージテンプレートの登録
 		interviewTemplateAPI.POST("/create", routes.CreateInterviewAdjustmentTemplate(db, firebase, r.cfg.Sendgrid))
@@ -927,7 +1172,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// 選考後アンケート API
 	//
-	selectionQuestionnaireAPI := noAuthAPI.Group("/selection_questionnaire")
+	selectionQuestionnaireAPI := authAPI.Group("/selection_questionnaire")
 	{
 		// 選考後アンケートを更新
 		selectionQuestionnaireAPI.POST("/create/:questionnaire_uuid", routes.CreateSelectionQuestionnaire(db, firebase, r.cfg.Sendgrid))
@@ -951,7 +1196,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// メッセージテンプレート API
 	//
-	messageTemplateAPI := noAuthAPI.Group("/template")
+	messageTemplateAPI := authAPI.Group("/template")
 	{
 		// メッセージテンプレートの登録
 		messageTemplateAPI.POST("/create", routes.CreateMessageTemplate(db, firebase, r.cfg.Sendgrid))
@@ -978,7 +1223,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// エージェントと求職者のチャットグループ API
 	//
-	chatGroupWithJobSeekerAPI := noAuthAPI.Group("/chat_group_with_job_seeker")
+	chatGroupWithJobSeekerAPI := authAPI.Group("/chat_group_with_job_seeker")
 	{
 		/************************************** POST	メソッド **************************************/
 
@@ -1008,7 +1253,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// エージェントと求職者のチャットメッセージAPI
 	//
-	chatMessageWithJobSeekerAPI := noAuthAPI.Group("/chat_message_with_job_seeker")
+	chatMessageWithJobSeekerAPI := authAPI.Group("/chat_message_with_job_seeker")
 	{
 		/************************************** POSTメソッド **************************************/
 
@@ -1027,7 +1272,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// エージェントと求職者のメールAPI
 	//
-	emailWithJobSeekerAPI := noAuthAPI.Group("/email_with_job_seeker")
+	emailWithJobSeekerAPI := authAPI.Group("/email_with_job_seeker")
 	{
 		/************************************** POSTメソッド **************************************/
 
@@ -1043,7 +1288,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// エージェント同士のチャットグループ API
 	//
-	chatGroupWithAgentAPI := noAuthAPI.Group("/chat_group_with_agent")
+	chatGroupWithAgentAPI := authAPI.Group("/chat_group_with_agent")
 	{
 		/************************************** POST	メソッド **************************************/
 
@@ -1081,7 +1326,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// エージェント同士のチャットスレッド API
 	//
-	chatThreadWithAgentAPI := noAuthAPI.Group("/chat_thread_with_agent")
+	chatThreadWithAgentAPI := authAPI.Group("/chat_thread_with_agent")
 	{
 		// /************************************** POST	メソッド **************************************/
 
@@ -1102,7 +1347,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// エージェントチャットのメッセージグループ API
 	//
-	chatMessageWithAgentAPI := noAuthAPI.Group("/chat_message_with_agent")
+	chatMessageWithAgentAPI := authAPI.Group("/chat_message_with_agent")
 	{
 		/************************************** POSTメソッド **************************************/
 		// メッセージ送信
@@ -1120,20 +1365,32 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// Sale API
 	//
-	saleAPI := noAuthAPI.Group("/sales")
+	saleAPI := authAPI.Group("/sales")
 	{
 		/************************************** POSTメソッド **************************************/
 
 		// ヨミの登録
 		saleAPI.POST("/create", routes.CreateSale(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 
+		// 早期退職を記録し売上を減額
+		saleAPI.POST("/guarantee/resignation/:sale_id", routes.RecordSaleResignation(db, r.cfg.Sendgrid))
+
 		/************************************** PUTメソッド **************************************/
 
 		// ヨミの更新
 		saleAPI.PUT("/update/:sale_id", routes.UpdateSale(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 
+		// 売上の自動作成・返金規定の設定を更新（管理者のみ）
+		saleAPI.PUT("/guarantee/policy/update", routes.UpdateSalePolicy(db, r.cfg.Sendgrid))
+
 		/************************************** GETメソッド **************************************/
 
+		// 売上の自動作成・返金規定の設定を取得
+		saleAPI.GET("/guarantee/policy", routes.GetSalePolicy(db, r.cfg.Sendgrid))
+
+		// 返金保証期間の対象の売上一覧を取得
+		saleAPI.GET("/guarantee/list", routes.GetSaleGuaranteeList(db, r.cfg.Sendgrid))
+
 		// ヨミ情報の取得
 		saleAPI.GET("/:sale_id", routes.GetSaleByID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 
@@ -1150,7 +1407,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// カレンダーのスケジュール API
 	//
-	scheduleAPI := noAuthAPI.Group("/schedule")
+	scheduleAPI := authAPI.Group("/schedule")
 	{
 		/************************************** GETメソッド **************************************/
 
@@ -1164,7 +1421,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	// ダッシュボード API
 	//
-	dashboardAPI := noAuthAPI.Group("/dashboard")
+	dashboardAPI := authAPI.Group("/dashboard")
 	{
 		/************************************** GETメソッド **************************************/
 
@@ -1193,7 +1450,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// スカウトサービス API
 	//
-	scoutServiceAPI := noAuthAPI.Group("/scout_service")
+	scoutServiceAPI := authAPI.Group("/scout_service")
 
 	{
 		scoutServiceHandler := di.InitializeScoutServiceHandler(firebase, db, r.cfg.Sendgrid, r.cfg.OneSignal, r.cfg.App, r.cfg.GoogleAPI, r.cfg.Slack)
@@ -1219,7 +1476,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// Agent API
 	//
-	saleManagementAPI := noAuthAPI.Group("/sale_management")
+	saleManagementAPI := authAPI.Group("/sale_management")
 	{
 		/************************************** GETメソッド **************************************/
 		// 売上期間の一覧(決算月単位で取得)
@@ -1241,7 +1498,7 @@ func (r *Router) SetUp() *Router {
 		saleManagementAPI.GET("/sum_of/staff_monthly/:management_id", routes.GetSumOfStaffMonthlyByManagementID(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
 	}
 
-	// applicationAPI := noAuthAPI.Group("/application")
+	// applicationAPI := authAPI.Group("/application")
 	// {
 	// 	// サービス申し込みのデータを作成
 	// 	applicationAPI.POST("/create", routes.CreateServiceApplication(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
@@ -1253,7 +1510,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// Agent API
 	//
-	agentStaffMonthlySaleAPI := noAuthAPI.Group("/staff_monthly_sale")
+	agentStaffMonthlySaleAPI := authAPI.Group("/staff_monthly_sale")
 	{
 		/************************************** POSTメソッド **************************************/
 		// エージェントの担当者アカウントの作成
@@ -1271,7 +1528,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// Agent API
 	//
-	agentMonthlySaleAPI := noAuthAPI.Group("/agent_monthly_sale")
+	agentMonthlySaleAPI := authAPI.Group("/agent_monthly_sale")
 	{
 		/************************************** POSTメソッド **************************************/
 		// エージェントの担当者アカウントの作成
@@ -1292,7 +1549,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// 企業求人一括インポート情報 API
 	//
-	initialEnterpriseImporterAPI := noAuthAPI.Group("/initial_enterprise_importer")
+	initialEnterpriseImporterAPI := authAPI.Group("/initial_enterprise_importer")
 	{
 		// 企業求人一括インポート情報の登録
 		initialEnterpriseImporterAPI.POST("/create", routes.CreateInitialEnterpriseImporter(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
@@ -1310,7 +1567,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	// お知らせ関連API
 	//
-	notificationForUserAPI := noAuthAPI.Group("/notification_for_user")
+	notificationForUserAPI := authAPI.Group("/notification_for_user")
 	{
 		/************************************** POSTメソッド **************************************/
 		// お知らせを作成
@@ -1332,7 +1589,7 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	// デプロイ反映関連API
 	//
-	deploymentReflectionAPI := noAuthAPI.Group("/deployment_reflection")
+	deploymentReflectionAPI := authAPI.Group("/deployment_reflection")
 	{
 		/************************************** POSTメソッド **************************************/
 		// デプロイ反映の情報を一括作成
@@ -1360,10 +1617,32 @@ func (r *Router) SetUp() *Router {
 	/// Admin API
 	//
 	adminAPI := r.Engine.Group("/admin")
-	adminNoAuthAPI := adminAPI.Group("")
+	adminNoAuthAPI := adminAPI.Group("", loginAttemptMiddleware(db, captcha))
+	{
+		// 管理者サイトのログイン（パスワード認証） {login_id, password}
+		adminNoAuthAPI.PUT("/authorize", routes.AdminAuthorize(db, r.cfg.Admin))
+	}
+
+	// 二要素認証前のセッションで利用できるAPI
+	adminPreMFAAPI := adminAPI.Group("", adminAuthMiddleware(db, r.cfg.Admin, false))
+	{
+		// 二要素認証の秘密鍵を発行
+		adminPreMFAAPI.POST("/totp/enroll", routes.AdminEnrollTOTP(db, r.cfg.Admin))
+
+		// ワンタイムパスワードを検証して二要素認証を完了 {code}
+		adminPreMFAAPI.PUT("/totp/verify", routes.AdminVerifyTOTP(db, r.cfg.Admin))
+
+		// ログアウト
+		adminPreMFAAPI.PUT("/signout", routes.AdminSignOut(db, r.cfg.Admin))
+	}
+
+	adminAuthAPI := adminAPI.Group("", adminAuthMiddleware(db, r.cfg.Admin, true))
 	{
-		// 管理者サイトのログイン
-		adminNoAuthAPI.PUT("/authorize", routes.AdminAuthorize(db, r.cfg.App))
+		// 管理者を作成 {login_id, name, password}
+		adminAuthAPI.POST("/admin_user/create", routes.CreateAdminUser(db, r.cfg.Admin))
+
+		// 管理者の操作ログを取得
+		adminAuthAPI.GET("/action_log/list", routes.GetAdminActionLogList(db, r.cfg.Admin))
 	}
 
 	/****************************************************************************************/
@@ -1371,11 +1650,11 @@ func (r *Router) SetUp() *Router {
 	/****************************************************************************************/
 	/// LP API
 	//
-	lpAPI := noAuthAPI.Group("/lp")
+	lpAPI := authAPI.Group("/lp")
 	{
 		/************************************** POSTメソッド **************************************/
 		// LPのログインフォームのログイン処理
-		lpAPI.POST("/job_seeker/login", routes.LoginGuestJobSeekerForLP(db, firebase, r.cfg.Sendgrid))
+		lpAPI.POST("/job_seeker/login", routes.LoginGuestJobSeekerForLP(db, firebase, r.cfg.Sendgrid, r.cfg.GuestLink))
 
 		// パスワード再設定メールを送信する（LP）
 		lpAPI.POST("/job_seeker/send/reset_password_email", routes.SendJobSeekerResetPasswordEmailForLP(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal, r.cfg.Slack))
@@ -1410,10 +1689,37 @@ func (r *Router) SetUp() *Router {
 		lpAPI.GET("/job_information/list/for_diagnosis", routes.GetJobInformationListForDiagnosis(db, firebase, r.cfg.Sendgrid))
 	}
 
+	/****************************************************************************************/
+	/// 外部媒体からのエントリー取り込み API
+	//
+	inboundEntryAPI := authAPI.Group("/inbound_entry")
+	{
+		/************************************** POSTメソッド **************************************/
+		// 提携求人媒体・自社フォームからの応募者を取り込む
+		inboundEntryAPI.POST("/job_seeker/create", routes.CreateJobSeekerFromInboundEntry(db, firebase, r.cfg.Sendgrid, r.cfg.OneSignal))
+	}
+
+	/****************************************************************************************/
+	/// 求職者の統合候補 API
+	//
+	jobSeekerMergeSuggestionAPI := authAPI.Group("/job_seeker_merge_suggestion")
+	{
+		/************************************** PUTメソッド **************************************/
+		// 統合候補の求職者を既存の求職者に統合する
+		jobSeekerMergeSuggestionAPI.PUT("/merge/:merge_suggestion_id", routes.MergeJobSeekerBySuggestion(db, firebase))
+
+		// 統合候補を別人として処理する
+		jobSeekerMergeSuggestionAPI.PUT("/dismiss/:merge_suggestion_id", routes.DismissJobSeekerMergeSuggestion(db, firebase))
+
+		/************************************** GETメソッド **************************************/
+		// エージェントIDから未対応の統合候補を取得
+		jobSeekerMergeSuggestionAPI.GET("/list/agent/:agent_id", routes.GetUnhandledJobSeekerMergeSuggestionListByAgentID(db, firebase))
+	}
+
 	/****************************************************************************************/
 	// Admin API
 	//
-	rpaAPI := noAuthAPI.Group("/rpa")
+	rpaAPI := authAPI.Group("/rpa")
 	{
 		// Gmail Webhook
 		rpaAPI.POST(
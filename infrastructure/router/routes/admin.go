@@ -2,6 +2,7 @@ package routes
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/spaceaiinc/autoscout-server/domain/config"
@@ -12,12 +13,20 @@ import (
 )
 
 type AdminAuthorizeParam struct {
-	Username string `json:"username" validate:"required"`
+	LoginID  string `json:"login_id" validate:"required"`
 	Password string `json:"password" validate:"required"`
 }
 
+type AdminVerifyTOTPParam struct {
+	Code string `json:"code" validate:"required"`
+}
+
+/****************************************************************************************/
+// 認証 API
+//
 // AdminAuthorize is
-func AdminAuthorize(db *database.DB, appConfig config.App) func(c echo.Context) error {
+// ログインIDとパスワードで認証し、二要素認証前のセッショントークンを返す
+func AdminAuthorize(db *database.DB, adminConfig config.Admin) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
 			param = new(AdminAuthorizeParam)
@@ -28,8 +37,119 @@ func AdminAuthorize(db *database.DB, appConfig config.App) func(c echo.Context)
 			return wrapped
 		}
 
-		h := di.InitializeAdminHandler(db, appConfig)
-		p, err := h.Authorize(param.Username, param.Password)
+		h := di.InitializeAdminHandler(db, adminConfig)
+		p, err := h.Authorize(param.LoginID, param.Password, NewAdminAccess(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 二要素認証の秘密鍵を発行（未登録の場合のみ）
+func AdminEnrollTOTP(db *database.DB, adminConfig config.Admin) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		h := di.InitializeAdminHandler(db, adminConfig)
+		p, err := h.EnrollTOTP(GetAuthenticatedAdminUser(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// ワンタイムパスワードを検証して二要素認証を完了 body: {code}
+func AdminVerifyTOTP(db *database.DB, adminConfig config.Admin) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param = new(AdminVerifyTOTPParam)
+		)
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeAdminHandler(db, adminConfig)
+		p, err := h.VerifyTOTP(GetAuthenticatedAdminUser(c), GetAuthenticatedAdminSession(c), param.Code)
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// ログアウト
+func AdminSignOut(db *database.DB, adminConfig config.Admin) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		h := di.InitializeAdminHandler(db, adminConfig)
+		p, err := h.SignOut(GetAuthenticatedAdminSession(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+/****************************************************************************************/
+// 管理者 API
+//
+// 管理者を作成 body: {login_id, name, password}
+func CreateAdminUser(db *database.DB, adminConfig config.Admin) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param = new(entity.CreateAdminUserParam)
+		)
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeAdminHandler(db, adminConfig)
+		p, err := h.CreateAdminUser(*param)
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 管理者の操作ログを取得 query: page（1始まり）
+func GetAdminActionLogList(db *database.DB, adminConfig config.Admin) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			pageStr = c.QueryParam("page")
+			page    = 1
+			err     error
+		)
+
+		if pageStr != "" {
+			page, err = strconv.Atoi(pageStr)
+			if err != nil || page < 1 {
+				wrapped := fmt.Errorf("%s:%w", "page is invalid", entity.ErrRequestError)
+				renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+				return wrapped
+			}
+		}
+
+		h := di.InitializeAdminHandler(db, adminConfig)
+		p, err := h.GetAdminActionLogList(uint(page))
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
 			return err
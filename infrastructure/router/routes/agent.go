@@ -133,6 +133,39 @@ func UpdateAgentAgreementFileURL(db *database.DB, firebase usecase.Firebase, sen
 	}
 }
 
+// 重複推薦を確認する期間を更新（自社の管理者のみ）
+func UpdateAgentDuplicateRecommendation(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param entity.AgentDuplicateRecommendationParam
+		)
+
+		if err := bindAndValidate(c, &param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeAgentHandler(firebase, tx, sendgrid)
+		p, err := h.UpdateAgentDuplicateRecommendation(param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
 // CRM機能の有無を更新
 func UpdateAgentForAdmin(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
 	return func(c echo.Context) error {
@@ -0,0 +1,136 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 担当CAの自動割り振りルールの更新
+func UpdateAgentAssignmentRule(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param entity.UpdateAgentAssignmentRuleParam
+		)
+
+		if err := bindAndValidate(c, &param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeAgentAssignmentRuleHandler(firebase, tx, sendgrid, oneSignal)
+		p, err := h.UpdateAgentAssignmentRule(param)
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// エージェントIDから担当CAの自動割り振りルールを取得
+func GetAgentAssignmentRuleByAgentID(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			agentIDStr = c.Param("agent_id")
+		)
+
+		agentID, err := strconv.Atoi(agentIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeAgentAssignmentRuleHandler(firebase, db, sendgrid, oneSignal)
+		p, err := h.GetAgentAssignmentRuleByAgentID(uint(agentID))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 求職者の担当CAを手動で変更
+func OverrideJobSeekerAssignment(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param         entity.OverrideJobSeekerAssignmentParam
+			firebaseToken = GetFirebaseToken(c)
+		)
+
+		if err := bindAndValidate(c, &param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeAgentAssignmentRuleHandler(firebase, tx, sendgrid, oneSignal)
+		p, err := h.OverrideJobSeekerAssignment(firebaseToken, param)
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 求職者IDから担当CAの割り振り履歴を取得
+func GetJobSeekerAssignmentLogListByJobSeekerID(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			jobSeekerIDStr = c.Param("job_seeker_id")
+		)
+
+		jobSeekerID, err := strconv.Atoi(jobSeekerIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeAgentAssignmentRuleHandler(firebase, db, sendgrid, oneSignal)
+		p, err := h.GetJobSeekerAssignmentLogListByJobSeekerID(uint(jobSeekerID))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
@@ -35,7 +35,7 @@ func CreateAgentMonthlySale(db *database.DB, firebase usecase.Firebase, sendgrid
 		}
 
 		h := di.InitializeAgentMonthlySaleHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateAgentMonthlySale(param)
+		p, err := h.CreateAgentMonthlySale(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -68,7 +68,7 @@ func UpdateAgentMonthlySale(db *database.DB, firebase usecase.Firebase, sendgrid
 		}
 
 		h := di.InitializeAgentMonthlySaleHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.UpdateAgentMonthlySale(param)
+		p, err := h.UpdateAgentMonthlySale(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
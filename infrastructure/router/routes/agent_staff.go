@@ -276,6 +276,28 @@ func UpdateAgentStaffNotificationUnwatched(db *database.DB, firebase usecase.Fir
 	}
 }
 
+// タスクの期限（SLA）に関する通知設定の更新 body: {agent_staff_id, manager_staff_id, notification_sla_reminder, notification_sla_digest}
+func UpdateAgentStaffSLASetting(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var param entity.UpdateAgentStaffSLASettingParam
+
+		if err := bindAndValidate(c, &param); err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeAgentStaffHandler(firebase, db, sendgrid)
+		p, err := h.UpdateAgentStaffSLASetting(param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
 // 管理権限の更新 body: {agent_staff_id, authority}
 func UpdateAgentStaffAuthority(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
 	return func(c echo.Context) error {
@@ -287,7 +309,7 @@ func UpdateAgentStaffAuthority(db *database.DB, firebase usecase.Firebase, sendg
 		}
 
 		h := di.InitializeAgentStaffHandler(firebase, db, sendgrid)
-		p, err := h.UpdateAgentStaffAuthority(param)
+		p, err := h.UpdateAgentStaffAuthority(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
 			return err
@@ -310,7 +332,7 @@ func DeleteAgentStaff(db *database.DB, firebase usecase.Firebase, sendgrid confi
 		}
 
 		h := di.InitializeAgentStaffHandler(firebase, db, sendgrid)
-		p, err := h.DeleteAgentStaff(param)
+		p, err := h.DeleteAgentStaff(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
 			return err
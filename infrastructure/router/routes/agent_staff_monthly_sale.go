@@ -35,7 +35,7 @@ func CreateAgentStaffMonthlySale(db *database.DB, firebase usecase.Firebase, sen
 		}
 
 		h := di.InitializeAgentStaffMonthlySaleHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateAgentStaffMonthlySale(param)
+		p, err := h.CreateAgentStaffMonthlySale(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -68,7 +68,7 @@ func UpdateAgentStaffMonthlySale(db *database.DB, firebase usecase.Firebase, sen
 		}
 
 		h := di.InitializeAgentStaffMonthlySaleHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.UpdateAgentStaffMonthlySale(param)
+		p, err := h.UpdateAgentStaffMonthlySale(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
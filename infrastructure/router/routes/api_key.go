@@ -0,0 +1,81 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+)
+
+/****************************************************************************************/
+// Admin API
+//
+// APIキーを発行（管理者のみ） body: {name, scopes, expire_days}
+func CreateAPIKey(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param = new(entity.CreateAPIKeyParam)
+		)
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeAPIKeyHandler(db)
+		p, err := h.CreateAPIKey(*param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// APIキーを無効化（管理者のみ）
+func RevokeAPIKey(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			apiKeyIDStr = c.Param("api_key_id")
+		)
+
+		apiKeyID, err := strconv.Atoi(apiKeyIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeAPIKeyHandler(db)
+		p, err := h.RevokeAPIKey(uint(apiKeyID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 自社のAPIキー一覧を取得（管理者のみ）
+func GetAPIKeyList(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		h := di.InitializeAPIKeyHandler(db)
+		p, err := h.GetAPIKeyList(GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
@@ -0,0 +1,63 @@
+package routes
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+)
+
+/****************************************************************************************/
+// Admin API
+//
+// 自社の監査ログを検索（管理者のみ）
+func GetSearchAuditLogList(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		searchParam, err := parseSearchAuditLogQueryParams(c)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeAuditLogHandler(db)
+		p, err := h.GetSearchAuditLogList(searchParam, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 自社の監査ログをcsvファイルで出力（管理者のみ）
+func ExportAuditLogCSV(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		searchParam, err := parseSearchAuditLogQueryParams(c)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeAuditLogHandler(db)
+		filePath, err := h.ExportAuditLogCSV(searchParam, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			fmt.Println(err)
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderFile(c, filePath)
+		// ローカルファイルの削除
+		os.Remove(filePath)
+
+		return nil
+	}
+}
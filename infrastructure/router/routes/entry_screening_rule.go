@@ -0,0 +1,150 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 選考ルールの作成
+func CreateEntryScreeningRule(db *database.DB, firebase usecase.Firebase) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param entity.CreateOrUpdateEntryScreeningRuleParam
+		)
+
+		if err := bindAndValidate(c, &param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeEntryScreeningRuleHandler(firebase, tx)
+		p, err := h.CreateEntryScreeningRule(param)
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 選考ルールの更新
+func UpdateEntryScreeningRule(db *database.DB, firebase usecase.Firebase) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param              entity.CreateOrUpdateEntryScreeningRuleParam
+			screeningRuleIDStr = c.Param("entry_screening_rule_id")
+		)
+
+		screeningRuleID, err := strconv.Atoi(screeningRuleIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		if err := bindAndValidate(c, &param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeEntryScreeningRuleHandler(firebase, tx)
+		p, err := h.UpdateEntryScreeningRule(uint(screeningRuleID), param)
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 選考ルールの削除
+func DeleteEntryScreeningRule(db *database.DB, firebase usecase.Firebase) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			screeningRuleIDStr = c.Param("entry_screening_rule_id")
+		)
+
+		screeningRuleID, err := strconv.Atoi(screeningRuleIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeEntryScreeningRuleHandler(firebase, tx)
+		p, err := h.DeleteEntryScreeningRule(uint(screeningRuleID))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// エージェントIDから選考ルール一覧を取得
+func GetEntryScreeningRuleListByAgentID(db *database.DB, firebase usecase.Firebase) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			agentIDStr = c.Param("agent_id")
+		)
+
+		agentID, err := strconv.Atoi(agentIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeEntryScreeningRuleHandler(firebase, db)
+		p, err := h.GetEntryScreeningRuleListByAgentID(uint(agentID))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
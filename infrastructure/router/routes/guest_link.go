@@ -0,0 +1,125 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// ゲスト用リンクを発行 body: {resource_type, resource_uuid, permissions, expire_days}
+func CreateGuestLink(db *database.DB, guestLink config.GuestLink) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param = new(entity.CreateGuestLinkParam)
+		)
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeGuestLinkHandler(db, guestLink)
+		p, err := h.CreateGuestLink(*param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// ゲスト用リンクを無効化
+func RevokeGuestLink(db *database.DB, guestLink config.GuestLink) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			guestLinkIDStr = c.Param("guest_link_id")
+		)
+
+		guestLinkID, err := strconv.Atoi(guestLinkIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeGuestLinkHandler(db, guestLink)
+		p, err := h.RevokeGuestLink(uint(guestLinkID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 自社のゲスト用リンク一覧を取得 query: resource_type, resource_uuid（任意）
+func GetGuestLinkList(db *database.DB, guestLink config.GuestLink) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			resourceType    = c.QueryParam("resource_type")
+			resourceUUIDStr = c.QueryParam("resource_uuid")
+			resourceUUID    uuid.UUID
+			err             error
+		)
+
+		if resourceUUIDStr != "" {
+			resourceUUID, err = uuid.Parse(resourceUUIDStr)
+			if err != nil {
+				wrapped := fmt.Errorf("%s:%w", "uuidのフォーマットが不正です", entity.ErrRequestError)
+				renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+				return wrapped
+			}
+		}
+
+		h := di.InitializeGuestLinkHandler(db, guestLink)
+		p, err := h.GetGuestLinkList(resourceType, resourceUUID, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// ゲスト用リンクのアクセスログを取得
+func GetGuestLinkAccessLogList(db *database.DB, guestLink config.GuestLink) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			guestLinkIDStr = c.Param("guest_link_id")
+		)
+
+		guestLinkID, err := strconv.Atoi(guestLinkIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeGuestLinkHandler(db, guestLink)
+		p, err := h.GetGuestLinkAccessLogList(uint(guestLinkID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
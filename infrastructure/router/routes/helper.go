@@ -3,10 +3,13 @@ package routes
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
 	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
 	"gopkg.in/go-playground/validator.v9"
 	"gopkg.in/guregu/null.v4"
 )
@@ -16,6 +19,97 @@ func GetFirebaseToken(c echo.Context) string {
 	return token
 }
 
+// ゲストログイン後のゲスト用APIのトークン
+func GetGuestToken(c echo.Context) string {
+	token := c.Request().Header.Get("X-Guest-Token")
+	return token
+}
+
+// 外部連携用のAPIキー
+func GetAPIKey(c echo.Context) string {
+	key := c.Request().Header.Get("X-API-Key")
+	return key
+}
+
+// 管理者サイトのセッショントークン
+func GetAdminToken(c echo.Context) string {
+	token := c.Request().Header.Get("AdminAuthorization")
+	return token
+}
+
+// 認証ミドルウェアがリクエストのコンテキストに設定する値のキー
+const (
+	ContextKeyAgentStaff = "agent_staff"
+	ContextKeyAgent      = "agent"
+	ContextKeyAPIKey     = "api_key" // APIキーで認証した場合のみ
+
+	// 管理者サイト
+	ContextKeyAdminUser    = "admin_user"
+	ContextKeyAdminSession = "admin_session"
+)
+
+// 認証済みの担当者を取得（認証を行わないルートではnil）
+func GetAuthenticatedAgentStaff(c echo.Context) *entity.AgentStaff {
+	agentStaff, ok := c.Get(ContextKeyAgentStaff).(*entity.AgentStaff)
+	if !ok {
+		return nil
+	}
+	return agentStaff
+}
+
+// 認証済みの担当者のエージェントを取得（認証を行わないルートではnil）
+func GetAuthenticatedAgent(c echo.Context) *entity.Agent {
+	agent, ok := c.Get(ContextKeyAgent).(*entity.Agent)
+	if !ok {
+		return nil
+	}
+	return agent
+}
+
+// APIキーで認証した場合のAPIキーを取得（Firebaseのトークンで認証した場合はnil）
+func GetAuthenticatedAPIKey(c echo.Context) *entity.APIKey {
+	apiKey, ok := c.Get(ContextKeyAPIKey).(*entity.APIKey)
+	if !ok {
+		return nil
+	}
+	return apiKey
+}
+
+// 認証済みの管理者を取得（管理者サイト以外のルートではnil）
+func GetAuthenticatedAdminUser(c echo.Context) *entity.AdminUser {
+	adminUser, ok := c.Get(ContextKeyAdminUser).(*entity.AdminUser)
+	if !ok {
+		return nil
+	}
+	return adminUser
+}
+
+// 認証済みの管理者のセッションを取得（管理者サイト以外のルートではnil）
+func GetAuthenticatedAdminSession(c echo.Context) *entity.AdminSession {
+	session, ok := c.Get(ContextKeyAdminSession).(*entity.AdminSession)
+	if !ok {
+		return nil
+	}
+	return session
+}
+
+// 管理者サイトのリクエスト情報（操作ログ用）
+func NewAdminAccess(c echo.Context) entity.AdminAccess {
+	return entity.AdminAccess{
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+}
+
+// ゲストログイン時のリクエスト情報（アクセスログ用）
+func newGuestLinkAccess(c echo.Context, token string) entity.GuestLinkAccess {
+	return entity.GuestLinkAccess{
+		Token:     token,
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+}
+
 func GetLineToken(c echo.Context) string {
 	token := c.Request().Header.Get("LineAuthorization")
 	return token
@@ -30,6 +124,9 @@ func bindAndValidate(c echo.Context, obj interface{}) (err error) {
 }
 
 func renderJSON(c echo.Context, p presenter.Presenter) {
+	// 求職者の個人情報はログイン中の担当者の権限に応じて伏せる
+	policy.MaskJobSeekerInResponse(GetAuthenticatedAgentStaff(c), p.Data())
+
 	err := c.JSON(p.StatusCode(), p.Data())
 	if err != nil {
 		fmt.Println(err)
@@ -1230,3 +1327,191 @@ func parseSearchDashboardQueryParams(c echo.Context) (entity.SearchDashboard, er
 
 	return searchDashboardParam, nil
 }
+
+// 監査ログ検索
+func parseSearchAuditLogQueryParams(c echo.Context) (entity.SearchAuditLogParam, error) {
+	var (
+		searchParam entity.SearchAuditLogParam
+
+		agentStaffIDStr = c.QueryParam("agent_staff_id")
+		actionStr       = c.QueryParam("action")
+		entityTypeStr   = c.QueryParam("entity_type")
+		entityIDStr     = c.QueryParam("entity_id")
+		fromDateStr     = c.QueryParam("from_date") // YYYY-MM-DD
+		toDateStr       = c.QueryParam("to_date")   // YYYY-MM-DD（指定日の終わりまでを含む）
+		pageNumberStr   = c.QueryParam("page_number")
+	)
+
+	searchParam.Action = actionStr
+	searchParam.EntityType = entityTypeStr
+	searchParam.PageNumber = 1
+
+	if agentStaffIDStr != "" {
+		agentStaffID, err := strconv.Atoi(agentStaffIDStr)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.AgentStaffID = uint(agentStaffID)
+	}
+
+	if entityIDStr != "" {
+		entityID, err := strconv.Atoi(entityIDStr)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.EntityID = uint(entityID)
+	}
+
+	if fromDateStr != "" {
+		fromDate, err := time.ParseInLocation("2006-01-02", fromDateStr, utility.Tokyo)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.FromDate = fromDate
+	}
+
+	if toDateStr != "" {
+		toDate, err := time.ParseInLocation("2006-01-02", toDateStr, utility.Tokyo)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.ToDate = toDate.AddDate(0, 0, 1).Add(-time.Second)
+	}
+
+	if pageNumberStr != "" {
+		pageNumber, err := strconv.Atoi(pageNumberStr)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.PageNumber = uint(pageNumber)
+	}
+
+	return searchParam, nil
+}
+
+// パイプライン検索
+func parseSearchTaskPipelineQueryParams(c echo.Context) (entity.SearchTaskPipelineParam, error) {
+	var (
+		searchParam entity.SearchTaskPipelineParam
+
+		agentStaffIDStr     = c.QueryParam("agent_staff_id")
+		raStaffIDStr        = c.QueryParam("ra_staff_id")
+		caStaffIDStr        = c.QueryParam("ca_staff_id")
+		enterpriseIDStr     = c.QueryParam("enterprise_id")
+		jobInformationIDStr = c.QueryParam("job_information_id")
+		allianceAgentIDStr  = c.QueryParam("alliance_agent_id")
+		fromDateStr         = c.QueryParam("from_date") // YYYY-MM-DD（エントリー日）
+		toDateStr           = c.QueryParam("to_date")   // YYYY-MM-DD（指定日の終わりまでを含む）
+		includeClosedStr    = c.QueryParam("include_closed")
+		phaseCategoryStr    = c.QueryParam("phase_category")
+		phaseSubCategoryStr = c.QueryParam("phase_sub_category")
+		pageNumberStr       = c.QueryParam("page_number")
+	)
+
+	searchParam.PageNumber = 1
+
+	for _, target := range []struct {
+		str   string
+		value *uint
+	}{
+		{agentStaffIDStr, &searchParam.AgentStaffID},
+		{raStaffIDStr, &searchParam.RAStaffID},
+		{caStaffIDStr, &searchParam.CAStaffID},
+		{enterpriseIDStr, &searchParam.EnterpriseID},
+		{jobInformationIDStr, &searchParam.JobInformationID},
+		{allianceAgentIDStr, &searchParam.AllianceAgentID},
+		{pageNumberStr, &searchParam.PageNumber},
+	} {
+		if target.str == "" {
+			continue
+		}
+
+		value, err := strconv.Atoi(target.str)
+		if err != nil || value < 0 {
+			return searchParam, fmt.Errorf("%s is invalid", target.str)
+		}
+		*target.value = uint(value)
+	}
+
+	if fromDateStr != "" {
+		fromDate, err := time.ParseInLocation("2006-01-02", fromDateStr, utility.Tokyo)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.FromDate = fromDate
+	}
+
+	if toDateStr != "" {
+		toDate, err := time.ParseInLocation("2006-01-02", toDateStr, utility.Tokyo)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.ToDate = toDate.AddDate(0, 0, 1).Add(-time.Second)
+	}
+
+	if includeClosedStr != "" {
+		includeClosed, err := strconv.ParseBool(includeClosedStr)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.IncludeClosed = includeClosed
+	}
+
+	if phaseCategoryStr != "" {
+		phaseCategory, err := strconv.Atoi(phaseCategoryStr)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.PhaseCategory = null.NewInt(int64(phaseCategory), true)
+	}
+
+	if phaseSubCategoryStr != "" {
+		phaseSubCategory, err := strconv.Atoi(phaseSubCategoryStr)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.PhaseSubCategory = null.NewInt(int64(phaseSubCategory), true)
+	}
+
+	return searchParam, nil
+}
+
+// 辞退・不合格の理由の集計条件
+func parseSearchTaskLossReasonQueryParams(c echo.Context) (entity.SearchTaskLossReasonParam, error) {
+	var (
+		searchParam entity.SearchTaskLossReasonParam
+
+		groupByStr  = c.QueryParam("group_by") // enterprise, job_information, ca_staff, ra_staff, phase
+		lossTypeStr = c.QueryParam("loss_type")
+		fromDateStr = c.QueryParam("from_date") // YYYY-MM-DD（終了日）
+		toDateStr   = c.QueryParam("to_date")   // YYYY-MM-DD（指定日の終わりまでを含む）
+	)
+
+	searchParam.GroupBy = groupByStr
+
+	if lossTypeStr != "" {
+		lossType, err := strconv.Atoi(lossTypeStr)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.LossType = null.NewInt(int64(lossType), true)
+	}
+
+	if fromDateStr != "" {
+		fromDate, err := time.ParseInLocation("2006-01-02", fromDateStr, utility.Tokyo)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.FromDate = fromDate
+	}
+
+	if toDateStr != "" {
+		toDate, err := time.ParseInLocation("2006-01-02", toDateStr, utility.Tokyo)
+		if err != nil {
+			return searchParam, err
+		}
+		searchParam.ToDate = toDate.AddDate(0, 0, 1).Add(-time.Second)
+	}
+
+	return searchParam, nil
+}
@@ -0,0 +1,60 @@
+package routes
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// 提携求人媒体・自社フォームからの応募者を取り込む
+func CreateJobSeekerFromInboundEntry(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param entity.CreateJobSeekerFromInboundEntryParam
+		)
+
+		if err := bindAndValidate(c, &param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeInboundEntryHandler(firebase, tx, sendgrid, oneSignal)
+		p, jobSeekerUUID, err := h.CreateJobSeekerFromInboundEntry(param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		// 既存の求職者に紐付けた場合は面談予約リンクを送らない
+		if jobSeekerUUID != uuid.Nil {
+			sendInterviewBookingLinkOnEntry(db, firebase, sendgrid, oneSignal, jobSeekerUUID, param.ExternalType.Int64)
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
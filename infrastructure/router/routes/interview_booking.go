@@ -0,0 +1,104 @@
+package routes
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+/****************************************************************************************/
+// Guest API
+//
+// 面談予約リンクの情報と予約可能な面談枠を取得
+func GetInterviewBookingByUUID(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			bookingUUIDStr = c.Param("booking_uuid")
+		)
+
+		bookingUUID, err := uuid.Parse(bookingUUIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", "uuidのフォーマットが不正です", entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeInterviewBookingHandler(firebase, db, sendgrid, oneSignal)
+		p, err := h.GetInterviewBookingByUUID(bookingUUID)
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 面談枠を予約する
+func BookInterview(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param          entity.BookInterviewParam
+			bookingUUIDStr = c.Param("booking_uuid")
+		)
+
+		bookingUUID, err := uuid.Parse(bookingUUIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", "uuidのフォーマットが不正です", entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		if err := bindAndValidate(c, &param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeInterviewBookingHandler(firebase, tx, sendgrid, oneSignal)
+		p, err := h.BookInterview(bookingUUID, param)
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		// 予約完了の通知はコミット後に送り、失敗しても予約結果はそのまま返す
+		err = di.InitializeInterviewBookingHandler(firebase, db, sendgrid, oneSignal).NotifyInterviewBooked(bookingUUID)
+		if err != nil {
+			fmt.Println("面談予約完了の通知に失敗しました:", err)
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// エントリーした求職者に面談予約リンクを送る（エントリーのコミット後に呼び出し、失敗してもログ出力のみとする）
+func sendInterviewBookingLinkOnEntry(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal, jobSeekerUUID uuid.UUID, externalType int64) {
+	h := di.InitializeInterviewBookingHandler(firebase, db, sendgrid, oneSignal)
+	err := h.SendInterviewBookingLinkOnEntry(jobSeekerUUID, externalType)
+	if err != nil {
+		fmt.Println("面談予約リンクの送信に失敗しました:", err)
+	}
+}
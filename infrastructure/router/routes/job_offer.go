@@ -0,0 +1,191 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 選考の内定を作成 body: {position, employment_status, work_location, annual_salary, base_salary, fixed_overtime_pay, fixed_overtime_hours, allowance, bonus, salary_remarks, start_date, answer_deadline, remarks}
+func CreateJobOffer(db *database.DB, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			taskGroupIDStr = c.Param("task_group_id")
+			param          = new(entity.CreateOrUpdateJobOfferParam)
+		)
+
+		taskGroupID, err := strconv.Atoi(taskGroupIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeJobOfferHandler(tx, sendgrid)
+		p, err := h.CreateJobOffer(uint(taskGroupID), *param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 選考の内定の条件を更新 body: {position, employment_status, work_location, annual_salary, base_salary, fixed_overtime_pay, fixed_overtime_hours, allowance, bonus, salary_remarks, start_date, answer_deadline, remarks}
+func UpdateJobOffer(db *database.DB, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			taskGroupIDStr = c.Param("task_group_id")
+			param          = new(entity.CreateOrUpdateJobOfferParam)
+		)
+
+		taskGroupID, err := strconv.Atoi(taskGroupIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeJobOfferHandler(tx, sendgrid)
+		p, err := h.UpdateJobOffer(uint(taskGroupID), *param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 選考の内定の状態を変更 body: {status, remarks}
+func UpdateJobOfferStatus(db *database.DB, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			taskGroupIDStr = c.Param("task_group_id")
+			param          = new(entity.UpdateJobOfferStatusParam)
+		)
+
+		taskGroupID, err := strconv.Atoi(taskGroupIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeJobOfferHandler(tx, sendgrid)
+		p, err := h.UpdateJobOfferStatus(uint(taskGroupID), *param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 選考の内定と状態の変更履歴を取得
+func GetJobOfferByTaskGroupID(db *database.DB, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			taskGroupIDStr = c.Param("task_group_id")
+		)
+
+		taskGroupID, err := strconv.Atoi(taskGroupIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeJobOfferHandler(db, sendgrid)
+		p, err := h.GetJobOfferByTaskGroupID(uint(taskGroupID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 求職者の内定を比較
+func GetJobOfferComparisonByJobSeekerID(db *database.DB, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			jobSeekerIDStr = c.Param("job_seeker_id")
+		)
+
+		jobSeekerID, err := strconv.Atoi(jobSeekerIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeJobOfferHandler(db, sendgrid)
+		p, err := h.GetJobOfferComparisonByJobSeekerID(uint(jobSeekerID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
@@ -956,6 +956,73 @@ func ExportJobSeekerCSV(db *database.DB, firebase usecase.Firebase, sendgrid con
 	}
 }
 
+/****************************************************************************************/
+// 個人情報の開示・削除 API
+//
+// 求職者の個人情報（personal_data.jsonと応募書類などのファイル）をzipファイルで出力（管理者のみ）
+func ExportJobSeekerPersonalData(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal, slack config.Slack) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			jobSeekerIDStr = c.Param("job_seeker_id")
+		)
+
+		jobSeekerID, err := strconv.Atoi(jobSeekerIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeJobSeekerHandler(firebase, db, sendgrid, oneSignal, slack)
+		p, err := h.ExportJobSeekerPersonalData(uint(jobSeekerID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			fmt.Println(err)
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderFile(c, p)
+		// ローカルファイルの削除
+		os.Remove(p)
+
+		return nil
+	}
+}
+
+// 求職者の個人情報を削除して匿名化（管理者のみ）
+func DeleteJobSeekerPersonalData(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal, slack config.Slack) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			jobSeekerIDStr = c.Param("job_seeker_id")
+		)
+
+		jobSeekerID, err := strconv.Atoi(jobSeekerIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeJobSeekerHandler(firebase, tx, sendgrid, oneSignal, slack)
+		p, err := h.DeleteJobSeekerPersonalData(uint(jobSeekerID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
 /****************************************************************************************/
 // LINE関連　API
 //
@@ -1575,14 +1642,21 @@ func CreateJobSeekerFromLP(db *database.DB, firebase usecase.Firebase, sendgrid
 		}
 
 		h := di.InitializeJobSeekerHandler(firebase, tx, sendgrid, oneSignal, slack)
-		p, err := h.CreateJobSeekerFromLP(param)
+		p, jobSeekerUUID, err := h.CreateJobSeekerFromLP(param)
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
 			return err
 		}
 
-		tx.Commit()
+		err = tx.Commit()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		sendInterviewBookingLinkOnEntry(db, firebase, sendgrid, oneSignal, jobSeekerUUID, entity.JobSeekerExternalTypeLP)
+
 		renderJSON(c, p)
 		return nil
 	}
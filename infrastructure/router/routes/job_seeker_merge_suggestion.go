@@ -0,0 +1,104 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// 統合候補の求職者を既存の求職者に統合する
+func MergeJobSeekerBySuggestion(db *database.DB, firebase usecase.Firebase) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			firebaseToken        = GetFirebaseToken(c)
+			mergeSuggestionIDStr = c.Param("merge_suggestion_id")
+		)
+
+		mergeSuggestionID, err := strconv.Atoi(mergeSuggestionIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeJobSeekerMergeSuggestionHandler(firebase, tx)
+		p, err := h.MergeJobSeekerBySuggestion(firebaseToken, uint(mergeSuggestionID))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 統合候補を別人として処理する
+func DismissJobSeekerMergeSuggestion(db *database.DB, firebase usecase.Firebase) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			firebaseToken        = GetFirebaseToken(c)
+			mergeSuggestionIDStr = c.Param("merge_suggestion_id")
+		)
+
+		mergeSuggestionID, err := strconv.Atoi(mergeSuggestionIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeJobSeekerMergeSuggestionHandler(firebase, db)
+		p, err := h.DismissJobSeekerMergeSuggestion(firebaseToken, uint(mergeSuggestionID))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// エージェントIDから未対応の統合候補を取得
+func GetUnhandledJobSeekerMergeSuggestionListByAgentID(db *database.DB, firebase usecase.Firebase) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			agentIDStr = c.Param("agent_id")
+		)
+
+		agentID, err := strconv.Atoi(agentIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeJobSeekerMergeSuggestionHandler(firebase, db)
+		p, err := h.GetUnhandledJobSeekerMergeSuggestionListByAgentID(uint(agentID))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+/****************************************************************************************/
+// Admin API
+//
+// ロック中のログイン試行を取得（管理者のみ）
+func GetLockedLoginAttemptList(db *database.DB, captcha usecase.Captcha) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		h := di.InitializeLoginAttemptHandler(db, captcha)
+		p, err := h.GetLockedLoginAttemptList(GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// ログイン試行のロックを解除（管理者のみ） body: {login_attempt_id} or {email}
+func UnlockLoginAttempt(db *database.DB, captcha usecase.Captcha) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param = new(entity.UnlockLoginAttemptParam)
+		)
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeLoginAttemptHandler(db, captcha)
+		p, err := h.UnlockLoginAttempt(*param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
@@ -37,7 +37,7 @@ func CreateSale(db *database.DB, firebase usecase.Firebase, sendgrid config.Send
 		}
 
 		h := di.InitializeSaleHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateSale(param)
+		p, err := h.CreateSale(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -79,7 +79,7 @@ func UpdateSale(db *database.DB, firebase usecase.Firebase, sendgrid config.Send
 		}
 
 		h := di.InitializeSaleHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.UpdateSale(uint(saleIDInt), param)
+		p, err := h.UpdateSale(uint(saleIDInt), param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -179,6 +179,13 @@ func GetAccuracySearchList(db *database.DB, firebase usecase.Firebase, sendgrid
 			return wrapped
 		}
 
+		// クエリパラムのエージェントIDはURLの認可対象外のため、ログイン中の担当者のエージェントに限定する
+		if operator := GetAuthenticatedAgentStaff(c); operator == nil || operator.AgentID != searchParam.AgentID {
+			wrapped := fmt.Errorf("%w:%s", entity.ErrForbidden, "他社の売上は参照できません")
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
 		h := di.InitializeSaleHandler(firebase, db, sendgrid, oneSignal)
 		p, err := h.GetAccuracySearchList(searchParam)
 		if err != nil {
@@ -0,0 +1,122 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 売上の自動作成・返金規定の設定を取得
+func GetSalePolicy(db *database.DB, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		h := di.InitializeSaleGuaranteeHandler(db, sendgrid)
+		p, err := h.GetSalePolicy(GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 売上の自動作成・返金規定の設定を更新 body: {ra_sales_ratio, refund_tier_list: [{within_days, refund_rate}]}
+func UpdateSalePolicy(db *database.DB, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param = new(entity.UpdateSalePolicyParam)
+		)
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeSaleGuaranteeHandler(tx, sendgrid)
+		p, err := h.UpdateSalePolicy(*param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 返金保証期間の対象の売上一覧を取得
+func GetSaleGuaranteeList(db *database.DB, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		h := di.InitializeSaleGuaranteeHandler(db, sendgrid)
+		p, err := h.GetSaleGuaranteeList(GetAuthenticatedAgentStaff(c), time.Now().In(utility.Tokyo))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 早期退職を記録し売上を減額 body: {resignation_date, remarks}
+func RecordSaleResignation(db *database.DB, sendgrid config.Sendgrid) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			saleIDStr = c.Param("sale_id")
+			param     = new(entity.RecordSaleResignationParam)
+		)
+
+		saleID, err := strconv.Atoi(saleIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeSaleGuaranteeHandler(tx, sendgrid)
+		p, err := h.RecordSaleResignation(uint(saleID), *param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
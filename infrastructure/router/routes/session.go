@@ -19,6 +19,7 @@ type SignInParam struct {
 
 type SignInPasswordParam struct {
 	Password string `json:"password" validate:"required"`
+	Token    string `json:"token"` // ゲスト用リンクのトークン（UUIDのみのリンクの場合は空文字）
 }
 
 type SignInLoginTokenParam struct {
@@ -35,7 +36,7 @@ type CheckSignInParam struct {
 }
 
 // SignIn
-func SignIn(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+func SignIn(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
 			param = new(SignInParam)
@@ -47,7 +48,7 @@ func SignIn(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid
 			return err
 		}
 
-		h := di.InitializeSessionHandler(firebase, db, sendgrid)
+		h := di.InitializeSessionHandler(firebase, db, sendgrid, guestLink)
 		p, err := h.SignIn(param.Token)
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -59,7 +60,7 @@ func SignIn(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid
 }
 
 // Signout
-func SignOut(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+func SignOut(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
 			param = new(SignInParam)
@@ -71,7 +72,7 @@ func SignOut(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgri
 			return err
 		}
 
-		h := di.InitializeSessionHandler(firebase, db, sendgrid)
+		h := di.InitializeSessionHandler(firebase, db, sendgrid, guestLink)
 		p, err := h.SignOut(param.Token)
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -82,13 +83,13 @@ func SignOut(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgri
 	}
 }
 
-func GetSignInUser(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+func GetSignInUser(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
 			firebaseToken = GetFirebaseToken(c)
 		)
 
-		h := di.InitializeSessionHandler(firebase, db, sendgrid)
+		h := di.InitializeSessionHandler(firebase, db, sendgrid, guestLink)
 		p, err := h.GetSignInUser(firebaseToken)
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -100,7 +101,7 @@ func GetSignInUser(db *database.DB, firebase usecase.Firebase, sendgrid config.S
 }
 
 // SignIn
-func SignInForGuestEnterprise(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+func SignInForGuestEnterprise(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
 			param   = new(SignInPasswordParam)
@@ -120,8 +121,8 @@ func SignInForGuestEnterprise(db *database.DB, firebase usecase.Firebase, sendgr
 			return wrapped
 		}
 
-		h := di.InitializeSessionHandler(firebase, db, sendgrid)
-		p, err := h.SignInForGuestEnterprise(param.Password, jobInformationUUID)
+		h := di.InitializeSessionHandler(firebase, db, sendgrid, guestLink)
+		p, err := h.SignInForGuestEnterprise(param.Password, jobInformationUUID, newGuestLinkAccess(c, param.Token))
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
 			return err
@@ -132,7 +133,7 @@ func SignInForGuestEnterprise(db *database.DB, firebase usecase.Firebase, sendgr
 }
 
 // SignIn
-func SignInForGuestEnterpriseByTaskGroupUUID(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+func SignInForGuestEnterpriseByTaskGroupUUID(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
 			param   = new(SignInPasswordParam)
@@ -152,8 +153,8 @@ func SignInForGuestEnterpriseByTaskGroupUUID(db *database.DB, firebase usecase.F
 			return wrapped
 		}
 
-		h := di.InitializeSessionHandler(firebase, db, sendgrid)
-		p, err := h.SignInForGuestEnterpriseByTaskGroupUUID(param.Password, taskGroupUUID)
+		h := di.InitializeSessionHandler(firebase, db, sendgrid, guestLink)
+		p, err := h.SignInForGuestEnterpriseByTaskGroupUUID(param.Password, taskGroupUUID, newGuestLinkAccess(c, param.Token))
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
 			return err
@@ -164,7 +165,7 @@ func SignInForGuestEnterpriseByTaskGroupUUID(db *database.DB, firebase usecase.F
 }
 
 // SignIn
-func SignInForGuestJobSeeker(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+func SignInForGuestJobSeeker(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
 			param   = new(SignInPasswordParam)
@@ -185,8 +186,8 @@ func SignInForGuestJobSeeker(db *database.DB, firebase usecase.Firebase, sendgri
 			return nil
 		}
 
-		h := di.InitializeSessionHandler(firebase, db, sendgrid)
-		p, err := h.SignInForGuestJobSeeker(param.Password, jobSeekerUUID)
+		h := di.InitializeSessionHandler(firebase, db, sendgrid, guestLink)
+		p, err := h.SignInForGuestJobSeeker(param.Password, jobSeekerUUID, newGuestLinkAccess(c, param.Token))
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
 			return err
@@ -197,7 +198,7 @@ func SignInForGuestJobSeeker(db *database.DB, firebase usecase.Firebase, sendgri
 }
 
 // マイページログイン（LPからログイントークンを使ってログイン）
-func SignInForGuestJobSeekerFromLP(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+func SignInForGuestJobSeekerFromLP(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
 			param   = new(SignInLoginTokenParam)
@@ -226,7 +227,7 @@ func SignInForGuestJobSeekerFromLP(db *database.DB, firebase usecase.Firebase, s
 			return nil
 		}
 
-		h := di.InitializeSessionHandler(firebase, db, sendgrid)
+		h := di.InitializeSessionHandler(firebase, db, sendgrid, guestLink)
 		p, err := h.SignInForGuestJobSeekerFromLP(jobSeekerUUID, loginToken)
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -238,7 +239,7 @@ func SignInForGuestJobSeekerFromLP(db *database.DB, firebase usecase.Firebase, s
 }
 
 // LPのログインフォームのログイン処理
-func LoginGuestJobSeekerForLP(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid) func(c echo.Context) error {
+func LoginGuestJobSeekerForLP(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, guestLink config.GuestLink) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
 			param = new(LoginForLPParam)
@@ -250,7 +251,7 @@ func LoginGuestJobSeekerForLP(db *database.DB, firebase usecase.Firebase, sendgr
 			return err
 		}
 
-		h := di.InitializeSessionHandler(firebase, db, sendgrid)
+		h := di.InitializeSessionHandler(firebase, db, sendgrid, guestLink)
 		p, err := h.LoginGuestJobSeekerForLP(param.Email, param.Password)
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
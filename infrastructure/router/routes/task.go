@@ -463,17 +463,9 @@ func CreateTaskInBatchProcessing(db *database.DB, firebase usecase.Firebase, sen
 func CreateNextTaskAfterEntryPhase(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
-			param           entity.NextTaskParam
-			agentStaffIDStr = c.Param("agent_staff_id")
+			param entity.NextTaskParam
 		)
 
-		agentStaffIDInt, err := strconv.Atoi(agentStaffIDStr)
-		if err != nil {
-			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
-			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
-			return wrapped
-		}
-
 		if err := bindAndValidate(c, &param); err != nil {
 			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
 			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
@@ -487,7 +479,7 @@ func CreateNextTaskAfterEntryPhase(db *database.DB, firebase usecase.Firebase, s
 		}
 
 		h := di.InitializeTaskHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateNextTaskAfterEntryPhase(param, uint(agentStaffIDInt))
+		p, err := h.CreateNextTaskAfterEntryPhase(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -504,17 +496,9 @@ func CreateNextTaskAfterEntryPhase(db *database.DB, firebase usecase.Firebase, s
 func CreateNextTaskAfterDocumentSelectionPhase(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
-			param           entity.NextTaskParam
-			agentStaffIDStr = c.Param("agent_staff_id")
+			param entity.NextTaskParam
 		)
 
-		agentStaffIDInt, err := strconv.Atoi(agentStaffIDStr)
-		if err != nil {
-			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
-			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
-			return wrapped
-		}
-
 		if err := bindAndValidate(c, &param); err != nil {
 			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
 			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
@@ -528,7 +512,7 @@ func CreateNextTaskAfterDocumentSelectionPhase(db *database.DB, firebase usecase
 		}
 
 		h := di.InitializeTaskHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateNextTaskAfterDocumentSelectionPhase(param, uint(agentStaffIDInt))
+		p, err := h.CreateNextTaskAfterDocumentSelectionPhase(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -545,17 +529,9 @@ func CreateNextTaskAfterDocumentSelectionPhase(db *database.DB, firebase usecase
 func CreateNextTaskAfterSelectionPhase(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
-			param           entity.NextTaskParam
-			agentStaffIDStr = c.Param("agent_staff_id")
+			param entity.NextTaskParam
 		)
 
-		agentStaffIDInt, err := strconv.Atoi(agentStaffIDStr)
-		if err != nil {
-			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
-			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
-			return wrapped
-		}
-
 		if err := bindAndValidate(c, &param); err != nil {
 			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
 			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
@@ -569,7 +545,7 @@ func CreateNextTaskAfterSelectionPhase(db *database.DB, firebase usecase.Firebas
 		}
 
 		h := di.InitializeTaskHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateNextTaskAfterSelectionPhase(param, uint(agentStaffIDInt))
+		p, err := h.CreateNextTaskAfterSelectionPhase(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -586,17 +562,9 @@ func CreateNextTaskAfterSelectionPhase(db *database.DB, firebase usecase.Firebas
 func CreateNextTaskAfterDeclinePhase(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
-			param           entity.NextTaskParam
-			agentStaffIDStr = c.Param("agent_staff_id")
+			param entity.NextTaskParam
 		)
 
-		agentStaffIDInt, err := strconv.Atoi(agentStaffIDStr)
-		if err != nil {
-			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
-			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
-			return wrapped
-		}
-
 		if err := bindAndValidate(c, &param); err != nil {
 			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
 			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
@@ -610,7 +578,7 @@ func CreateNextTaskAfterDeclinePhase(db *database.DB, firebase usecase.Firebase,
 		}
 
 		h := di.InitializeTaskHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateNextTaskAfterDeclinePhase(param, uint(agentStaffIDInt))
+		p, err := h.CreateNextTaskAfterDeclinePhase(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -627,17 +595,9 @@ func CreateNextTaskAfterDeclinePhase(db *database.DB, firebase usecase.Firebase,
 func CreateNextTaskAfterHoldJobOfferPhase(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
-			param           entity.NextTaskParam
-			agentStaffIDStr = c.Param("agent_staff_id")
+			param entity.NextTaskParam
 		)
 
-		agentStaffIDInt, err := strconv.Atoi(agentStaffIDStr)
-		if err != nil {
-			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
-			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
-			return wrapped
-		}
-
 		if err := bindAndValidate(c, &param); err != nil {
 			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
 			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
@@ -651,7 +611,7 @@ func CreateNextTaskAfterHoldJobOfferPhase(db *database.DB, firebase usecase.Fire
 		}
 
 		h := di.InitializeTaskHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateNextTaskAfterHoldJobOfferPhase(param, uint(agentStaffIDInt))
+		p, err := h.CreateNextTaskAfterHoldJobOfferPhase(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -668,17 +628,9 @@ func CreateNextTaskAfterHoldJobOfferPhase(db *database.DB, firebase usecase.Fire
 func CreateNextTaskAfterAcceptJobOfferPhase(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
-			param           entity.NextTaskParam
-			agentStaffIDStr = c.Param("agent_staff_id")
+			param entity.NextTaskParam
 		)
 
-		agentStaffIDInt, err := strconv.Atoi(agentStaffIDStr)
-		if err != nil {
-			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
-			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
-			return wrapped
-		}
-
 		if err := bindAndValidate(c, &param); err != nil {
 			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
 			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
@@ -692,7 +644,7 @@ func CreateNextTaskAfterAcceptJobOfferPhase(db *database.DB, firebase usecase.Fi
 		}
 
 		h := di.InitializeTaskHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateNextTaskAfterAcceptJobOfferPhase(param, uint(agentStaffIDInt))
+		p, err := h.CreateNextTaskAfterAcceptJobOfferPhase(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -709,17 +661,9 @@ func CreateNextTaskAfterAcceptJobOfferPhase(db *database.DB, firebase usecase.Fi
 func CreateNextSameTaskList(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
 	return func(c echo.Context) error {
 		var (
-			param           entity.NextSameTaskListParam
-			agentStaffIDStr = c.Param("agent_staff_id")
+			param entity.NextSameTaskListParam
 		)
 
-		agentStaffIDInt, err := strconv.Atoi(agentStaffIDStr)
-		if err != nil {
-			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
-			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
-			return wrapped
-		}
-
 		if err := bindAndValidate(c, &param); err != nil {
 			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
 			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
@@ -733,7 +677,7 @@ func CreateNextSameTaskList(db *database.DB, firebase usecase.Firebase, sendgrid
 		}
 
 		h := di.InitializeTaskHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.CreateNextSameTaskList(param, uint(agentStaffIDInt))
+		p, err := h.CreateNextSameTaskList(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -801,7 +745,7 @@ func UpdateTaskGroupDocument(db *database.DB, firebase usecase.Firebase, sendgri
 		}
 
 		h := di.InitializeTaskHandler(firebase, tx, sendgrid, oneSignal)
-		p, err := h.UpdateTaskGroupDocument(param)
+		p, err := h.UpdateTaskGroupDocument(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			tx.Rollback()
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
@@ -1012,6 +956,58 @@ func GetTaskByID(db *database.DB, firebase usecase.Firebase, sendgrid config.Sen
 	}
 }
 
+// タスクから次に実行できる操作の一覧を取得
+func GetTaskPhaseActionList(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			taskIDStr = c.Param("task_id")
+		)
+
+		taskIDInt, err := strconv.Atoi(taskIDStr)
+
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskHandler(firebase, db, sendgrid, oneSignal)
+		p, err := h.GetTaskPhaseActionList(uint(taskIDInt))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 推薦前に同じ企業への重複推薦の可能性がある選考を確認
+func CheckDuplicateRecommendation(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param entity.CheckDuplicateRecommendationParam
+		)
+
+		if err := bindAndValidate(c, &param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskHandler(firebase, db, sendgrid, oneSignal)
+		p, err := h.CheckDuplicateRecommendation(param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
 // タスクグループの一覧取得（自分が関わっているタスク）
 func GetTaskListByAgentIDAndPage(db *database.DB, firebase usecase.Firebase, sendgrid config.Sendgrid, oneSignal config.OneSignal) func(c echo.Context) error {
 	return func(c echo.Context) error {
@@ -1251,7 +1247,7 @@ func DeleteTask(db *database.DB, firebase usecase.Firebase, sendgrid config.Send
 		}
 
 		h := di.InitializeTaskHandler(firebase, db, sendgrid, oneSignal)
-		p, err := h.DeleteTask(param)
+		p, err := h.DeleteTask(param, GetAuthenticatedAgentStaff(c))
 		if err != nil {
 			renderJSON(c, presenter.NewErrorJSONPresenter(err))
 			return err
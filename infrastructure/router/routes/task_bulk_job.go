@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// タスクの一括操作を登録 body: {action_type, task_group_id_list, phase_category, phase_sub_category, staff_type, remarks, good_point, ng_point, deadline_day, deadline_time, ra_staff_id, ca_staff_id}
+func CreateTaskBulkJob(db *database.DB, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param = new(entity.CreateTaskBulkJobParam)
+		)
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		h := di.InitializeTaskBulkJobHandler(tx, oneSignal)
+		p, err := h.CreateTaskBulkJob(*param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			tx.Rollback()
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		tx.Commit()
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 一括操作のジョブと選考ごとの結果を取得
+func GetTaskBulkJobByID(db *database.DB, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			jobIDStr = c.Param("job_id")
+		)
+
+		jobID, err := strconv.Atoi(jobIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskBulkJobHandler(db, oneSignal)
+		p, err := h.GetTaskBulkJobByID(uint(jobID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 自分が登録した一括操作の一覧を取得
+func GetTaskBulkJobList(db *database.DB, oneSignal config.OneSignal) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		h := di.InitializeTaskBulkJobHandler(db, oneSignal)
+		p, err := h.GetTaskBulkJobList(GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// タスクグループの活動履歴を取得
+func GetTaskGroupTimeline(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			taskGroupIDStr = c.Param("task_group_id")
+		)
+
+		taskGroupID, err := strconv.Atoi(taskGroupIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskGroupEventHandler(db)
+		p, err := h.GetTaskGroupTimeline(uint(taskGroupID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 求職者の全ての選考の活動履歴を取得
+func GetJobSeekerTimeline(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			jobSeekerIDStr = c.Param("job_seeker_id")
+		)
+
+		jobSeekerID, err := strconv.Atoi(jobSeekerIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskGroupEventHandler(db)
+		p, err := h.GetJobSeekerTimeline(uint(jobSeekerID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
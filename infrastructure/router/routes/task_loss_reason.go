@@ -0,0 +1,106 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 辞退・不合格の理由の一覧 query: {phase_category, phase_sub_category}
+func GetTaskLossReasonMasterList(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			phaseCategoryStr    = c.QueryParam("phase_category")
+			phaseSubCategoryStr = c.QueryParam("phase_sub_category")
+			phaseCategory       = null.NewInt(0, false)
+			phaseSubCategory    = null.NewInt(0, false)
+		)
+
+		for _, target := range []struct {
+			str   string
+			value *null.Int
+		}{
+			{phaseCategoryStr, &phaseCategory},
+			{phaseSubCategoryStr, &phaseSubCategory},
+		} {
+			if target.str == "" {
+				continue
+			}
+
+			value, err := strconv.Atoi(target.str)
+			if err != nil {
+				wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+				renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+				return wrapped
+			}
+			*target.value = null.NewInt(int64(value), true)
+		}
+
+		h := di.InitializeTaskLossReasonHandler(db)
+		p, err := h.GetTaskLossReasonMasterList(phaseCategory, phaseSubCategory)
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 選考の辞退・不合格の理由を取得
+func GetTaskLossReasonListByTaskGroupID(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			taskGroupIDStr = c.Param("task_group_id")
+		)
+
+		taskGroupID, err := strconv.Atoi(taskGroupIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskLossReasonHandler(db)
+		p, err := h.GetTaskLossReasonListByTaskGroupID(uint(taskGroupID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 辞退・不合格の理由を集計 query: {group_by, loss_type, from_date, to_date}
+func GetTaskLossReasonAnalytics(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		searchParam, err := parseSearchTaskLossReasonQueryParams(c)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskLossReasonHandler(db)
+		p, err := h.GetTaskLossReasonAnalytics(searchParam, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
@@ -0,0 +1,266 @@
+package routes
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 絞り込みは全てクエリパラメータで指定する
+// agent_staff_id, ra_staff_id, ca_staff_id, enterprise_id, job_information_id, alliance_agent_id, from_date, to_date, include_closed
+//
+// エージェントのパイプラインを取得
+func GetTaskPipelineByAgentID(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			agentIDStr = c.Param("agent_id")
+		)
+
+		agentID, err := strconv.Atoi(agentIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		searchParam, err := parseSearchTaskPipelineQueryParams(c)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskPipelineHandler(db)
+		p, err := h.GetTaskPipelineByAgentID(uint(agentID), searchParam, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 担当者のパイプラインを取得
+func GetTaskPipelineByAgentStaffID(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			agentStaffIDStr = c.Param("agent_staff_id")
+		)
+
+		agentStaffID, err := strconv.Atoi(agentStaffIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		searchParam, err := parseSearchTaskPipelineQueryParams(c)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskPipelineHandler(db)
+		p, err := h.GetTaskPipelineByAgentStaffID(uint(agentStaffID), searchParam, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 求人のパイプラインを取得
+func GetTaskPipelineByJobInformationID(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			jobInformationIDStr = c.Param("job_information_id")
+		)
+
+		jobInformationID, err := strconv.Atoi(jobInformationIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		searchParam, err := parseSearchTaskPipelineQueryParams(c)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskPipelineHandler(db)
+		p, err := h.GetTaskPipelineByJobInformationID(uint(jobInformationID), searchParam, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// パイプラインの列のカードを取得 query: phase_category（必須）, phase_sub_category, page_number と上記の絞り込み
+func GetTaskPipelineCardList(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			agentIDStr = c.Param("agent_id")
+		)
+
+		agentID, err := strconv.Atoi(agentIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		searchParam, err := parseSearchTaskPipelineQueryParams(c)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskPipelineHandler(db)
+		p, err := h.GetTaskPipelineCardList(uint(agentID), searchParam, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+/****************************************************************************************/
+// 応募者一覧 API（RA向け）
+//
+// 求人の応募者一覧を取得
+func GetTaskPipelineApplicantListByJobInformationID(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			jobInformationIDStr = c.Param("job_information_id")
+		)
+
+		jobInformationID, err := strconv.Atoi(jobInformationIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskPipelineHandler(db)
+		p, err := h.GetTaskPipelineApplicantListByJobInformationID(uint(jobInformationID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 請求先の求人の応募者一覧を取得
+func GetTaskPipelineApplicantListByBillingAddressID(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			billingAddressIDStr = c.Param("billing_address_id")
+		)
+
+		billingAddressID, err := strconv.Atoi(billingAddressIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskPipelineHandler(db)
+		p, err := h.GetTaskPipelineApplicantListByBillingAddressID(uint(billingAddressID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 求人の応募者一覧をcsvファイルで出力
+func ExportTaskPipelineApplicantCSVByJobInformationID(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			jobInformationIDStr = c.Param("job_information_id")
+		)
+
+		jobInformationID, err := strconv.Atoi(jobInformationIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskPipelineHandler(db)
+		filePath, err := h.ExportTaskPipelineApplicantCSVByJobInformationID(uint(jobInformationID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			fmt.Println(err)
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderFile(c, filePath)
+		// ローカルファイルの削除
+		os.Remove(filePath)
+
+		return nil
+	}
+}
+
+// 請求先の求人の応募者一覧をcsvファイルで出力
+func ExportTaskPipelineApplicantCSVByBillingAddressID(db *database.DB) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			billingAddressIDStr = c.Param("billing_address_id")
+		)
+
+		billingAddressID, err := strconv.Atoi(billingAddressIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskPipelineHandler(db)
+		filePath, err := h.ExportTaskPipelineApplicantCSVByBillingAddressID(uint(billingAddressID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			fmt.Println(err)
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderFile(c, filePath)
+		// ローカルファイルの削除
+		os.Remove(filePath)
+
+		return nil
+	}
+}
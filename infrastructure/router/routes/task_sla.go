@@ -0,0 +1,118 @@
+package routes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+)
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 自社の期限の設定一覧を取得
+func GetTaskSLARuleList(db *database.DB, sendgrid config.Sendgrid, sla config.SLA) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		h := di.InitializeTaskSLAHandler(db, sendgrid, sla)
+		p, err := h.GetTaskSLARuleList(GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+/****************************************************************************************/
+// Admin API
+//
+// 期限の設定を作成（管理者のみ） body: {target_type, phase_category, phase_sub_category, deadline_hours, reminder_hours, escalation_hours}
+func CreateTaskSLARule(db *database.DB, sendgrid config.Sendgrid, sla config.SLA) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			param = new(entity.CreateOrUpdateTaskSLARuleParam)
+		)
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskSLAHandler(db, sendgrid, sla)
+		p, err := h.CreateTaskSLARule(*param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 期限の設定を更新（管理者のみ） body: {target_type, phase_category, phase_sub_category, deadline_hours, reminder_hours, escalation_hours}
+func UpdateTaskSLARule(db *database.DB, sendgrid config.Sendgrid, sla config.SLA) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			ruleIDStr = c.Param("rule_id")
+			param     = new(entity.CreateOrUpdateTaskSLARuleParam)
+		)
+
+		ruleID, err := strconv.Atoi(ruleIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		if err := bindAndValidate(c, param); err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskSLAHandler(db, sendgrid, sla)
+		p, err := h.UpdateTaskSLARule(uint(ruleID), *param, GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
+
+// 期限の設定を削除（管理者のみ）
+func DeleteTaskSLARule(db *database.DB, sendgrid config.Sendgrid, sla config.SLA) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		var (
+			ruleIDStr = c.Param("rule_id")
+		)
+
+		ruleID, err := strconv.Atoi(ruleIDStr)
+		if err != nil {
+			wrapped := fmt.Errorf("%s:%w", err.Error(), entity.ErrRequestError)
+			renderJSON(c, presenter.NewErrorJSONPresenter(wrapped))
+			return wrapped
+		}
+
+		h := di.InitializeTaskSLAHandler(db, sendgrid, sla)
+		p, err := h.DeleteTaskSLARule(uint(ruleID), GetAuthenticatedAgentStaff(c))
+		if err != nil {
+			renderJSON(c, presenter.NewErrorJSONPresenter(err))
+			return err
+		}
+
+		renderJSON(c, p)
+		return nil
+	}
+}
@@ -1,13 +1,22 @@
 package handler
 
 import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
 	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
 	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
 	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
 )
 
 type AdminHandler interface {
-	Authorize(username, password string) (presenter.Presenter, error)
+	// 認証 API
+	Authorize(loginID, password string, access entity.AdminAccess) (presenter.Presenter, error)
+	EnrollTOTP(adminUser *entity.AdminUser) (presenter.Presenter, error)
+	VerifyTOTP(adminUser *entity.AdminUser, session *entity.AdminSession, code string) (presenter.Presenter, error)
+	SignOut(session *entity.AdminSession) (presenter.Presenter, error)
+
+	// 管理者 API
+	CreateAdminUser(param entity.CreateAdminUserParam) (presenter.Presenter, error)
+	GetAdminActionLogList(page uint) (presenter.Presenter, error)
 }
 
 type AdminHandlerImpl struct {
@@ -18,9 +27,12 @@ func NewAdminHandlerImpl(aI interactor.AdminInteractor) AdminHandler {
 	return &AdminHandlerImpl{adminInteractor: aI}
 }
 
-func (h *AdminHandlerImpl) Authorize(username, password string) (presenter.Presenter, error) {
+/****************************************************************************************/
+// 認証 API
+//
+func (h *AdminHandlerImpl) Authorize(loginID, password string, access entity.AdminAccess) (presenter.Presenter, error) {
 	var (
-		input = interactor.AdminAuthorizeInput{Username: username, Password: password}
+		input = interactor.AdminAuthorizeInput{LoginID: loginID, Password: password, Access: access}
 	)
 
 	output, err := h.adminInteractor.Authorize(input)
@@ -28,5 +40,65 @@ func (h *AdminHandlerImpl) Authorize(username, password string) (presenter.Prese
 		return nil, err
 	}
 
+	return presenter.NewAdminAuthorizeJSONPresenter(responses.NewAdminAuthorize(output.Token, output.MFAStatus, output.ExpiresAt, output.AdminUser)), nil
+}
+
+func (h *AdminHandlerImpl) EnrollTOTP(adminUser *entity.AdminUser) (presenter.Presenter, error) {
+	output, err := h.adminInteractor.EnrollTOTP(interactor.AdminEnrollTOTPInput{
+		AdminUser: adminUser,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewAdminTOTPEnrollmentJSONPresenter(responses.NewAdminTOTPEnrollment(output.Secret, output.URI)), nil
+}
+
+func (h *AdminHandlerImpl) VerifyTOTP(adminUser *entity.AdminUser, session *entity.AdminSession, code string) (presenter.Presenter, error) {
+	output, err := h.adminInteractor.VerifyTOTP(interactor.AdminVerifyTOTPInput{
+		AdminUser: adminUser,
+		Session:   session,
+		Code:      code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewAdminSessionJSONPresenter(responses.NewAdminSession(entity.AdminMFAStatusVerified, output.ExpiresAt)), nil
+}
+
+func (h *AdminHandlerImpl) SignOut(session *entity.AdminSession) (presenter.Presenter, error) {
+	output, err := h.adminInteractor.SignOut(interactor.AdminSignOutInput{
+		Session: session,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
 }
+
+/****************************************************************************************/
+// 管理者 API
+//
+func (h *AdminHandlerImpl) CreateAdminUser(param entity.CreateAdminUserParam) (presenter.Presenter, error) {
+	output, err := h.adminInteractor.CreateAdminUser(interactor.CreateAdminUserInput{
+		CreateParam: param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewAdminUserJSONPresenter(responses.NewAdminUser(output.AdminUser)), nil
+}
+
+func (h *AdminHandlerImpl) GetAdminActionLogList(page uint) (presenter.Presenter, error) {
+	output, err := h.adminInteractor.GetAdminActionLogList(interactor.GetAdminActionLogListInput{
+		Page: page,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewAdminActionLogListJSONPresenter(responses.NewAdminActionLogList(output.ActionLogList)), nil
+}
@@ -15,6 +15,7 @@ type AgentHandler interface {
 	UpdateAgent(agentID uint, param entity.CreateOrUpdateAgentParam) (presenter.Presenter, error)
 	UpdateAgentAgreementFileURL(param entity.AgentAgreementFileURLParam) (presenter.Presenter, error)
 	UpdateAgentForAdmin(param entity.AgentForAdminParam) (presenter.Presenter, error)
+	UpdateAgentDuplicateRecommendation(param entity.AgentDuplicateRecommendationParam, operator *entity.AgentStaff) (presenter.Presenter, error)
 	GetAllianceAgentListByAgentID(agentID uint) (presenter.Presenter, error)
 	GetAllianceAgentListByAgentIDForSelect(agentID uint) (presenter.Presenter, error)
 	GetAgreementFileURL(firebaseToken string) (presenter.Presenter, error)
@@ -96,6 +97,19 @@ func (h *AgentHandlerImpl) UpdateAgentAgreementFileURL(param entity.AgentAgreeme
 	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
 }
 
+func (h *AgentHandlerImpl) UpdateAgentDuplicateRecommendation(param entity.AgentDuplicateRecommendationParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.AgentInteractor.UpdateAgentDuplicateRecommendation(interactor.UpdateAgentDuplicateRecommendationInput{
+		Operator: operator,
+		Param:    param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
 func (h *AgentHandlerImpl) UpdateAgentForAdmin(param entity.AgentForAdminParam) (presenter.Presenter, error) {
 	output, err := h.AgentInteractor.UpdateAgentForAdmin(interactor.UpdateAgentForAdminInput{
 		Param: param,
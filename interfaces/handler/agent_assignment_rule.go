@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type AgentAssignmentRuleHandler interface {
+	// 汎用系 API
+	UpdateAgentAssignmentRule(param entity.UpdateAgentAssignmentRuleParam) (presenter.Presenter, error)
+	GetAgentAssignmentRuleByAgentID(agentID uint) (presenter.Presenter, error)
+	OverrideJobSeekerAssignment(token string, param entity.OverrideJobSeekerAssignmentParam) (presenter.Presenter, error)
+	GetJobSeekerAssignmentLogListByJobSeekerID(jobSeekerID uint) (presenter.Presenter, error)
+}
+
+type AgentAssignmentRuleHandlerImpl struct {
+	agentAssignmentRuleInteractor interactor.AgentAssignmentRuleInteractor
+}
+
+func NewAgentAssignmentRuleHandlerImpl(aarI interactor.AgentAssignmentRuleInteractor) AgentAssignmentRuleHandler {
+	return &AgentAssignmentRuleHandlerImpl{
+		agentAssignmentRuleInteractor: aarI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 担当CAの自動割り振りルールを更新
+func (h *AgentAssignmentRuleHandlerImpl) UpdateAgentAssignmentRule(param entity.UpdateAgentAssignmentRuleParam) (presenter.Presenter, error) {
+	output, err := h.agentAssignmentRuleInteractor.UpdateAgentAssignmentRule(interactor.UpdateAgentAssignmentRuleInput{
+		Param: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewAgentAssignmentRuleJSONPresenter(responses.NewAgentAssignmentRule(output.AssignmentRule)), nil
+}
+
+// エージェントの自動割り振りルールを取得
+func (h *AgentAssignmentRuleHandlerImpl) GetAgentAssignmentRuleByAgentID(agentID uint) (presenter.Presenter, error) {
+	output, err := h.agentAssignmentRuleInteractor.GetAgentAssignmentRuleByAgentID(interactor.GetAgentAssignmentRuleByAgentIDInput{
+		AgentID: agentID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewAgentAssignmentRuleJSONPresenter(responses.NewAgentAssignmentRule(output.AssignmentRule)), nil
+}
+
+// 求職者の担当CAを手動で変更
+func (h *AgentAssignmentRuleHandlerImpl) OverrideJobSeekerAssignment(token string, param entity.OverrideJobSeekerAssignmentParam) (presenter.Presenter, error) {
+	output, err := h.agentAssignmentRuleInteractor.OverrideJobSeekerAssignment(interactor.OverrideJobSeekerAssignmentInput{
+		Token: token,
+		Param: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+// 求職者の担当CAの割り振り履歴を取得
+func (h *AgentAssignmentRuleHandlerImpl) GetJobSeekerAssignmentLogListByJobSeekerID(jobSeekerID uint) (presenter.Presenter, error) {
+	output, err := h.agentAssignmentRuleInteractor.GetJobSeekerAssignmentLogListByJobSeekerID(interactor.GetJobSeekerAssignmentLogListByJobSeekerIDInput{
+		JobSeekerID: jobSeekerID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewJobSeekerAssignmentLogListJSONPresenter(responses.NewJobSeekerAssignmentLogList(output.AssignmentLogList)), nil
+}
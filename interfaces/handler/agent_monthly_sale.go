@@ -11,8 +11,8 @@ import (
 
 type AgentMonthlySaleHandler interface {
 	// 汎用系 API
-	CreateAgentMonthlySale(param entity.CreateOrUpdateAgentMonthlyManagementParam) (presenter.Presenter, error)
-	UpdateAgentMonthlySale(param entity.CreateOrUpdateAgentMonthlyManagementParam) (presenter.Presenter, error)
+	CreateAgentMonthlySale(param entity.CreateOrUpdateAgentMonthlyManagementParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	UpdateAgentMonthlySale(param entity.CreateOrUpdateAgentMonthlyManagementParam, operator *entity.AgentStaff) (presenter.Presenter, error)
 	GetAgentMonthlySaleList(agentID, managementID uint) (presenter.Presenter, error)
 	GetDefaultPreviewAgentMonthlySaleListByAgentID(agentID uint) (presenter.Presenter, error)
 
@@ -37,8 +37,9 @@ func NewAgentMonthlySaleHandlerImpl(jslI interactor.AgentMonthlySaleInteractor)
 /****************************************************************************************/
 // 汎用系 API
 //
-func (h *AgentMonthlySaleHandlerImpl) CreateAgentMonthlySale(param entity.CreateOrUpdateAgentMonthlyManagementParam) (presenter.Presenter, error) {
+func (h *AgentMonthlySaleHandlerImpl) CreateAgentMonthlySale(param entity.CreateOrUpdateAgentMonthlyManagementParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.agentMonthlySaleInteractor.CreateAgentMonthlySale(interactor.CreateAgentMonthlySaleInput{
+		Operator:    operator,
 		CreateParam: param,
 	})
 
@@ -49,8 +50,9 @@ func (h *AgentMonthlySaleHandlerImpl) CreateAgentMonthlySale(param entity.Create
 	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
 }
 
-func (h *AgentMonthlySaleHandlerImpl) UpdateAgentMonthlySale(param entity.CreateOrUpdateAgentMonthlyManagementParam) (presenter.Presenter, error) {
+func (h *AgentMonthlySaleHandlerImpl) UpdateAgentMonthlySale(param entity.CreateOrUpdateAgentMonthlyManagementParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.agentMonthlySaleInteractor.UpdateAgentMonthlySale(interactor.UpdateAgentMonthlySaleInput{
+		Operator:    operator,
 		UpdateParam: param,
 	})
 
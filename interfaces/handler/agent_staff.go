@@ -18,8 +18,9 @@ type AgentStaffHandler interface {
 	UpdateAgentStaffUsageReStart(agentStaffID uint) (presenter.Presenter, error)
 	UpdateAgentStaffNotificationJobSeeker(param entity.UpdateAgentStaffNotificationJobSeekerParam) (presenter.Presenter, error)
 	UpdateAgentStaffNotificationUnwatched(param entity.UpdateAgentStaffNotificationUnwatchedParam) (presenter.Presenter, error)
-	UpdateAgentStaffAuthority(param entity.UpdateAgentStaffAuthorityParam) (presenter.Presenter, error)
-	DeleteAgentStaff(param entity.DeleteAgentStaffParam) (presenter.Presenter, error) // 担当者削除　*firebaseのアイパスを削除&DBのis_deletedをtrueにする
+	UpdateAgentStaffSLASetting(param entity.UpdateAgentStaffSLASettingParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	UpdateAgentStaffAuthority(param entity.UpdateAgentStaffAuthorityParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	DeleteAgentStaff(param entity.DeleteAgentStaffParam, operator *entity.AgentStaff) (presenter.Presenter, error) // 担当者削除　*firebaseのアイパスを削除&DBのis_deletedをtrueにする
 	GetOhterAgentStaffListByAgentIDAndAllianceAgentID(agentID, allianceAgentID, agentStaffID uint) (presenter.Presenter, error)
 	GetAgentStaffListWithSaleNotCreated(token string, agentID, managementID uint) (presenter.Presenter, error)
 	GetAgentStaffListByAgentIDAndUsageStatusAvailable(token string, agentID uint) (presenter.Presenter, error) // 利用可能の担当者を取得
@@ -117,9 +118,10 @@ func (h *AgentStaffHandlerImpl) UpdateAgentStaffUsageEnd(param entity.DeleteAgen
 }
 
 // 担当者削除　*firebaseのアイパスを削除&DBのis_deletedをtrueにする
-func (h *AgentStaffHandlerImpl) DeleteAgentStaff(param entity.DeleteAgentStaffParam) (presenter.Presenter, error) {
+func (h *AgentStaffHandlerImpl) DeleteAgentStaff(param entity.DeleteAgentStaffParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.AgentStaffInteractor.DeleteAgentStaff(interactor.DeleteAgentStaffInput{
-		Param: param,
+		Operator: operator,
+		Param:    param,
 	})
 	if err != nil {
 		return nil, err
@@ -163,9 +165,23 @@ func (h *AgentStaffHandlerImpl) UpdateAgentStaffNotificationUnwatched(param enti
 	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
 }
 
+// タスクの期限（SLA）に関する通知設定の更新 body: {agent_staff_id, manager_staff_id, notification_sla_reminder, notification_sla_digest}
+func (h *AgentStaffHandlerImpl) UpdateAgentStaffSLASetting(param entity.UpdateAgentStaffSLASettingParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.AgentStaffInteractor.UpdateAgentStaffSLASetting(interactor.UpdateAgentStaffSLASettingInput{
+		Operator:    operator,
+		UpdateParam: param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
 // 管理権限の更新 body: {agent_staff_id, authority}
-func (h *AgentStaffHandlerImpl) UpdateAgentStaffAuthority(param entity.UpdateAgentStaffAuthorityParam) (presenter.Presenter, error) {
+func (h *AgentStaffHandlerImpl) UpdateAgentStaffAuthority(param entity.UpdateAgentStaffAuthorityParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.AgentStaffInteractor.UpdateAgentStaffAuthority(interactor.UpdateAgentStaffAuthorityInput{
+		Operator:    operator,
 		UpdateParam: param,
 	})
 	if err != nil {
@@ -9,8 +9,8 @@ import (
 
 type AgentStaffMonthlySaleHandler interface {
 	// 汎用系 API
-	CreateAgentStaffMonthlySale(param entity.CreateOrUpdateStaffMonthlyManagementParam) (presenter.Presenter, error)
-	UpdateAgentStaffMonthlySale(param entity.CreateOrUpdateStaffMonthlyManagementParam) (presenter.Presenter, error)
+	CreateAgentStaffMonthlySale(param entity.CreateOrUpdateStaffMonthlyManagementParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	UpdateAgentStaffMonthlySale(param entity.CreateOrUpdateStaffMonthlyManagementParam, operator *entity.AgentStaff) (presenter.Presenter, error)
 	GetStaffMonthlySaleList(agentStaffID, managementID uint) (presenter.Presenter, error)
 	GetStaffSaleManagementAndAgentMonthlyByID(agentStaffID, managementID uint) (presenter.Presenter, error)
 }
@@ -28,8 +28,9 @@ func NewAgentStaffMonthlySaleHandlerImpl(asmI interactor.AgentStaffMonthlySaleIn
 /****************************************************************************************/
 /// 汎用系 API
 //
-func (h *AgentStaffMonthlySaleHandlerImpl) CreateAgentStaffMonthlySale(param entity.CreateOrUpdateStaffMonthlyManagementParam) (presenter.Presenter, error) {
+func (h *AgentStaffMonthlySaleHandlerImpl) CreateAgentStaffMonthlySale(param entity.CreateOrUpdateStaffMonthlyManagementParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.agentStaffMonthlySaleInteractor.CreateAgentStaffMonthlySale(interactor.CreateAgentStaffMonthlySaleInput{
+		Operator:    operator,
 		CreateParam: param,
 	})
 
@@ -40,8 +41,9 @@ func (h *AgentStaffMonthlySaleHandlerImpl) CreateAgentStaffMonthlySale(param ent
 	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
 }
 
-func (h *AgentStaffMonthlySaleHandlerImpl) UpdateAgentStaffMonthlySale(param entity.CreateOrUpdateStaffMonthlyManagementParam) (presenter.Presenter, error) {
+func (h *AgentStaffMonthlySaleHandlerImpl) UpdateAgentStaffMonthlySale(param entity.CreateOrUpdateStaffMonthlyManagementParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.agentStaffMonthlySaleInteractor.UpdateAgentStaffMonthlySale(interactor.UpdateAgentStaffMonthlySaleInput{
+		Operator:    operator,
 		UpdateParam: param,
 	})
 
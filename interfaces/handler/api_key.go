@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type APIKeyHandler interface {
+	// Admin API
+	CreateAPIKey(param entity.CreateAPIKeyParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	RevokeAPIKey(apiKeyID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetAPIKeyList(operator *entity.AgentStaff) (presenter.Presenter, error)
+}
+
+type APIKeyHandlerImpl struct {
+	apiKeyInteractor interactor.APIKeyInteractor
+}
+
+func NewAPIKeyHandlerImpl(akI interactor.APIKeyInteractor) APIKeyHandler {
+	return &APIKeyHandlerImpl{
+		apiKeyInteractor: akI,
+	}
+}
+
+/****************************************************************************************/
+// Admin API
+//
+// APIキーを発行
+func (h *APIKeyHandlerImpl) CreateAPIKey(param entity.CreateAPIKeyParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.apiKeyInteractor.CreateAPIKey(interactor.CreateAPIKeyInput{
+		Operator:    operator,
+		CreateParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewAPIKeyJSONPresenter(responses.NewAPIKey(output.APIKey)), nil
+}
+
+// APIキーを無効化
+func (h *APIKeyHandlerImpl) RevokeAPIKey(apiKeyID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.apiKeyInteractor.RevokeAPIKey(interactor.RevokeAPIKeyInput{
+		Operator: operator,
+		APIKeyID: apiKeyID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+// 自社のAPIキー一覧を取得
+func (h *APIKeyHandlerImpl) GetAPIKeyList(operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.apiKeyInteractor.GetAPIKeyList(interactor.GetAPIKeyListInput{
+		Operator: operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewAPIKeyListJSONPresenter(responses.NewAPIKeyList(output.APIKeyList)), nil
+}
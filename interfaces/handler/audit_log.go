@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type AuditLogHandler interface {
+	// Admin API
+	GetSearchAuditLogList(searchParam entity.SearchAuditLogParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	ExportAuditLogCSV(searchParam entity.SearchAuditLogParam, operator *entity.AgentStaff) (string, error)
+}
+
+type AuditLogHandlerImpl struct {
+	auditLogInteractor interactor.AuditLogInteractor
+}
+
+func NewAuditLogHandlerImpl(alI interactor.AuditLogInteractor) AuditLogHandler {
+	return &AuditLogHandlerImpl{
+		auditLogInteractor: alI,
+	}
+}
+
+/****************************************************************************************/
+// Admin API
+//
+// 自社の監査ログを検索
+func (h *AuditLogHandlerImpl) GetSearchAuditLogList(searchParam entity.SearchAuditLogParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.auditLogInteractor.GetSearchAuditLogList(interactor.GetSearchAuditLogListInput{
+		Operator:    operator,
+		SearchParam: searchParam,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewAuditLogListAndMaxPageJSONPresenter(responses.NewAuditLogListAndMaxPage(output.AuditLogList, output.MaxPageNumber)), nil
+}
+
+// 自社の監査ログをcsvファイルで出力
+func (h *AuditLogHandlerImpl) ExportAuditLogCSV(searchParam entity.SearchAuditLogParam, operator *entity.AgentStaff) (string, error) {
+	output, err := h.auditLogInteractor.ExportAuditLogCSV(interactor.ExportAuditLogCSVInput{
+		Operator:    operator,
+		SearchParam: searchParam,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return output.FilePath.PathName, nil
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type EntryScreeningRuleHandler interface {
+	// 汎用系 API
+	CreateEntryScreeningRule(param entity.CreateOrUpdateEntryScreeningRuleParam) (presenter.Presenter, error)
+	UpdateEntryScreeningRule(screeningRuleID uint, param entity.CreateOrUpdateEntryScreeningRuleParam) (presenter.Presenter, error)
+	DeleteEntryScreeningRule(screeningRuleID uint) (presenter.Presenter, error)
+	GetEntryScreeningRuleListByAgentID(agentID uint) (presenter.Presenter, error)
+}
+
+type EntryScreeningRuleHandlerImpl struct {
+	entryScreeningRuleInteractor interactor.EntryScreeningRuleInteractor
+}
+
+func NewEntryScreeningRuleHandlerImpl(esrI interactor.EntryScreeningRuleInteractor) EntryScreeningRuleHandler {
+	return &EntryScreeningRuleHandlerImpl{
+		entryScreeningRuleInteractor: esrI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 選考ルールの作成
+func (h *EntryScreeningRuleHandlerImpl) CreateEntryScreeningRule(param entity.CreateOrUpdateEntryScreeningRuleParam) (presenter.Presenter, error) {
+	output, err := h.entryScreeningRuleInteractor.CreateEntryScreeningRule(interactor.CreateEntryScreeningRuleInput{
+		Param: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewEntryScreeningRuleJSONPresenter(responses.NewEntryScreeningRule(output.ScreeningRule)), nil
+}
+
+// 選考ルールの更新
+func (h *EntryScreeningRuleHandlerImpl) UpdateEntryScreeningRule(screeningRuleID uint, param entity.CreateOrUpdateEntryScreeningRuleParam) (presenter.Presenter, error) {
+	output, err := h.entryScreeningRuleInteractor.UpdateEntryScreeningRule(interactor.UpdateEntryScreeningRuleInput{
+		ScreeningRuleID: screeningRuleID,
+		Param:           param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewEntryScreeningRuleJSONPresenter(responses.NewEntryScreeningRule(output.ScreeningRule)), nil
+}
+
+// 選考ルールの削除
+func (h *EntryScreeningRuleHandlerImpl) DeleteEntryScreeningRule(screeningRuleID uint) (presenter.Presenter, error) {
+	output, err := h.entryScreeningRuleInteractor.DeleteEntryScreeningRule(interactor.DeleteEntryScreeningRuleInput{
+		ScreeningRuleID: screeningRuleID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+// エージェントIDから選考ルール一覧を取得（ルールごとの該当件数を含む）
+func (h *EntryScreeningRuleHandlerImpl) GetEntryScreeningRuleListByAgentID(agentID uint) (presenter.Presenter, error) {
+	output, err := h.entryScreeningRuleInteractor.GetEntryScreeningRuleListByAgentID(interactor.GetEntryScreeningRuleListByAgentIDInput{
+		AgentID: agentID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewEntryScreeningRuleListJSONPresenter(responses.NewEntryScreeningRuleList(output.ScreeningRuleList)), nil
+}
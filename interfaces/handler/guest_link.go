@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type GuestLinkHandler interface {
+	// 汎用系 API
+	CreateGuestLink(param entity.CreateGuestLinkParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	RevokeGuestLink(guestLinkID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetGuestLinkList(resourceType string, resourceUUID uuid.UUID, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetGuestLinkAccessLogList(guestLinkID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+}
+
+type GuestLinkHandlerImpl struct {
+	guestLinkInteractor interactor.GuestLinkInteractor
+}
+
+func NewGuestLinkHandlerImpl(glI interactor.GuestLinkInteractor) GuestLinkHandler {
+	return &GuestLinkHandlerImpl{
+		guestLinkInteractor: glI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// ゲスト用リンクを発行（トークンは発行時のみ返す）
+func (h *GuestLinkHandlerImpl) CreateGuestLink(param entity.CreateGuestLinkParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.guestLinkInteractor.CreateGuestLink(interactor.CreateGuestLinkInput{
+		Operator:    operator,
+		CreateParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewGuestLinkJSONPresenter(responses.NewGuestLink(output.GuestLink)), nil
+}
+
+// ゲスト用リンクを無効化
+func (h *GuestLinkHandlerImpl) RevokeGuestLink(guestLinkID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.guestLinkInteractor.RevokeGuestLink(interactor.RevokeGuestLinkInput{
+		Operator:    operator,
+		GuestLinkID: guestLinkID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+// 自社のゲスト用リンク一覧を取得
+func (h *GuestLinkHandlerImpl) GetGuestLinkList(resourceType string, resourceUUID uuid.UUID, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.guestLinkInteractor.GetGuestLinkList(interactor.GetGuestLinkListInput{
+		Operator:     operator,
+		ResourceType: resourceType,
+		ResourceUUID: resourceUUID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewGuestLinkListJSONPresenter(responses.NewGuestLinkList(output.GuestLinkList)), nil
+}
+
+// ゲスト用リンクのアクセスログを取得
+func (h *GuestLinkHandlerImpl) GetGuestLinkAccessLogList(guestLinkID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.guestLinkInteractor.GetGuestLinkAccessLogList(interactor.GetGuestLinkAccessLogListInput{
+		Operator:    operator,
+		GuestLinkID: guestLinkID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewGuestLinkAccessLogListJSONPresenter(responses.NewGuestLinkAccessLogList(output.AccessLogList)), nil
+}
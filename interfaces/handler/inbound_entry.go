@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type InboundEntryHandler interface {
+	// 汎用系 API
+	CreateJobSeekerFromInboundEntry(param entity.CreateJobSeekerFromInboundEntryParam, operator *entity.AgentStaff) (presenter.Presenter, uuid.UUID, error)
+}
+
+type InboundEntryHandlerImpl struct {
+	inboundEntryInteractor interactor.InboundEntryInteractor
+}
+
+func NewInboundEntryHandlerImpl(ieI interactor.InboundEntryInteractor) InboundEntryHandler {
+	return &InboundEntryHandlerImpl{
+		inboundEntryInteractor: ieI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 提携求人媒体・自社フォームからの応募者を取り込む
+// 新しく作成した求職者のUUIDはコミット後に面談予約リンクを送るために返す（既存の求職者に紐付けた場合はuuid.Nil）
+func (h *InboundEntryHandlerImpl) CreateJobSeekerFromInboundEntry(param entity.CreateJobSeekerFromInboundEntryParam, operator *entity.AgentStaff) (presenter.Presenter, uuid.UUID, error) {
+	output, err := h.inboundEntryInteractor.CreateJobSeekerFromInboundEntry(interactor.CreateJobSeekerFromInboundEntryInput{
+		Operator: operator,
+		Param:    param,
+	})
+
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	createdUUID := output.Result.UUID
+	if output.Result.IsDuplicate {
+		createdUUID = uuid.Nil
+	}
+
+	return presenter.NewInboundEntryResultJSONPresenter(responses.NewInboundEntryResult(output.Result)), createdUUID, nil
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type InterviewBookingHandler interface {
+	// Guest API
+	GetInterviewBookingByUUID(bookingUUID uuid.UUID) (presenter.Presenter, error)
+	BookInterview(bookingUUID uuid.UUID, param entity.BookInterviewParam) (presenter.Presenter, error)
+	NotifyInterviewBooked(bookingUUID uuid.UUID) error
+
+	// エントリー
+	SendInterviewBookingLinkOnEntry(jobSeekerUUID uuid.UUID, externalType int64) error
+}
+
+type InterviewBookingHandlerImpl struct {
+	interviewBookingInteractor interactor.InterviewBookingInteractor
+}
+
+func NewInterviewBookingHandlerImpl(ibI interactor.InterviewBookingInteractor) InterviewBookingHandler {
+	return &InterviewBookingHandlerImpl{
+		interviewBookingInteractor: ibI,
+	}
+}
+
+/****************************************************************************************/
+// Guest API
+//
+// 面談予約リンクの情報と予約可能な面談枠を取得
+func (h *InterviewBookingHandlerImpl) GetInterviewBookingByUUID(bookingUUID uuid.UUID) (presenter.Presenter, error) {
+	output, err := h.interviewBookingInteractor.GetInterviewBookingByUUID(interactor.GetInterviewBookingByUUIDInput{
+		BookingUUID: bookingUUID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewInterviewBookingLinkJSONPresenter(responses.NewInterviewBookingLink(output.BookingLink)), nil
+}
+
+// 面談枠を予約する
+func (h *InterviewBookingHandlerImpl) BookInterview(bookingUUID uuid.UUID, param entity.BookInterviewParam) (presenter.Presenter, error) {
+	output, err := h.interviewBookingInteractor.BookInterview(interactor.BookInterviewInput{
+		BookingUUID: bookingUUID,
+		Param:       param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewInterviewBookingLinkJSONPresenter(responses.NewInterviewBookingLink(output.BookingLink)), nil
+}
+
+// 予約完了を通知する（予約のコミット後に呼び出す）
+func (h *InterviewBookingHandlerImpl) NotifyInterviewBooked(bookingUUID uuid.UUID) error {
+	_, err := h.interviewBookingInteractor.NotifyInterviewBooked(interactor.NotifyInterviewBookedInput{
+		BookingUUID: bookingUUID,
+	})
+
+	return err
+}
+
+/****************************************************************************************/
+// エントリー
+//
+// エントリーした求職者に面談予約リンクを送る（エントリーのコミット後に呼び出す）
+func (h *InterviewBookingHandlerImpl) SendInterviewBookingLinkOnEntry(jobSeekerUUID uuid.UUID, externalType int64) error {
+	_, err := h.interviewBookingInteractor.SendInterviewBookingLinkOnEntry(interactor.SendInterviewBookingLinkOnEntryInput{
+		JobSeekerUUID: jobSeekerUUID,
+		ExternalType:  externalType,
+	})
+
+	return err
+}
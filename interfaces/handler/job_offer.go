@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type JobOfferHandler interface {
+	// 汎用系 API
+	CreateJobOffer(taskGroupID uint, param entity.CreateOrUpdateJobOfferParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	UpdateJobOffer(taskGroupID uint, param entity.CreateOrUpdateJobOfferParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	UpdateJobOfferStatus(taskGroupID uint, param entity.UpdateJobOfferStatusParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetJobOfferByTaskGroupID(taskGroupID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetJobOfferComparisonByJobSeekerID(jobSeekerID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+
+	// Batch API
+	BatchNotifyJobOfferDeadline(now time.Time) (presenter.Presenter, error)
+}
+
+type JobOfferHandlerImpl struct {
+	jobOfferInteractor interactor.JobOfferInteractor
+}
+
+func NewJobOfferHandlerImpl(joI interactor.JobOfferInteractor) JobOfferHandler {
+	return &JobOfferHandlerImpl{
+		jobOfferInteractor: joI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 選考の内定を作成
+func (h *JobOfferHandlerImpl) CreateJobOffer(taskGroupID uint, param entity.CreateOrUpdateJobOfferParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.jobOfferInteractor.CreateJobOffer(interactor.CreateJobOfferInput{
+		TaskGroupID: taskGroupID,
+		Operator:    operator,
+		CreateParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewJobOfferJSONPresenter(responses.NewJobOffer(output.JobOffer)), nil
+}
+
+// 選考の内定の条件を更新
+func (h *JobOfferHandlerImpl) UpdateJobOffer(taskGroupID uint, param entity.CreateOrUpdateJobOfferParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.jobOfferInteractor.UpdateJobOffer(interactor.UpdateJobOfferInput{
+		TaskGroupID: taskGroupID,
+		Operator:    operator,
+		UpdateParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewJobOfferJSONPresenter(responses.NewJobOffer(output.JobOffer)), nil
+}
+
+// 選考の内定の状態を変更
+func (h *JobOfferHandlerImpl) UpdateJobOfferStatus(taskGroupID uint, param entity.UpdateJobOfferStatusParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.jobOfferInteractor.UpdateJobOfferStatus(interactor.UpdateJobOfferStatusInput{
+		TaskGroupID: taskGroupID,
+		Operator:    operator,
+		UpdateParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewJobOfferJSONPresenter(responses.NewJobOffer(output.JobOffer)), nil
+}
+
+// 選考の内定と状態の変更履歴を取得
+func (h *JobOfferHandlerImpl) GetJobOfferByTaskGroupID(taskGroupID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.jobOfferInteractor.GetJobOfferByTaskGroupID(interactor.GetJobOfferByTaskGroupIDInput{
+		TaskGroupID: taskGroupID,
+		Operator:    operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewJobOfferJSONPresenter(responses.NewJobOffer(output.JobOffer)), nil
+}
+
+// 求職者の内定を比較
+func (h *JobOfferHandlerImpl) GetJobOfferComparisonByJobSeekerID(jobSeekerID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.jobOfferInteractor.GetJobOfferComparisonByJobSeekerID(interactor.GetJobOfferComparisonByJobSeekerIDInput{
+		JobSeekerID: jobSeekerID,
+		Operator:    operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewJobOfferListJSONPresenter(responses.NewJobOfferList(output.JobOfferList)), nil
+}
+
+/****************************************************************************************/
+// Batch API
+//
+// 内定の回答期限の前日・期限切れを通知
+func (h *JobOfferHandlerImpl) BatchNotifyJobOfferDeadline(now time.Time) (presenter.Presenter, error) {
+	output, err := h.jobOfferInteractor.BatchNotifyJobOfferDeadline(interactor.BatchNotifyJobOfferDeadlineInput{
+		Now: now,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
 	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
@@ -77,7 +79,7 @@ type JobSeekerHandler interface {
 	UpdateInterviewDateByJobSeekerID(param entity.UpdateJobSeekerInterviewDateFromGuestPageParam) (presenter.Presenter, error)
 
 	// LP
-	CreateJobSeekerFromLP(param entity.CreateJobSeekerFromLPParam) (presenter.Presenter, error)
+	CreateJobSeekerFromLP(param entity.CreateJobSeekerFromLPParam) (presenter.Presenter, uuid.UUID, error)
 	UpdateJobSeekerPhoneFromLP(param entity.UpdateJobSeekerPhoneFromLPParam) (presenter.Presenter, error)
 	UpdateJobSeekerDesiredFromLP(param entity.UpdateJobSeekerDesiredFromLPParam) (presenter.Presenter, error)
 	GetJobSeekerLPRegisterStatusByUUID(jobSeekerUUID uuid.UUID) (presenter.Presenter, error)
@@ -85,6 +87,14 @@ type JobSeekerHandler interface {
 	SendJobSeekerResetPasswordEmailForLP(param entity.SendJobSeekerResetPasswordEmailFromLPParam) (presenter.Presenter, error)
 	ResetPasswordForLP(param entity.ResetPasswordFromLPParam) (presenter.Presenter, error)
 	CheckResetPasswordToken(resetPasswordToken string) (presenter.Presenter, error)
+
+	// 個人情報の開示・削除 API
+	ExportJobSeekerPersonalData(jobSeekerID uint, operator *entity.AgentStaff) (string, error)
+	DeleteJobSeekerPersonalData(jobSeekerID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+
+	// Batch処理 API
+	BatchEncryptJobSeekerPersonalInformation() (presenter.Presenter, error)
+	BatchAnonymizeInactiveJobSeeker(now time.Time, retentionYears uint) (presenter.Presenter, error)
 }
 
 type JobSeekerHandlerImpl struct {
@@ -719,16 +729,17 @@ func (h *JobSeekerHandlerImpl) UpdateInterviewDateByJobSeekerID(param entity.Upd
 /****************************************************************************************/
 // LP用 API
 //
-func (h *JobSeekerHandlerImpl) CreateJobSeekerFromLP(param entity.CreateJobSeekerFromLPParam) (presenter.Presenter, error) {
+// 作成した求職者のUUIDはコミット後に面談予約リンクを送るために返す
+func (h *JobSeekerHandlerImpl) CreateJobSeekerFromLP(param entity.CreateJobSeekerFromLPParam) (presenter.Presenter, uuid.UUID, error) {
 	output, err := h.jobSeekerInteractor.CreateJobSeekerFromLP(interactor.CreateJobSeekerFromLPInput{
 		Param: param,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, uuid.Nil, err
 	}
 
-	return presenter.NewJobSeekerUUIDJSONPresenter(responses.NewJobSeekerUUID(output.UUID)), nil
+	return presenter.NewJobSeekerUUIDJSONPresenter(responses.NewJobSeekerUUID(output.UUID)), output.UUID, nil
 }
 
 // LPから求職者の電話番号を更新
@@ -818,3 +829,58 @@ func (h *JobSeekerHandlerImpl) CheckResetPasswordToken(resetPasswordToken string
 
 	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
 }
+
+/****************************************************************************************/
+// 個人情報の開示・削除 API
+//
+// 求職者の個人情報をzipファイルで出力する
+func (h *JobSeekerHandlerImpl) ExportJobSeekerPersonalData(jobSeekerID uint, operator *entity.AgentStaff) (string, error) {
+	output, err := h.jobSeekerInteractor.ExportJobSeekerPersonalData(interactor.ExportJobSeekerPersonalDataInput{
+		Operator:    operator,
+		JobSeekerID: jobSeekerID,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return output.FilePath.PathName, nil
+}
+
+// 求職者の個人情報を削除して匿名化する
+func (h *JobSeekerHandlerImpl) DeleteJobSeekerPersonalData(jobSeekerID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.jobSeekerInteractor.DeleteJobSeekerPersonalData(interactor.DeleteJobSeekerPersonalDataInput{
+		Operator:    operator,
+		JobSeekerID: jobSeekerID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+/****************************************************************************************/
+// Batch処理 API
+//
+func (h *JobSeekerHandlerImpl) BatchEncryptJobSeekerPersonalInformation() (presenter.Presenter, error) {
+	output, err := h.jobSeekerInteractor.BatchEncryptJobSeekerPersonalInformation(interactor.BatchEncryptJobSeekerPersonalInformationInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+func (h *JobSeekerHandlerImpl) BatchAnonymizeInactiveJobSeeker(now time.Time, retentionYears uint) (presenter.Presenter, error) {
+	output, err := h.jobSeekerInteractor.BatchAnonymizeInactiveJobSeeker(interactor.BatchAnonymizeInactiveJobSeekerInput{
+		Now:            now,
+		RetentionYears: retentionYears,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
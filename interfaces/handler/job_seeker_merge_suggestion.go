@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type JobSeekerMergeSuggestionHandler interface {
+	// 汎用系 API
+	MergeJobSeekerBySuggestion(token string, mergeSuggestionID uint) (presenter.Presenter, error)
+	DismissJobSeekerMergeSuggestion(token string, mergeSuggestionID uint) (presenter.Presenter, error)
+	GetUnhandledJobSeekerMergeSuggestionListByAgentID(agentID uint) (presenter.Presenter, error)
+}
+
+type JobSeekerMergeSuggestionHandlerImpl struct {
+	jobSeekerMergeSuggestionInteractor interactor.JobSeekerMergeSuggestionInteractor
+}
+
+func NewJobSeekerMergeSuggestionHandlerImpl(jsmsI interactor.JobSeekerMergeSuggestionInteractor) JobSeekerMergeSuggestionHandler {
+	return &JobSeekerMergeSuggestionHandlerImpl{
+		jobSeekerMergeSuggestionInteractor: jsmsI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 統合候補の求職者を既存の求職者に統合する
+func (h *JobSeekerMergeSuggestionHandlerImpl) MergeJobSeekerBySuggestion(token string, mergeSuggestionID uint) (presenter.Presenter, error) {
+	output, err := h.jobSeekerMergeSuggestionInteractor.MergeJobSeekerBySuggestion(interactor.MergeJobSeekerBySuggestionInput{
+		Token:             token,
+		MergeSuggestionID: mergeSuggestionID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+// 統合候補を別人として処理する
+func (h *JobSeekerMergeSuggestionHandlerImpl) DismissJobSeekerMergeSuggestion(token string, mergeSuggestionID uint) (presenter.Presenter, error) {
+	output, err := h.jobSeekerMergeSuggestionInteractor.DismissJobSeekerMergeSuggestion(interactor.DismissJobSeekerMergeSuggestionInput{
+		Token:             token,
+		MergeSuggestionID: mergeSuggestionID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+// 指定エージェントの未対応の統合候補を取得
+func (h *JobSeekerMergeSuggestionHandlerImpl) GetUnhandledJobSeekerMergeSuggestionListByAgentID(agentID uint) (presenter.Presenter, error) {
+	output, err := h.jobSeekerMergeSuggestionInteractor.GetUnhandledJobSeekerMergeSuggestionListByAgentID(interactor.GetUnhandledJobSeekerMergeSuggestionListByAgentIDInput{
+		AgentID: agentID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewJobSeekerMergeSuggestionListJSONPresenter(responses.NewJobSeekerMergeSuggestionList(output.MergeSuggestionList)), nil
+}
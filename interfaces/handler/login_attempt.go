@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type LoginAttemptHandler interface {
+	// Admin API
+	GetLockedLoginAttemptList(operator *entity.AgentStaff) (presenter.Presenter, error)
+	UnlockLoginAttempt(param entity.UnlockLoginAttemptParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+}
+
+type LoginAttemptHandlerImpl struct {
+	loginAttemptInteractor interactor.LoginAttemptInteractor
+}
+
+func NewLoginAttemptHandlerImpl(laI interactor.LoginAttemptInteractor) LoginAttemptHandler {
+	return &LoginAttemptHandlerImpl{
+		loginAttemptInteractor: laI,
+	}
+}
+
+/****************************************************************************************/
+// Admin API
+//
+// ロック中のログイン試行を取得
+func (h *LoginAttemptHandlerImpl) GetLockedLoginAttemptList(operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.loginAttemptInteractor.GetLockedLoginAttemptList(interactor.GetLockedLoginAttemptListInput{
+		Operator: operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewLoginAttemptListJSONPresenter(responses.NewLoginAttemptList(output.LoginAttemptList)), nil
+}
+
+// ログイン試行のロックを解除
+func (h *LoginAttemptHandlerImpl) UnlockLoginAttempt(param entity.UnlockLoginAttemptParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.loginAttemptInteractor.UnlockLoginAttempt(interactor.UnlockLoginAttemptInput{
+		Operator: operator,
+		Param:    param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
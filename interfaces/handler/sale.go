@@ -9,8 +9,8 @@ import (
 
 type SaleHandler interface {
 	// 汎用系 API
-	CreateSale(param entity.CreateOrUpdateSaleParam) (presenter.Presenter, error)
-	UpdateSale(saleID uint, param entity.CreateOrUpdateSaleParam) (presenter.Presenter, error)
+	CreateSale(param entity.CreateOrUpdateSaleParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	UpdateSale(saleID uint, param entity.CreateOrUpdateSaleParam, operator *entity.AgentStaff) (presenter.Presenter, error)
 	GetSaleByID(saleID uint) (presenter.Presenter, error)
 	GetSaleByJobSeekerID(jobSeekerID uint) (presenter.Presenter, error)
 	GetSaleListByIDList(idList []uint) (presenter.Presenter, error)
@@ -30,9 +30,10 @@ func NewSaleHandlerImpl(itI interactor.SaleInteractor) SaleHandler {
 /****************************************************************************************/
 /// 汎用系 API
 //
-func (h *SaleHandlerImpl) CreateSale(param entity.CreateOrUpdateSaleParam) (presenter.Presenter, error) {
+func (h *SaleHandlerImpl) CreateSale(param entity.CreateOrUpdateSaleParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.saleInteractor.CreateSale(interactor.CreateSaleInput{
 		CreateParam: param,
+		Operator:    operator,
 	})
 
 	if err != nil {
@@ -42,10 +43,11 @@ func (h *SaleHandlerImpl) CreateSale(param entity.CreateOrUpdateSaleParam) (pres
 	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
 }
 
-func (h *SaleHandlerImpl) UpdateSale(saleID uint, param entity.CreateOrUpdateSaleParam) (presenter.Presenter, error) {
+func (h *SaleHandlerImpl) UpdateSale(saleID uint, param entity.CreateOrUpdateSaleParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.saleInteractor.UpdateSale(interactor.UpdateSaleInput{
 		SaleID:      saleID,
 		UpdateParam: param,
+		Operator:    operator,
 	})
 
 	if err != nil {
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type SaleGuaranteeHandler interface {
+	// 汎用系 API
+	GetSalePolicy(operator *entity.AgentStaff) (presenter.Presenter, error)
+	UpdateSalePolicy(param entity.UpdateSalePolicyParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetSaleGuaranteeList(operator *entity.AgentStaff, now time.Time) (presenter.Presenter, error)
+	RecordSaleResignation(saleID uint, param entity.RecordSaleResignationParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+
+	// Batch API
+	BatchNotifySaleGuarantee(now time.Time) (presenter.Presenter, error)
+}
+
+type SaleGuaranteeHandlerImpl struct {
+	saleGuaranteeInteractor interactor.SaleGuaranteeInteractor
+}
+
+func NewSaleGuaranteeHandlerImpl(sgI interactor.SaleGuaranteeInteractor) SaleGuaranteeHandler {
+	return &SaleGuaranteeHandlerImpl{
+		saleGuaranteeInteractor: sgI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 売上の自動作成・返金規定の設定を取得
+func (h *SaleGuaranteeHandlerImpl) GetSalePolicy(operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.saleGuaranteeInteractor.GetSalePolicy(interactor.GetSalePolicyInput{
+		Operator: operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewSalePolicyJSONPresenter(responses.NewSalePolicy(output.SalePolicy)), nil
+}
+
+// 売上の自動作成・返金規定の設定を更新
+func (h *SaleGuaranteeHandlerImpl) UpdateSalePolicy(param entity.UpdateSalePolicyParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.saleGuaranteeInteractor.UpdateSalePolicy(interactor.UpdateSalePolicyInput{
+		Operator:    operator,
+		UpdateParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewSalePolicyJSONPresenter(responses.NewSalePolicy(output.SalePolicy)), nil
+}
+
+// 返金保証期間の対象の売上一覧を取得
+func (h *SaleGuaranteeHandlerImpl) GetSaleGuaranteeList(operator *entity.AgentStaff, now time.Time) (presenter.Presenter, error) {
+	output, err := h.saleGuaranteeInteractor.GetSaleGuaranteeList(interactor.GetSaleGuaranteeListInput{
+		Operator: operator,
+		Now:      now,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewSaleGuaranteeListJSONPresenter(responses.NewSaleGuaranteeList(output.SaleGuaranteeList)), nil
+}
+
+// 早期退職を記録し売上を減額
+func (h *SaleGuaranteeHandlerImpl) RecordSaleResignation(saleID uint, param entity.RecordSaleResignationParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.saleGuaranteeInteractor.RecordSaleResignation(interactor.RecordSaleResignationInput{
+		SaleID:      saleID,
+		Operator:    operator,
+		RecordParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewSaleRefundJSONPresenter(responses.NewSaleRefund(output.SaleRefund)), nil
+}
+
+/****************************************************************************************/
+// Batch API
+//
+// 返金規定の段階の終了が近い売上を通知
+func (h *SaleGuaranteeHandlerImpl) BatchNotifySaleGuarantee(now time.Time) (presenter.Presenter, error) {
+	output, err := h.saleGuaranteeInteractor.BatchNotifySaleGuarantee(interactor.BatchNotifySaleGuaranteeInput{
+		Now: now,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
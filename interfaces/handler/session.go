@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
 	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
 	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
 	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
@@ -14,11 +15,11 @@ type SessionHandler interface {
 	GetSignInUser(token string) (presenter.Presenter, error)
 
 	// 求人企業が求人票修正するためのログイン
-	SignInForGuestEnterprise(password string, jobInformationUUID uuid.UUID) (presenter.Presenter, error)
-	SignInForGuestEnterpriseByTaskGroupUUID(password string, taskGroupUUID uuid.UUID) (presenter.Presenter, error)
+	SignInForGuestEnterprise(password string, jobInformationUUID uuid.UUID, access entity.GuestLinkAccess) (presenter.Presenter, error)
+	SignInForGuestEnterpriseByTaskGroupUUID(password string, taskGroupUUID uuid.UUID, access entity.GuestLinkAccess) (presenter.Presenter, error)
 
 	// ゲスト求職者ためのログイン
-	SignInForGuestJobSeeker(password string, jobSeekerUUID uuid.UUID) (presenter.Presenter, error)
+	SignInForGuestJobSeeker(password string, jobSeekerUUID uuid.UUID, access entity.GuestLinkAccess) (presenter.Presenter, error)
 	SignInForGuestJobSeekerFromLP(jobSeekerUUID, loginToken uuid.UUID) (presenter.Presenter, error)
 
 	// LPのログインフォームのログイン処理
@@ -64,10 +65,11 @@ func (h *SessionHandlerImpl) GetSignInUser(token string) (presenter.Presenter, e
 	return presenter.NewUserSessionJSONPresenter(responses.NewUserSession(output.User)), nil
 }
 
-func (h *SessionHandlerImpl) SignInForGuestEnterprise(password string, jobInformationUUID uuid.UUID) (presenter.Presenter, error) {
+func (h *SessionHandlerImpl) SignInForGuestEnterprise(password string, jobInformationUUID uuid.UUID, access entity.GuestLinkAccess) (presenter.Presenter, error) {
 	output, err := h.sessionInteractor.SignInForGuestEnterprise(interactor.SessionSignInForGuestEnterpriseInput{
 		Password:           password,
 		JobInformationUUID: jobInformationUUID,
+		Access:             access,
 	})
 	if err != nil {
 		return nil, err
@@ -76,10 +78,11 @@ func (h *SessionHandlerImpl) SignInForGuestEnterprise(password string, jobInform
 	return presenter.NewGuestEnterpriseUserSessionJSONPresenter(responses.NewGuestEnterpriseUserSession(output.User)), nil
 }
 
-func (h *SessionHandlerImpl) SignInForGuestEnterpriseByTaskGroupUUID(password string, taskGroupUUID uuid.UUID) (presenter.Presenter, error) {
+func (h *SessionHandlerImpl) SignInForGuestEnterpriseByTaskGroupUUID(password string, taskGroupUUID uuid.UUID, access entity.GuestLinkAccess) (presenter.Presenter, error) {
 	output, err := h.sessionInteractor.SignInForGuestEnterpriseByTaskGroupUUID(interactor.SessionSignInForGuestEnterpriseByTaskGroupUUIDInput{
 		Password:      password,
 		TaskGroupUUID: taskGroupUUID,
+		Access:        access,
 	})
 	if err != nil {
 		return nil, err
@@ -88,10 +91,11 @@ func (h *SessionHandlerImpl) SignInForGuestEnterpriseByTaskGroupUUID(password st
 	return presenter.NewGuestEnterpriseUserSessionJSONPresenter(responses.NewGuestEnterpriseUserSession(output.User)), nil
 }
 
-func (h *SessionHandlerImpl) SignInForGuestJobSeeker(password string, jobSeekerUUID uuid.UUID) (presenter.Presenter, error) {
+func (h *SessionHandlerImpl) SignInForGuestJobSeeker(password string, jobSeekerUUID uuid.UUID, access entity.GuestLinkAccess) (presenter.Presenter, error) {
 	output, err := h.sessionInteractor.SignInForGuestJobSeeker(interactor.SessionSignInForGuestJobSeekerInput{
 		Password:      password,
 		JobSeekerUUID: jobSeekerUUID,
+		Access:        access,
 	})
 	if err != nil {
 		return nil, err
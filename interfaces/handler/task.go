@@ -26,15 +26,15 @@ type TaskHandler interface {
 	GetLatestTaskListByAgentStaffID(agentID, agentStaffID, deadLine, staffType, partnerType, taskType, phase, jobSeekerID uint) (presenter.Presenter, error)
 	GetLatestSameTaskListByJobSeekerID(jobSeekerID, taskID uint, phaseCategory, phaseSubCategory null.Int) (presenter.Presenter, error)
 	GetLatestTaskByJobSeekerIDAndJobInformationID(jobSeekerID, jobInformationID uint) (presenter.Presenter, error)
-	CreateNextTaskAfterEntryPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error)
-	CreateNextTaskAfterDocumentSelectionPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error) // 書類選考
-	CreateNextTaskAfterSelectionPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error)         // 選考(1次-最終)
-	CreateNextTaskAfterDeclinePhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error)           //  内定辞退
-	CreateNextTaskAfterHoldJobOfferPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error)      // 内定保留
-	CreateNextTaskAfterAcceptJobOfferPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error)    // 内定承諾
-	CreateNextSameTaskList(param entity.NextSameTaskListParam, agentStaffID uint) (presenter.Presenter, error)            // 同一タスクをまとめて処理
-	CreateEntryTaskFromMatchingJob(param entity.CreateEntryTaskFromMatchingJobParam) (presenter.Presenter, error)         // マイページのマッチ求人からエントリー
-	UpdateTaskGroupDocument(param entity.UpdateTaskGroupDocumentParam) (presenter.Presenter, error)
+	CreateNextTaskAfterEntryPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	CreateNextTaskAfterDocumentSelectionPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error) // 書類選考
+	CreateNextTaskAfterSelectionPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error)         // 選考(1次-最終)
+	CreateNextTaskAfterDeclinePhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error)           //  内定辞退
+	CreateNextTaskAfterHoldJobOfferPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error)      // 内定保留
+	CreateNextTaskAfterAcceptJobOfferPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error)    // 内定承諾
+	CreateNextSameTaskList(param entity.NextSameTaskListParam, operator *entity.AgentStaff) (presenter.Presenter, error)            // 同一タスクをまとめて処理
+	CreateEntryTaskFromMatchingJob(param entity.CreateEntryTaskFromMatchingJobParam) (presenter.Presenter, error)                   // マイページのマッチ求人からエントリー
+	UpdateTaskGroupDocument(param entity.UpdateTaskGroupDocumentParam, operator *entity.AgentStaff) (presenter.Presenter, error)
 	UpdateRALastWatched(groupID uint) (presenter.Presenter, error)
 	UpdateRALastRequest(groupID uint) (presenter.Presenter, error)
 	UpdateCALastWatched(groupID uint) (presenter.Presenter, error)
@@ -50,6 +50,8 @@ type TaskHandler interface {
 
 	// 汎用系 API（仕様変更前に作成した関数）
 	GetTaskByID(taskID uint) (presenter.Presenter, error)
+	GetTaskPhaseActionList(taskID uint) (presenter.Presenter, error)
+	CheckDuplicateRecommendation(param entity.CheckDuplicateRecommendationParam, operator *entity.AgentStaff) (presenter.Presenter, error)
 	GetTaskListByAgentIDAndPage(agentID, pageNumber uint) (presenter.Presenter, error)
 	GetTaskListAfterEntryByJobSeekerID(jobSeekerID uint) (presenter.Presenter, error)
 	GetSearchTaskListByAgentIDAndPage(agentStaffID, pageNumber uint, param entity.SearchTask) (presenter.Presenter, error)
@@ -58,7 +60,7 @@ type TaskHandler interface {
 	GetActiveTaskCountByJobInformationID(jobInformationID uint) (presenter.Presenter, error)
 	GetActiveTaskCountBySelectionID(selectionID uint) (presenter.Presenter, error)
 
-	DeleteTask(param entity.DeleteTaskParam) (presenter.Presenter, error) // GoogleCalendarからタスクを取得する関数
+	DeleteTask(param entity.DeleteTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error) // GoogleCalendarからタスクを取得する関数
 	// Admin API
 
 	// Batch API
@@ -250,10 +252,10 @@ func (h *TaskHandlerImpl) GetLatestTaskByJobSeekerIDAndJobInformationID(jobSeeke
 	return presenter.NewTaskJSONPresenter(responses.NewTask(output.Task)), nil
 }
 
-func (h *TaskHandlerImpl) CreateNextTaskAfterEntryPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error) {
+func (h *TaskHandlerImpl) CreateNextTaskAfterEntryPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.CreateNextTaskAfterEntryPhase(interactor.CreateNextTaskAfterEntryPhaseInput{
 		NextTaskParam: param,
-		AgentStaffID:  agentStaffID,
+		Operator:      operator,
 	})
 
 	if err != nil {
@@ -264,10 +266,10 @@ func (h *TaskHandlerImpl) CreateNextTaskAfterEntryPhase(param entity.NextTaskPar
 }
 
 // 書類選考
-func (h *TaskHandlerImpl) CreateNextTaskAfterDocumentSelectionPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error) {
+func (h *TaskHandlerImpl) CreateNextTaskAfterDocumentSelectionPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.CreateNextTaskAfterDocumentSelectionPhase(interactor.CreateNextTaskAfterDocumentSelectionPhaseInput{
 		NextTaskParam: param,
-		AgentStaffID:  agentStaffID,
+		Operator:      operator,
 	})
 
 	if err != nil {
@@ -278,10 +280,10 @@ func (h *TaskHandlerImpl) CreateNextTaskAfterDocumentSelectionPhase(param entity
 }
 
 // 選考(1次-最終)
-func (h *TaskHandlerImpl) CreateNextTaskAfterSelectionPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error) {
+func (h *TaskHandlerImpl) CreateNextTaskAfterSelectionPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.CreateNextTaskAfterSelectionPhase(interactor.CreateNextTaskAfterSelectionPhaseInput{
 		NextTaskParam: param,
-		AgentStaffID:  agentStaffID,
+		Operator:      operator,
 	})
 
 	if err != nil {
@@ -292,10 +294,10 @@ func (h *TaskHandlerImpl) CreateNextTaskAfterSelectionPhase(param entity.NextTas
 }
 
 // 辞退
-func (h *TaskHandlerImpl) CreateNextTaskAfterDeclinePhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error) {
+func (h *TaskHandlerImpl) CreateNextTaskAfterDeclinePhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.CreateNextTaskAfterDeclinePhase(interactor.CreateNextTaskAfterDeclinePhaseInput{
 		NextTaskParam: param,
-		AgentStaffID:  agentStaffID,
+		Operator:      operator,
 	})
 
 	if err != nil {
@@ -306,10 +308,10 @@ func (h *TaskHandlerImpl) CreateNextTaskAfterDeclinePhase(param entity.NextTaskP
 }
 
 // 内定保留
-func (h *TaskHandlerImpl) CreateNextTaskAfterHoldJobOfferPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error) {
+func (h *TaskHandlerImpl) CreateNextTaskAfterHoldJobOfferPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.CreateNextTaskAfterHoldJobOfferPhase(interactor.CreateNextTaskAfterHoldJobOfferPhaseInput{
 		NextTaskParam: param,
-		AgentStaffID:  agentStaffID,
+		Operator:      operator,
 	})
 
 	if err != nil {
@@ -320,10 +322,10 @@ func (h *TaskHandlerImpl) CreateNextTaskAfterHoldJobOfferPhase(param entity.Next
 }
 
 // 内定承諾
-func (h *TaskHandlerImpl) CreateNextTaskAfterAcceptJobOfferPhase(param entity.NextTaskParam, agentStaffID uint) (presenter.Presenter, error) {
+func (h *TaskHandlerImpl) CreateNextTaskAfterAcceptJobOfferPhase(param entity.NextTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.CreateNextTaskAfterAcceptJobOfferPhase(interactor.CreateNextTaskAfterAcceptJobOfferPhaseInput{
 		NextTaskParam: param,
-		AgentStaffID:  agentStaffID,
+		Operator:      operator,
 	})
 
 	if err != nil {
@@ -334,10 +336,10 @@ func (h *TaskHandlerImpl) CreateNextTaskAfterAcceptJobOfferPhase(param entity.Ne
 }
 
 // 同一タスクをまとめて処理
-func (h *TaskHandlerImpl) CreateNextSameTaskList(param entity.NextSameTaskListParam, agentStaffID uint) (presenter.Presenter, error) {
+func (h *TaskHandlerImpl) CreateNextSameTaskList(param entity.NextSameTaskListParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.CreateNextSameTaskList(interactor.CreateNextSameTaskListInput{
 		NextSameTaskListParam: param,
-		AgentStaffID:          agentStaffID,
+		Operator:              operator,
 	})
 
 	if err != nil {
@@ -360,9 +362,10 @@ func (h *TaskHandlerImpl) CreateEntryTaskFromMatchingJob(param entity.CreateEntr
 	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
 }
 
-func (h *TaskHandlerImpl) UpdateTaskGroupDocument(param entity.UpdateTaskGroupDocumentParam) (presenter.Presenter, error) {
+func (h *TaskHandlerImpl) UpdateTaskGroupDocument(param entity.UpdateTaskGroupDocumentParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.UpdateTaskGroupDocument(interactor.UpdateTaskGroupDocumentInput{
-		Param: param,
+		Param:    param,
+		Operator: operator,
 	})
 
 	if err != nil {
@@ -465,6 +468,33 @@ func (h *TaskHandlerImpl) GetTaskByID(taskID uint) (presenter.Presenter, error)
 	return presenter.NewTaskJSONPresenter(responses.NewTask(output.Task)), nil
 }
 
+// タスクから次に実行できる操作の一覧を取得
+func (h *TaskHandlerImpl) GetTaskPhaseActionList(taskID uint) (presenter.Presenter, error) {
+	output, err := h.taskInteractor.GetTaskPhaseActionList(interactor.GetTaskPhaseActionListInput{
+		TaskID: taskID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskPhaseActionListJSONPresenter(responses.NewTaskPhaseActionList(output.Task, output.IsLatest, output.ActionList)), nil
+}
+
+// 推薦前に同じ企業への重複推薦の可能性がある選考を確認
+func (h *TaskHandlerImpl) CheckDuplicateRecommendation(param entity.CheckDuplicateRecommendationParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskInteractor.CheckDuplicateRecommendation(interactor.CheckDuplicateRecommendationInput{
+		Operator: operator,
+		Param:    param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewDuplicateRecommendationResultListJSONPresenter(responses.NewDuplicateRecommendationResultList(output.ResultList)), nil
+}
+
 // タスクグループの一覧取得（エージェントが関わっているタスク）
 func (h *TaskHandlerImpl) GetTaskListByAgentIDAndPage(agentID, pageNumber uint) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.GetTaskListByAgentIDAndPage(interactor.GetTaskListByAgentIDAndPageInput{
@@ -558,9 +588,10 @@ func (h *TaskHandlerImpl) GetActiveTaskCountBySelectionID(selectionID uint) (pre
 	return presenter.NewActiveTaskCountJSONPresenter(responses.NewActiveTaskCount(output.TaskCount)), nil
 }
 
-func (h *TaskHandlerImpl) DeleteTask(param entity.DeleteTaskParam) (presenter.Presenter, error) {
+func (h *TaskHandlerImpl) DeleteTask(param entity.DeleteTaskParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
 	output, err := h.taskInteractor.DeleteTask(interactor.DeleteTaskInput{
 		DeleteParam: param,
+		Operator:    operator,
 	})
 
 	if err != nil {
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type TaskBulkJobHandler interface {
+	// 汎用系 API
+	CreateTaskBulkJob(param entity.CreateTaskBulkJobParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetTaskBulkJobByID(jobID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetTaskBulkJobList(operator *entity.AgentStaff) (presenter.Presenter, error)
+
+	// Batch API
+	BatchStartTaskBulkJobList(now time.Time) ([]*entity.TaskBulkJob, error)
+	BatchProcessTaskBulkJobItem(job *entity.TaskBulkJob, item *entity.TaskBulkJobItem) (presenter.Presenter, error)
+	BatchFailTaskBulkJobItem(item *entity.TaskBulkJobItem, itemErr error) (presenter.Presenter, error)
+	BatchFinishTaskBulkJob(jobID uint, now time.Time) (presenter.Presenter, error)
+}
+
+type TaskBulkJobHandlerImpl struct {
+	taskBulkJobInteractor interactor.TaskBulkJobInteractor
+}
+
+func NewTaskBulkJobHandlerImpl(tbjI interactor.TaskBulkJobInteractor) TaskBulkJobHandler {
+	return &TaskBulkJobHandlerImpl{
+		taskBulkJobInteractor: tbjI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// タスクの一括操作を登録
+func (h *TaskBulkJobHandlerImpl) CreateTaskBulkJob(param entity.CreateTaskBulkJobParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskBulkJobInteractor.CreateTaskBulkJob(interactor.CreateTaskBulkJobInput{
+		Operator:    operator,
+		CreateParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskBulkJobJSONPresenter(responses.NewTaskBulkJob(output.Job)), nil
+}
+
+// 一括操作のジョブと選考ごとの結果を取得
+func (h *TaskBulkJobHandlerImpl) GetTaskBulkJobByID(jobID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskBulkJobInteractor.GetTaskBulkJobByID(interactor.GetTaskBulkJobByIDInput{
+		Operator: operator,
+		JobID:    jobID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskBulkJobJSONPresenter(responses.NewTaskBulkJob(output.Job)), nil
+}
+
+// 自分が登録した一括操作の一覧を取得
+func (h *TaskBulkJobHandlerImpl) GetTaskBulkJobList(operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskBulkJobInteractor.GetTaskBulkJobList(interactor.GetTaskBulkJobListInput{
+		Operator: operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskBulkJobListJSONPresenter(responses.NewTaskBulkJobList(output.JobList)), nil
+}
+
+/****************************************************************************************/
+// Batch API
+//
+// 未処理の一括操作を開始し、選考ごとの処理対象とあわせて返す
+func (h *TaskBulkJobHandlerImpl) BatchStartTaskBulkJobList(now time.Time) ([]*entity.TaskBulkJob, error) {
+	output, err := h.taskBulkJobInteractor.StartTaskBulkJobList(interactor.StartTaskBulkJobListInput{
+		Now: now,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.JobList, nil
+}
+
+// 選考1件を処理（エラーの場合は呼び出し側でロールバックする）
+func (h *TaskBulkJobHandlerImpl) BatchProcessTaskBulkJobItem(job *entity.TaskBulkJob, item *entity.TaskBulkJobItem) (presenter.Presenter, error) {
+	output, err := h.taskBulkJobInteractor.ProcessTaskBulkJobItem(interactor.ProcessTaskBulkJobItemInput{
+		Job:  job,
+		Item: item,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+// 選考1件の失敗を記録
+func (h *TaskBulkJobHandlerImpl) BatchFailTaskBulkJobItem(item *entity.TaskBulkJobItem, itemErr error) (presenter.Presenter, error) {
+	output, err := h.taskBulkJobInteractor.FailTaskBulkJobItem(interactor.FailTaskBulkJobItemInput{
+		Item:      item,
+		ItemError: itemErr,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+// 選考ごとの結果を集計して一括操作を完了
+func (h *TaskBulkJobHandlerImpl) BatchFinishTaskBulkJob(jobID uint, now time.Time) (presenter.Presenter, error) {
+	output, err := h.taskBulkJobInteractor.FinishTaskBulkJob(interactor.FinishTaskBulkJobInput{
+		JobID: jobID,
+		Now:   now,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type TaskGroupEventHandler interface {
+	// 汎用系 API
+	GetTaskGroupTimeline(taskGroupID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetJobSeekerTimeline(jobSeekerID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+}
+
+type TaskGroupEventHandlerImpl struct {
+	taskGroupEventInteractor interactor.TaskGroupEventInteractor
+}
+
+func NewTaskGroupEventHandlerImpl(tgeI interactor.TaskGroupEventInteractor) TaskGroupEventHandler {
+	return &TaskGroupEventHandlerImpl{
+		taskGroupEventInteractor: tgeI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// タスクグループの活動履歴を取得
+func (h *TaskGroupEventHandlerImpl) GetTaskGroupTimeline(taskGroupID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskGroupEventInteractor.GetTaskGroupTimeline(interactor.GetTaskGroupTimelineInput{
+		TaskGroupID: taskGroupID,
+		Operator:    operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskGroupEventListJSONPresenter(responses.NewTaskGroupEventList(output.EventList)), nil
+}
+
+// 求職者の全ての選考の活動履歴を取得
+func (h *TaskGroupEventHandlerImpl) GetJobSeekerTimeline(jobSeekerID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskGroupEventInteractor.GetJobSeekerTimeline(interactor.GetJobSeekerTimelineInput{
+		JobSeekerID: jobSeekerID,
+		Operator:    operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskGroupEventListJSONPresenter(responses.NewTaskGroupEventList(output.EventList)), nil
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+	"gopkg.in/guregu/null.v4"
+)
+
+type TaskLossReasonHandler interface {
+	// 汎用系 API
+	GetTaskLossReasonMasterList(phaseCategory, phaseSubCategory null.Int) (presenter.Presenter, error)
+	GetTaskLossReasonListByTaskGroupID(taskGroupID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetTaskLossReasonAnalytics(searchParam entity.SearchTaskLossReasonParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+}
+
+type TaskLossReasonHandlerImpl struct {
+	taskLossReasonInteractor interactor.TaskLossReasonInteractor
+}
+
+func NewTaskLossReasonHandlerImpl(tlrI interactor.TaskLossReasonInteractor) TaskLossReasonHandler {
+	return &TaskLossReasonHandlerImpl{
+		taskLossReasonInteractor: tlrI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 辞退・不合格の理由の一覧
+func (h *TaskLossReasonHandlerImpl) GetTaskLossReasonMasterList(phaseCategory, phaseSubCategory null.Int) (presenter.Presenter, error) {
+	output, err := h.taskLossReasonInteractor.GetTaskLossReasonMasterList(interactor.GetTaskLossReasonMasterListInput{
+		PhaseCategory:    phaseCategory,
+		PhaseSubCategory: phaseSubCategory,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskLossReasonMasterListJSONPresenter(responses.NewTaskLossReasonMasterList(output.MasterList)), nil
+}
+
+// 選考の辞退・不合格の理由を取得
+func (h *TaskLossReasonHandlerImpl) GetTaskLossReasonListByTaskGroupID(taskGroupID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskLossReasonInteractor.GetTaskLossReasonListByTaskGroupID(interactor.GetTaskLossReasonListByTaskGroupIDInput{
+		TaskGroupID: taskGroupID,
+		Operator:    operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskLossReasonListJSONPresenter(responses.NewTaskLossReasonList(output.LossReasonList)), nil
+}
+
+// 辞退・不合格の理由を集計
+func (h *TaskLossReasonHandlerImpl) GetTaskLossReasonAnalytics(searchParam entity.SearchTaskLossReasonParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskLossReasonInteractor.GetTaskLossReasonAnalytics(interactor.GetTaskLossReasonAnalyticsInput{
+		SearchParam: searchParam,
+		Operator:    operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskLossReasonAnalyticsJSONPresenter(responses.NewTaskLossReasonAnalytics(output.AnalyticsList, output.Summary)), nil
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type TaskPipelineHandler interface {
+	// 汎用系 API
+	GetTaskPipelineByAgentID(agentID uint, searchParam entity.SearchTaskPipelineParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetTaskPipelineByAgentStaffID(agentStaffID uint, searchParam entity.SearchTaskPipelineParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetTaskPipelineByJobInformationID(jobInformationID uint, searchParam entity.SearchTaskPipelineParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetTaskPipelineCardList(agentID uint, searchParam entity.SearchTaskPipelineParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+
+	// 応募者一覧 API（RA向け）
+	GetTaskPipelineApplicantListByJobInformationID(jobInformationID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+	GetTaskPipelineApplicantListByBillingAddressID(billingAddressID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+	ExportTaskPipelineApplicantCSVByJobInformationID(jobInformationID uint, operator *entity.AgentStaff) (string, error)
+	ExportTaskPipelineApplicantCSVByBillingAddressID(billingAddressID uint, operator *entity.AgentStaff) (string, error)
+}
+
+type TaskPipelineHandlerImpl struct {
+	taskPipelineInteractor interactor.TaskPipelineInteractor
+}
+
+func NewTaskPipelineHandlerImpl(tpI interactor.TaskPipelineInteractor) TaskPipelineHandler {
+	return &TaskPipelineHandlerImpl{
+		taskPipelineInteractor: tpI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// エージェントのパイプラインを取得
+func (h *TaskPipelineHandlerImpl) GetTaskPipelineByAgentID(agentID uint, searchParam entity.SearchTaskPipelineParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskPipelineInteractor.GetTaskPipelineByAgentID(interactor.GetTaskPipelineByAgentIDInput{
+		Operator:    operator,
+		AgentID:     agentID,
+		SearchParam: searchParam,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskPipelineJSONPresenter(responses.NewTaskPipeline(output.PhaseList, output.TotalCount)), nil
+}
+
+// 担当者のパイプラインを取得
+func (h *TaskPipelineHandlerImpl) GetTaskPipelineByAgentStaffID(agentStaffID uint, searchParam entity.SearchTaskPipelineParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskPipelineInteractor.GetTaskPipelineByAgentStaffID(interactor.GetTaskPipelineByAgentStaffIDInput{
+		Operator:     operator,
+		AgentStaffID: agentStaffID,
+		SearchParam:  searchParam,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskPipelineJSONPresenter(responses.NewTaskPipeline(output.PhaseList, output.TotalCount)), nil
+}
+
+// 求人のパイプラインを取得
+func (h *TaskPipelineHandlerImpl) GetTaskPipelineByJobInformationID(jobInformationID uint, searchParam entity.SearchTaskPipelineParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskPipelineInteractor.GetTaskPipelineByJobInformationID(interactor.GetTaskPipelineByJobInformationIDInput{
+		Operator:         operator,
+		JobInformationID: jobInformationID,
+		SearchParam:      searchParam,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskPipelineJSONPresenter(responses.NewTaskPipeline(output.PhaseList, output.TotalCount)), nil
+}
+
+// パイプラインの列のカードを取得
+func (h *TaskPipelineHandlerImpl) GetTaskPipelineCardList(agentID uint, searchParam entity.SearchTaskPipelineParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskPipelineInteractor.GetTaskPipelineCardList(interactor.GetTaskPipelineCardListInput{
+		Operator:    operator,
+		AgentID:     agentID,
+		SearchParam: searchParam,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskPipelineCardListJSONPresenter(responses.NewTaskPipelineCardList(output.CardList, output.TotalCount, output.MaxPageNumber)), nil
+}
+
+/****************************************************************************************/
+// 応募者一覧 API（RA向け）
+//
+// 求人の応募者一覧を取得
+func (h *TaskPipelineHandlerImpl) GetTaskPipelineApplicantListByJobInformationID(jobInformationID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskPipelineInteractor.GetTaskPipelineApplicantListByJobInformationID(interactor.GetTaskPipelineApplicantListByJobInformationIDInput{
+		Operator:         operator,
+		JobInformationID: jobInformationID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskPipelineApplicantListJSONPresenter(responses.NewTaskPipelineApplicantList(output.ApplicantList, output.TotalCount)), nil
+}
+
+// 請求先の求人の応募者一覧を取得
+func (h *TaskPipelineHandlerImpl) GetTaskPipelineApplicantListByBillingAddressID(billingAddressID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskPipelineInteractor.GetTaskPipelineApplicantListByBillingAddressID(interactor.GetTaskPipelineApplicantListByBillingAddressIDInput{
+		Operator:         operator,
+		BillingAddressID: billingAddressID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskPipelineApplicantListJSONPresenter(responses.NewTaskPipelineApplicantList(output.ApplicantList, output.TotalCount)), nil
+}
+
+// 求人の応募者一覧をcsvファイルで出力
+func (h *TaskPipelineHandlerImpl) ExportTaskPipelineApplicantCSVByJobInformationID(jobInformationID uint, operator *entity.AgentStaff) (string, error) {
+	output, err := h.taskPipelineInteractor.ExportTaskPipelineApplicantCSVByJobInformationID(interactor.GetTaskPipelineApplicantListByJobInformationIDInput{
+		Operator:         operator,
+		JobInformationID: jobInformationID,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return output.FilePath.PathName, nil
+}
+
+// 請求先の求人の応募者一覧をcsvファイルで出力
+func (h *TaskPipelineHandlerImpl) ExportTaskPipelineApplicantCSVByBillingAddressID(billingAddressID uint, operator *entity.AgentStaff) (string, error) {
+	output, err := h.taskPipelineInteractor.ExportTaskPipelineApplicantCSVByBillingAddressID(interactor.GetTaskPipelineApplicantListByBillingAddressIDInput{
+		Operator:         operator,
+		BillingAddressID: billingAddressID,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return output.FilePath.PathName, nil
+}
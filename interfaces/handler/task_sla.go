@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+	"github.com/spaceaiinc/autoscout-server/interfaces/presenter"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+)
+
+type TaskSLAHandler interface {
+	// 汎用系 API
+	GetTaskSLARuleList(operator *entity.AgentStaff) (presenter.Presenter, error)
+
+	// Admin API
+	CreateTaskSLARule(param entity.CreateOrUpdateTaskSLARuleParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	UpdateTaskSLARule(ruleID uint, param entity.CreateOrUpdateTaskSLARuleParam, operator *entity.AgentStaff) (presenter.Presenter, error)
+	DeleteTaskSLARule(ruleID uint, operator *entity.AgentStaff) (presenter.Presenter, error)
+
+	// Batch API
+	BatchNotifyTaskSLA(now time.Time) (presenter.Presenter, error)
+	BatchNotifyTaskSLADigest(now time.Time) (presenter.Presenter, error)
+}
+
+type TaskSLAHandlerImpl struct {
+	taskSLAInteractor interactor.TaskSLAInteractor
+}
+
+func NewTaskSLAHandlerImpl(tsI interactor.TaskSLAInteractor) TaskSLAHandler {
+	return &TaskSLAHandlerImpl{
+		taskSLAInteractor: tsI,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 自社の期限の設定一覧を取得
+func (h *TaskSLAHandlerImpl) GetTaskSLARuleList(operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskSLAInteractor.GetTaskSLARuleList(interactor.GetTaskSLARuleListInput{
+		Operator: operator,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskSLARuleListJSONPresenter(responses.NewTaskSLARuleList(output.RuleList, output.DefaultRuleList)), nil
+}
+
+/****************************************************************************************/
+// Admin API
+//
+// 期限の設定を作成
+func (h *TaskSLAHandlerImpl) CreateTaskSLARule(param entity.CreateOrUpdateTaskSLARuleParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskSLAInteractor.CreateTaskSLARule(interactor.CreateTaskSLARuleInput{
+		Operator:    operator,
+		CreateParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskSLARuleJSONPresenter(responses.NewTaskSLARule(output.Rule)), nil
+}
+
+// 期限の設定を更新
+func (h *TaskSLAHandlerImpl) UpdateTaskSLARule(ruleID uint, param entity.CreateOrUpdateTaskSLARuleParam, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskSLAInteractor.UpdateTaskSLARule(interactor.UpdateTaskSLARuleInput{
+		Operator:    operator,
+		RuleID:      ruleID,
+		UpdateParam: param,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewTaskSLARuleJSONPresenter(responses.NewTaskSLARule(output.Rule)), nil
+}
+
+// 期限の設定を削除
+func (h *TaskSLAHandlerImpl) DeleteTaskSLARule(ruleID uint, operator *entity.AgentStaff) (presenter.Presenter, error) {
+	output, err := h.taskSLAInteractor.DeleteTaskSLARule(interactor.DeleteTaskSLARuleInput{
+		Operator: operator,
+		RuleID:   ruleID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+/****************************************************************************************/
+// Batch API
+//
+// タスクの期限のリマインド・期限切れ・エスカレーションを通知
+func (h *TaskSLAHandlerImpl) BatchNotifyTaskSLA(now time.Time) (presenter.Presenter, error) {
+	output, err := h.taskSLAInteractor.BatchNotifyTaskSLA(interactor.BatchNotifyTaskSLAInput{
+		Now: now,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
+
+// タスクの期限の日次まとめを通知
+func (h *TaskSLAHandlerImpl) BatchNotifyTaskSLADigest(now time.Time) (presenter.Presenter, error) {
+	output, err := h.taskSLAInteractor.BatchNotifyTaskSLADigest(interactor.BatchNotifyTaskSLADigestInput{
+		Now: now,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.NewOKJSONPresenter(responses.NewOK(output.OK)), nil
+}
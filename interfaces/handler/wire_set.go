@@ -36,4 +36,20 @@ var WireSet = wire.NewSet(
 	NewDeploymentReflectionHandlerImpl,
 	NewAgentInflowChannelOptionHandlerImpl,
 	NewGoogleAuthenticationHandlerImpl,
+	NewInboundEntryHandlerImpl,
+	NewJobSeekerMergeSuggestionHandlerImpl,
+	NewAgentAssignmentRuleHandlerImpl,
+	NewEntryScreeningRuleHandlerImpl,
+	NewInterviewBookingHandlerImpl,
+	NewAuditLogHandlerImpl,
+	NewGuestLinkHandlerImpl,
+	NewLoginAttemptHandlerImpl,
+	NewAPIKeyHandlerImpl,
+	NewTaskSLAHandlerImpl,
+	NewTaskGroupEventHandlerImpl,
+	NewTaskBulkJobHandlerImpl,
+	NewTaskPipelineHandlerImpl,
+	NewJobOfferHandlerImpl,
+	NewTaskLossReasonHandlerImpl,
+	NewSaleGuaranteeHandlerImpl,
 )
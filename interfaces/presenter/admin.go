@@ -0,0 +1,23 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewAdminAuthorizeJSONPresenter(resp responses.AdminAuthorize) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewAdminTOTPEnrollmentJSONPresenter(resp responses.AdminTOTPEnrollment) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewAdminSessionJSONPresenter(resp responses.AdminSession) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewAdminUserJSONPresenter(resp responses.AdminUser) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewAdminActionLogListJSONPresenter(resp responses.AdminActionLogList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
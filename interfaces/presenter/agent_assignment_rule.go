@@ -0,0 +1,11 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewAgentAssignmentRuleJSONPresenter(resp responses.AgentAssignmentRule) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewJobSeekerAssignmentLogListJSONPresenter(resp responses.JobSeekerAssignmentLogList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
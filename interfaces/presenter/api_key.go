@@ -0,0 +1,11 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewAPIKeyJSONPresenter(resp responses.APIKey) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewAPIKeyListJSONPresenter(resp responses.APIKeyList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
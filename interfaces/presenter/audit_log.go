@@ -0,0 +1,7 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewAuditLogListAndMaxPageJSONPresenter(resp responses.AuditLogListAndMaxPage) Presenter {
+	return NewJSONPresenter(200, resp)
+}
@@ -0,0 +1,11 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewEntryScreeningRuleJSONPresenter(resp responses.EntryScreeningRule) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewEntryScreeningRuleListJSONPresenter(resp responses.EntryScreeningRuleList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
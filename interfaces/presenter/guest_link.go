@@ -0,0 +1,15 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewGuestLinkJSONPresenter(resp responses.GuestLink) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewGuestLinkListJSONPresenter(resp responses.GuestLinkList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewGuestLinkAccessLogListJSONPresenter(resp responses.GuestLinkAccessLogList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
@@ -0,0 +1,7 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewInterviewBookingLinkJSONPresenter(resp responses.InterviewBookingLink) Presenter {
+	return NewJSONPresenter(200, resp)
+}
@@ -0,0 +1,11 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewJobOfferJSONPresenter(resp responses.JobOffer) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewJobOfferListJSONPresenter(resp responses.JobOfferList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
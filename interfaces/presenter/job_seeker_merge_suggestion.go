@@ -0,0 +1,7 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewJobSeekerMergeSuggestionListJSONPresenter(resp responses.JobSeekerMergeSuggestionList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
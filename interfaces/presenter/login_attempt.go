@@ -0,0 +1,7 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewLoginAttemptListJSONPresenter(resp responses.LoginAttemptList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
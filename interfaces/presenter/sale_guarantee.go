@@ -0,0 +1,15 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewSalePolicyJSONPresenter(resp responses.SalePolicy) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewSaleGuaranteeListJSONPresenter(resp responses.SaleGuaranteeList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewSaleRefundJSONPresenter(resp responses.SaleRefund) Presenter {
+	return NewJSONPresenter(200, resp)
+}
@@ -37,3 +37,11 @@ func NewTaskListAndJobSeekerListJSONPresenter(resp responses.TaskListAndJobSeeke
 func NewJobSeekerTaskListJSONPresenter(resp responses.JobSeekerTaskList) Presenter {
 	return NewJSONPresenter(200, resp)
 }
+
+func NewDuplicateRecommendationResultListJSONPresenter(resp responses.DuplicateRecommendationResultList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewTaskPhaseActionListJSONPresenter(resp responses.TaskPhaseActionList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
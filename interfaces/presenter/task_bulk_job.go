@@ -0,0 +1,11 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewTaskBulkJobJSONPresenter(resp responses.TaskBulkJob) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewTaskBulkJobListJSONPresenter(resp responses.TaskBulkJobList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
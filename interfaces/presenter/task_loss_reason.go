@@ -0,0 +1,15 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewTaskLossReasonMasterListJSONPresenter(resp responses.TaskLossReasonMasterList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewTaskLossReasonListJSONPresenter(resp responses.TaskLossReasonList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewTaskLossReasonAnalyticsJSONPresenter(resp responses.TaskLossReasonAnalytics) Presenter {
+	return NewJSONPresenter(200, resp)
+}
@@ -0,0 +1,15 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewTaskPipelineJSONPresenter(resp responses.TaskPipeline) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewTaskPipelineCardListJSONPresenter(resp responses.TaskPipelineCardList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewTaskPipelineApplicantListJSONPresenter(resp responses.TaskPipelineApplicantList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
@@ -0,0 +1,11 @@
+package presenter
+
+import "github.com/spaceaiinc/autoscout-server/domain/entity/responses"
+
+func NewTaskSLARuleJSONPresenter(resp responses.TaskSLARule) Presenter {
+	return NewJSONPresenter(200, resp)
+}
+
+func NewTaskSLARuleListJSONPresenter(resp responses.TaskSLARuleList) Presenter {
+	return NewJSONPresenter(200, resp)
+}
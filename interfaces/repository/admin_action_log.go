@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type AdminActionLogRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewAdminActionLogRepositoryImpl(ex interfaces.SQLExecuter) usecase.AdminActionLogRepository {
+	return &AdminActionLogRepositoryImpl{
+		Name:     "AdminActionLogRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 操作ログを作成
+func (repo *AdminActionLogRepositoryImpl) Create(actionLog *entity.AdminActionLog) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO admin_action_logs (
+				admin_user_id,
+				method,
+				path,
+				uri,
+				request_body,
+				status_code,
+				ip_address,
+				user_agent,
+				created_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		actionLog.AdminUserID,
+		actionLog.Method,
+		actionLog.Path,
+		actionLog.URI,
+		actionLog.RequestBody,
+		actionLog.StatusCode,
+		actionLog.IPAddress,
+		actionLog.UserAgent,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	actionLog.ID = uint(lastID)
+	actionLog.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 新しい順に1ページ分の操作ログを取得
+func (repo *AdminActionLogRepositoryImpl) GetListByPage(page uint) ([]*entity.AdminActionLog, error) {
+	var (
+		actionLogList []*entity.AdminActionLog
+		offset        uint
+	)
+
+	if page > 1 {
+		offset = (page - 1) * entity.AdminActionLogPageSize
+	}
+
+	err := repo.executer.Select(
+		repo.Name+".GetListByPage",
+		&actionLogList, `
+		SELECT
+			log.*,
+			IFNULL(admin_user.name, '') AS admin_user_name
+		FROM
+			admin_action_logs AS log
+		LEFT OUTER JOIN
+			admin_users AS admin_user
+		ON
+			log.admin_user_id = admin_user.id
+		ORDER BY log.id DESC
+		LIMIT ? OFFSET ?
+		`,
+		entity.AdminActionLogPageSize,
+		offset,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return actionLogList, nil
+}
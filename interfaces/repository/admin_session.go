@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type AdminSessionRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewAdminSessionRepositoryImpl(ex interfaces.SQLExecuter) usecase.AdminSessionRepository {
+	return &AdminSessionRepositoryImpl{
+		Name:     "AdminSessionRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// セッションを作成
+func (repo *AdminSessionRepositoryImpl) Create(session *entity.AdminSession) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO admin_sessions (
+				admin_user_id,
+				token_hash,
+				expires_at,
+				ip_address,
+				user_agent,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		session.AdminUserID,
+		session.TokenHash,
+		session.ExpiresAt,
+		session.IPAddress,
+		session.UserAgent,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	session.ID = uint(lastID)
+	session.CreatedAt = now
+	session.UpdatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 二要素認証を完了
+func (repo *AdminSessionRepositoryImpl) VerifyMFA(id uint, expiresAt time.Time) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".VerifyMFA",
+		`
+		UPDATE admin_sessions
+		SET
+			mfa_verified_at = ?,
+			expires_at = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		now,
+		expiresAt,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// ワンタイムパスワードの誤り回数を1増やす
+func (repo *AdminSessionRepositoryImpl) IncrementMFAFailedCount(id uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".IncrementMFAFailedCount",
+		`
+		UPDATE admin_sessions
+		SET
+			mfa_failed_count = mfa_failed_count + 1,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 有効期限を更新
+func (repo *AdminSessionRepositoryImpl) UpdateExpiresAt(id uint, expiresAt time.Time) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateExpiresAt",
+		`
+		UPDATE admin_sessions
+		SET
+			expires_at = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		expiresAt,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// ログアウト
+func (repo *AdminSessionRepositoryImpl) Revoke(id uint) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".Revoke",
+		`
+		UPDATE admin_sessions
+		SET
+			revoked_at = ?,
+			updated_at = ?
+		WHERE
+			id = ? AND
+			revoked_at IS NULL
+		`,
+		now,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// トークンのハッシュからセッションを取得（認証で毎回呼ばれるため見つからない場合はログを出さない）
+func (repo *AdminSessionRepositoryImpl) FindByTokenHash(tokenHash string) (*entity.AdminSession, error) {
+	var (
+		session entity.AdminSession
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByTokenHash",
+		&session, `
+		SELECT *
+		FROM admin_sessions
+		WHERE
+			token_hash = ?
+		LIMIT 1
+		`,
+		tokenHash,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type AdminUserRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewAdminUserRepositoryImpl(ex interfaces.SQLExecuter) usecase.AdminUserRepository {
+	return &AdminUserRepositoryImpl{
+		Name:     "AdminUserRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 管理者を作成
+func (repo *AdminUserRepositoryImpl) Create(adminUser *entity.AdminUser) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO admin_users (
+				login_id,
+				name,
+				password,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?
+			)
+		`,
+		adminUser.LoginID,
+		adminUser.Name,
+		adminUser.Password,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	adminUser.ID = uint(lastID)
+	adminUser.CreatedAt = now
+	adminUser.UpdatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 二要素認証の秘密鍵を登録（登録の完了前）
+func (repo *AdminUserRepositoryImpl) UpdateTOTPSecret(id uint, totpSecret string) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateTOTPSecret",
+		`
+		UPDATE admin_users
+		SET
+			totp_secret = ?,
+			updated_at = ?
+		WHERE
+			id = ? AND
+			totp_enabled_at IS NULL
+		`,
+		totpSecret,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 二要素認証の登録を完了
+func (repo *AdminUserRepositoryImpl) EnableTOTP(id uint, lastStep int64) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".EnableTOTP",
+		`
+		UPDATE admin_users
+		SET
+			totp_enabled_at = ?,
+			totp_last_step = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		now,
+		lastStep,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 最後に使用したワンタイムパスワードの時間ステップを更新
+func (repo *AdminUserRepositoryImpl) UpdateTOTPLastStep(id uint, lastStep int64) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateTOTPLastStep",
+		`
+		UPDATE admin_users
+		SET
+			totp_last_step = ?
+		WHERE
+			id = ?
+		`,
+		lastStep,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 最終ログイン日時を更新
+func (repo *AdminUserRepositoryImpl) UpdateLastLoginAt(id uint, lastLoginAt time.Time) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateLastLoginAt",
+		`
+		UPDATE admin_users
+		SET
+			last_login_at = ?
+		WHERE
+			id = ?
+		`,
+		lastLoginAt,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// IDから管理者を取得
+func (repo *AdminUserRepositoryImpl) FindByID(id uint) (*entity.AdminUser, error) {
+	var (
+		adminUser entity.AdminUser
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&adminUser, `
+		SELECT *
+		FROM admin_users
+		WHERE
+			id = ?
+		LIMIT 1
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &adminUser, nil
+}
+
+// ログインIDから管理者を取得（削除済みは除く）
+func (repo *AdminUserRepositoryImpl) FindByLoginID(loginID string) (*entity.AdminUser, error) {
+	var (
+		adminUser entity.AdminUser
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByLoginID",
+		&adminUser, `
+		SELECT *
+		FROM admin_users
+		WHERE
+			login_id = ? AND
+			is_deleted = false
+		LIMIT 1
+		`,
+		loginID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminUser, nil
+}
@@ -244,6 +244,54 @@ func (repo *AgentRepositoryImpl) UpdateAgreementFileURL(id uint, agentAgreement
 /****************************************************************************************/
 // 単数取得 API
 //
+func (repo *AgentRepositoryImpl) UpdateDuplicateRecommendationDays(id uint, days uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateDuplicateRecommendationDays",
+		`
+		UPDATE agents
+		SET
+			duplicate_recommendation_days = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		days,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+func (repo *AgentRepositoryImpl) UpdateRASalesRatio(id uint, raSalesRatio uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateRASalesRatio",
+		`
+		UPDATE agents
+		SET
+			ra_sales_ratio = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		raSalesRatio,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
 func (repo *AgentRepositoryImpl) FindByID(id uint) (*entity.Agent, error) {
 	var (
 		agent entity.Agent
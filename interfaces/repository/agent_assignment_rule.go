@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type AgentAssignmentRuleRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewAgentAssignmentRuleRepositoryImpl(ex interfaces.SQLExecuter) usecase.AgentAssignmentRuleRepository {
+	return &AgentAssignmentRuleRepositoryImpl{
+		Name:     "AgentAssignmentRuleRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 担当CAの自動割り振りルールを作成
+func (repo *AgentAssignmentRuleRepositoryImpl) Create(assignmentRule *entity.AgentAssignmentRule) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO agent_assignment_rules (
+				agent_id,
+				assignment_type,
+				is_working_hours_aware,
+				is_active,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?
+			)
+		`,
+		assignmentRule.AgentID,
+		assignmentRule.AssignmentType,
+		assignmentRule.IsWorkingHoursAware,
+		assignmentRule.IsActive,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	assignmentRule.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 担当CAの自動割り振りルールを更新
+func (repo *AgentAssignmentRuleRepositoryImpl) Update(id uint, assignmentRule *entity.AgentAssignmentRule) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".Update",
+		`
+		UPDATE agent_assignment_rules
+		SET
+			assignment_type = ?,
+			is_working_hours_aware = ?,
+			is_active = ?,
+			updated_at = ?
+		WHERE id = ?
+		`,
+		assignmentRule.AssignmentType,
+		assignmentRule.IsWorkingHoursAware,
+		assignmentRule.IsActive,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 最後に割り振った担当者を更新（ラウンドロビン用）
+func (repo *AgentAssignmentRuleRepositoryImpl) UpdateLastAssignedStaffID(id, agentStaffID uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateLastAssignedStaffID",
+		`
+		UPDATE agent_assignment_rules
+		SET
+			last_assigned_staff_id = ?,
+			updated_at = ?
+		WHERE id = ?
+		`,
+		agentStaffID,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// エージェントの自動割り振りルールを取得
+func (repo *AgentAssignmentRuleRepositoryImpl) FindByAgentID(agentID uint) (*entity.AgentAssignmentRule, error) {
+	var (
+		assignmentRule entity.AgentAssignmentRule
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByAgentID",
+		&assignmentRule, `
+		SELECT *
+		FROM agent_assignment_rules
+		WHERE agent_id = ?
+		LIMIT 1
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &assignmentRule, nil
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type AgentAssignmentStaffRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewAgentAssignmentStaffRepositoryImpl(ex interfaces.SQLExecuter) usecase.AgentAssignmentStaffRepository {
+	return &AgentAssignmentStaffRepositoryImpl{
+		Name:     "AgentAssignmentStaffRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 自動割り振りの対象となる担当者を作成
+func (repo *AgentAssignmentStaffRepositoryImpl) Create(assignmentStaff *entity.AgentAssignmentStaff) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO agent_assignment_staffs (
+				agent_id,
+				agent_staff_id,
+				working_start_time,
+				working_end_time,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?
+			)
+		`,
+		assignmentStaff.AgentID,
+		assignmentStaff.AgentStaffID,
+		assignmentStaff.WorkingStartTime,
+		assignmentStaff.WorkingEndTime,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	assignmentStaff.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 削除 API
+//
+// エージェントの割り振り対象の担当者を削除（得意分野はCASCADEで削除）
+func (repo *AgentAssignmentStaffRepositoryImpl) DeleteByAgentID(agentID uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".DeleteByAgentID",
+		`
+		DELETE
+		FROM agent_assignment_staffs
+		WHERE agent_id = ?
+		`, agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// エージェントの割り振り対象の担当者を取得（削除済み・利用停止中の担当者は除く）
+func (repo *AgentAssignmentStaffRepositoryImpl) GetByAgentID(agentID uint) ([]*entity.AgentAssignmentStaff, error) {
+	var (
+		assignmentStaffList []*entity.AgentAssignmentStaff
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentID",
+		&assignmentStaffList, `
+		SELECT
+			assignment.*,
+			staff.staff_name
+		FROM
+			agent_assignment_staffs AS assignment
+		INNER JOIN
+			agent_staffs AS staff
+		ON
+			assignment.agent_staff_id = staff.id
+		WHERE
+			assignment.agent_id = ? AND
+			staff.is_deleted = FALSE
+		ORDER BY assignment.agent_staff_id ASC
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return assignmentStaffList, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type AgentAssignmentStaffSpecialtyRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewAgentAssignmentStaffSpecialtyRepositoryImpl(ex interfaces.SQLExecuter) usecase.AgentAssignmentStaffSpecialtyRepository {
+	return &AgentAssignmentStaffSpecialtyRepositoryImpl{
+		Name:     "AgentAssignmentStaffSpecialtyRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 担当者の得意分野を作成
+func (repo *AgentAssignmentStaffSpecialtyRepositoryImpl) Create(specialty *entity.AgentAssignmentStaffSpecialty) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO agent_assignment_staff_specialties (
+				assignment_staff_id,
+				specialty_type,
+				value,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?
+			)
+		`,
+		specialty.AssignmentStaffID,
+		specialty.SpecialtyType,
+		specialty.Value,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	specialty.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// エージェントの割り振り対象の担当者の得意分野を取得
+func (repo *AgentAssignmentStaffSpecialtyRepositoryImpl) GetByAgentID(agentID uint) ([]*entity.AgentAssignmentStaffSpecialty, error) {
+	var (
+		specialtyList []*entity.AgentAssignmentStaffSpecialty
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentID",
+		&specialtyList, `
+		SELECT
+			specialty.*
+		FROM
+			agent_assignment_staff_specialties AS specialty
+		INNER JOIN
+			agent_assignment_staffs AS assignment
+		ON
+			specialty.assignment_staff_id = assignment.id
+		WHERE
+			assignment.agent_id = ?
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return specialtyList, nil
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type AgentRefundTierRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewAgentRefundTierRepositoryImpl(ex interfaces.SQLExecuter) usecase.AgentRefundTierRepository {
+	return &AgentRefundTierRepositoryImpl{
+		Name:     "AgentRefundTierRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 返金規定の段階を作成
+func (repo *AgentRefundTierRepositoryImpl) Create(tier *entity.AgentRefundTier) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO agent_refund_tiers (
+				agent_id,
+				within_days,
+				refund_rate,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?
+			)
+		`,
+		tier.AgentID,
+		tier.WithinDays,
+		tier.RefundRate,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	tier.ID = uint(lastID)
+	tier.CreatedAt = now
+	tier.UpdatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 削除 API
+//
+// エージェントの返金規定を全て削除
+func (repo *AgentRefundTierRepositoryImpl) DeleteByAgentID(agentID uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".DeleteByAgentID",
+		`
+		DELETE
+		FROM agent_refund_tiers
+		WHERE
+			agent_id = ?
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// エージェントIDから返金規定を入社からの日数の短い順に取得
+func (repo *AgentRefundTierRepositoryImpl) GetByAgentID(agentID uint) ([]*entity.AgentRefundTier, error) {
+	var (
+		tierList []*entity.AgentRefundTier
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentID",
+		&tierList, `
+		SELECT *
+		FROM agent_refund_tiers
+		WHERE
+			agent_id = ?
+		ORDER BY
+			within_days ASC
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return tierList, nil
+}
+
+// 全てのエージェントの返金規定を取得
+func (repo *AgentRefundTierRepositoryImpl) All() ([]*entity.AgentRefundTier, error) {
+	var (
+		tierList []*entity.AgentRefundTier
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".All",
+		&tierList, `
+		SELECT *
+		FROM agent_refund_tiers
+		ORDER BY
+			agent_id ASC, within_days ASC
+		`,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return tierList, nil
+}
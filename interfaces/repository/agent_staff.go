@@ -262,6 +262,34 @@ func (repo *AgentStaffRepositoryImpl) UpdateNotificationUnwatched(id uint, notif
 	return err
 }
 
+func (repo *AgentStaffRepositoryImpl) UpdateSLASetting(id uint, managerStaffID null.Int, notificationSLAReminder, notificationSLADigest bool) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateSLASetting",
+		`
+		UPDATE agent_staffs 
+		SET
+			manager_staff_id = ?,
+			notification_sla_reminder = ?,
+			notification_sla_digest = ?,
+			updated_at = ?
+		WHERE 
+			id = ?
+		`,
+		managerStaffID,
+		notificationSLAReminder,
+		notificationSLADigest,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return err
+}
+
 func (repo *AgentStaffRepositoryImpl) UpdateAuthority(id uint, authority uint) error {
 	_, err := repo.executer.Exec(
 		repo.Name+".UpdateAuthority",
@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type APIKeyRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewAPIKeyRepositoryImpl(ex interfaces.SQLExecuter) usecase.APIKeyRepository {
+	return &APIKeyRepositoryImpl{
+		Name:     "APIKeyRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// APIキーを作成
+func (repo *APIKeyRepositoryImpl) Create(apiKey *entity.APIKey) error {
+	now := time.Now().In(time.UTC)
+	apiKey.UUID = utility.CreateUUID()
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO api_keys (
+				uuid,
+				agent_id,
+				agent_staff_id,
+				name,
+				key_prefix,
+				key_hash,
+				scopes,
+				expires_at,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		apiKey.UUID,
+		apiKey.AgentID,
+		apiKey.AgentStaffID,
+		apiKey.Name,
+		apiKey.KeyPrefix,
+		apiKey.KeyHash,
+		apiKey.Scopes,
+		apiKey.ExpiresAt,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	apiKey.ID = uint(lastID)
+	apiKey.CreatedAt = now
+	apiKey.UpdatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// APIキーを無効化（無効化済みの場合は日時を更新しない）
+func (repo *APIKeyRepositoryImpl) Revoke(id, revokedStaffID uint) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".Revoke",
+		`
+		UPDATE api_keys
+		SET
+			revoked_at = ?,
+			revoked_staff_id = ?,
+			updated_at = ?
+		WHERE
+			id = ? AND
+			revoked_at IS NULL
+		`,
+		now,
+		revokedStaffID,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 最終利用日時と接続元IPアドレスを更新
+func (repo *APIKeyRepositoryImpl) UpdateLastUsed(id uint, lastUsedAt time.Time, ipAddress string) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateLastUsed",
+		`
+		UPDATE api_keys
+		SET
+			last_used_at = ?,
+			last_used_ip = ?
+		WHERE
+			id = ?
+		`,
+		lastUsedAt,
+		ipAddress,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// IDからAPIキーを取得
+func (repo *APIKeyRepositoryImpl) FindByID(id uint) (*entity.APIKey, error) {
+	var (
+		apiKey entity.APIKey
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&apiKey, `
+		SELECT
+			api_key.*,
+			IFNULL(staff.staff_name, '') AS staff_name
+		FROM
+			api_keys AS api_key
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			api_key.agent_staff_id = staff.id
+		WHERE
+			api_key.id = ?
+		LIMIT 1
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+// キーのハッシュからAPIキーを取得（認証で毎回呼ばれるため見つからない場合はログを出さない）
+func (repo *APIKeyRepositoryImpl) FindByKeyHash(keyHash string) (*entity.APIKey, error) {
+	var (
+		apiKey entity.APIKey
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByKeyHash",
+		&apiKey, `
+		SELECT *
+		FROM api_keys
+		WHERE
+			key_hash = ?
+		LIMIT 1
+		`,
+		keyHash,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// エージェントIDからAPIキー一覧を取得
+func (repo *APIKeyRepositoryImpl) GetByAgentID(agentID uint) ([]*entity.APIKey, error) {
+	var (
+		apiKeyList []*entity.APIKey
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentID",
+		&apiKeyList, `
+		SELECT
+			api_key.*,
+			IFNULL(staff.staff_name, '') AS staff_name
+		FROM
+			api_keys AS api_key
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			api_key.agent_staff_id = staff.id
+		WHERE
+			api_key.agent_id = ?
+		ORDER BY api_key.id DESC
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return apiKeyList, nil
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type AuditLogRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewAuditLogRepositoryImpl(ex interfaces.SQLExecuter) usecase.AuditLogRepository {
+	return &AuditLogRepositoryImpl{
+		Name:     "AuditLogRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 監査ログを作成
+func (repo *AuditLogRepositoryImpl) Create(auditLog *entity.AuditLog) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO audit_logs (
+				agent_id,
+				agent_staff_id,
+				action,
+				entity_type,
+				entity_id,
+				diff,
+				method,
+				path,
+				ip_address,
+				user_agent,
+				created_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		auditLog.AgentID,
+		auditLog.AgentStaffID,
+		auditLog.Action,
+		auditLog.EntityType,
+		auditLog.EntityID,
+		auditLog.Diff,
+		auditLog.Method,
+		auditLog.Path,
+		auditLog.IPAddress,
+		auditLog.UserAgent,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	auditLog.ID = uint(lastID)
+	auditLog.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 検索条件から自社の監査ログを取得
+func (repo *AuditLogRepositoryImpl) GetSearchByAgentID(searchParam entity.SearchAuditLogParam) ([]*entity.AuditLog, error) {
+	var (
+		auditLogList []*entity.AuditLog
+		conditions   string
+		args         = []interface{}{searchParam.AgentID}
+	)
+
+	// 操作した担当者の条件
+	if searchParam.AgentStaffID != 0 {
+		conditions += `
+			AND log.agent_staff_id = ?
+		`
+		args = append(args, searchParam.AgentStaffID)
+	}
+
+	// 操作の条件
+	action, err := strconv.Atoi(searchParam.Action)
+	if err == nil {
+		conditions += `
+			AND log.action = ?
+		`
+		args = append(args, action)
+	}
+
+	// 対象の条件
+	if searchParam.EntityType != "" {
+		conditions += `
+			AND log.entity_type = ?
+		`
+		args = append(args, searchParam.EntityType)
+	}
+
+	if searchParam.EntityID != 0 {
+		conditions += `
+			AND log.entity_id = ?
+		`
+		args = append(args, searchParam.EntityID)
+	}
+
+	// 期間の条件
+	if !searchParam.FromDate.IsZero() {
+		conditions += `
+			AND log.created_at >= ?
+		`
+		args = append(args, searchParam.FromDate.In(time.UTC))
+	}
+
+	if !searchParam.ToDate.IsZero() {
+		conditions += `
+			AND log.created_at <= ?
+		`
+		args = append(args, searchParam.ToDate.In(time.UTC))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			log.*,
+			IFNULL(staff.staff_name, '') AS staff_name
+		FROM
+			audit_logs AS log
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			log.agent_staff_id = staff.id
+		WHERE
+			log.agent_id = ?
+		%s
+		ORDER BY log.id DESC
+	`, conditions)
+
+	err = repo.executer.Select(
+		repo.Name+".GetSearchByAgentID",
+		&auditLogList,
+		query,
+		args...,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return auditLogList, nil
+}
@@ -283,6 +283,13 @@ func (repo *ChatGroupWithJobSeekerRepositoryImpl) GetByAgentID(agentID uint) ([]
 		return nil, err
 	}
 
+	for _, chatGroupWithJobSeeker := range chatGroupWithJobSeekerList {
+		err = decryptJobSeekerFields(&chatGroupWithJobSeeker.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return chatGroupWithJobSeekerList, nil
 }
 
@@ -353,6 +360,13 @@ func (repo *ChatGroupWithJobSeekerRepositoryImpl) GetByAgentIDAndFreeWord(agentI
 		return nil, err
 	}
 
+	for _, chatGroupWithJobSeeker := range chatGroupWithJobSeekerList {
+		err = decryptJobSeekerFields(&chatGroupWithJobSeeker.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return chatGroupWithJobSeekerList, nil
 }
 
@@ -405,6 +419,13 @@ func (repo *ChatGroupWithJobSeekerRepositoryImpl) GetActiveJobSeekerByAgentID(ag
 		return nil, err
 	}
 
+	for _, chatGroupWithJobSeeker := range chatGroupWithJobSeekerList {
+		err = decryptJobSeekerFields(&chatGroupWithJobSeeker.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return chatGroupWithJobSeekerList, nil
 }
 
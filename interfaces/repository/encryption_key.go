@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+// テーブル名・カラム名は entity.EncryptedColumnList の固定値のみを使うため、クエリに直接埋め込む
+type EncryptionKeyRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewEncryptionKeyRepositoryImpl(ex interfaces.SQLExecuter) usecase.EncryptionKeyRepository {
+	return &EncryptionKeyRepositoryImpl{
+		Name:     "EncryptionKeyRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 再暗号化した値で更新する（取得後に値が変わっていない場合のみ）
+// 内容は変わらないためupdated_atは更新しない
+func (repo *EncryptionKeyRepositoryImpl) UpdateEncryptedValue(column entity.EncryptedColumn, id uint, before, after string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET
+			%s = ?
+		WHERE
+			id = ? AND
+			%s = ?
+	`, column.Table, column.Column, column.Column)
+
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateEncryptedValue",
+		query,
+		after, id, before,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 保存されている暗号文が参照する鍵のバージョン一覧を取得する
+func (repo *EncryptionKeyRepositoryImpl) GetReferencedKeyVersionList() ([]int, error) {
+	var (
+		versionList []int
+		queryList   []string
+	)
+
+	for _, column := range entity.EncryptedColumnList {
+		queryList = append(queryList, fmt.Sprintf(`
+			SELECT DISTINCT
+				CAST(SUBSTRING_INDEX(SUBSTRING(%s, 2), ':', 1) AS UNSIGNED) AS version
+			FROM
+				%s
+			WHERE
+				%s REGEXP '^v[0-9]+:'
+		`, column.Column, column.Table, column.Column))
+	}
+
+	query := strings.Join(queryList, "UNION") + "ORDER BY version ASC"
+
+	err := repo.executer.Select(
+		repo.Name+".GetReferencedKeyVersionList",
+		&versionList,
+		query,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return versionList, nil
+}
+
+// 指定バージョン以外の鍵で暗号化された値を、指定IDより後からlimit件取得する
+// 暗号化前の平文が残る項目は、バージョンのない値（平文）を対象外にする
+func (repo *EncryptionKeyRepositoryImpl) GetValueListNotEncryptedWithVersion(column entity.EncryptedColumn, version int, afterID uint, limit uint) ([]*entity.EncryptedValue, error) {
+	var (
+		valueList       []*entity.EncryptedValue
+		legacyCondition string
+	)
+
+	if !column.IsLegacyEncrypted {
+		legacyCondition = fmt.Sprintf("AND %s REGEXP '^v[0-9]+:'", column.Column)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id, %s AS value
+		FROM
+			%s
+		WHERE
+			id > ? AND
+			%s != '' AND
+			%s NOT LIKE ?
+			%s
+		ORDER BY id ASC
+		LIMIT ?
+	`, column.Column, column.Table, column.Column, column.Column, legacyCondition)
+
+	err := repo.executer.Select(
+		repo.Name+".GetValueListNotEncryptedWithVersion",
+		&valueList,
+		query,
+		afterID, fmt.Sprintf("v%d:%%", version), limit,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return valueList, nil
+}
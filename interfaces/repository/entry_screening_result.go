@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type EntryScreeningResultRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewEntryScreeningResultRepositoryImpl(ex interfaces.SQLExecuter) usecase.EntryScreeningResultRepository {
+	return &EntryScreeningResultRepositoryImpl{
+		Name:     "EntryScreeningResultRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 選考ルールに該当した求職者を記録
+func (repo *EntryScreeningResultRepositoryImpl) Create(screeningResult *entity.EntryScreeningResult) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO entry_screening_results (
+				agent_id,
+				job_seeker_id,
+				rule_id,
+				tag,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?
+			)
+		`,
+		screeningResult.AgentID,
+		screeningResult.JobSeekerID,
+		screeningResult.RuleID,
+		screeningResult.Tag,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	screeningResult.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 選考ルールごとの該当件数を取得
+func (repo *EntryScreeningResultRepositoryImpl) GetHitCountByAgentID(agentID uint) ([]*entity.EntryScreeningRuleHitCount, error) {
+	var (
+		hitCountList []*entity.EntryScreeningRuleHitCount
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetHitCountByAgentID",
+		&hitCountList, `
+		SELECT
+			rule_id,
+			COUNT(*) AS count
+		FROM entry_screening_results
+		WHERE agent_id = ?
+		GROUP BY rule_id
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return hitCountList, nil
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type EntryScreeningRuleRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewEntryScreeningRuleRepositoryImpl(ex interfaces.SQLExecuter) usecase.EntryScreeningRuleRepository {
+	return &EntryScreeningRuleRepositoryImpl{
+		Name:     "EntryScreeningRuleRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 選考ルールを作成
+func (repo *EntryScreeningRuleRepositoryImpl) Create(screeningRule *entity.EntryScreeningRule) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO entry_screening_rules (
+				agent_id,
+				title,
+				tag,
+				result_phase,
+				result_user_status,
+				is_skip_interview_adjustment,
+				is_active,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		screeningRule.AgentID,
+		screeningRule.Title,
+		screeningRule.Tag,
+		screeningRule.ResultPhase,
+		screeningRule.ResultUserStatus,
+		screeningRule.IsSkipInterviewAdjustment,
+		screeningRule.IsActive,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	screeningRule.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 選考ルールを更新
+func (repo *EntryScreeningRuleRepositoryImpl) Update(id uint, screeningRule *entity.EntryScreeningRule) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".Update",
+		`
+		UPDATE entry_screening_rules
+		SET
+			title = ?,
+			tag = ?,
+			result_phase = ?,
+			result_user_status = ?,
+			is_skip_interview_adjustment = ?,
+			is_active = ?,
+			updated_at = ?
+		WHERE id = ?
+		`,
+		screeningRule.Title,
+		screeningRule.Tag,
+		screeningRule.ResultPhase,
+		screeningRule.ResultUserStatus,
+		screeningRule.IsSkipInterviewAdjustment,
+		screeningRule.IsActive,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 削除 API
+//
+// 選考ルールを論理削除（該当した求職者の履歴は残す）
+func (repo *EntryScreeningRuleRepositoryImpl) Delete(id uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".Delete",
+		`
+		UPDATE entry_screening_rules
+		SET
+			is_deleted = TRUE,
+			updated_at = ?
+		WHERE id = ?
+		`,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// IDから選考ルールを取得
+func (repo *EntryScreeningRuleRepositoryImpl) FindByID(id uint) (*entity.EntryScreeningRule, error) {
+	var (
+		screeningRule entity.EntryScreeningRule
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&screeningRule, `
+		SELECT *
+		FROM entry_screening_rules
+		WHERE
+			id = ? AND
+			is_deleted = FALSE
+		LIMIT 1
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &screeningRule, nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// エージェントの選考ルールを取得
+func (repo *EntryScreeningRuleRepositoryImpl) GetByAgentID(agentID uint) ([]*entity.EntryScreeningRule, error) {
+	var (
+		screeningRuleList []*entity.EntryScreeningRule
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentID",
+		&screeningRuleList, `
+		SELECT *
+		FROM entry_screening_rules
+		WHERE
+			agent_id = ? AND
+			is_deleted = FALSE
+		ORDER BY id ASC
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return screeningRuleList, nil
+}
+
+// エージェントの有効な選考ルールを取得（作成順に判定するためID順）
+func (repo *EntryScreeningRuleRepositoryImpl) GetActiveByAgentID(agentID uint) ([]*entity.EntryScreeningRule, error) {
+	var (
+		screeningRuleList []*entity.EntryScreeningRule
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetActiveByAgentID",
+		&screeningRuleList, `
+		SELECT *
+		FROM entry_screening_rules
+		WHERE
+			agent_id = ? AND
+			is_active = TRUE AND
+			is_deleted = FALSE
+		ORDER BY id ASC
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return screeningRuleList, nil
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type EntryScreeningRuleConditionRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewEntryScreeningRuleConditionRepositoryImpl(ex interfaces.SQLExecuter) usecase.EntryScreeningRuleConditionRepository {
+	return &EntryScreeningRuleConditionRepositoryImpl{
+		Name:     "EntryScreeningRuleConditionRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 選考ルールの条件を作成
+func (repo *EntryScreeningRuleConditionRepositoryImpl) Create(condition *entity.EntryScreeningRuleCondition) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO entry_screening_rule_conditions (
+				rule_id,
+				target,
+				operator,
+				value,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?
+			)
+		`,
+		condition.RuleID,
+		condition.Target,
+		condition.Operator,
+		condition.Value,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	condition.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 削除 API
+//
+// 選考ルールの条件を削除
+func (repo *EntryScreeningRuleConditionRepositoryImpl) DeleteByRuleID(ruleID uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".DeleteByRuleID",
+		`
+		DELETE
+		FROM entry_screening_rule_conditions
+		WHERE rule_id = ?
+		`, ruleID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 選考ルールの条件を取得
+func (repo *EntryScreeningRuleConditionRepositoryImpl) GetByRuleID(ruleID uint) ([]*entity.EntryScreeningRuleCondition, error) {
+	var (
+		conditionList []*entity.EntryScreeningRuleCondition
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByRuleID",
+		&conditionList, `
+		SELECT *
+		FROM entry_screening_rule_conditions
+		WHERE rule_id = ?
+		ORDER BY id ASC
+		`,
+		ruleID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return conditionList, nil
+}
+
+// エージェントの選考ルールの条件を取得
+func (repo *EntryScreeningRuleConditionRepositoryImpl) GetByAgentID(agentID uint) ([]*entity.EntryScreeningRuleCondition, error) {
+	var (
+		conditionList []*entity.EntryScreeningRuleCondition
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentID",
+		&conditionList, `
+		SELECT
+			cond.*
+		FROM
+			entry_screening_rule_conditions AS cond
+		INNER JOIN
+			entry_screening_rules AS rule
+		ON
+			cond.rule_id = rule.id
+		WHERE
+			rule.agent_id = ? AND
+			rule.is_deleted = FALSE
+		ORDER BY cond.id ASC
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return conditionList, nil
+}
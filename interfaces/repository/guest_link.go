@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type GuestLinkRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewGuestLinkRepositoryImpl(ex interfaces.SQLExecuter) usecase.GuestLinkRepository {
+	return &GuestLinkRepositoryImpl{
+		Name:     "GuestLinkRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// ゲスト用リンクを作成
+func (repo *GuestLinkRepositoryImpl) Create(guestLink *entity.GuestLink) error {
+	now := time.Now().In(time.UTC)
+	guestLink.UUID = utility.CreateUUID()
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO guest_links (
+				uuid,
+				agent_id,
+				agent_staff_id,
+				resource_type,
+				resource_uuid,
+				permissions,
+				expires_at,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		guestLink.UUID,
+		guestLink.AgentID,
+		guestLink.AgentStaffID,
+		guestLink.ResourceType,
+		guestLink.ResourceUUID,
+		guestLink.Permissions,
+		guestLink.ExpiresAt,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	guestLink.ID = uint(lastID)
+	guestLink.CreatedAt = now
+	guestLink.UpdatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// ゲスト用リンクを無効化（無効化済みの場合は日時を更新しない）
+func (repo *GuestLinkRepositoryImpl) Revoke(id, revokedStaffID uint) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".Revoke",
+		`
+		UPDATE guest_links
+		SET
+			revoked_at = ?,
+			revoked_staff_id = ?,
+			updated_at = ?
+		WHERE
+			id = ? AND
+			revoked_at IS NULL
+		`,
+		now,
+		revokedStaffID,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// IDからゲスト用リンクを取得
+func (repo *GuestLinkRepositoryImpl) FindByID(id uint) (*entity.GuestLink, error) {
+	var (
+		guestLink entity.GuestLink
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&guestLink, `
+		SELECT
+			link.*,
+			IFNULL(staff.staff_name, '') AS staff_name
+		FROM
+			guest_links AS link
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			link.agent_staff_id = staff.id
+		WHERE
+			link.id = ?
+		LIMIT 1
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &guestLink, nil
+}
+
+// トークンのID（jti）からゲスト用リンクを取得
+func (repo *GuestLinkRepositoryImpl) FindByUUID(linkUUID uuid.UUID) (*entity.GuestLink, error) {
+	var (
+		guestLink entity.GuestLink
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByUUID",
+		&guestLink, `
+		SELECT
+			link.*,
+			IFNULL(staff.staff_name, '') AS staff_name
+		FROM
+			guest_links AS link
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			link.agent_staff_id = staff.id
+		WHERE
+			link.uuid = ?
+		LIMIT 1
+		`,
+		linkUUID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &guestLink, nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// エージェントIDからゲスト用リンク一覧を取得（対象を指定した場合は絞り込む）
+func (repo *GuestLinkRepositoryImpl) GetByAgentID(agentID uint, resourceType string, resourceUUID uuid.UUID) ([]*entity.GuestLink, error) {
+	var (
+		guestLinkList []*entity.GuestLink
+		conditions    string
+		args          = []interface{}{entity.GuestLinkAccessResultSuccess, agentID}
+	)
+
+	if resourceType != "" {
+		conditions += `
+			AND link.resource_type = ?
+		`
+		args = append(args, resourceType)
+	}
+
+	if resourceUUID != uuid.Nil {
+		conditions += `
+			AND link.resource_uuid = ?
+		`
+		args = append(args, resourceUUID)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			link.*,
+			IFNULL(staff.staff_name, '') AS staff_name,
+			(
+				SELECT COUNT(*)
+				FROM guest_link_access_logs AS log
+				WHERE
+					log.guest_link_id = link.id AND
+					log.result = ?
+			) AS access_count
+		FROM
+			guest_links AS link
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			link.agent_staff_id = staff.id
+		WHERE
+			link.agent_id = ?
+		%s
+		ORDER BY link.id DESC
+	`, conditions)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentID",
+		&guestLinkList,
+		query,
+		args...,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return guestLinkList, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type GuestLinkAccessLogRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewGuestLinkAccessLogRepositoryImpl(ex interfaces.SQLExecuter) usecase.GuestLinkAccessLogRepository {
+	return &GuestLinkAccessLogRepositoryImpl{
+		Name:     "GuestLinkAccessLogRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// アクセスログを作成
+func (repo *GuestLinkAccessLogRepositoryImpl) Create(accessLog *entity.GuestLinkAccessLog) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO guest_link_access_logs (
+				guest_link_id,
+				resource_type,
+				resource_uuid,
+				result,
+				ip_address,
+				user_agent,
+				created_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		accessLog.GuestLinkID,
+		accessLog.ResourceType,
+		accessLog.ResourceUUID,
+		accessLog.Result,
+		accessLog.IPAddress,
+		accessLog.UserAgent,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	accessLog.ID = uint(lastID)
+	accessLog.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// ゲスト用リンクIDからアクセスログを取得
+func (repo *GuestLinkAccessLogRepositoryImpl) GetByGuestLinkID(guestLinkID uint) ([]*entity.GuestLinkAccessLog, error) {
+	var (
+		accessLogList []*entity.GuestLinkAccessLog
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByGuestLinkID",
+		&accessLogList, `
+		SELECT *
+		FROM guest_link_access_logs
+		WHERE guest_link_id = ?
+		ORDER BY id DESC
+		`,
+		guestLinkID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return accessLogList, nil
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type InterviewBookingLinkRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewInterviewBookingLinkRepositoryImpl(ex interfaces.SQLExecuter) usecase.InterviewBookingLinkRepository {
+	return &InterviewBookingLinkRepositoryImpl{
+		Name:     "InterviewBookingLinkRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 面談予約リンクを作成
+func (repo *InterviewBookingLinkRepositoryImpl) Create(bookingLink *entity.InterviewBookingLink) error {
+	now := time.Now().In(time.UTC)
+	bookingLink.UUID = utility.CreateUUID()
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO interview_booking_links (
+				uuid,
+				agent_id,
+				job_seeker_id,
+				agent_staff_id,
+				interview_adjustment_template_id,
+				expired_at,
+				is_booked,
+				interview_date,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		bookingLink.UUID,
+		bookingLink.AgentID,
+		bookingLink.JobSeekerID,
+		bookingLink.AgentStaffID,
+		bookingLink.InterviewAdjustmentTemplateID,
+		bookingLink.ExpiredAt,
+		false,
+		bookingLink.InterviewDate,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	bookingLink.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 面談予約リンクを予約済みにする
+func (repo *InterviewBookingLinkRepositoryImpl) UpdateBooked(id uint, interviewDate time.Time) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateBooked",
+		`
+		UPDATE interview_booking_links
+		SET
+			is_booked = TRUE,
+			interview_date = ?,
+			updated_at = ?
+		WHERE id = ?
+		`,
+		interviewDate,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// UUIDから面談予約リンクを取得
+func (repo *InterviewBookingLinkRepositoryImpl) FindByUUID(bookingUUID uuid.UUID) (*entity.InterviewBookingLink, error) {
+	var (
+		bookingLink entity.InterviewBookingLink
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByUUID",
+		&bookingLink, `
+		SELECT
+			link.*,
+			seeker.last_name,
+			seeker.first_name,
+			IFNULL(staff.staff_name, '') AS staff_name,
+			agent.agent_name
+		FROM
+			interview_booking_links AS link
+		INNER JOIN
+			job_seekers AS seeker
+		ON
+			link.job_seeker_id = seeker.id
+		INNER JOIN
+			agents AS agent
+		ON
+			link.agent_id = agent.id
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			link.agent_staff_id = staff.id
+		WHERE
+			link.uuid = ?
+		LIMIT 1
+		`,
+		bookingUUID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &bookingLink, nil
+}
+
+// UUIDから面談予約リンクを取得して行をロック
+// 結合した担当CAの行もロックされるため、同じ担当CAの枠への同時予約は後の予約が待たされる
+func (repo *InterviewBookingLinkRepositoryImpl) FindByUUIDForUpdate(bookingUUID uuid.UUID) (*entity.InterviewBookingLink, error) {
+	var (
+		bookingLink entity.InterviewBookingLink
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByUUIDForUpdate",
+		&bookingLink, `
+		SELECT
+			link.*,
+			seeker.last_name,
+			seeker.first_name,
+			IFNULL(staff.staff_name, '') AS staff_name,
+			agent.agent_name
+		FROM
+			interview_booking_links AS link
+		INNER JOIN
+			job_seekers AS seeker
+		ON
+			link.job_seeker_id = seeker.id
+		INNER JOIN
+			agents AS agent
+		ON
+			link.agent_id = agent.id
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			link.agent_staff_id = staff.id
+		WHERE
+			link.uuid = ?
+		LIMIT 1
+		FOR UPDATE
+		`,
+		bookingUUID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &bookingLink, nil
+}
@@ -145,6 +145,11 @@ func (repo *InterviewTaskRepositoryImpl) FindByID(id uint) (*entity.InterviewTas
 		return nil, err
 	}
 
+	err = decryptJobSeekerFields(&interviewTask.Email)
+	if err != nil {
+		return nil, err
+	}
+
 	return &interviewTask, nil
 }
 
@@ -302,6 +307,13 @@ func (repo *InterviewTaskRepositoryImpl) GetLatestAdjustmentByAgentID(agentID ui
 		return nil, err
 	}
 
+	for _, interviewTask := range interviewTaskList {
+		err = decryptJobSeekerFields(&interviewTask.Email, &interviewTask.PhoneNumber)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return interviewTaskList, nil
 }
 
@@ -375,5 +387,73 @@ func (repo *InterviewTaskRepositoryImpl) GetLatestConfirmationByAgentID(agentID
 		return nil, err
 	}
 
+	for _, interviewTask := range interviewTaskList {
+		err = decryptJobSeekerFields(&interviewTask.Email, &interviewTask.PhoneNumber)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return interviewTaskList, nil
+}
+
+// 全エージェントの対応中（phaseが「0 ~ 3」）の最新の面談調整のタスクを取得（期限の通知用）
+func (repo *InterviewTaskRepositoryImpl) GetLatestActive() ([]*entity.InterviewTask, error) {
+	var (
+		interviewTaskList []*entity.InterviewTask
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetLatestActive",
+		&interviewTaskList, `
+		SELECT 
+			task.*, ca_staff.id AS ca_staff_id, IFNULL(ca_staff.staff_name, '') AS ca_staff_name,
+			task_group.agent_id, task_group.job_seeker_id,
+			agent.uuid AS agent_uuid, agent.agent_name,
+			seeker.uuid AS job_seeker_uuid, seeker.last_name, seeker.first_name, 
+			IFNULL(staff.staff_name, '') AS staff_name
+		FROM 
+			interview_tasks AS task 
+		INNER JOIN
+			interview_task_groups AS task_group
+		ON
+			task_group.id = task.interview_task_group_id
+		INNER JOIN
+			agents AS agent
+		ON
+			agent.id = task_group.agent_id
+		INNER JOIN
+			job_seekers AS seeker
+		ON
+			seeker.id = task_group.job_seeker_id
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			task.agent_staff_id = staff.id
+		LEFT OUTER JOIN
+			agent_staffs AS ca_staff
+		ON
+			seeker.agent_staff_id = ca_staff.id
+		WHERE
+			task.id = (
+				SELECT task_join.id
+				FROM interview_tasks AS task_join 
+				WHERE 
+					task.interview_task_group_id = task_join.interview_task_group_id
+				ORDER BY 
+					task_join.created_at DESC, task_join.id DESC
+				LIMIT 1
+			)
+		AND
+			task.phase_category IN (0, 1, 2, 3)
+		ORDER BY task.deadline_day ASC, task.deadline_time ASC
+		`,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
 	return interviewTaskList, nil
 }
@@ -0,0 +1,326 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type JobOfferRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewJobOfferRepositoryImpl(ex interfaces.SQLExecuter) usecase.JobOfferRepository {
+	return &JobOfferRepositoryImpl{
+		Name:     "JobOfferRepository",
+		executer: ex,
+	}
+}
+
+// 内定と選考（求職者・求人・企業・CA/RA担当者）の情報
+var jobOfferSelect = `
+	SELECT
+		job_offer.*,
+		task_group.job_seeker_id, task_group.job_information_id,
+		seeker.last_name, seeker.first_name,
+		ca_staff.id AS ca_staff_id, ca_staff.agent_id AS ca_agent_id,
+		ra_staff.id AS ra_staff_id, ra_staff.agent_id AS ra_agent_id,
+		CASE
+			WHEN job_info.is_external = TRUE AND task_group.external_job_information_title != ''
+			THEN task_group.external_job_information_title
+			ELSE job_info.title
+		END AS title,
+		CASE
+			WHEN job_info.is_external = TRUE AND task_group.external_company_name != ''
+			THEN task_group.external_company_name
+			ELSE enterprise.company_name
+		END AS company_name
+	FROM
+		job_offers AS job_offer
+	INNER JOIN
+		task_groups AS task_group
+	ON
+		job_offer.task_group_id = task_group.id
+	INNER JOIN
+		job_seekers AS seeker
+	ON
+		seeker.id = task_group.job_seeker_id
+	INNER JOIN
+		job_informations AS job_info
+	ON
+		job_info.id = task_group.job_information_id
+	INNER JOIN
+		billing_addresses AS billing
+	ON
+		job_info.billing_address_id = billing.id
+	INNER JOIN
+		enterprise_profiles AS enterprise
+	ON
+		billing.enterprise_id = enterprise.id
+	INNER JOIN
+		agent_staffs AS ca_staff
+	ON
+		seeker.agent_staff_id = ca_staff.id
+	INNER JOIN
+		agent_staffs AS ra_staff
+	ON
+		billing.agent_staff_id = ra_staff.id
+`
+
+/****************************************************************************************/
+// 作成 API
+//
+// 内定を作成
+func (repo *JobOfferRepositoryImpl) Create(offer *entity.JobOffer) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO job_offers (
+				task_group_id,
+				position,
+				employment_status,
+				work_location,
+				annual_salary,
+				base_salary,
+				fixed_overtime_pay,
+				fixed_overtime_hours,
+				allowance,
+				bonus,
+				salary_remarks,
+				start_date,
+				answer_deadline,
+				status,
+				remarks,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		offer.TaskGroupID,
+		offer.Position,
+		offer.EmploymentStatus,
+		offer.WorkLocation,
+		offer.AnnualSalary,
+		offer.BaseSalary,
+		offer.FixedOvertimePay,
+		offer.FixedOvertimeHours,
+		offer.Allowance,
+		offer.Bonus,
+		offer.SalaryRemarks,
+		offer.StartDate,
+		offer.AnswerDeadline,
+		offer.Status,
+		offer.Remarks,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	offer.ID = uint(lastID)
+	offer.CreatedAt = now
+	offer.UpdatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 内定の条件を更新（状態は UpdateStatus で更新する）
+func (repo *JobOfferRepositoryImpl) Update(id uint, offer *entity.JobOffer) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".Update",
+		`
+		UPDATE job_offers
+		SET
+			position = ?,
+			employment_status = ?,
+			work_location = ?,
+			annual_salary = ?,
+			base_salary = ?,
+			fixed_overtime_pay = ?,
+			fixed_overtime_hours = ?,
+			allowance = ?,
+			bonus = ?,
+			salary_remarks = ?,
+			start_date = ?,
+			answer_deadline = ?,
+			remarks = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		offer.Position,
+		offer.EmploymentStatus,
+		offer.WorkLocation,
+		offer.AnnualSalary,
+		offer.BaseSalary,
+		offer.FixedOvertimePay,
+		offer.FixedOvertimeHours,
+		offer.Allowance,
+		offer.Bonus,
+		offer.SalaryRemarks,
+		offer.StartDate,
+		offer.AnswerDeadline,
+		offer.Remarks,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	offer.UpdatedAt = now
+
+	return nil
+}
+
+// 内定の状態を更新
+func (repo *JobOfferRepositoryImpl) UpdateStatus(id uint, status int64) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateStatus",
+		`
+		UPDATE job_offers
+		SET
+			status = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		status,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+func (repo *JobOfferRepositoryImpl) FindByID(id uint) (*entity.JobOffer, error) {
+	var (
+		offer entity.JobOffer
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&offer,
+		jobOfferSelect+`
+		WHERE
+			job_offer.id = ?
+		LIMIT 1
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &offer, nil
+}
+
+// タスクグループIDから内定を取得
+func (repo *JobOfferRepositoryImpl) FindByTaskGroupID(taskGroupID uint) (*entity.JobOffer, error) {
+	var (
+		offer entity.JobOffer
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByTaskGroupID",
+		&offer,
+		jobOfferSelect+`
+		WHERE
+			job_offer.task_group_id = ?
+		LIMIT 1
+		`,
+		taskGroupID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &offer, nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 求職者の全ての選考の内定を取得
+func (repo *JobOfferRepositoryImpl) GetByJobSeekerID(jobSeekerID uint) ([]*entity.JobOffer, error) {
+	var (
+		offerList []*entity.JobOffer
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByJobSeekerID",
+		&offerList,
+		jobOfferSelect+`
+		WHERE
+			task_group.job_seeker_id = ?
+		ORDER BY
+			job_offer.id ASC
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return offerList, nil
+}
+
+// 回答期限が指定日時までの回答待ち（提示中・条件交渉中）の内定を取得
+func (repo *JobOfferRepositoryImpl) GetOpenByAnswerDeadlineUntil(until time.Time) ([]*entity.JobOffer, error) {
+	var (
+		offerList []*entity.JobOffer
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetOpenByAnswerDeadlineUntil",
+		&offerList,
+		jobOfferSelect+`
+		WHERE
+			job_offer.status IN (?, ?)
+		AND
+			job_offer.answer_deadline IS NOT NULL
+		AND
+			job_offer.answer_deadline <= ?
+		ORDER BY
+			job_offer.answer_deadline ASC
+		`,
+		entity.JobOfferStatusOffered,
+		entity.JobOfferStatusNegotiating,
+		until.In(time.UTC),
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return offerList, nil
+}
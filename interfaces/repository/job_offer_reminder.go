@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type JobOfferReminderRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewJobOfferReminderRepositoryImpl(ex interfaces.SQLExecuter) usecase.JobOfferReminderRepository {
+	return &JobOfferReminderRepositoryImpl{
+		Name:     "JobOfferReminderRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 送信した通知を記録（同じ回答期限・同じ通知の記録がある場合は何もしない）
+func (repo *JobOfferReminderRepositoryImpl) Create(reminder *entity.JobOfferReminder) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT IGNORE INTO job_offer_reminders (
+				job_offer_id,
+				agent_staff_id,
+				reminder_type,
+				answer_deadline,
+				created_at
+			) VALUES (
+				?, ?, ?, ?, ?
+			)
+		`,
+		reminder.JobOfferID,
+		reminder.AgentStaffID,
+		reminder.ReminderType,
+		reminder.AnswerDeadline.In(time.UTC),
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	reminder.ID = uint(lastID)
+	reminder.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 内定IDリストから送信済みの通知を取得
+func (repo *JobOfferReminderRepositoryImpl) GetByJobOfferIDList(jobOfferIDList []uint) ([]*entity.JobOfferReminder, error) {
+	var (
+		reminderList []*entity.JobOfferReminder
+	)
+
+	if len(jobOfferIDList) == 0 {
+		return reminderList, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT *
+		FROM job_offer_reminders
+		WHERE
+			job_offer_id IN (%s)
+		`,
+		strings.Trim(strings.Join(strings.Fields(fmt.Sprint(jobOfferIDList)), ", "), "[]"),
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByJobOfferIDList",
+		&reminderList,
+		query,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return reminderList, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type JobOfferStatusHistoryRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewJobOfferStatusHistoryRepositoryImpl(ex interfaces.SQLExecuter) usecase.JobOfferStatusHistoryRepository {
+	return &JobOfferStatusHistoryRepositoryImpl{
+		Name:     "JobOfferStatusHistoryRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 状態の変更を記録
+func (repo *JobOfferStatusHistoryRepositoryImpl) Create(history *entity.JobOfferStatusHistory) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO job_offer_status_histories (
+				job_offer_id,
+				prev_status,
+				status,
+				agent_staff_id,
+				remarks,
+				created_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?
+			)
+		`,
+		history.JobOfferID,
+		history.PrevStatus,
+		history.Status,
+		history.AgentStaffID,
+		history.Remarks,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	history.ID = uint(lastID)
+	history.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 内定の状態の変更履歴を発生順に取得
+func (repo *JobOfferStatusHistoryRepositoryImpl) GetByJobOfferID(jobOfferID uint) ([]*entity.JobOfferStatusHistory, error) {
+	var (
+		historyList []*entity.JobOfferStatusHistory
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByJobOfferID",
+		&historyList, `
+		SELECT
+			history.*,
+			IFNULL(staff.staff_name, '') AS staff_name
+		FROM
+			job_offer_status_histories AS history
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			history.agent_staff_id = staff.id
+		WHERE
+			history.job_offer_id = ?
+		ORDER BY
+			history.id ASC
+		`,
+		jobOfferID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return historyList, nil
+}
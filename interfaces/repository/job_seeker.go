@@ -33,6 +33,11 @@ func NewJobSeekerRepositoryImpl(ex interfaces.SQLExecuter) usecase.JobSeekerRepo
 func (repo *JobSeekerRepositoryImpl) Create(jobSeeker *entity.JobSeeker) error {
 	jobSeeker.UUID = utility.CreateUUID()
 
+	encrypted, err := encryptJobSeeker(jobSeeker)
+	if err != nil {
+		return err
+	}
+
 	lastID, err := repo.executer.Exec(
 		repo.Name+".Create",
 		`INSERT INTO job_seekers (
@@ -118,12 +123,12 @@ func (repo *JobSeekerRepositoryImpl) Create(jobSeeker *entity.JobSeeker) error {
 		jobSeeker.Spouse,
 		jobSeeker.SupportObligation,
 		jobSeeker.Dependents,
-		jobSeeker.PhoneNumber,
-		jobSeeker.Email,
+		encrypted.PhoneNumber,
+		encrypted.Email,
 		jobSeeker.EmergencyPhoneNumber,
 		jobSeeker.PostCode,
 		jobSeeker.Prefecture,
-		jobSeeker.Address,
+		encrypted.Address,
 		jobSeeker.AddressFurigana,
 		jobSeeker.StateOfEmployment,
 		jobSeeker.JobSummary,
@@ -144,7 +149,7 @@ func (repo *JobSeekerRepositoryImpl) Create(jobSeeker *entity.JobSeeker) error {
 		jobSeeker.Thinking,
 		jobSeeker.RecommendationProfile,
 		jobSeeker.CandidProfile,
-		jobSeeker.SecretMemo,
+		encrypted.SecretMemo,
 		jobSeeker.JobHuntingState,
 		jobSeeker.RecommendReason,
 		jobSeeker.Phase,
@@ -157,7 +162,7 @@ func (repo *JobSeekerRepositoryImpl) Create(jobSeeker *entity.JobSeeker) error {
 		jobSeeker.PowerPointSkill,
 		jobSeeker.InflowChannelID,
 		jobSeeker.NationalityRemarks,
-		jobSeeker.MedicalHistoryRemarks,
+		encrypted.MedicalHistoryRemarks,
 		jobSeeker.AcceptancePoints,
 		"",
 		time.Now().In(time.UTC),
@@ -170,6 +175,12 @@ func (repo *JobSeekerRepositoryImpl) Create(jobSeeker *entity.JobSeeker) error {
 	}
 
 	jobSeeker.ID = uint(lastID)
+
+	err = repo.updateBlindIndex(jobSeeker.ID, jobSeeker)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -178,7 +189,12 @@ func (repo *JobSeekerRepositoryImpl) Create(jobSeeker *entity.JobSeeker) error {
 //
 // agreementは更新しない
 func (repo *JobSeekerRepositoryImpl) Update(id uint, jobSeeker *entity.JobSeeker) error {
-	_, err := repo.executer.Exec(
+	encrypted, err := encryptJobSeeker(jobSeeker)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.executer.Exec(
 		repo.Name+".Update",
 		`
 		UPDATE job_seekers
@@ -250,12 +266,12 @@ func (repo *JobSeekerRepositoryImpl) Update(id uint, jobSeeker *entity.JobSeeker
 		jobSeeker.Spouse,
 		jobSeeker.SupportObligation,
 		jobSeeker.Dependents,
-		jobSeeker.PhoneNumber,
-		jobSeeker.Email,
+		encrypted.PhoneNumber,
+		encrypted.Email,
 		jobSeeker.EmergencyPhoneNumber,
 		jobSeeker.PostCode,
 		jobSeeker.Prefecture,
-		jobSeeker.Address,
+		encrypted.Address,
 		jobSeeker.AddressFurigana,
 		jobSeeker.StateOfEmployment,
 		jobSeeker.JobSummary,
@@ -276,7 +292,7 @@ func (repo *JobSeekerRepositoryImpl) Update(id uint, jobSeeker *entity.JobSeeker
 		jobSeeker.Thinking,
 		jobSeeker.RecommendationProfile,
 		jobSeeker.CandidProfile,
-		jobSeeker.SecretMemo,
+		encrypted.SecretMemo,
 		jobSeeker.JobHuntingState,
 		jobSeeker.RecommendReason,
 		jobSeeker.Phase,
@@ -288,7 +304,7 @@ func (repo *JobSeekerRepositoryImpl) Update(id uint, jobSeeker *entity.JobSeeker
 		jobSeeker.PowerPointSkill,
 		jobSeeker.InflowChannelID,
 		jobSeeker.NationalityRemarks,
-		jobSeeker.MedicalHistoryRemarks,
+		encrypted.MedicalHistoryRemarks,
 		jobSeeker.AcceptancePoints,
 		time.Now().In(time.UTC),
 		id,
@@ -299,7 +315,12 @@ func (repo *JobSeekerRepositoryImpl) Update(id uint, jobSeeker *entity.JobSeeker
 		return err
 	}
 
-	return err
+	err = repo.updateBlindIndex(id, jobSeeker)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // 開発環境で実行時にテストユーザーの名前で更新する
@@ -312,7 +333,13 @@ func (repo *JobSeekerRepositoryImpl) UpdateForDev(id uint) error {
 		phoneNumber   = "080-0000-0000"
 	)
 
-	_, err := repo.executer.Exec(
+	encryptedPhoneNumber, err := utility.EncryptString(phoneNumber)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	_, err = repo.executer.Exec(
 		repo.Name+".UpdateForDev",
 		`
 		UPDATE job_seekers
@@ -329,7 +356,7 @@ func (repo *JobSeekerRepositoryImpl) UpdateForDev(id uint) error {
 		firstName,
 		lastFurigana,
 		firstFurigana,
-		phoneNumber,
+		encryptedPhoneNumber,
 		id,
 	)
 
@@ -338,7 +365,18 @@ func (repo *JobSeekerRepositoryImpl) UpdateForDev(id uint) error {
 		return err
 	}
 
-	return err
+	err = repo.updateBlindIndex(id, &entity.JobSeeker{
+		LastName:      lastName,
+		FirstName:     firstName,
+		LastFurigana:  lastFurigana,
+		FirstFurigana: firstFurigana,
+		PhoneNumber:   phoneNumber,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // 個人情報同意の更新
@@ -512,6 +550,37 @@ func (repo *JobSeekerRepositoryImpl) UpdateAcceptancePoints(id uint, acceptanceP
 	return err
 }
 
+// メモを更新 *求職者の統合時に使用
+func (repo *JobSeekerRepositoryImpl) UpdateSecretMemo(id uint, secretMemo string) error {
+	encryptedSecretMemo, err := utility.EncryptString(secretMemo)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	_, err = repo.executer.Exec(
+		repo.Name+".UpdateSecretMemo",
+		`
+		UPDATE job_seekers
+		SET
+			secret_memo = ?,
+			updated_at = ?
+		WHERE 
+			id = ?
+		`,
+		encryptedSecretMemo,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
 // LINEIDを更新
 func (repo *JobSeekerRepositoryImpl) UpdateLineIDByUUID(uuid uuid.UUID, lineID string) error {
 	_, err := repo.executer.Exec(
@@ -587,7 +656,17 @@ func (repo *JobSeekerRepositoryImpl) UpdateResetPasswordToken(uuid uuid.UUID, re
 
 // uuidを使って電話番号を更新（LPでのみ使用）
 func (repo *JobSeekerRepositoryImpl) UpdatePhoneNumberByUUID(uuid uuid.UUID, phoneNumber string) error {
-	_, err := repo.executer.Exec(
+	var (
+		jobSeekerID uint
+	)
+
+	encryptedPhoneNumber, err := utility.EncryptString(phoneNumber)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	_, err = repo.executer.Exec(
 		repo.Name+".UpdatePhoneNumberByUUID",
 		`
 			UPDATE 
@@ -598,7 +677,7 @@ func (repo *JobSeekerRepositoryImpl) UpdatePhoneNumberByUUID(uuid uuid.UUID, pho
 			WHERE 
 				uuid = ?
 		`,
-		phoneNumber,
+		encryptedPhoneNumber,
 		time.Now().In(time.UTC),
 		uuid,
 	)
@@ -608,6 +687,23 @@ func (repo *JobSeekerRepositoryImpl) UpdatePhoneNumberByUUID(uuid uuid.UUID, pho
 		return err
 	}
 
+	err = repo.executer.Get(
+		repo.Name+".UpdatePhoneNumberByUUID.FindID",
+		&jobSeekerID,
+		"SELECT id FROM job_seekers WHERE uuid = ?",
+		uuid,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	err = repo.updateBlindIndexByType(jobSeekerID, entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(phoneNumber))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -663,6 +759,117 @@ func (repo *JobSeekerRepositoryImpl) UpdateCanViewMatchingJob(id uint, canView b
 	return nil
 }
 
+// 個人情報を暗号化して更新し、ブラインドインデックスを作り直す（暗号化前に登録された求職者の移行に使用）
+// 内容は変わらないためupdated_atは更新せず、移行済みとしてpersonal_information_encrypted_atを記録する
+func (repo *JobSeekerRepositoryImpl) UpdatePersonalInformation(id uint, jobSeeker *entity.JobSeeker) error {
+	encrypted, err := encryptJobSeeker(jobSeeker)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.executer.Exec(
+		repo.Name+".UpdatePersonalInformation",
+		`
+			UPDATE 
+				job_seekers
+			SET 
+				phone_number = ?,
+				email = ?,
+				address = ?,
+				secret_memo = ?,
+				medical_history_remarks = ?,
+				personal_information_encrypted_at = ?
+			WHERE 
+				id = ?
+		`,
+		encrypted.PhoneNumber,
+		encrypted.Email,
+		encrypted.Address,
+		encrypted.SecretMemo,
+		encrypted.MedicalHistoryRemarks,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	err = repo.updateBlindIndex(id, jobSeeker)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// 個人情報の項目を消去して匿名化する
+// 性別・都道府県・年収・フェーズなど個人を特定できない項目は集計のために残す
+// uuidを振り直し、ゲストページ・LPのURLとLINE連携を無効にする
+func (repo *JobSeekerRepositoryImpl) Anonymize(id uint) error {
+	now := time.Now().In(time.UTC)
+
+	_, err := repo.executer.Exec(
+		repo.Name+".Anonymize",
+		`
+			UPDATE
+				job_seekers
+			SET
+				uuid = ?,
+				line_id = '',
+				last_name = '削除済み',
+				first_name = '求職者',
+				last_furigana = '',
+				first_furigana = '',
+				gender_remarks = '',
+				birthday = '',
+				phone_number = '',
+				email = '',
+				emergency_phone_number = '',
+				post_code = '',
+				address = '',
+				address_furigana = '',
+				job_summary = '',
+				history_supplement = '',
+				research_content = '',
+				transfer_requirement = '',
+				short_resignation_remarks = '',
+				recommendation_profile = '',
+				candid_profile = '',
+				secret_memo = '',
+				recommend_reason = '',
+				activity_memo = '',
+				nationality_remarks = '',
+				medical_history_remarks = '',
+				acceptance_points = '',
+				password = '',
+				reset_password_token = '',
+				anonymized_at = ?,
+				updated_at = ?
+			WHERE
+				id = ?
+		`,
+		utility.CreateUUID(),
+		now,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	// 電話番号・メールアドレス・氏名で検索されないようにブラインドインデックスを削除する
+	err = repo.updateBlindIndex(id, &entity.JobSeeker{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 /****************************************************************************************/
 /// 削除
 //
@@ -737,7 +944,7 @@ func (repo *JobSeekerRepositoryImpl) FindByID(id uint) (*entity.JobSeeker, error
 		return nil, err
 	}
 
-	return &jobSeeker, nil
+	return decryptJobSeeker(&jobSeeker)
 }
 
 func (repo *JobSeekerRepositoryImpl) FindByUUID(jobSeekerUUID uuid.UUID) (*entity.JobSeeker, error) {
@@ -763,7 +970,7 @@ func (repo *JobSeekerRepositoryImpl) FindByUUID(jobSeekerUUID uuid.UUID) (*entit
 		return nil, err
 	}
 
-	return &jobSeeker, nil
+	return decryptJobSeeker(&jobSeeker)
 }
 
 func (repo *JobSeekerRepositoryImpl) FindByTaskGroupUUID(taskGroupUUID uuid.UUID) (*entity.JobSeeker, error) {
@@ -793,7 +1000,7 @@ func (repo *JobSeekerRepositoryImpl) FindByTaskGroupUUID(taskGroupUUID uuid.UUID
 		return nil, err
 	}
 
-	return &jobSeeker, nil
+	return decryptJobSeeker(&jobSeeker)
 }
 
 func (repo *JobSeekerRepositoryImpl) FindByAgentIDAndLineID(agentID uint, lineID string) (*entity.JobSeeker, error) {
@@ -819,7 +1026,7 @@ func (repo *JobSeekerRepositoryImpl) FindByAgentIDAndLineID(agentID uint, lineID
 		return nil, err
 	}
 
-	return &jobSeeker, nil
+	return decryptJobSeeker(&jobSeeker)
 }
 
 // 送客の重複登録判定（System管理の求職者内で重複チェックするため）
@@ -841,24 +1048,27 @@ func (repo *JobSeekerRepositoryImpl) FindByNameAndPhoneNumberBySystemAgent(first
 			seeker.last_name = ? AND
 			seeker.first_furigana = ? AND
 			seeker.last_furigana = ? AND
-			seeker.phone_number = ?
+			seeker.id IN (
+				SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?
+			)
 		LIMIT 1
 		`,
 		firstName,
 		lastName,
 		firstFurigana,
 		lastFurigana,
-		phoneNumber,
+		entity.JobSeekerBlindIndexPhoneNumber,
+		utility.BlindIndexPhoneNumber(phoneNumber),
 	)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &jobSeeker, nil
+	return decryptJobSeeker(&jobSeeker)
 }
 
-// ハイフンの有無に関わらず取得するためにREPLACEを使用
+// ハイフンの有無に関わらず取得するためにブラインドインデックス（正規化済み）を使用
 func (repo *JobSeekerRepositoryImpl) FindByPhoneNumberForLP(phoneNumber string, agentID uint) (*entity.JobSeeker, error) {
 	var (
 		jobSeeker entity.JobSeeker
@@ -872,21 +1082,23 @@ func (repo *JobSeekerRepositoryImpl) FindByPhoneNumberForLP(phoneNumber string,
 		FROM 
 			job_seekers
 		WHERE
-			REPLACE(phone_number, '-', '') = ?
+			id IN (
+				SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?
+			)
 		AND
 			agent_id = ?
 		ORDER BY 
 			id DESC
 		LIMIT 1
 		`,
-		phoneNumber, agentID,
+		entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(phoneNumber), agentID,
 	)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &jobSeeker, nil
+	return decryptJobSeeker(&jobSeeker)
 }
 
 func (repo *JobSeekerRepositoryImpl) FindByEmailForLP(email string, agentID uint) (*entity.JobSeeker, error) {
@@ -902,21 +1114,23 @@ func (repo *JobSeekerRepositoryImpl) FindByEmailForLP(email string, agentID uint
 		FROM 
 			job_seekers
 		WHERE
-			email = ?
+			id IN (
+				SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?
+			)
 		AND
 			agent_id = ?
 		ORDER BY 
 			id DESC
 		LIMIT 1
 		`,
-		email, agentID,
+		entity.JobSeekerBlindIndexEmail, utility.BlindIndexEmail(email), agentID,
 	)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &jobSeeker, nil
+	return decryptJobSeeker(&jobSeeker)
 }
 
 func (repo *JobSeekerRepositoryImpl) FindByResetPasswordTokenForLP(resetPasswordToken string, agentID uint) (*entity.JobSeeker, error) {
@@ -944,7 +1158,7 @@ func (repo *JobSeekerRepositoryImpl) FindByResetPasswordTokenForLP(resetPassword
 		return nil, err
 	}
 
-	return &jobSeeker, nil
+	return decryptJobSeeker(&jobSeeker)
 }
 
 /****************************************************************************************/
@@ -995,7 +1209,7 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentID(agentID uint) ([]*entity.JobSe
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 // agentIDから3日以内に登録された求職者一覧を取得
@@ -1024,7 +1238,7 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentIDWithinTwoDays(agentID uint) ([]
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 // 求職者IDリストに合致する求職者の一覧を取得
@@ -1079,7 +1293,7 @@ func (repo *JobSeekerRepositoryImpl) GetByIDList(idList []uint) ([]*entity.JobSe
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 // 求職者IDリストに合致する求職者の一覧を取得
@@ -1130,7 +1344,7 @@ func (repo *JobSeekerRepositoryImpl) GetByIDListForTask(idList []uint) ([]*entit
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 // agentIDから求職者一覧を取得
@@ -1184,7 +1398,7 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentIDAndPage(agentID uint) ([]*entit
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 // agentIDから求職者一覧を取得　アライアンス含む
@@ -1223,9 +1437,9 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentIDAndFreeWordAndAlliance(agentID
 				AND (	
 					CONCAT(seeker.last_name, seeker.first_name) LIKE '%s' OR 
 					CONCAT(seeker.last_furigana, seeker.first_furigana) LIKE '%s' OR
-					seeker.phone_number = '%s'
+					%s
 				)
-			`, freeWordForLike, freeWordForLike, freeWord)
+			`, freeWordForLike, freeWordForLike, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 		}
 
 	} else {
@@ -1233,8 +1447,8 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentIDAndFreeWordAndAlliance(agentID
 		freeWordQuery = fmt.Sprintf(`
 			AND 	
 				seeker.id = %d OR
-				REPLACE(seeker.phone_number, '-', '') = '%s'
-				`, freeWordInt, freeWord)
+				%s
+				`, freeWordInt, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 	}
 	query := fmt.Sprintf(
 		`
@@ -1287,7 +1501,7 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentIDAndFreeWordAndAlliance(agentID
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 // agentStaffIDとサービス終了ではないから求職者一覧を取得
@@ -1317,7 +1531,7 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentStaffIDAndNotRelease(agentID uint
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 // 引数の値で重複する求職者を取得する
@@ -1339,11 +1553,17 @@ func (repo *JobSeekerRepositoryImpl) GetDuplicateByNameAndFuriganaAndEmailAndPho
 			first_name = ? AND
 			last_furigana = ? AND
 			first_furigana = ? AND
-			email = ? AND
-			phone_number = ?
+			id IN (
+				SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?
+			) AND
+			id IN (
+				SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?
+			)
 		ORDER BY id ASC
 		`,
-		agentID, lastName, firstName, lastFurigana, firstFurigana, email, phoneNumber,
+		agentID, lastName, firstName, lastFurigana, firstFurigana,
+		entity.JobSeekerBlindIndexEmail, utility.BlindIndexEmail(email),
+		entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(phoneNumber),
 	)
 
 	if err != nil {
@@ -1351,7 +1571,65 @@ func (repo *JobSeekerRepositoryImpl) GetDuplicateByNameAndFuriganaAndEmailAndPho
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
+}
+
+// 正規化した氏名・フリガナ・電話番号・メールアドレスのいずれかが一致する求職者を取得する *取り込み時の重複判定に使用
+// 空文字の条件は使用しない（全て空文字の場合は空のリストを返す）
+func (repo *JobSeekerRepositoryImpl) GetDuplicateCandidateByAgentID(agentID uint, name, furigana, phoneNumber, email string) ([]*entity.JobSeeker, error) {
+	var (
+		jobSeekerList  []*entity.JobSeeker
+		conditionList  []string
+		conditionValue []interface{}
+	)
+
+	// 氏名・フリガナは全角/半角やひらがな/カタカナの違いをSQLでは吸収できないため、正規化した値のインデックスで照合する
+	if name != "" {
+		conditionList = append(conditionList, "id IN (SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?)")
+		conditionValue = append(conditionValue, entity.JobSeekerBlindIndexName, utility.BlindIndexName(name))
+	}
+	if furigana != "" {
+		conditionList = append(conditionList, "id IN (SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?)")
+		conditionValue = append(conditionValue, entity.JobSeekerBlindIndexFurigana, utility.BlindIndexKana(furigana))
+	}
+	if phoneNumber != "" {
+		conditionList = append(conditionList, "id IN (SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?)")
+		conditionValue = append(conditionValue, entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(phoneNumber))
+	}
+	if email != "" {
+		conditionList = append(conditionList, "id IN (SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?)")
+		conditionValue = append(conditionValue, entity.JobSeekerBlindIndexEmail, utility.BlindIndexEmail(email))
+	}
+
+	if len(conditionList) == 0 {
+		return jobSeekerList, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT 
+			id, uuid, agent_id, last_name, first_name, last_furigana, first_furigana, 
+			birthday, email, phone_number, secret_memo
+		FROM 
+			job_seekers
+		WHERE
+			agent_id = ? AND
+			(%s)
+		ORDER BY id ASC
+	`, strings.Join(conditionList, " OR "))
+
+	err := repo.executer.Select(
+		repo.Name+".GetDuplicateCandidateByAgentID",
+		&jobSeekerList,
+		query,
+		append([]interface{}{agentID}, conditionValue...)...,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 /****************************************************************************************/
@@ -1376,9 +1654,9 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentIDAndFreeWord(agentID uint, freeW
 				AND (	
 					CONCAT(seeker.last_name, seeker.first_name) LIKE '%s' OR 
 					CONCAT(seeker.last_furigana, seeker.first_furigana) LIKE '%s' OR
-					seeker.phone_number = '%s'
+					%s
 				)
-			`, freeWordForLike, freeWordForLike, freeWord)
+			`, freeWordForLike, freeWordForLike, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 		}
 
 	} else {
@@ -1386,8 +1664,8 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentIDAndFreeWord(agentID uint, freeW
 		freeWordQuery = fmt.Sprintf(`
 			AND 	
 				seeker.id = %d OR
-				REPLACE(seeker.phone_number, '-', '') = '%s'
-				`, freeWordInt, freeWord)
+				%s
+				`, freeWordInt, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 	}
 
 	// クエリをまとめる
@@ -1437,7 +1715,7 @@ func (repo *JobSeekerRepositoryImpl) GetByAgentIDAndFreeWord(agentID uint, freeW
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 // agentIDから求職者一覧を取得
@@ -1474,9 +1752,9 @@ func (repo *JobSeekerRepositoryImpl) GetByOtherAgentIDAndFreeWord(agentID uint,
 				AND (	
 					CONCAT(seeker.last_name, seeker.first_name) LIKE '%s' OR 
 					CONCAT(seeker.last_furigana, seeker.first_furigana) LIKE '%s' OR
-					seeker.phone_number = '%s'
+					%s
 				)
-			`, freeWordForLike, freeWordForLike, freeWord)
+			`, freeWordForLike, freeWordForLike, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 		}
 
 	} else {
@@ -1484,8 +1762,8 @@ func (repo *JobSeekerRepositoryImpl) GetByOtherAgentIDAndFreeWord(agentID uint,
 		freeWordQuery = fmt.Sprintf(`
 			AND 	
 				seeker.id = %d OR
-				REPLACE(seeker.phone_number, '-', '') = '%s'
-				`, freeWordInt, freeWord)
+				%s
+				`, freeWordInt, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 	}
 
 	// クエリをまとめる
@@ -1535,7 +1813,7 @@ func (repo *JobSeekerRepositoryImpl) GetByOtherAgentIDAndFreeWord(agentID uint,
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 /****************************************************************************************/
@@ -1558,9 +1836,9 @@ func (repo *JobSeekerRepositoryImpl) GetActiveAllAndFreeWord(agentID uint, freeW
 				AND (	
 					CONCAT(seeker.last_name, seeker.first_name) LIKE '%s' OR 
 					CONCAT(seeker.last_furigana, seeker.first_furigana) LIKE '%s' OR
-					seeker.phone_number = '%s'
+					%s
 				)
-			`, freeWordForLike, freeWordForLike, freeWord)
+			`, freeWordForLike, freeWordForLike, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 		}
 
 	} else {
@@ -1568,8 +1846,8 @@ func (repo *JobSeekerRepositoryImpl) GetActiveAllAndFreeWord(agentID uint, freeW
 		freeWordQuery = fmt.Sprintf(`
 			AND 	
 				seeker.id = %d OR
-				REPLACE(seeker.phone_number, '-', '') = '%s'
-				`, freeWordInt, freeWord)
+				%s
+				`, freeWordInt, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 	}
 
 	/**
@@ -1642,7 +1920,7 @@ func (repo *JobSeekerRepositoryImpl) GetActiveAllAndFreeWord(agentID uint, freeW
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 // 自社のアクティブな求職者
@@ -1662,9 +1940,9 @@ func (repo *JobSeekerRepositoryImpl) GetActiveOwnAndFreeWord(agentID uint, freeW
 				AND (	
 					CONCAT(seeker.last_name, seeker.first_name) LIKE '%s' OR 
 					CONCAT(seeker.last_furigana, seeker.first_furigana) LIKE '%s' OR
-					seeker.phone_number = '%s'
+					%s
 				)
-			`, freeWordForLike, freeWordForLike, freeWord)
+			`, freeWordForLike, freeWordForLike, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 		}
 
 	} else {
@@ -1672,8 +1950,8 @@ func (repo *JobSeekerRepositoryImpl) GetActiveOwnAndFreeWord(agentID uint, freeW
 		freeWordQuery = fmt.Sprintf(`
 			AND 	
 				seeker.id = %d OR
-				REPLACE(seeker.phone_number, '-', '') = '%s'
-				`, freeWordInt, freeWord)
+				%s
+				`, freeWordInt, jobSeekerBlindIndexCondition("seeker.id", entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(freeWord)))
 	}
 
 	/**
@@ -1738,7 +2016,7 @@ func (repo *JobSeekerRepositoryImpl) GetActiveOwnAndFreeWord(agentID uint, freeW
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 /****************************************************************************************/
@@ -1818,7 +2096,7 @@ func (repo *JobSeekerRepositoryImpl) GetReleaseByStaffID(staffID uint) ([]*entit
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 func (repo *JobSeekerRepositoryImpl) GetReleaseByAgentID(agentID uint) ([]*entity.JobSeeker, error) {
@@ -1890,7 +2168,103 @@ func (repo *JobSeekerRepositoryImpl) GetReleaseByAgentID(agentID uint) ([]*entit
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
+}
+
+// 個人情報が暗号化されていない求職者を取得する（暗号化前に登録された求職者の移行に使用）
+// 暗号化済みの値は「v{鍵のバージョン}:」で始まる
+// 氏名・フリガナのブラインドインデックスが未作成の求職者も対象にする
+// 空白のみの氏名などインデックスを作成できない求職者を繰り返し取得しないよう、移行済みの求職者は除く
+func (repo *JobSeekerRepositoryImpl) GetUnencryptedPersonalInformation(limit uint) ([]*entity.JobSeeker, error) {
+	var (
+		jobSeekerList []*entity.JobSeeker
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetUnencryptedPersonalInformation",
+		&jobSeekerList, `
+			SELECT *
+			FROM job_seekers
+			WHERE
+				personal_information_encrypted_at IS NULL AND (
+					(phone_number != '' AND phone_number NOT REGEXP '^v[0-9]+:') OR
+					(email != '' AND email NOT REGEXP '^v[0-9]+:') OR
+					(address != '' AND address NOT REGEXP '^v[0-9]+:') OR
+					(secret_memo != '' AND secret_memo NOT REGEXP '^v[0-9]+:') OR
+					(medical_history_remarks != '' AND medical_history_remarks NOT REGEXP '^v[0-9]+:') OR
+					(
+						anonymized_at IS NULL AND
+						CONCAT(last_name, first_name) != '' AND
+						id NOT IN (SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = 'name')
+					) OR
+					(
+						anonymized_at IS NULL AND
+						CONCAT(last_furigana, first_furigana) != '' AND
+						id NOT IN (SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = 'furigana')
+					)
+				)
+			ORDER BY id ASC
+			LIMIT ?
+		`,
+		limit,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return decryptJobSeekerList(jobSeekerList)
+}
+
+// 指定日時以降に求職者・選考・LINEの更新がない未匿名化の求職者IDを取得する
+func (repo *JobSeekerRepositoryImpl) GetInactiveIDListForRetention(inactiveBefore time.Time, afterID uint, limit uint) ([]uint, error) {
+	var (
+		idList []uint
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetInactiveIDListForRetention",
+		&idList, `
+			SELECT seeker.id
+			FROM job_seekers AS seeker
+			WHERE
+				seeker.id > ? AND
+				seeker.anonymized_at IS NULL AND
+				seeker.updated_at < ? AND
+				NOT EXISTS (
+					SELECT 1
+					FROM task_groups AS tg
+					WHERE
+						tg.job_seeker_id = seeker.id AND
+						tg.updated_at >= ?
+				) AND
+				NOT EXISTS (
+					SELECT 1
+					FROM chat_group_with_job_seekers AS chat
+					WHERE
+						chat.job_seeker_id = seeker.id AND (
+							chat.job_seeker_last_send_at >= ? OR
+							chat.agent_last_send_at >= ?
+						)
+				)
+			ORDER BY seeker.id ASC
+			LIMIT ?
+		`,
+		afterID,
+		inactiveBefore,
+		inactiveBefore,
+		inactiveBefore,
+		inactiveBefore,
+		limit,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return idList, nil
 }
 
 // すべての求職者情報を取得
@@ -1912,7 +2286,7 @@ func (repo *JobSeekerRepositoryImpl) All() ([]*entity.JobSeeker, error) {
 		return nil, err
 	}
 
-	return jobSeekerList, nil
+	return decryptJobSeekerList(jobSeekerList)
 }
 
 /****************************************************************************************/
@@ -1931,9 +2305,11 @@ func (repo *JobSeekerRepositoryImpl) CountByEmail(email string) (float64, error)
 			FROM 
 				job_seekers AS seeker
 			WHERE
-				seeker.email = ?
+				seeker.id IN (
+					SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = ? AND value_hash = ?
+				)
     `,
-		email,
+		entity.JobSeekerBlindIndexEmail, utility.BlindIndexEmail(email),
 	)
 
 	if err != nil {
@@ -1943,3 +2319,209 @@ func (repo *JobSeekerRepositoryImpl) CountByEmail(email string) (float64, error)
 
 	return result.Count, nil
 }
+
+// 担当者ごとの未完了タスク数（面談調整中の求職者数）を取得
+func (repo *JobSeekerRepositoryImpl) GetOpenTaskCountByAgentStaffIDList(agentStaffIDList []uint) ([]*entity.AgentStaffOpenTaskCount, error) {
+	var (
+		openTaskCountList []*entity.AgentStaffOpenTaskCount
+	)
+
+	if len(agentStaffIDList) < 1 {
+		return openTaskCountList, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			seeker.agent_staff_id,
+			COUNT(*) AS count
+		FROM
+			job_seekers AS seeker
+		WHERE
+			seeker.agent_staff_id IN (%s) AND
+			seeker.phase IN (?, ?, ?, ?)
+		GROUP BY
+			seeker.agent_staff_id
+	`,
+		strings.Trim(strings.Join(strings.Fields(fmt.Sprint(agentStaffIDList)), ", "), "[]"))
+
+	err := repo.executer.Select(
+		repo.Name+".GetOpenTaskCountByAgentStaffIDList",
+		&openTaskCountList, query,
+		entity.EntryInterview,
+		entity.InvitationInterview,
+		entity.ReservationInterview,
+		entity.WaitingInterview,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return openTaskCountList, nil
+}
+
+/****************************************************************************************/
+/// 個人情報の暗号化
+//
+// 電話番号・メールアドレス・住所・社内限定メモ・既往歴備考は暗号化して保存し、取得時に復号する
+// 電話番号・メールアドレスの検索と重複判定はブラインドインデックス（job_seeker_blind_indexes）を使う
+
+// 暗号化した個人情報
+type encryptedJobSeekerFields struct {
+	PhoneNumber           string
+	Email                 string
+	Address               string
+	SecretMemo            string
+	MedicalHistoryRemarks string
+}
+
+// 保存用に個人情報を暗号化する（呼び出し元の求職者の値は平文のまま残す）
+func encryptJobSeeker(jobSeeker *entity.JobSeeker) (encryptedJobSeekerFields, error) {
+	var (
+		encrypted encryptedJobSeekerFields
+		err       error
+	)
+
+	for _, field := range []struct {
+		dest  *string
+		plain string
+	}{
+		{&encrypted.PhoneNumber, jobSeeker.PhoneNumber},
+		{&encrypted.Email, jobSeeker.Email},
+		{&encrypted.Address, jobSeeker.Address},
+		{&encrypted.SecretMemo, jobSeeker.SecretMemo},
+		{&encrypted.MedicalHistoryRemarks, jobSeeker.MedicalHistoryRemarks},
+	} {
+		*field.dest, err = utility.EncryptString(field.plain)
+		if err != nil {
+			fmt.Println(err)
+			return encrypted, err
+		}
+	}
+
+	return encrypted, nil
+}
+
+// 取得した求職者の個人情報を復号する
+func decryptJobSeeker(jobSeeker *entity.JobSeeker) (*entity.JobSeeker, error) {
+	err := decryptJobSeekerFields(
+		&jobSeeker.PhoneNumber,
+		&jobSeeker.Email,
+		&jobSeeker.Address,
+		&jobSeeker.SecretMemo,
+		&jobSeeker.MedicalHistoryRemarks,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobSeeker, nil
+}
+
+func decryptJobSeekerList(jobSeekerList []*entity.JobSeeker) ([]*entity.JobSeeker, error) {
+	for _, jobSeeker := range jobSeekerList {
+		_, err := decryptJobSeeker(jobSeeker)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return jobSeekerList, nil
+}
+
+// 他のテーブルと結合して取得した求職者の個人情報を復号する
+func decryptJobSeekerFields(fieldList ...*string) error {
+	var err error
+
+	for _, field := range fieldList {
+		*field, err = utility.DecryptString(*field)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 電話番号・メールアドレスのブラインドインデックスに一致する求職者を絞り込む条件
+// ハッシュ値は16進数のみのため、クエリに直接埋め込んでも問題ない
+func jobSeekerBlindIndexCondition(column, indexType, valueHash string) string {
+	return fmt.Sprintf(
+		"%s IN (SELECT job_seeker_id FROM job_seeker_blind_indexes WHERE index_type = '%s' AND value_hash = '%s')",
+		column, indexType, valueHash,
+	)
+}
+
+// 求職者の電話番号・メールアドレス・氏名・フリガナのブラインドインデックスを更新する
+func (repo *JobSeekerRepositoryImpl) updateBlindIndex(jobSeekerID uint, jobSeeker *entity.JobSeeker) error {
+	for _, index := range []struct {
+		indexType string
+		valueHash string
+	}{
+		{entity.JobSeekerBlindIndexPhoneNumber, utility.BlindIndexPhoneNumber(jobSeeker.PhoneNumber)},
+		{entity.JobSeekerBlindIndexEmail, utility.BlindIndexEmail(jobSeeker.Email)},
+		{entity.JobSeekerBlindIndexName, utility.BlindIndexName(jobSeeker.LastName + jobSeeker.FirstName)},
+		{entity.JobSeekerBlindIndexFurigana, utility.BlindIndexKana(jobSeeker.LastFurigana + jobSeeker.FirstFurigana)},
+	} {
+		err := repo.updateBlindIndexByType(jobSeekerID, index.indexType, index.valueHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 指定項目のブラインドインデックスを作り直す（値が空の場合は削除のみ）
+func (repo *JobSeekerRepositoryImpl) updateBlindIndexByType(jobSeekerID uint, indexType, valueHash string) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".updateBlindIndexByType.Delete",
+		`
+		DELETE
+		FROM job_seeker_blind_indexes
+		WHERE
+			job_seeker_id = ? AND
+			index_type = ?
+		`,
+		jobSeekerID,
+		indexType,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	if valueHash == "" {
+		return nil
+	}
+
+	_, err = repo.executer.Exec(
+		repo.Name+".updateBlindIndexByType.Insert",
+		`
+		INSERT INTO job_seeker_blind_indexes (
+			job_seeker_id,
+			index_type,
+			value_hash,
+			created_at,
+			updated_at
+		) VALUES (
+			?, ?, ?, ?, ?
+		)
+		`,
+		jobSeekerID,
+		indexType,
+		valueHash,
+		time.Now().In(time.UTC),
+		time.Now().In(time.UTC),
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type JobSeekerAssignmentLogRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewJobSeekerAssignmentLogRepositoryImpl(ex interfaces.SQLExecuter) usecase.JobSeekerAssignmentLogRepository {
+	return &JobSeekerAssignmentLogRepositoryImpl{
+		Name:     "JobSeekerAssignmentLogRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 担当CAの割り振り履歴を作成
+func (repo *JobSeekerAssignmentLogRepositoryImpl) Create(assignmentLog *entity.JobSeekerAssignmentLog) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO job_seeker_assignment_logs (
+				agent_id,
+				job_seeker_id,
+				agent_staff_id,
+				previous_agent_staff_id,
+				assignment_type,
+				operated_staff_id,
+				reason,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		assignmentLog.AgentID,
+		assignmentLog.JobSeekerID,
+		assignmentLog.AgentStaffID,
+		assignmentLog.PreviousAgentStaffID,
+		assignmentLog.AssignmentType,
+		assignmentLog.OperatedStaffID,
+		assignmentLog.Reason,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	assignmentLog.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 求職者の担当CAの割り振り履歴を取得
+func (repo *JobSeekerAssignmentLogRepositoryImpl) GetByJobSeekerID(jobSeekerID uint) ([]*entity.JobSeekerAssignmentLog, error) {
+	var (
+		assignmentLogList []*entity.JobSeekerAssignmentLog
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByJobSeekerID",
+		&assignmentLogList, `
+		SELECT
+			log.*,
+			IFNULL(staff.staff_name, '') AS staff_name,
+			IFNULL(previous_staff.staff_name, '') AS previous_staff_name,
+			IFNULL(operated_staff.staff_name, '') AS operated_staff_name
+		FROM
+			job_seeker_assignment_logs AS log
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			log.agent_staff_id = staff.id
+		LEFT OUTER JOIN
+			agent_staffs AS previous_staff
+		ON
+			log.previous_agent_staff_id = previous_staff.id
+		LEFT OUTER JOIN
+			agent_staffs AS operated_staff
+		ON
+			log.operated_staff_id = operated_staff.id
+		WHERE
+			log.job_seeker_id = ?
+		ORDER BY log.id DESC
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return assignmentLogList, nil
+}
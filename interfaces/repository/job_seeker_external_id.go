@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+type JobSeekerExternalIDRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewJobSeekerExternalIDRepositoryImpl(ex interfaces.SQLExecuter) usecase.JobSeekerExternalIDRepository {
+	return &JobSeekerExternalIDRepositoryImpl{
+		Name:     "JobSeekerExternalIDRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 流入元媒体でのIDを作成
+func (repo *JobSeekerExternalIDRepositoryImpl) Create(externalID *entity.JobSeekerExternalID) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO job_seeker_external_ids (
+				job_seeker_id,
+				agent_id,
+				external_type,
+				external_id,
+				inflow_channel_id,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		externalID.JobSeekerID,
+		externalID.AgentID,
+		externalID.ExternalType,
+		externalID.ExternalID,
+		externalID.InflowChannelID,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	externalID.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 求職者の統合時に流入元の紐付け先を変更
+func (repo *JobSeekerExternalIDRepositoryImpl) UpdateJobSeekerIDByJobSeekerID(fromJobSeekerID, toJobSeekerID uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateJobSeekerIDByJobSeekerID",
+		`
+		UPDATE job_seeker_external_ids
+		SET
+			job_seeker_id = ?,
+			updated_at = ?
+		WHERE job_seeker_id = ?
+		`,
+		toJobSeekerID,
+		time.Now().In(time.UTC),
+		fromJobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// 指定エージェントの媒体タイプと媒体でのIDから取得
+func (repo *JobSeekerExternalIDRepositoryImpl) FindByAgentIDAndExternalTypeAndExternalID(agentID uint, externalType null.Int, externalID string) (*entity.JobSeekerExternalID, error) {
+	var (
+		jobSeekerExternalID entity.JobSeekerExternalID
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByAgentIDAndExternalTypeAndExternalID",
+		&jobSeekerExternalID, `
+		SELECT *
+		FROM job_seeker_external_ids
+		WHERE
+			agent_id = ? AND
+			external_type = ? AND
+			external_id = ?
+		LIMIT 1
+		`,
+		agentID, externalType, externalID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &jobSeekerExternalID, nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 指定求職者IDの流入元媒体でのIDを取得
+func (repo *JobSeekerExternalIDRepositoryImpl) GetByJobSeekerID(jobSeekerID uint) ([]*entity.JobSeekerExternalID, error) {
+	var (
+		externalIDList []*entity.JobSeekerExternalID
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByJobSeekerID",
+		&externalIDList, `
+		SELECT *
+		FROM job_seeker_external_ids
+		WHERE job_seeker_id = ?
+		ORDER BY id ASC
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return externalIDList, nil
+}
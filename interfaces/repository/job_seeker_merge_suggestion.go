@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type JobSeekerMergeSuggestionRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewJobSeekerMergeSuggestionRepositoryImpl(ex interfaces.SQLExecuter) usecase.JobSeekerMergeSuggestionRepository {
+	return &JobSeekerMergeSuggestionRepositoryImpl{
+		Name:     "JobSeekerMergeSuggestionRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 統合候補を作成
+func (repo *JobSeekerMergeSuggestionRepositoryImpl) Create(mergeSuggestion *entity.JobSeekerMergeSuggestion) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO job_seeker_merge_suggestions (
+				agent_id,
+				job_seeker_id,
+				duplicate_job_seeker_id,
+				match_reason,
+				status,
+				reviewed_staff_id,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		mergeSuggestion.AgentID,
+		mergeSuggestion.JobSeekerID,
+		mergeSuggestion.DuplicateJobSeekerID,
+		mergeSuggestion.MatchReason,
+		mergeSuggestion.Status,
+		mergeSuggestion.ReviewedStaffID,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	mergeSuggestion.ID = uint(lastID)
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 対応状況を更新
+func (repo *JobSeekerMergeSuggestionRepositoryImpl) UpdateStatus(id uint, status int64, reviewedStaffID uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateStatus",
+		`
+		UPDATE job_seeker_merge_suggestions
+		SET
+			status = ?,
+			reviewed_staff_id = ?,
+			updated_at = ?
+		WHERE id = ?
+		`,
+		status,
+		reviewedStaffID,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+func (repo *JobSeekerMergeSuggestionRepositoryImpl) FindByID(id uint) (*entity.JobSeekerMergeSuggestion, error) {
+	var (
+		mergeSuggestion entity.JobSeekerMergeSuggestion
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&mergeSuggestion, `
+		SELECT *
+		FROM job_seeker_merge_suggestions
+		WHERE id = ?
+		LIMIT 1
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &mergeSuggestion, nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 指定エージェントの対応状況ごとの統合候補を取得
+func (repo *JobSeekerMergeSuggestionRepositoryImpl) GetByAgentIDAndStatus(agentID uint, status int64) ([]*entity.JobSeekerMergeSuggestion, error) {
+	var (
+		mergeSuggestionList []*entity.JobSeekerMergeSuggestion
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentIDAndStatus",
+		&mergeSuggestionList, `
+		SELECT
+			suggestion.*,
+			seeker.uuid AS job_seeker_uuid,
+			seeker.last_name AS job_seeker_last_name,
+			seeker.first_name AS job_seeker_first_name,
+			seeker.last_furigana AS job_seeker_last_furigana,
+			seeker.first_furigana AS job_seeker_first_furigana,
+			seeker.email AS job_seeker_email,
+			seeker.phone_number AS job_seeker_phone_number,
+			IFNULL(duplicate.uuid, '00000000-0000-0000-0000-000000000000') AS duplicate_job_seeker_uuid,
+			IFNULL(duplicate.last_name, '') AS duplicate_job_seeker_last_name,
+			IFNULL(duplicate.first_name, '') AS duplicate_job_seeker_first_name,
+			IFNULL(duplicate.last_furigana, '') AS duplicate_job_seeker_last_furigana,
+			IFNULL(duplicate.first_furigana, '') AS duplicate_job_seeker_first_furigana,
+			IFNULL(duplicate.email, '') AS duplicate_job_seeker_email,
+			IFNULL(duplicate.phone_number, '') AS duplicate_job_seeker_phone_number
+		FROM
+			job_seeker_merge_suggestions AS suggestion
+		INNER JOIN
+			job_seekers AS seeker
+		ON
+			suggestion.job_seeker_id = seeker.id
+		LEFT OUTER JOIN
+			job_seekers AS duplicate
+		ON
+			suggestion.duplicate_job_seeker_id = duplicate.id
+		WHERE
+			suggestion.agent_id = ? AND
+			suggestion.status = ?
+		ORDER BY suggestion.id DESC
+		`,
+		agentID, status,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	for _, mergeSuggestion := range mergeSuggestionList {
+		err = decryptJobSeekerFields(
+			&mergeSuggestion.JobSeekerEmail,
+			&mergeSuggestion.JobSeekerPhoneNumber,
+			&mergeSuggestion.DuplicateJobSeekerEmail,
+			&mergeSuggestion.DuplicateJobSeekerPhoneNumber,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return mergeSuggestionList, nil
+}
@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+// 求職者の個人情報を含むテーブルをまとめて扱う（開示・削除請求の対応）
+type JobSeekerPersonalDataRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewJobSeekerPersonalDataRepositoryImpl(ex interfaces.SQLExecuter) usecase.JobSeekerPersonalDataRepository {
+	return &JobSeekerPersonalDataRepositoryImpl{
+		Name:     "JobSeekerPersonalDataRepository",
+		executer: ex,
+	}
+}
+
+// 求職者IDで削除するテーブル（子テーブルは外部キーのON DELETE CASCADEで削除される）
+var jobSeekerPersonalDataTableList = []string{
+	"job_seeker_student_histories",
+	"job_seeker_work_histories",
+	"job_seeker_licenses",
+	"job_seeker_self_promotions",
+	"job_seeker_documents",
+	"job_seeker_desired_industries",
+	"job_seeker_desired_occupations",
+	"job_seeker_desired_work_locations",
+	"job_seeker_desired_holiday_types",
+	"job_seeker_desired_company_scales",
+	"job_seeker_development_skills",
+	"job_seeker_language_skills",
+	"job_seeker_pc_tools",
+	"job_seeker_lp_login_token",
+	"job_seeker_external_ids",
+	"job_seeker_interested_job_listings",
+	"job_seeker_schedules",
+	"email_with_job_seekers",
+	"initial_questionnaires",
+	"interview_booking_links",
+}
+
+/****************************************************************************************/
+/// 削除
+//
+// 求職者に紐づく個人情報を含むテーブルのデータを削除する
+// 選考（task_groups, tasks）・売上（sales）・担当者の変更履歴は集計に使うため残し、
+// 選考後アンケート・選考の活動履歴・失注理由は集計に使う項目を残して自由記述・変更内容のみ消去する
+func (repo *JobSeekerPersonalDataRepositoryImpl) DeleteByJobSeekerID(jobSeekerID uint) error {
+	for _, table := range jobSeekerPersonalDataTableList {
+		_, err := repo.executer.Exec(
+			repo.Name+".DeleteByJobSeekerID."+table,
+			fmt.Sprintf(`
+				DELETE
+				FROM %s
+				WHERE job_seeker_id = ?
+			`, table),
+			jobSeekerID,
+		)
+
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	// 統合候補（統合先・統合元のどちらの場合も削除）
+	_, err := repo.executer.Exec(
+		repo.Name+".DeleteByJobSeekerID.job_seeker_merge_suggestions",
+		`
+			DELETE
+			FROM job_seeker_merge_suggestions
+			WHERE
+				job_seeker_id = ? OR
+				duplicate_job_seeker_id = ?
+		`,
+		jobSeekerID, jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	// LINEのメッセージ（チャットグループは未読件数などの集計に使うため残す）
+	_, err = repo.executer.Exec(
+		repo.Name+".DeleteByJobSeekerID.chat_message_with_job_seekers",
+		`
+			DELETE
+			FROM chat_message_with_job_seekers
+			WHERE group_id IN (
+				SELECT id
+				FROM chat_group_with_job_seekers
+				WHERE job_seeker_id = ?
+			)
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	// 選考の添付ファイル
+	_, err = repo.executer.Exec(
+		repo.Name+".DeleteByJobSeekerID.task_group_documents",
+		`
+			DELETE
+			FROM task_group_documents
+			WHERE task_group_id IN (
+				SELECT id
+				FROM task_groups
+				WHERE job_seeker_id = ?
+			)
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	// 内定条件（給与・備考は求職者の個人情報のため削除。状態の履歴・通知の記録は外部キーのON DELETE CASCADEで削除される）
+	_, err = repo.executer.Exec(
+		repo.Name+".DeleteByJobSeekerID.job_offers",
+		`
+			DELETE
+			FROM job_offers
+			WHERE task_group_id IN (
+				SELECT id
+				FROM task_groups
+				WHERE job_seeker_id = ?
+			)
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	// 選考の活動履歴の変更内容（フェーズの推移は集計に使うため残す）
+	_, err = repo.executer.Exec(
+		repo.Name+".DeleteByJobSeekerID.task_group_events",
+		`
+			UPDATE
+				task_group_events
+			SET
+				diff = '[]'
+			WHERE
+				job_seeker_id = ?
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	// 失注理由の備考（理由の区分は集計に使うため残す）
+	_, err = repo.executer.Exec(
+		repo.Name+".DeleteByJobSeekerID.task_loss_reasons",
+		`
+			UPDATE
+				task_loss_reasons
+			SET
+				remarks = ''
+			WHERE task_group_id IN (
+				SELECT id
+				FROM task_groups
+				WHERE job_seeker_id = ?
+			)
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	// 選考後アンケートの自由記述
+	_, err = repo.executer.Exec(
+		repo.Name+".DeleteByJobSeekerID.selection_questionnaires",
+		`
+			UPDATE
+				selection_questionnaires
+			SET
+				my_ranking_reason = '',
+				concern_point = '',
+				my_ranking_detail = '',
+				selection_question = '',
+				remarks = '',
+				intention_detail = ''
+			WHERE
+				job_seeker_id = ?
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+/// 複数取得
+//
+// 求職者の応募書類・選考の添付ファイル・LINEで送受信したファイルのURLを取得する（重複・空文字は除く）
+func (repo *JobSeekerPersonalDataRepositoryImpl) GetFileURLListByJobSeekerID(jobSeekerID uint) ([]string, error) {
+	var (
+		urlList   []string
+		queryList []string
+		argList   []interface{}
+	)
+
+	for _, column := range []string{
+		"resume_origin_url", "resume_pdf_url", "cv_origin_url", "cv_pdf_url", "recommendation_origin_url",
+		"recommendation_pdf_url", "id_photo_url", "other_document1_url", "other_document2_url", "other_document3_url",
+	} {
+		queryList = append(queryList, fmt.Sprintf(`
+			SELECT %s AS url
+			FROM job_seeker_documents
+			WHERE job_seeker_id = ?
+		`, column))
+		argList = append(argList, jobSeekerID)
+	}
+
+	for _, column := range []string{
+		"document1_url", "document2_url", "document3_url", "document4_url", "document5_url",
+	} {
+		queryList = append(queryList, fmt.Sprintf(`
+			SELECT doc.%s AS url
+			FROM task_group_documents AS doc
+			INNER JOIN task_groups AS tg
+			ON doc.task_group_id = tg.id
+			WHERE tg.job_seeker_id = ?
+		`, column))
+		argList = append(argList, jobSeekerID)
+	}
+
+	for _, column := range []string{
+		"original_content_url", "preview_image_url",
+	} {
+		queryList = append(queryList, fmt.Sprintf(`
+			SELECT message.%s AS url
+			FROM chat_message_with_job_seekers AS message
+			INNER JOIN chat_group_with_job_seekers AS chat
+			ON message.group_id = chat.id
+			WHERE chat.job_seeker_id = ?
+		`, column))
+		argList = append(argList, jobSeekerID)
+	}
+
+	query := `
+		SELECT DISTINCT url
+		FROM (` + strings.Join(queryList, "UNION ALL") + `) AS file
+		WHERE url != ''
+	`
+
+	err := repo.executer.Select(
+		repo.Name+".GetFileURLListByJobSeekerID",
+		&urlList,
+		query,
+		argList...,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return urlList, nil
+}
@@ -235,6 +235,35 @@ func (repo *JobSeekerScheduleRepositoryImpl) DeleteScheduleInTaskGroupAboveTaskI
 	return err
 }
 
+/****************************************************************************************/
+/// 単数取得
+//
+func (repo *JobSeekerScheduleRepositoryImpl) FindByID(id uint) (*entity.JobSeekerSchedule, error) {
+	var (
+		jobSeekerSchedule entity.JobSeekerSchedule
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&jobSeekerSchedule, `
+		SELECT 
+			*
+		FROM 
+			job_seeker_schedules
+		WHERE 
+			id = ?
+		LIMIT 1
+		`, id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &jobSeekerSchedule, nil
+}
+
 /****************************************************************************************/
 /// 複数取得
 //
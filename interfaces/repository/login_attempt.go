@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type LoginAttemptRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewLoginAttemptRepositoryImpl(ex interfaces.SQLExecuter) usecase.LoginAttemptRepository {
+	return &LoginAttemptRepositoryImpl{
+		Name:     "LoginAttemptRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 試行状態を作成（同時に作成された場合に重複させないためINSERT IGNOREを使う）
+func (repo *LoginAttemptRepositoryImpl) CreateIfNotExists(attempt *entity.LoginAttempt) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".CreateIfNotExists",
+		`
+			INSERT IGNORE INTO login_attempts (
+				scope,
+				key_type,
+				key_value,
+				attempt_count,
+				window_started_at,
+				lock_count,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		attempt.Scope,
+		attempt.KeyType,
+		attempt.KeyValue,
+		0,
+		attempt.WindowStartedAt,
+		0,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 試行回数とロック状態を更新
+func (repo *LoginAttemptRepositoryImpl) Update(attempt *entity.LoginAttempt) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".Update",
+		`
+		UPDATE login_attempts
+		SET
+			attempt_count = ?,
+			window_started_at = ?,
+			lock_count = ?,
+			locked_until = ?,
+			updated_at = ?
+		WHERE id = ?
+		`,
+		attempt.AttemptCount,
+		attempt.WindowStartedAt,
+		attempt.LockCount,
+		attempt.LockedUntil,
+		time.Now().In(time.UTC),
+		attempt.ID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// ロックを解除して回数を戻す
+func (repo *LoginAttemptRepositoryImpl) Unlock(id uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".Unlock",
+		`
+		UPDATE login_attempts
+		SET
+			attempt_count = 0,
+			lock_count = 0,
+			locked_until = NULL,
+			updated_at = ?
+		WHERE id = ?
+		`,
+		time.Now().In(time.UTC),
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 指定の単位・値のロックを全ての操作で解除して回数を戻す
+func (repo *LoginAttemptRepositoryImpl) UnlockByKey(keyType, keyValue string) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".UnlockByKey",
+		`
+		UPDATE login_attempts
+		SET
+			attempt_count = 0,
+			lock_count = 0,
+			locked_until = NULL,
+			updated_at = ?
+		WHERE
+			key_type = ? AND
+			key_value = ?
+		`,
+		time.Now().In(time.UTC),
+		keyType,
+		keyValue,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 削除 API
+//
+// ログインに成功した場合に試行状態を削除
+func (repo *LoginAttemptRepositoryImpl) DeleteByKey(scope, keyType, keyValue string) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".DeleteByKey",
+		`
+		DELETE
+		FROM login_attempts
+		WHERE
+			scope = ? AND
+			key_type = ? AND
+			key_value = ?
+		`,
+		scope,
+		keyType,
+		keyValue,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// IDから試行状態を取得
+func (repo *LoginAttemptRepositoryImpl) FindByID(id uint) (*entity.LoginAttempt, error) {
+	var (
+		attempt entity.LoginAttempt
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&attempt, `
+		SELECT *
+		FROM login_attempts
+		WHERE id = ?
+		LIMIT 1
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &attempt, nil
+}
+
+// 対象の操作・単位・値から試行状態を取得
+func (repo *LoginAttemptRepositoryImpl) FindByKey(scope, keyType, keyValue string) (*entity.LoginAttempt, error) {
+	var (
+		attempt entity.LoginAttempt
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByKey",
+		&attempt, `
+		SELECT *
+		FROM login_attempts
+		WHERE
+			scope = ? AND
+			key_type = ? AND
+			key_value = ?
+		LIMIT 1
+		`,
+		scope,
+		keyType,
+		keyValue,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &attempt, nil
+}
+
+// 対象の操作・単位・値から試行状態を取得して行をロック
+func (repo *LoginAttemptRepositoryImpl) FindByKeyForUpdate(scope, keyType, keyValue string) (*entity.LoginAttempt, error) {
+	var (
+		attempt entity.LoginAttempt
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByKeyForUpdate",
+		&attempt, `
+		SELECT *
+		FROM login_attempts
+		WHERE
+			scope = ? AND
+			key_type = ? AND
+			key_value = ?
+		LIMIT 1
+		FOR UPDATE
+		`,
+		scope,
+		keyType,
+		keyValue,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &attempt, nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// ロック中の試行状態を取得
+func (repo *LoginAttemptRepositoryImpl) GetLockedList(now time.Time) ([]*entity.LoginAttempt, error) {
+	var (
+		attemptList []*entity.LoginAttempt
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetLockedList",
+		&attemptList, `
+		SELECT *
+		FROM login_attempts
+		WHERE locked_until > ?
+		ORDER BY locked_until DESC
+		`,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return attemptList, nil
+}
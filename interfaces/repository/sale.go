@@ -45,10 +45,11 @@ func (repo *SaleRepositoryImpl) Create(sale *entity.Sale) error {
 			ra_sales_ratio,
 			ca_staff_id ,
 			ca_sales_ratio,
+			is_draft,
 			created_at,
 			updated_at
 			) VALUES (
-				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 			)`,
 		sale.JobSeekerID,
 		sale.JobInformationID,
@@ -62,6 +63,7 @@ func (repo *SaleRepositoryImpl) Create(sale *entity.Sale) error {
 		sale.RaSalesRatio,
 		sale.CAStaffID,
 		sale.CaSalesRatio,
+		sale.IsDraft,
 		now,
 		now,
 	)
@@ -97,6 +99,7 @@ func (repo *SaleRepositoryImpl) Update(id uint, sale *entity.Sale) error {
 			ra_sales_ratio = ?,
 			ca_staff_id  = ?,
 			ca_sales_ratio = ?,
+			is_draft = ?,
 			updated_at = ?
 		WHERE 
 			id = ?
@@ -113,6 +116,7 @@ func (repo *SaleRepositoryImpl) Update(id uint, sale *entity.Sale) error {
 		sale.RaSalesRatio,
 		sale.CAStaffID,
 		sale.CaSalesRatio,
+		sale.IsDraft,
 		time.Now().In(time.UTC),
 		id,
 	)
@@ -1719,3 +1723,129 @@ func (repo *SaleRepositoryImpl) GetByAgentIDForCSV(agentID uint) ([]*entity.Sale
 
 	return saleList, nil
 }
+
+/****************************************************************************************/
+/// 返金保証期間
+//
+// 返金保証期間の対象の売上（入社日と退職の記録）
+var saleGuaranteeSelect = `
+	SELECT
+		sale.id AS sale_id, sale.job_seeker_id, sale.job_information_id,
+		sale.billing_amount, sale.is_draft,
+		sale.ra_staff_id, sale.ca_staff_id,
+		task_group.id AS task_group_id, task_group.joining_date,
+		ra_staff.agent_id AS ra_agent_id, ca_staff.agent_id AS ca_agent_id,
+		ra_staff.staff_name AS ra_staff_name, ca_staff.staff_name AS ca_staff_name,
+		seeker.last_name, seeker.first_name,
+		CASE
+			WHEN job_info.is_external = TRUE AND task_group.external_job_information_title != ''
+			THEN task_group.external_job_information_title
+			ELSE job_info.title
+		END AS title,
+		CASE
+			WHEN job_info.is_external = TRUE AND task_group.external_company_name != ''
+			THEN task_group.external_company_name
+			ELSE enterprise.company_name
+		END AS company_name,
+		refund.resignation_date, refund.refund_rate, refund.refund_amount
+	FROM
+		sales AS sale
+	INNER JOIN
+		agent_staffs AS ca_staff
+	ON
+		sale.ca_staff_id = ca_staff.id
+	INNER JOIN
+		agent_staffs AS ra_staff
+	ON
+		sale.ra_staff_id = ra_staff.id
+	INNER JOIN
+		job_seekers AS seeker
+	ON
+		seeker.id = sale.job_seeker_id
+	INNER JOIN
+		job_informations AS job_info
+	ON
+		job_info.id = sale.job_information_id
+	INNER JOIN
+		billing_addresses AS billing
+	ON
+		job_info.billing_address_id = billing.id
+	INNER JOIN
+		enterprise_profiles AS enterprise
+	ON
+		billing.enterprise_id = enterprise.id
+	INNER JOIN
+		task_groups AS task_group
+	ON
+		sale.job_seeker_id = task_group.job_seeker_id
+	AND
+		sale.job_information_id = task_group.job_information_id
+	LEFT OUTER JOIN
+		sale_refunds AS refund
+	ON
+		sale.id = refund.sale_id
+`
+
+// エージェント（RA側・CA側）の入社日が指定日以降の売上を取得
+func (repo *SaleRepositoryImpl) GetGuaranteeByAgentID(agentID uint, joinedSince string) ([]*entity.SaleGuarantee, error) {
+	var (
+		guaranteeList []*entity.SaleGuarantee
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetGuaranteeByAgentID",
+		&guaranteeList,
+		saleGuaranteeSelect+`
+		WHERE (
+			ca_staff.agent_id = ?
+		OR
+			ra_staff.agent_id = ?
+		)
+		AND
+			task_group.joining_date != ''
+		AND
+			task_group.joining_date >= ?
+		ORDER BY
+			task_group.joining_date ASC, sale.id ASC
+		`,
+		agentID, agentID,
+		joinedSince,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return guaranteeList, nil
+}
+
+// 全エージェントの入社日が指定日以降で退職の記録がない売上を取得
+func (repo *SaleRepositoryImpl) GetActiveGuaranteeByJoinedSince(joinedSince string) ([]*entity.SaleGuarantee, error) {
+	var (
+		guaranteeList []*entity.SaleGuarantee
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetActiveGuaranteeByJoinedSince",
+		&guaranteeList,
+		saleGuaranteeSelect+`
+		WHERE
+			task_group.joining_date != ''
+		AND
+			task_group.joining_date >= ?
+		AND
+			refund.id IS NULL
+		ORDER BY
+			task_group.joining_date ASC, sale.id ASC
+		`,
+		joinedSince,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return guaranteeList, nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type SaleGuaranteeNotificationRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewSaleGuaranteeNotificationRepositoryImpl(ex interfaces.SQLExecuter) usecase.SaleGuaranteeNotificationRepository {
+	return &SaleGuaranteeNotificationRepositoryImpl{
+		Name:     "SaleGuaranteeNotificationRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 送信した通知を記録（同じ入社日・同じ段階の記録がある場合は何もしない）
+func (repo *SaleGuaranteeNotificationRepositoryImpl) Create(notification *entity.SaleGuaranteeNotification) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT IGNORE INTO sale_guarantee_notifications (
+				sale_id,
+				agent_staff_id,
+				within_days,
+				joining_date,
+				created_at
+			) VALUES (
+				?, ?, ?, ?, ?
+			)
+		`,
+		notification.SaleID,
+		notification.AgentStaffID,
+		notification.WithinDays,
+		notification.JoiningDate,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	notification.ID = uint(lastID)
+	notification.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 売上IDリストから送信済みの通知を取得
+func (repo *SaleGuaranteeNotificationRepositoryImpl) GetBySaleIDList(saleIDList []uint) ([]*entity.SaleGuaranteeNotification, error) {
+	var (
+		notificationList []*entity.SaleGuaranteeNotification
+	)
+
+	if len(saleIDList) == 0 {
+		return notificationList, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT *
+		FROM sale_guarantee_notifications
+		WHERE
+			sale_id IN (%s)
+		`,
+		strings.Trim(strings.Join(strings.Fields(fmt.Sprint(saleIDList)), ", "), "[]"),
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetBySaleIDList",
+		&notificationList,
+		query,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return notificationList, nil
+}
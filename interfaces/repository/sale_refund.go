@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type SaleRefundRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewSaleRefundRepositoryImpl(ex interfaces.SQLExecuter) usecase.SaleRefundRepository {
+	return &SaleRefundRepositoryImpl{
+		Name:     "SaleRefundRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 早期退職による返金を記録
+func (repo *SaleRefundRepositoryImpl) Create(refund *entity.SaleRefund) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO sale_refunds (
+				sale_id,
+				joining_date,
+				resignation_date,
+				days_employed,
+				refund_rate,
+				original_billing_amount,
+				refund_amount,
+				remarks,
+				agent_staff_id,
+				created_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		refund.SaleID,
+		refund.JoiningDate,
+		refund.ResignationDate,
+		refund.DaysEmployed,
+		refund.RefundRate,
+		refund.OriginalBillingAmount,
+		refund.RefundAmount,
+		refund.Remarks,
+		refund.AgentStaffID,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	refund.ID = uint(lastID)
+	refund.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// 売上IDから返金の記録を取得
+func (repo *SaleRefundRepositoryImpl) FindBySaleID(saleID uint) (*entity.SaleRefund, error) {
+	var (
+		refund entity.SaleRefund
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindBySaleID",
+		&refund, `
+		SELECT *
+		FROM sale_refunds
+		WHERE
+			sale_id = ?
+		LIMIT 1
+		`,
+		saleID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
@@ -3470,3 +3470,368 @@ func (repo *TaskRepositoryImpl) GetInterviewJobIntroductionPerformanceCountBySta
 
 	return result.Count, nil
 }
+
+/****************************************************************************************/
+// パイプライン（選考の進捗ボード）
+//
+// 最新タスクのフェーズ・サブフェーズごとの件数を集計
+func (repo *TaskRepositoryImpl) GetPipelineCountList(searchParam entity.SearchTaskPipelineParam) ([]*entity.TaskPipelineCount, error) {
+	var (
+		countList []*entity.TaskPipelineCount
+	)
+
+	conditions, args := getTaskPipelineConditions(searchParam)
+
+	query := fmt.Sprintf(`
+		SELECT
+			task.phase_category,
+			task.phase_sub_category,
+			COUNT(*) AS count
+		%s
+		%s
+		GROUP BY
+			task.phase_category, task.phase_sub_category
+		ORDER BY
+			task.phase_category ASC, task.phase_sub_category ASC
+	`, taskPipelineFrom, conditions)
+
+	err := repo.executer.Select(
+		repo.Name+".GetPipelineCountList",
+		&countList,
+		query,
+		args...,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return countList, nil
+}
+
+// 指定の列のカードを最新タスクの新しい順に1ページ分取得
+func (repo *TaskRepositoryImpl) GetPipelineCardList(searchParam entity.SearchTaskPipelineParam) ([]*entity.TaskPipelineCard, error) {
+	var (
+		cardList []*entity.TaskPipelineCard
+		page     = searchParam.PageNumber
+	)
+
+	if page < 1 {
+		page = 1
+	}
+
+	conditions, args := getTaskPipelineConditions(searchParam)
+	args = append(args, entity.TaskPipelineCardPerPage, (page-1)*entity.TaskPipelineCardPerPage)
+
+	query := fmt.Sprintf(`
+		SELECT
+			task_group.id AS task_group_id,
+			task.id AS task_id,
+			task_group.job_seeker_id,
+			task_group.job_information_id,
+			task.phase_category,
+			task.phase_sub_category,
+			task.staff_type,
+			task.deadline_day,
+			task.deadline_time,
+			task_group.is_double_sided,
+			seeker.last_name,
+			seeker.first_name,
+			CASE
+				WHEN job_info.is_external = TRUE AND task_group.external_job_information_title != ''
+				THEN task_group.external_job_information_title
+				ELSE job_info.title
+			END AS title,
+			CASE
+				WHEN job_info.is_external = TRUE AND task_group.external_company_name != ''
+				THEN task_group.external_company_name
+				ELSE IFNULL(enterprise.company_name, '')
+			END AS company_name,
+			task_group.ca_agent_id,
+			task_group.ra_agent_id,
+			seeker.agent_staff_id AS ca_staff_id,
+			billing.agent_staff_id AS ra_staff_id,
+			IFNULL(ca_staff.staff_name, '') AS ca_staff_name,
+			IFNULL(ra_staff.staff_name, '') AS ra_staff_name,
+			task.created_at AS phase_changed_at,
+			task_group.created_at AS entry_at
+		%s
+		LEFT OUTER JOIN
+			enterprise_profiles AS enterprise
+		ON
+			billing.enterprise_id = enterprise.id
+		LEFT OUTER JOIN
+			agent_staffs AS ca_staff
+		ON
+			seeker.agent_staff_id = ca_staff.id
+		LEFT OUTER JOIN
+			agent_staffs AS ra_staff
+		ON
+			billing.agent_staff_id = ra_staff.id
+		%s
+		ORDER BY
+			task.id DESC
+		LIMIT ? OFFSET ?
+	`, taskPipelineFrom, conditions)
+
+	err := repo.executer.Select(
+		repo.Name+".GetPipelineCardList",
+		&cardList,
+		query,
+		args...,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return cardList, nil
+}
+
+// 求人の選考中の応募者一覧を取得（CAのアライアンス先を含む）
+func (repo *TaskRepositoryImpl) GetActiveApplicantListByJobInformationID(jobInformationID uint) ([]*entity.TaskPipelineApplicant, error) {
+	var (
+		applicantList []*entity.TaskPipelineApplicant
+	)
+
+	query := fmt.Sprintf(`
+		%s
+		WHERE
+			task_group.job_information_id = ?
+		AND
+			task.phase_sub_category NOT IN(90, 91, 92, 93, 94, 95, 96, 97, 98, 99)
+		ORDER BY
+			task.phase_category DESC, task.phase_sub_category DESC, task.id ASC
+	`, taskPipelineApplicantSelect)
+
+	err := repo.executer.Select(
+		repo.Name+".GetActiveApplicantListByJobInformationID",
+		&applicantList,
+		query,
+		jobInformationID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return applicantList, nil
+}
+
+// 請求先の求人の選考中の応募者一覧を取得（CAのアライアンス先を含む）
+func (repo *TaskRepositoryImpl) GetActiveApplicantListByBillingAddressID(billingAddressID uint) ([]*entity.TaskPipelineApplicant, error) {
+	var (
+		applicantList []*entity.TaskPipelineApplicant
+	)
+
+	query := fmt.Sprintf(`
+		%s
+		WHERE
+			job_info.billing_address_id = ?
+		AND
+			task.phase_sub_category NOT IN(90, 91, 92, 93, 94, 95, 96, 97, 98, 99)
+		ORDER BY
+			job_info.id ASC, task.phase_category DESC, task.phase_sub_category DESC, task.id ASC
+	`, taskPipelineApplicantSelect)
+
+	err := repo.executer.Select(
+		repo.Name+".GetActiveApplicantListByBillingAddressID",
+		&applicantList,
+		query,
+		billingAddressID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return applicantList, nil
+}
+
+// 応募者一覧の取得項目（最新の評価点は求職者と求人の組み合わせで最後に登録されたもの）
+var taskPipelineApplicantSelect = `
+		SELECT
+			task_group.id AS task_group_id,
+			task.id AS task_id,
+			task_group.job_seeker_id,
+			task_group.job_information_id,
+			CASE
+				WHEN job_info.is_external = TRUE AND task_group.external_job_information_title != ''
+				THEN task_group.external_job_information_title
+				ELSE job_info.title
+			END AS title,
+			seeker.last_name,
+			seeker.first_name,
+			task.phase_category,
+			task.phase_sub_category,
+			task.staff_type,
+			task.deadline_day,
+			task.deadline_time,
+			task_group.ca_agent_id,
+			IFNULL(ca_agent.agent_name, '') AS ca_agent_name,
+			task_group.ra_agent_id,
+			seeker.agent_staff_id AS ca_staff_id,
+			IFNULL(ca_staff.staff_name, '') AS ca_staff_name,
+			billing.agent_staff_id AS ra_staff_id,
+			IFNULL(ra_staff.staff_name, '') AS ra_staff_name,
+			task.created_at AS phase_changed_at,
+			task_group.created_at AS entry_at,
+			evaluation.is_passed,
+			evaluation.is_re_interview,
+			evaluation.good_point,
+			evaluation.ng_point,
+			evaluation.created_at AS evaluated_at
+		` + taskPipelineFrom + `
+		LEFT OUTER JOIN
+			agents AS ca_agent
+		ON
+			task_group.ca_agent_id = ca_agent.id
+		LEFT OUTER JOIN
+			agent_staffs AS ca_staff
+		ON
+			seeker.agent_staff_id = ca_staff.id
+		LEFT OUTER JOIN
+			agent_staffs AS ra_staff
+		ON
+			billing.agent_staff_id = ra_staff.id
+		LEFT OUTER JOIN
+			evaluation_points AS evaluation
+		ON
+			evaluation.id = (
+				SELECT MAX(latest_evaluation.id)
+				FROM evaluation_points AS latest_evaluation
+				WHERE latest_evaluation.job_seeker_id = task_group.job_seeker_id
+				AND latest_evaluation.job_information_id = task_group.job_information_id
+			)
+`
+
+// パイプラインの集計対象（選考ごとの最新タスク）
+const taskPipelineFrom = `
+		FROM
+			task_groups AS task_group
+		INNER JOIN
+			tasks AS task
+		ON
+			task.id = (
+				SELECT MAX(latest_task.id)
+				FROM tasks AS latest_task
+				WHERE latest_task.task_group_id = task_group.id
+			)
+		INNER JOIN
+			job_seekers AS seeker
+		ON
+			task_group.job_seeker_id = seeker.id
+		INNER JOIN
+			job_informations AS job_info
+		ON
+			task_group.job_information_id = job_info.id
+		INNER JOIN
+			billing_addresses AS billing
+		ON
+			job_info.billing_address_id = billing.id
+`
+
+// パイプラインの検索条件（自社がCA・RAのいずれかで関わる選考に限る）
+func getTaskPipelineConditions(searchParam entity.SearchTaskPipelineParam) (string, []interface{}) {
+	var (
+		conditions = `
+		WHERE
+			(task_group.ra_agent_id = ? OR task_group.ca_agent_id = ?)
+		`
+		args = []interface{}{searchParam.AgentID, searchParam.AgentID}
+	)
+
+	// 担当者の条件
+	if searchParam.AgentStaffID != 0 {
+		conditions += `
+			AND (seeker.agent_staff_id = ? OR billing.agent_staff_id = ?)
+		`
+		args = append(args, searchParam.AgentStaffID, searchParam.AgentStaffID)
+	}
+
+	if searchParam.RAStaffID != 0 {
+		conditions += `
+			AND billing.agent_staff_id = ?
+		`
+		args = append(args, searchParam.RAStaffID)
+	}
+
+	if searchParam.CAStaffID != 0 {
+		conditions += `
+			AND seeker.agent_staff_id = ?
+		`
+		args = append(args, searchParam.CAStaffID)
+	}
+
+	// 企業・求人の条件
+	if searchParam.EnterpriseID != 0 {
+		conditions += `
+			AND billing.enterprise_id = ?
+		`
+		args = append(args, searchParam.EnterpriseID)
+	}
+
+	if searchParam.JobInformationID != 0 {
+		conditions += `
+			AND task_group.job_information_id = ?
+		`
+		args = append(args, searchParam.JobInformationID)
+	}
+
+	// アライアンス先の条件（自社がRAの場合は相手がCA、自社がCAの場合は相手がRA）
+	if searchParam.AllianceAgentID != 0 {
+		conditions += `
+			AND (
+				(task_group.ra_agent_id = ? AND task_group.ca_agent_id = ?)
+				OR
+				(task_group.ca_agent_id = ? AND task_group.ra_agent_id = ?)
+			)
+		`
+		args = append(args, searchParam.AgentID, searchParam.AllianceAgentID, searchParam.AgentID, searchParam.AllianceAgentID)
+	}
+
+	// エントリー日の条件
+	if !searchParam.FromDate.IsZero() {
+		conditions += `
+			AND task_group.created_at >= ?
+		`
+		args = append(args, searchParam.FromDate.In(time.UTC))
+	}
+
+	if !searchParam.ToDate.IsZero() {
+		conditions += `
+			AND task_group.created_at <= ?
+		`
+		args = append(args, searchParam.ToDate.In(time.UTC))
+	}
+
+	// 終了した選考（90番台のサブフェーズと内定承諾の決定終了）を除く
+	if !searchParam.IncludeClosed {
+		conditions += `
+			AND task.phase_sub_category NOT BETWEEN 90 AND 99
+			AND NOT (task.phase_category = ? AND task.phase_sub_category = ?)
+		`
+		args = append(args, entity.AcceptJobOffer, entity.Decision)
+	}
+
+	// 列の条件
+	if searchParam.PhaseCategory.Valid {
+		conditions += `
+			AND task.phase_category = ?
+		`
+		args = append(args, searchParam.PhaseCategory.Int64)
+	}
+
+	if searchParam.PhaseSubCategory.Valid {
+		conditions += `
+			AND task.phase_sub_category = ?
+		`
+		args = append(args, searchParam.PhaseSubCategory.Int64)
+	}
+
+	return conditions, args
+}
@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type TaskBulkJobRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewTaskBulkJobRepositoryImpl(ex interfaces.SQLExecuter) usecase.TaskBulkJobRepository {
+	return &TaskBulkJobRepositoryImpl{
+		Name:     "TaskBulkJobRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 一括操作のジョブを登録
+func (repo *TaskBulkJobRepositoryImpl) Create(job *entity.TaskBulkJob) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO task_bulk_jobs (
+				agent_id,
+				agent_staff_id,
+				action_type,
+				param,
+				status,
+				total_count,
+				success_count,
+				failure_count,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		job.AgentID,
+		job.AgentStaffID,
+		job.ActionType,
+		job.Param,
+		job.Status,
+		job.TotalCount,
+		job.SuccessCount,
+		job.FailureCount,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	job.ID = uint(lastID)
+	job.CreatedAt = now
+	job.UpdatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// ジョブを実行中にする（中断したジョブを再開する場合は開始日時を変更しない）
+func (repo *TaskBulkJobRepositoryImpl) Start(id uint, startedAt time.Time) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".Start",
+		`
+		UPDATE task_bulk_jobs
+		SET
+			status = ?,
+			started_at = IFNULL(started_at, ?),
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		entity.TaskBulkJobStatusRunning,
+		startedAt,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 実行結果の件数を記録して完了にする
+func (repo *TaskBulkJobRepositoryImpl) Finish(id uint, successCount, failureCount uint, finishedAt time.Time) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".Finish",
+		`
+		UPDATE task_bulk_jobs
+		SET
+			status = ?,
+			success_count = ?,
+			failure_count = ?,
+			finished_at = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		entity.TaskBulkJobStatusDone,
+		successCount,
+		failureCount,
+		finishedAt,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// IDからジョブを取得
+func (repo *TaskBulkJobRepositoryImpl) FindByID(id uint) (*entity.TaskBulkJob, error) {
+	var (
+		job entity.TaskBulkJob
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&job, `
+		SELECT
+			job.*,
+			IFNULL(staff.staff_name, '') AS staff_name
+		FROM
+			task_bulk_jobs AS job
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			job.agent_staff_id = staff.id
+		WHERE
+			job.id = ?
+		LIMIT 1
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 担当者が依頼したジョブを新しい順に取得
+func (repo *TaskBulkJobRepositoryImpl) GetByAgentStaffID(agentStaffID uint) ([]*entity.TaskBulkJob, error) {
+	var (
+		jobList []*entity.TaskBulkJob
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentStaffID",
+		&jobList, `
+		SELECT
+			job.*,
+			IFNULL(staff.staff_name, '') AS staff_name
+		FROM
+			task_bulk_jobs AS job
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			job.agent_staff_id = staff.id
+		WHERE
+			job.agent_staff_id = ?
+		ORDER BY
+			job.id DESC
+		`,
+		agentStaffID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return jobList, nil
+}
+
+// 未完了（待機中・中断した実行中）のジョブを登録順に取得
+func (repo *TaskBulkJobRepositoryImpl) GetUnfinished(limit uint) ([]*entity.TaskBulkJob, error) {
+	var (
+		jobList []*entity.TaskBulkJob
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetUnfinished",
+		&jobList, `
+		SELECT *
+		FROM task_bulk_jobs
+		WHERE
+			status IN (?, ?)
+		ORDER BY id ASC
+		LIMIT ?
+		`,
+		entity.TaskBulkJobStatusPending,
+		entity.TaskBulkJobStatusRunning,
+		limit,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return jobList, nil
+}
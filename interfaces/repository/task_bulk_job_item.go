@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+type TaskBulkJobItemRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewTaskBulkJobItemRepositoryImpl(ex interfaces.SQLExecuter) usecase.TaskBulkJobItemRepository {
+	return &TaskBulkJobItemRepositoryImpl{
+		Name:     "TaskBulkJobItemRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 対象の選考を未処理として一括で登録
+func (repo *TaskBulkJobItemRepositoryImpl) CreateMulti(taskBulkJobID uint, taskGroupIDList []uint) error {
+	var (
+		nowTime   = time.Now().In(time.UTC).Format("\"2006-01-02 15:04:05\"")
+		valuesStr string
+		srtFields []string
+	)
+
+	if len(taskGroupIDList) == 0 {
+		return nil
+	}
+
+	for _, taskGroupID := range taskGroupIDList {
+		srtFields = append(
+			srtFields,
+			fmt.Sprintf(
+				"( %v, %v, %v, '', %s, %s )",
+				taskBulkJobID,
+				taskGroupID,
+				entity.TaskBulkJobItemStatusPending,
+				nowTime,
+				nowTime,
+			),
+		)
+	}
+
+	valuesStr = strings.Join(srtFields, ", ")
+
+	query := fmt.Sprintf(`
+		INSERT INTO task_bulk_job_items (
+			task_bulk_job_id,
+			task_group_id,
+			status,
+			error_message,
+			created_at,
+			updated_at
+		)
+		VALUES %s
+	`, valuesStr)
+
+	_, err := repo.executer.Exec(
+		repo.Name+".CreateMulti", query,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 選考ごとの結果を記録
+func (repo *TaskBulkJobItemRepositoryImpl) UpdateResult(id uint, status int64, taskID null.Int, errorMessage string) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".UpdateResult",
+		`
+		UPDATE task_bulk_job_items
+		SET
+			status = ?,
+			task_id = ?,
+			error_message = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		status,
+		taskID,
+		errorMessage,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// ジョブの対象の選考を登録順に取得（タスクグループが削除された場合も結果は返す）
+func (repo *TaskBulkJobItemRepositoryImpl) GetByTaskBulkJobID(taskBulkJobID uint) ([]*entity.TaskBulkJobItem, error) {
+	var (
+		itemList []*entity.TaskBulkJobItem
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByTaskBulkJobID",
+		&itemList, `
+		SELECT
+			item.*,
+			IFNULL(seeker.last_name, '') AS last_name,
+			IFNULL(seeker.first_name, '') AS first_name,
+			IFNULL(job_info.title, '') AS title,
+			IFNULL(enterprise.company_name, '') AS company_name
+		FROM
+			task_bulk_job_items AS item
+		LEFT OUTER JOIN
+			task_groups AS task_group
+		ON
+			item.task_group_id = task_group.id
+		LEFT OUTER JOIN
+			job_seekers AS seeker
+		ON
+			task_group.job_seeker_id = seeker.id
+		LEFT OUTER JOIN
+			job_informations AS job_info
+		ON
+			task_group.job_information_id = job_info.id
+		LEFT OUTER JOIN
+			billing_addresses AS billing
+		ON
+			job_info.billing_address_id = billing.id
+		LEFT OUTER JOIN
+			enterprise_profiles AS enterprise
+		ON
+			billing.enterprise_id = enterprise.id
+		WHERE
+			item.task_bulk_job_id = ?
+		ORDER BY
+			item.id ASC
+		`,
+		taskBulkJobID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return itemList, nil
+}
@@ -775,3 +775,115 @@ func (repo *TaskGroupRepositoryImpl) GetByAgentID(agentID uint) ([]*entity.TaskG
 
 	return taskGroupList, nil
 }
+
+// 同じ企業への重複推薦の可能性がある選考を取得
+// 同じ求職者に加え、別の求職者でも氏名（空白を除く）と生年月日、または電話番号のブラインドインデックスが一致する場合は同一人物とみなす
+// エントリーフェーズで終了した選考（企業へ推薦していない）は対象外
+func (repo *TaskGroupRepositoryImpl) GetDuplicateRecommendationList(searchParam entity.SearchDuplicateRecommendationParam) ([]*entity.DuplicateRecommendation, error) {
+	var (
+		duplicateList []*entity.DuplicateRecommendation
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetDuplicateRecommendationList",
+		&duplicateList, `
+		SELECT
+			task_group.id AS task_group_id,
+			task_group.job_seeker_id,
+			task_group.job_information_id,
+			job_info.title,
+			IFNULL(enterprise.company_name, '') AS company_name,
+			task_group.ca_agent_id,
+			IFNULL(ca_agent.agent_name, '') AS ca_agent_name,
+			task_group.ra_agent_id,
+			task.phase_category,
+			task.phase_sub_category,
+			CASE
+				WHEN task_group.job_seeker_id = own_seeker.id THEN ?
+				WHEN own_seeker.birthday != ''
+					AND seeker.birthday = own_seeker.birthday
+					AND REPLACE(REPLACE(CONCAT(seeker.last_name, seeker.first_name), ' ', ''), '　', '')
+						= REPLACE(REPLACE(CONCAT(own_seeker.last_name, own_seeker.first_name), ' ', ''), '　', '')
+				THEN ?
+				ELSE ?
+			END AS match_type,
+			task_group.created_at AS entry_at
+		FROM
+			task_groups AS task_group
+		INNER JOIN
+			tasks AS task
+		ON
+			task.id = (
+				SELECT MAX(latest_task.id)
+				FROM tasks AS latest_task
+				WHERE latest_task.task_group_id = task_group.id
+			)
+		INNER JOIN
+			job_seekers AS seeker
+		ON
+			task_group.job_seeker_id = seeker.id
+		INNER JOIN
+			job_seekers AS own_seeker
+		ON
+			own_seeker.id = ?
+		INNER JOIN
+			job_informations AS job_info
+		ON
+			task_group.job_information_id = job_info.id
+		INNER JOIN
+			billing_addresses AS billing
+		ON
+			job_info.billing_address_id = billing.id
+		LEFT OUTER JOIN
+			enterprise_profiles AS enterprise
+		ON
+			billing.enterprise_id = enterprise.id
+		LEFT OUTER JOIN
+			agents AS ca_agent
+		ON
+			task_group.ca_agent_id = ca_agent.id
+		WHERE
+			billing.enterprise_id = ?
+		AND
+			task_group.created_at >= ?
+		AND
+			task_group.id != ?
+		AND
+			NOT (task.phase_category = ? AND task.phase_sub_category BETWEEN 90 AND 99)
+		AND (
+			task_group.job_seeker_id = own_seeker.id
+			OR (
+				own_seeker.birthday != ''
+				AND seeker.birthday = own_seeker.birthday
+				AND REPLACE(REPLACE(CONCAT(seeker.last_name, seeker.first_name), ' ', ''), '　', '')
+					= REPLACE(REPLACE(CONCAT(own_seeker.last_name, own_seeker.first_name), ' ', ''), '　', '')
+			)
+			OR task_group.job_seeker_id IN (
+				SELECT other_index.job_seeker_id
+				FROM job_seeker_blind_indexes AS other_index
+				INNER JOIN job_seeker_blind_indexes AS own_index
+				ON own_index.index_type = other_index.index_type AND own_index.value_hash = other_index.value_hash
+				WHERE own_index.job_seeker_id = own_seeker.id AND own_index.index_type = ?
+			)
+		)
+		ORDER BY
+			task_group.id DESC
+		`,
+		entity.DuplicateRecommendationMatchJobSeeker,
+		entity.DuplicateRecommendationMatchNameAndBirthday,
+		entity.DuplicateRecommendationMatchPhoneNumber,
+		searchParam.JobSeekerID,
+		searchParam.EnterpriseID,
+		searchParam.Since.In(time.UTC),
+		searchParam.ExcludeTaskGroupID,
+		entity.Entry,
+		entity.JobSeekerBlindIndexPhoneNumber,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return duplicateList, nil
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type TaskGroupEventRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewTaskGroupEventRepositoryImpl(ex interfaces.SQLExecuter) usecase.TaskGroupEventRepository {
+	return &TaskGroupEventRepositoryImpl{
+		Name:     "TaskGroupEventRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 活動履歴を記録（求職者ID・求人IDはタスクグループから取得して保存する）
+func (repo *TaskGroupEventRepositoryImpl) Create(event *entity.TaskGroupEvent) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO task_group_events (
+				task_group_id,
+				job_seeker_id,
+				job_information_id,
+				event_type,
+				agent_staff_id,
+				task_id,
+				prev_phase_category,
+				prev_phase_sub_category,
+				phase_category,
+				phase_sub_category,
+				diff,
+				created_at
+			)
+			SELECT
+				task_group.id,
+				task_group.job_seeker_id,
+				task_group.job_information_id,
+				?, ?, ?, ?, ?, ?, ?, ?, ?
+			FROM
+				task_groups AS task_group
+			WHERE
+				task_group.id = ?
+			OR (
+				task_group.job_seeker_id = ?
+				AND
+				task_group.job_information_id = ?
+			)
+			LIMIT 1
+		`,
+		event.EventType,
+		event.AgentStaffID,
+		event.TaskID,
+		event.PrevPhaseCategory,
+		event.PrevPhaseSubCategory,
+		event.PhaseCategory,
+		event.PhaseSubCategory,
+		event.Diff,
+		now,
+		event.TaskGroupID,
+		event.JobSeekerID,
+		event.JobInformationID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	event.ID = uint(lastID)
+	event.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// タスクグループの活動履歴を取得
+func (repo *TaskGroupEventRepositoryImpl) GetByTaskGroupID(taskGroupID uint) ([]*entity.TaskGroupEvent, error) {
+	var (
+		eventList []*entity.TaskGroupEvent
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByTaskGroupID",
+		&eventList, `
+		SELECT
+			event.*,
+			IFNULL(staff.staff_name, '') AS staff_name,
+			IFNULL(job_info.title, '') AS title,
+			IFNULL(enterprise.company_name, '') AS company_name
+		FROM
+			task_group_events AS event
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			event.agent_staff_id = staff.id
+		LEFT OUTER JOIN
+			job_informations AS job_info
+		ON
+			event.job_information_id = job_info.id
+		LEFT OUTER JOIN
+			billing_addresses AS billing
+		ON
+			job_info.billing_address_id = billing.id
+		LEFT OUTER JOIN
+			enterprise_profiles AS enterprise
+		ON
+			billing.enterprise_id = enterprise.id
+		WHERE
+			event.task_group_id = ?
+		ORDER BY
+			event.created_at ASC, event.id ASC
+		`,
+		taskGroupID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return eventList, nil
+}
+
+// 求職者の全ての選考の活動履歴を取得（削除済みのタスクグループの履歴も含む）
+func (repo *TaskGroupEventRepositoryImpl) GetByJobSeekerID(jobSeekerID uint) ([]*entity.TaskGroupEvent, error) {
+	var (
+		eventList []*entity.TaskGroupEvent
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByJobSeekerID",
+		&eventList, `
+		SELECT
+			event.*,
+			IFNULL(staff.staff_name, '') AS staff_name,
+			IFNULL(job_info.title, '') AS title,
+			IFNULL(enterprise.company_name, '') AS company_name
+		FROM
+			task_group_events AS event
+		LEFT OUTER JOIN
+			agent_staffs AS staff
+		ON
+			event.agent_staff_id = staff.id
+		LEFT OUTER JOIN
+			job_informations AS job_info
+		ON
+			event.job_information_id = job_info.id
+		LEFT OUTER JOIN
+			billing_addresses AS billing
+		ON
+			job_info.billing_address_id = billing.id
+		LEFT OUTER JOIN
+			enterprise_profiles AS enterprise
+		ON
+			billing.enterprise_id = enterprise.id
+		WHERE
+			event.job_seeker_id = ?
+		ORDER BY
+			event.created_at ASC, event.id ASC
+		`,
+		jobSeekerID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return eventList, nil
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type TaskLossReasonRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewTaskLossReasonRepositoryImpl(ex interfaces.SQLExecuter) usecase.TaskLossReasonRepository {
+	return &TaskLossReasonRepositoryImpl{
+		Name:     "TaskLossReasonRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 終了のタスクの理由を記録
+func (repo *TaskLossReasonRepositoryImpl) Create(lossReason *entity.TaskLossReason) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO task_loss_reasons (
+				task_group_id,
+				task_id,
+				phase_category,
+				phase_sub_category,
+				loss_type,
+				loss_reason,
+				remarks,
+				agent_staff_id,
+				created_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		lossReason.TaskGroupID,
+		lossReason.TaskID,
+		lossReason.PhaseCategory,
+		lossReason.PhaseSubCategory,
+		lossReason.LossType,
+		lossReason.LossReason,
+		lossReason.Remarks,
+		lossReason.AgentStaffID,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	lossReason.ID = uint(lastID)
+	lossReason.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// 選考の理由を記録順に取得
+func (repo *TaskLossReasonRepositoryImpl) GetByTaskGroupID(taskGroupID uint) ([]*entity.TaskLossReason, error) {
+	var (
+		lossReasonList []*entity.TaskLossReason
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByTaskGroupID",
+		&lossReasonList,
+		taskLossReasonSelect+`
+		WHERE
+			loss_reason.task_group_id = ?
+		ORDER BY
+			loss_reason.id ASC
+		`,
+		taskGroupID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return lossReasonList, nil
+}
+
+// 集計条件に一致する理由を取得（自社がCA・RAのいずれかで関わる選考に限る）
+func (repo *TaskLossReasonRepositoryImpl) GetForAnalytics(searchParam entity.SearchTaskLossReasonParam) ([]*entity.TaskLossReason, error) {
+	var (
+		lossReasonList []*entity.TaskLossReason
+		conditions     = `
+		WHERE
+			(task_group.ra_agent_id = ? OR task_group.ca_agent_id = ?)
+		`
+		args = []interface{}{searchParam.AgentID, searchParam.AgentID}
+	)
+
+	if searchParam.LossType.Valid {
+		conditions += `
+			AND loss_reason.loss_type = ?
+		`
+		args = append(args, searchParam.LossType.Int64)
+	}
+
+	// 終了日の条件
+	if !searchParam.FromDate.IsZero() {
+		conditions += `
+			AND loss_reason.created_at >= ?
+		`
+		args = append(args, searchParam.FromDate.In(time.UTC))
+	}
+
+	if !searchParam.ToDate.IsZero() {
+		conditions += `
+			AND loss_reason.created_at <= ?
+		`
+		args = append(args, searchParam.ToDate.In(time.UTC))
+	}
+
+	err := repo.executer.Select(
+		repo.Name+".GetForAnalytics",
+		&lossReasonList,
+		taskLossReasonSelect+conditions+`
+		ORDER BY
+			loss_reason.id ASC
+		`,
+		args...,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return lossReasonList, nil
+}
+
+// 理由と企業・求人・担当者（CAは求職者の担当者、RAは求人の請求先の担当者）
+const taskLossReasonSelect = `
+		SELECT
+			loss_reason.*,
+			task_group.job_information_id,
+			billing.enterprise_id,
+			CASE
+				WHEN job_info.is_external = TRUE AND task_group.external_company_name != ''
+				THEN task_group.external_company_name
+				ELSE IFNULL(enterprise.company_name, '')
+			END AS company_name,
+			CASE
+				WHEN job_info.is_external = TRUE AND task_group.external_job_information_title != ''
+				THEN task_group.external_job_information_title
+				ELSE job_info.title
+			END AS title,
+			seeker.agent_staff_id AS ca_staff_id,
+			billing.agent_staff_id AS ra_staff_id,
+			IFNULL(ca_staff.staff_name, '') AS ca_staff_name,
+			IFNULL(ra_staff.staff_name, '') AS ra_staff_name
+		FROM
+			task_loss_reasons AS loss_reason
+		INNER JOIN
+			task_groups AS task_group
+		ON
+			loss_reason.task_group_id = task_group.id
+		INNER JOIN
+			job_seekers AS seeker
+		ON
+			task_group.job_seeker_id = seeker.id
+		INNER JOIN
+			job_informations AS job_info
+		ON
+			task_group.job_information_id = job_info.id
+		INNER JOIN
+			billing_addresses AS billing
+		ON
+			job_info.billing_address_id = billing.id
+		LEFT OUTER JOIN
+			enterprise_profiles AS enterprise
+		ON
+			billing.enterprise_id = enterprise.id
+		LEFT OUTER JOIN
+			agent_staffs AS ca_staff
+		ON
+			seeker.agent_staff_id = ca_staff.id
+		LEFT OUTER JOIN
+			agent_staffs AS ra_staff
+		ON
+			billing.agent_staff_id = ra_staff.id
+`
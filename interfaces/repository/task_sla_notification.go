@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type TaskSLANotificationRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewTaskSLANotificationRepositoryImpl(ex interfaces.SQLExecuter) usecase.TaskSLANotificationRepository {
+	return &TaskSLANotificationRepositoryImpl{
+		Name:     "TaskSLANotificationRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 送信した通知を記録（同じ期限・同じ通知の記録がある場合は何もしない）
+func (repo *TaskSLANotificationRepositoryImpl) Create(notification *entity.TaskSLANotification) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT IGNORE INTO task_sla_notifications (
+				target_type,
+				task_id,
+				agent_staff_id,
+				notification_type,
+				due_at,
+				created_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?
+			)
+		`,
+		notification.TargetType,
+		notification.TaskID,
+		notification.AgentStaffID,
+		notification.NotificationType,
+		notification.DueAt.In(time.UTC),
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	notification.ID = uint(lastID)
+	notification.CreatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// タスクIDリストから送信済みの通知を取得
+func (repo *TaskSLANotificationRepositoryImpl) GetByTargetTypeAndTaskIDList(targetType int64, taskIDList []uint) ([]*entity.TaskSLANotification, error) {
+	var (
+		notificationList []*entity.TaskSLANotification
+	)
+
+	if len(taskIDList) == 0 {
+		return notificationList, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT *
+		FROM task_sla_notifications
+		WHERE
+			target_type = ?
+		AND
+			task_id IN (%s)
+		`,
+		strings.Trim(strings.Join(strings.Fields(fmt.Sprint(taskIDList)), ", "), "[]"),
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByTargetTypeAndTaskIDList",
+		&notificationList,
+		query,
+		targetType,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return notificationList, nil
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type TaskSLARuleRepositoryImpl struct {
+	Name     string
+	executer interfaces.SQLExecuter
+}
+
+func NewTaskSLARuleRepositoryImpl(ex interfaces.SQLExecuter) usecase.TaskSLARuleRepository {
+	return &TaskSLARuleRepositoryImpl{
+		Name:     "TaskSLARuleRepository",
+		executer: ex,
+	}
+}
+
+/****************************************************************************************/
+// 作成 API
+//
+// 期限の設定を作成
+func (repo *TaskSLARuleRepositoryImpl) Create(rule *entity.TaskSLARule) error {
+	now := time.Now().In(time.UTC)
+	lastID, err := repo.executer.Exec(
+		repo.Name+".Create",
+		`
+			INSERT INTO task_sla_rules (
+				agent_id,
+				target_type,
+				phase_category,
+				phase_sub_category,
+				deadline_hours,
+				reminder_hours,
+				escalation_hours,
+				created_at,
+				updated_at
+			) VALUES (
+				?, ?, ?, ?, ?, ?, ?, ?, ?
+			)
+		`,
+		rule.AgentID,
+		rule.TargetType,
+		rule.PhaseCategory,
+		rule.PhaseSubCategory,
+		rule.DeadlineHours,
+		rule.ReminderHours,
+		rule.EscalationHours,
+		now,
+		now,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	rule.ID = uint(lastID)
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 更新 API
+//
+// 期限の設定を更新
+func (repo *TaskSLARuleRepositoryImpl) Update(id uint, rule *entity.TaskSLARule) error {
+	now := time.Now().In(time.UTC)
+	_, err := repo.executer.Exec(
+		repo.Name+".Update",
+		`
+		UPDATE task_sla_rules
+		SET
+			target_type = ?,
+			phase_category = ?,
+			phase_sub_category = ?,
+			deadline_hours = ?,
+			reminder_hours = ?,
+			escalation_hours = ?,
+			updated_at = ?
+		WHERE
+			id = ?
+		`,
+		rule.TargetType,
+		rule.PhaseCategory,
+		rule.PhaseSubCategory,
+		rule.DeadlineHours,
+		rule.ReminderHours,
+		rule.EscalationHours,
+		now,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	rule.UpdatedAt = now
+
+	return nil
+}
+
+/****************************************************************************************/
+// 削除 API
+//
+// 期限の設定を削除
+func (repo *TaskSLARuleRepositoryImpl) Delete(id uint) error {
+	_, err := repo.executer.Exec(
+		repo.Name+".Delete",
+		`
+		DELETE
+		FROM task_sla_rules
+		WHERE id = ?
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+/****************************************************************************************/
+// 単数取得 API
+//
+// IDから期限の設定を取得
+func (repo *TaskSLARuleRepositoryImpl) FindByID(id uint) (*entity.TaskSLARule, error) {
+	var (
+		rule entity.TaskSLARule
+	)
+
+	err := repo.executer.Get(
+		repo.Name+".FindByID",
+		&rule, `
+		SELECT *
+		FROM task_sla_rules
+		WHERE
+			id = ?
+		LIMIT 1
+		`,
+		id,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+/****************************************************************************************/
+// 複数取得 API
+//
+// エージェントIDから期限の設定一覧を取得
+func (repo *TaskSLARuleRepositoryImpl) GetByAgentID(agentID uint) ([]*entity.TaskSLARule, error) {
+	var (
+		ruleList []*entity.TaskSLARule
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".GetByAgentID",
+		&ruleList, `
+		SELECT *
+		FROM task_sla_rules
+		WHERE
+			agent_id = ?
+		ORDER BY target_type ASC, phase_category ASC, phase_sub_category ASC
+		`,
+		agentID,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return ruleList, nil
+}
+
+// 全ての期限の設定を取得
+func (repo *TaskSLARuleRepositoryImpl) All() ([]*entity.TaskSLARule, error) {
+	var (
+		ruleList []*entity.TaskSLARule
+	)
+
+	err := repo.executer.Select(
+		repo.Name+".All",
+		&ruleList, `
+		SELECT *
+		FROM task_sla_rules
+		ORDER BY agent_id ASC, target_type ASC, phase_category ASC, phase_sub_category ASC
+		`,
+	)
+
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return ruleList, nil
+}
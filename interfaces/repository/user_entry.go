@@ -42,7 +42,7 @@ func (repo *UserEntryRepositoryImpl) Create(userEntry *entity.UserEntry) error {
 		`,
 		userEntry.UserID,
 		userEntry.ServiceType,
-		false,
+		userEntry.IsProcessed,
 		time.Now().In(time.UTC),
 		time.Now().In(time.UTC),
 	)
@@ -97,4 +97,36 @@ var WireSet = wire.NewSet(
 	NewJobSeekerExperienceJobRepositoryImpl,
 	NewJobSeekerLPLoginTokenRepositoryImpl,
 	NewJobSeekerInterestedJobListingRepositoryImpl,
+	NewJobSeekerExternalIDRepositoryImpl,
+	NewJobSeekerMergeSuggestionRepositoryImpl,
+	NewAgentAssignmentRuleRepositoryImpl,
+	NewAgentAssignmentStaffRepositoryImpl,
+	NewAgentAssignmentStaffSpecialtyRepositoryImpl,
+	NewJobSeekerAssignmentLogRepositoryImpl,
+	NewEntryScreeningRuleRepositoryImpl,
+	NewEntryScreeningRuleConditionRepositoryImpl,
+	NewEntryScreeningResultRepositoryImpl,
+	NewInterviewBookingLinkRepositoryImpl,
+	NewAuditLogRepositoryImpl,
+	NewGuestLinkRepositoryImpl,
+	NewGuestLinkAccessLogRepositoryImpl,
+	NewLoginAttemptRepositoryImpl,
+	NewAPIKeyRepositoryImpl,
+	NewAdminUserRepositoryImpl,
+	NewAdminSessionRepositoryImpl,
+	NewAdminActionLogRepositoryImpl,
+	NewEncryptionKeyRepositoryImpl,
+	NewJobSeekerPersonalDataRepositoryImpl,
+	NewTaskSLARuleRepositoryImpl,
+	NewTaskSLANotificationRepositoryImpl,
+	NewTaskGroupEventRepositoryImpl,
+	NewTaskBulkJobRepositoryImpl,
+	NewTaskBulkJobItemRepositoryImpl,
+	NewJobOfferRepositoryImpl,
+	NewJobOfferStatusHistoryRepositoryImpl,
+	NewJobOfferReminderRepositoryImpl,
+	NewTaskLossReasonRepositoryImpl,
+	NewAgentRefundTierRepositoryImpl,
+	NewSaleRefundRepositoryImpl,
+	NewSaleGuaranteeNotificationRepositoryImpl,
 )
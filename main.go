@@ -17,9 +17,12 @@ import (
 	"github.com/spaceaiinc/autoscout-server/domain/utility"
 	"github.com/spaceaiinc/autoscout-server/infrastructure/batch"
 	"github.com/spaceaiinc/autoscout-server/infrastructure/database"
+	"github.com/spaceaiinc/autoscout-server/infrastructure/di"
 	"github.com/spaceaiinc/autoscout-server/infrastructure/driver"
 	"github.com/spaceaiinc/autoscout-server/infrastructure/router"
 	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/interactor"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
 )
 
 func init() {
@@ -49,6 +52,8 @@ func main() {
 		if err != nil {
 			fmt.Println(err)
 		}
+		validateEncryptionKey(db)
+		validateGuestLinkLegacyUntil(cfg.GuestLink)
 		// cache := driver.NewRedisCacheImpl(cfg.Redis)
 		if cfg.App.Env == "local" {
 			firebase := driver.NewFirebaseImpl(cfg.Firebase)
@@ -70,11 +75,64 @@ func main() {
 		if err != nil {
 			fmt.Println(err)
 		}
+		validateEncryptionKey(db)
 		firebase := driver.NewFirebaseImpl(cfg.Firebase)
 		// バッチ処理開始
 		b := batch.NewBatch(cfg, db, firebase)
 		b.SetUp().Start()
+
+	case "rotate_encryption_key":
+		// ENCRYPTION_KEY_VERSIONの鍵で、保存されている暗号文を再暗号化する
+		db := database.NewDB(cfg.DB, false)
+		validateEncryptionKey(db)
+		output, err := di.InitializeEncryptionKeyInteractor(db).RotateEncryptionKey(interactor.RotateEncryptionKeyInput{
+			BatchSize: cfg.Encryption.RotationBatchSize,
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		for _, result := range output.ResultList {
+			if result.FailedCount > 0 {
+				panic(fmt.Sprintf("復号できない値があります。%s.%s: %d件", result.Table, result.Column, result.FailedCount))
+			}
+		}
+		fmt.Println("鍵の切り替えが完了しました。現在の鍵のバージョン:", output.CurrentVersion)
+
+	case "create_admin_user":
+		// 最初の管理者を作成する（以降の管理者は管理者サイトから作成する）
+		db := database.NewDB(cfg.DB, false)
+		validateEncryptionKey(db)
+		output, err := di.InitializeAdminInteractor(db, cfg.Admin).CreateAdminUser(interactor.CreateAdminUserInput{
+			CreateParam: entity.CreateAdminUserParam{
+				LoginID:  cfg.Admin.LoginID,
+				Name:     cfg.Admin.Name,
+				Password: cfg.Admin.Password,
+			},
+		})
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println("管理者を作成しました。ログインID:", output.AdminUser.LoginID)
+	}
+}
+
+// 暗号化の鍵束を読み込み、保存されている暗号文が参照する鍵が揃っていない場合は起動を中止する
+func validateEncryptionKey(db *database.DB) {
+	output, err := di.InitializeEncryptionKeyInteractor(db).ValidateEncryptionKey(interactor.ValidateEncryptionKeyInput{})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("暗号化の鍵のバージョン:", output.VersionList, "現在:", output.CurrentVersion)
+}
+
+// UUIDのみのリンクの移行期限が設定されていない場合は起動を中止する
+func validateGuestLinkLegacyUntil(guestLink config.GuestLink) {
+	until, err := policy.ValidateGuestLinkLegacyUntil(guestLink.LegacyUntil)
+	if err != nil {
+		panic(err)
 	}
+	fmt.Println("UUIDのみのリンクの移行期限:", until.Format("2006-01-02"))
 }
 
 func getTestUserToken(fb usecase.Firebase, uuid string) {
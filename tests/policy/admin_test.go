@@ -0,0 +1,125 @@
+package policy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// 管理者サイトの認証
+//
+var adminTestNow = time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)
+
+// RFC 6238 のテストベクトル（秘密鍵 "12345678901234567890"、T=59 の下6桁）
+func Test_Utility_TOTPCode(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	code, err := utility.TOTPCode(secret, utility.TOTPStep(time.Unix(59, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != "287082" {
+		t.Errorf("287082 を期待しましたが %s でした", code)
+	}
+}
+
+func Test_Utility_VerifyTOTP(t *testing.T) {
+	secret, err := utility.GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	step := utility.TOTPStep(adminTestNow)
+	code, _ := utility.TOTPCode(secret, step)
+
+	// 正しいコードは受け付け、利用したステップを返すこと
+	usedStep, ok := utility.VerifyTOTP(secret, code, adminTestNow, 0)
+	if !ok || usedStep != step {
+		t.Fatalf("正しいコードが拒否されました: %v %d", ok, usedStep)
+	}
+
+	// 一度利用したコードは再利用できないこと
+	if _, ok := utility.VerifyTOTP(secret, code, adminTestNow, usedStep); ok {
+		t.Errorf("利用済みのコードが受け付けられました")
+	}
+
+	// 前後1ステップを超える時刻のコードは受け付けないこと
+	oldCode, _ := utility.TOTPCode(secret, step-2)
+	if _, ok := utility.VerifyTOTP(secret, oldCode, adminTestNow, 0); ok {
+		t.Errorf("期限切れのコードが受け付けられました")
+	}
+}
+
+func Test_Policy_VerifyAdminSession(t *testing.T) {
+	adminUser := entity.NewAdminUser("admin", "管理者", "hash")
+
+	newSession := func() *entity.AdminSession {
+		session := entity.NewAdminSession(1, "hash", adminTestNow.Add(policy.AdminPreMFASessionDuration), "", "")
+		session.CreatedAt = adminTestNow
+		return session
+	}
+
+	// 二要素認証前のセッションは二要素認証のAPIのみ利用できること
+	session := newSession()
+	if err := policy.VerifyAdminSession(adminUser, session, false, adminTestNow); err != nil {
+		t.Errorf("二要素認証前のセッションが拒否されました: %v", err)
+	}
+	if err := policy.VerifyAdminSession(adminUser, session, true, adminTestNow); err == nil {
+		t.Errorf("二要素認証前のセッションで管理者のAPIを利用できました")
+	}
+
+	// 二要素認証後のセッション
+	session.MFAVerifiedAt = null.NewTime(adminTestNow, true)
+	if err := policy.VerifyAdminSession(adminUser, session, true, adminTestNow); err != nil {
+		t.Errorf("二要素認証後のセッションが拒否されました: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(session *entity.AdminSession)
+		now    time.Time
+	}{
+		{"有効期限切れ", func(s *entity.AdminSession) {}, adminTestNow.Add(policy.AdminPreMFASessionDuration)},
+		{"ログアウト済み", func(s *entity.AdminSession) { s.RevokedAt = null.NewTime(adminTestNow, true) }, adminTestNow},
+		{"コードの誤りが上限", func(s *entity.AdminSession) { s.MFAFailedCount = policy.AdminMaxMFAFailedCount }, adminTestNow},
+	}
+
+	for _, c := range cases {
+		session := newSession()
+		c.modify(session)
+		if err := policy.VerifyAdminSession(adminUser, session, false, c.now); err == nil {
+			t.Errorf("%s: セッションが受け付けられました", c.name)
+		}
+	}
+}
+
+func Test_Policy_ExtendAdminSession(t *testing.T) {
+	session := entity.NewAdminSession(1, "hash", adminTestNow, "", "")
+	session.CreatedAt = adminTestNow
+
+	// 最後の操作から30分延長すること
+	now := adminTestNow.Add(time.Hour)
+	if got, want := policy.ExtendAdminSession(session, 30, 8, now), now.Add(30*time.Minute); !got.Equal(want) {
+		t.Errorf("%v を期待しましたが %v でした", want, got)
+	}
+
+	// ログインから8時間を超えて延長しないこと
+	now = adminTestNow.Add(7*time.Hour + 50*time.Minute)
+	if got, want := policy.ExtendAdminSession(session, 30, 8, now), adminTestNow.Add(8*time.Hour); !got.Equal(want) {
+		t.Errorf("%v を期待しましたが %v でした", want, got)
+	}
+}
+
+func Test_Policy_ValidateAdminPassword(t *testing.T) {
+	if err := policy.ValidateAdminPassword("short"); err == nil {
+		t.Errorf("短いパスワードが受け付けられました")
+	}
+	if err := policy.ValidateAdminPassword("long-enough-password"); err != nil {
+		t.Errorf("十分な長さのパスワードが拒否されました: %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package policy_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// 外部連携用のAPIキー
+//
+// 求職者の参照のみ許可したキー（有効期限は2024-10-31 00:00 UTC）
+//
+var (
+	apiKeyTestNow       = time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)
+	apiKeyTestExpiresAt = time.Date(2024, 10, 31, 0, 0, 0, 0, time.UTC)
+)
+
+func newTestAPIKey() *entity.APIKey {
+	return entity.NewAPIKey(1, 1, "BI", "ask_xxxxxxxx", "hash", entity.APIKeyScopeJobSeekerRead, apiKeyTestExpiresAt)
+}
+
+func Test_Utility_APIKey(t *testing.T) {
+	key, prefix, err := utility.GenerateAPIKey()
+	if err != nil {
+		t.Fatalf("APIキーの発行に失敗しました: %v", err)
+	}
+
+	if !utility.IsAPIKey(key) || len(prefix) >= len(key) || key[:len(prefix)] != prefix {
+		t.Errorf("APIキーの形式が正しくありません: %s（%s）", key, prefix)
+	}
+
+	other, _, _ := utility.GenerateAPIKey()
+	if key == other || utility.HashAPIKey(key) == utility.HashAPIKey(other) {
+		t.Errorf("同じAPIキーが発行されました")
+	}
+
+	if utility.HashAPIKey(key) != utility.HashAPIKey(" "+key+" ") {
+		t.Errorf("前後の空白でハッシュが変わりました")
+	}
+}
+
+func Test_Policy_VerifyAPIKey(t *testing.T) {
+	revoked := newTestAPIKey()
+	revoked.RevokedAt = null.NewTime(apiKeyTestNow.Add(-time.Hour), true)
+
+	cases := []struct {
+		name    string
+		apiKey  *entity.APIKey
+		scope   string
+		now     time.Time
+		wantErr error
+	}{
+		{"許可された操作", newTestAPIKey(), entity.APIKeyScopeJobSeekerRead, apiKeyTestNow, nil},
+		{"許可されていない操作", newTestAPIKey(), entity.APIKeyScopeSaleRead, apiKeyTestNow, entity.ErrForbidden},
+		{"APIキーで利用できないAPI", newTestAPIKey(), "", apiKeyTestNow, entity.ErrForbidden},
+		{"有効期限切れ", newTestAPIKey(), entity.APIKeyScopeJobSeekerRead, apiKeyTestExpiresAt, entity.ErrUnauthorized},
+		{"無効化済み", revoked, entity.APIKeyScopeJobSeekerRead, apiKeyTestNow, entity.ErrUnauthorized},
+	}
+
+	for _, c := range cases {
+		err := policy.VerifyAPIKey(c.apiKey, c.scope, c.now)
+		if c.wantErr == nil && err != nil {
+			t.Errorf("%s: エラーが返りました: %v", c.name, err)
+		}
+		if c.wantErr != nil && !errors.Is(err, c.wantErr) {
+			t.Errorf("%s: %v を期待しましたが %v が返りました", c.name, c.wantErr, err)
+		}
+	}
+}
+
+func Test_Policy_ShouldUpdateAPIKeyLastUsed(t *testing.T) {
+	apiKey := newTestAPIKey()
+	if !policy.ShouldUpdateAPIKeyLastUsed(apiKey, apiKeyTestNow) {
+		t.Errorf("未利用のキーで更新しないと判定されました")
+	}
+
+	apiKey.LastUsedAt = null.NewTime(apiKeyTestNow, true)
+	if policy.ShouldUpdateAPIKeyLastUsed(apiKey, apiKeyTestNow.Add(policy.APIKeyLastUsedInterval-time.Second)) {
+		t.Errorf("更新間隔内で更新すると判定されました")
+	}
+	if !policy.ShouldUpdateAPIKeyLastUsed(apiKey, apiKeyTestNow.Add(policy.APIKeyLastUsedInterval)) {
+		t.Errorf("更新間隔を過ぎても更新しないと判定されました")
+	}
+}
+
+func Test_Policy_NormalizeAPIKeyScopes(t *testing.T) {
+	cases := []struct {
+		name    string
+		scopes  []string
+		want    string
+		wantErr bool
+	}{
+		{"売上の参照のみ", []string{"sale:read"}, "sale:read", false},
+		{"重複を除く", []string{"job_seeker:read", "entry:write", "job_seeker:read"}, "job_seeker:read,entry:write", false},
+		{"指定なし", []string{}, "", true},
+		{"不正な操作", []string{"job_seeker:read", "job_seeker:write"}, "", true},
+	}
+
+	for _, c := range cases {
+		got, err := policy.NormalizeAPIKeyScopes(c.scopes)
+		if got != c.want || (err != nil) != c.wantErr {
+			t.Errorf("%s: %q を期待しましたが %q（%v）が返りました", c.name, c.want, got, err)
+		}
+	}
+}
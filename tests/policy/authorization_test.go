@@ -0,0 +1,889 @@
+package policy_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// テスト用のリポジトリ（判定に使うメソッドのみ実装）
+//
+type stubAgentStaffRepository struct {
+	usecase.AgentStaffRepository
+	staffList map[uint]*entity.AgentStaff
+}
+
+func (r *stubAgentStaffRepository) FindByID(id uint) (*entity.AgentStaff, error) {
+	if staff, ok := r.staffList[id]; ok {
+		return staff, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubAgentAllianceRepository struct {
+	usecase.AgentAllianceRepository
+	allianceList []*entity.AgentAlliance
+}
+
+func (r *stubAgentAllianceRepository) FindByAgentID(agent1ID uint, agent2ID uint) (*entity.AgentAlliance, error) {
+	for _, alliance := range r.allianceList {
+		if (alliance.Agent1ID == agent1ID && alliance.Agent2ID == agent2ID) ||
+			(alliance.Agent1ID == agent2ID && alliance.Agent2ID == agent1ID) {
+			return alliance, nil
+		}
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubJobSeekerRepository struct {
+	usecase.JobSeekerRepository
+	jobSeekerList map[uint]*entity.JobSeeker
+}
+
+func (r *stubJobSeekerRepository) FindByID(id uint) (*entity.JobSeeker, error) {
+	if jobSeeker, ok := r.jobSeekerList[id]; ok {
+		return jobSeeker, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubJobSeekerHideToAgentRepository struct {
+	usecase.JobSeekerHideToAgentRepository
+	hideList []*entity.JobSeekerHideToAgent
+}
+
+func (r *stubJobSeekerHideToAgentRepository) GetByJobSeekerID(jobSeekerID uint) ([]*entity.JobSeekerHideToAgent, error) {
+	var hideList []*entity.JobSeekerHideToAgent
+	for _, hide := range r.hideList {
+		if hide.JobSeekerID == jobSeekerID {
+			hideList = append(hideList, hide)
+		}
+	}
+	return hideList, nil
+}
+
+type stubJobInformationRepository struct {
+	usecase.JobInformationRepository
+	jobInformationList map[uint]*entity.JobInformation
+}
+
+func (r *stubJobInformationRepository) FindByID(id uint) (*entity.JobInformation, error) {
+	if jobInformation, ok := r.jobInformationList[id]; ok {
+		return jobInformation, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubJobInformationHideToAgentRepository struct {
+	usecase.JobInformationHideToAgentRepository
+	hideList []*entity.JobInformationHideToAgent
+}
+
+func (r *stubJobInformationHideToAgentRepository) GetByJobInformationID(jobInformationID uint) ([]*entity.JobInformationHideToAgent, error) {
+	var hideList []*entity.JobInformationHideToAgent
+	for _, hide := range r.hideList {
+		if hide.JobInformationID == jobInformationID {
+			hideList = append(hideList, hide)
+		}
+	}
+	return hideList, nil
+}
+
+type stubTaskRepository struct {
+	usecase.TaskRepository
+	taskList map[uint]*entity.Task
+}
+
+func (r *stubTaskRepository) FindByID(id uint) (*entity.Task, error) {
+	if task, ok := r.taskList[id]; ok {
+		return task, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubTaskGroupRepository struct {
+	usecase.TaskGroupRepository
+	taskGroupList map[uint]*entity.TaskGroup
+}
+
+func (r *stubTaskGroupRepository) FindByID(id uint) (*entity.TaskGroup, error) {
+	if taskGroup, ok := r.taskGroupList[id]; ok {
+		return taskGroup, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubEnterpriseProfileRepository struct {
+	usecase.EnterpriseProfileRepository
+	enterpriseList map[uint]*entity.EnterpriseProfile
+}
+
+func (r *stubEnterpriseProfileRepository) FindByID(id uint) (*entity.EnterpriseProfile, error) {
+	if enterprise, ok := r.enterpriseList[id]; ok {
+		return enterprise, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubBillingAddressRepository struct {
+	usecase.BillingAddressRepository
+	billingAddressList map[uint]*entity.BillingAddress
+}
+
+func (r *stubBillingAddressRepository) FindByID(billingAddressID uint) (*entity.BillingAddress, error) {
+	if billingAddress, ok := r.billingAddressList[billingAddressID]; ok {
+		return billingAddress, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubSaleRepository struct {
+	usecase.SaleRepository
+	saleList map[uint]*entity.Sale
+}
+
+func (r *stubSaleRepository) FindByID(id uint) (*entity.Sale, error) {
+	if sale, ok := r.saleList[id]; ok {
+		return sale, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+/****************************************************************************************/
+// テストデータ
+//
+// エージェント1: 自社
+// エージェント2: アライアンス締結済み
+// エージェント3: アライアンス申請中（未成立）
+// エージェント4: アライアンスなし
+//
+var (
+	adminStaff    = &entity.AgentStaff{ID: 1, AgentID: 1, Authority: null.NewInt(int64(entity.AuthorityAdmin), true)}
+	generalStaff  = &entity.AgentStaff{ID: 2, AgentID: 1, Authority: null.NewInt(int64(entity.AuthorityGeneral), true)}
+	allianceStaff = &entity.AgentStaff{ID: 3, AgentID: 2, Authority: null.NewInt(int64(entity.AuthorityAdmin), true)}
+	pendingStaff  = &entity.AgentStaff{ID: 4, AgentID: 3, Authority: null.NewInt(int64(entity.AuthorityAdmin), true)}
+	otherStaff    = &entity.AgentStaff{ID: 5, AgentID: 4, Authority: null.NewInt(int64(entity.AuthorityAdmin), true)}
+)
+
+func newAgentStaffRepository() usecase.AgentStaffRepository {
+	return &stubAgentStaffRepository{
+		staffList: map[uint]*entity.AgentStaff{
+			adminStaff.ID:    adminStaff,
+			generalStaff.ID:  generalStaff,
+			allianceStaff.ID: allianceStaff,
+			pendingStaff.ID:  pendingStaff,
+			otherStaff.ID:    otherStaff,
+		},
+	}
+}
+
+func newAgentAllianceRepository() usecase.AgentAllianceRepository {
+	return &stubAgentAllianceRepository{
+		allianceList: []*entity.AgentAlliance{
+			{Agent1ID: 1, Agent2ID: 2, Agent1Request: true, Agent2Request: true},
+			{Agent1ID: 3, Agent2ID: 1, Agent1Request: true, Agent2Request: false},
+		},
+	}
+}
+
+// 求職者1: 自社、求職者2: エージェント2（公開）、求職者3: エージェント2（エージェント1に非公開）
+func newJobSeekerRepositories() (usecase.JobSeekerRepository, usecase.JobSeekerHideToAgentRepository) {
+	return &stubJobSeekerRepository{
+		jobSeekerList: map[uint]*entity.JobSeeker{
+			1: {ID: 1, AgentID: 1},
+			2: {ID: 2, AgentID: 2},
+			3: {ID: 3, AgentID: 2},
+		},
+	}, &stubJobSeekerHideToAgentRepository{
+		hideList: []*entity.JobSeekerHideToAgent{
+			{JobSeekerID: 3, AgentID: 1},
+		},
+	}
+}
+
+// 求人1: 自社、求人2: エージェント2（公開）、求人3: エージェント2（エージェント1に非公開）
+func newJobInformationRepositories() (usecase.JobInformationRepository, usecase.JobInformationHideToAgentRepository) {
+	return &stubJobInformationRepository{
+		jobInformationList: map[uint]*entity.JobInformation{
+			1: {ID: 1, AgentID: 1},
+			2: {ID: 2, AgentID: 2},
+			3: {ID: 3, AgentID: 2},
+		},
+	}, &stubJobInformationHideToAgentRepository{
+		hideList: []*entity.JobInformationHideToAgent{
+			{JobInformationID: 3, AgentID: 1},
+		},
+	}
+}
+
+// タスクグループ1: RA自社・CAエージェント2、タスクグループ2: RA・CAともにエージェント2
+// タスク1: タスクグループ1、タスク2: タスクグループ2
+func newTaskRepositories() (usecase.TaskRepository, usecase.TaskGroupRepository) {
+	return &stubTaskRepository{
+		taskList: map[uint]*entity.Task{
+			1: {ID: 1, TaskGroupID: 1},
+			2: {ID: 2, TaskGroupID: 2},
+		},
+	}, &stubTaskGroupRepository{
+		taskGroupList: map[uint]*entity.TaskGroup{
+			1: {ID: 1, RAAgentID: 1, CAAgentID: 2},
+			2: {ID: 2, RAAgentID: 2, CAAgentID: 2},
+		},
+	}
+}
+
+func assertForbidden(t *testing.T, name string, err error, wantForbidden bool) {
+	t.Helper()
+
+	if wantForbidden && !errors.Is(err, entity.ErrForbidden) {
+		t.Errorf("%s: ErrForbiddenを期待しましたが %v が返りました", name, err)
+	}
+	if !wantForbidden && err != nil {
+		t.Errorf("%s: 許可を期待しましたが %v が返りました", name, err)
+	}
+}
+
+/****************************************************************************************/
+// 管理者権限
+//
+func Test_Policy_RequireAdmin(t *testing.T) {
+	assertForbidden(t, "管理者", policy.RequireAdmin(adminStaff), false)
+	assertForbidden(t, "一般", policy.RequireAdmin(generalStaff), true)
+	assertForbidden(t, "権限未設定", policy.RequireAdmin(&entity.AgentStaff{ID: 9, AgentID: 1}), true)
+
+	err := policy.RequireAdmin(nil)
+	if !errors.Is(err, entity.ErrUnauthorized) {
+		t.Errorf("未認証: ErrUnauthorizedを期待しましたが %v が返りました", err)
+	}
+}
+
+/****************************************************************************************/
+// エージェント
+//
+func Test_Policy_AuthorizeAgent(t *testing.T) {
+	aaR := newAgentAllianceRepository()
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		agentID       uint
+		isWrite       bool
+		wantForbidden bool
+	}{
+		{"管理者・自社・参照", adminStaff, 1, false, false},
+		{"一般・自社・更新", generalStaff, 1, true, false},
+		{"一般・アライアンス先・参照", generalStaff, 2, false, false},
+		{"一般・アライアンス先・更新", generalStaff, 2, true, true},
+		{"アライアンス先の担当者・相手先・参照", allianceStaff, 1, false, false},
+		{"管理者・申請中のエージェント・参照", adminStaff, 3, false, true},
+		{"管理者・他社・参照", adminStaff, 4, false, true},
+		{"他社の管理者・自社以外・参照", otherStaff, 1, false, true},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeAgent(aaR, c.operator, c.agentID, c.isWrite)
+		assertForbidden(t, c.name, err, c.wantForbidden)
+	}
+}
+
+/****************************************************************************************/
+// 担当者
+//
+func Test_Policy_AuthorizeAgentStaff(t *testing.T) {
+	asR := newAgentStaffRepository()
+	aaR := newAgentAllianceRepository()
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		agentStaffID  uint
+		isWrite       bool
+		wantForbidden bool
+	}{
+		{"一般・本人・更新", generalStaff, generalStaff.ID, true, false},
+		{"一般・同じエージェントの管理者・更新", generalStaff, adminStaff.ID, true, false},
+		{"管理者・アライアンス先の担当者・参照", adminStaff, allianceStaff.ID, false, false},
+		{"管理者・アライアンス先の担当者・更新", adminStaff, allianceStaff.ID, true, true},
+		{"管理者・申請中のエージェントの担当者・参照", adminStaff, pendingStaff.ID, false, true},
+		{"他社の管理者・自社の担当者・参照", otherStaff, generalStaff.ID, false, true},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeAgentStaff(asR, aaR, c.operator, c.agentStaffID, c.isWrite)
+		assertForbidden(t, c.name, err, c.wantForbidden)
+	}
+
+	err := policy.AuthorizeAgentStaff(asR, aaR, adminStaff, 999, false)
+	if !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("存在しない担当者: ErrNotFoundを期待しましたが %v が返りました", err)
+	}
+}
+
+/****************************************************************************************/
+// 求職者
+//
+func Test_Policy_AuthorizeJobSeeker(t *testing.T) {
+	jsR, jshR := newJobSeekerRepositories()
+	aaR := newAgentAllianceRepository()
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		jobSeekerID   uint
+		isWrite       bool
+		wantForbidden bool
+	}{
+		{"一般・自社の求職者・更新", generalStaff, 1, true, false},
+		{"一般・アライアンス先の公開求職者・参照", generalStaff, 2, false, false},
+		{"一般・アライアンス先の公開求職者・更新", generalStaff, 2, true, true},
+		{"管理者・アライアンス先の非公開求職者・参照", adminStaff, 3, false, true},
+		{"アライアンス先の担当者・自社の非公開求職者・更新", allianceStaff, 3, true, false},
+		{"アライアンス先の担当者・相手先の求職者・参照", allianceStaff, 1, false, false},
+		{"申請中のエージェントの担当者・求職者・参照", pendingStaff, 1, false, true},
+		{"他社の管理者・求職者・参照", otherStaff, 2, false, true},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeJobSeeker(jsR, jshR, aaR, c.operator, c.jobSeekerID, c.isWrite)
+		assertForbidden(t, c.name, err, c.wantForbidden)
+	}
+}
+
+/****************************************************************************************/
+// 求人
+//
+func Test_Policy_AuthorizeJobInformation(t *testing.T) {
+	jiR, jihR := newJobInformationRepositories()
+	aaR := newAgentAllianceRepository()
+
+	cases := []struct {
+		name             string
+		operator         *entity.AgentStaff
+		jobInformationID uint
+		isWrite          bool
+		wantForbidden    bool
+	}{
+		{"一般・自社の求人・更新", generalStaff, 1, true, false},
+		{"一般・アライアンス先の公開求人・参照", generalStaff, 2, false, false},
+		{"一般・アライアンス先の公開求人・更新", generalStaff, 2, true, true},
+		{"管理者・アライアンス先の非公開求人・参照", adminStaff, 3, false, true},
+		{"アライアンス先の担当者・相手先の求人・参照", allianceStaff, 1, false, false},
+		{"他社の管理者・求人・参照", otherStaff, 1, false, true},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeJobInformation(jiR, jihR, aaR, c.operator, c.jobInformationID, c.isWrite)
+		assertForbidden(t, c.name, err, c.wantForbidden)
+	}
+}
+
+/****************************************************************************************/
+// タスク・タスクグループ
+//
+func Test_Policy_AuthorizeTask(t *testing.T) {
+	tR, tgR := newTaskRepositories()
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		taskID        uint
+		wantForbidden bool
+	}{
+		{"一般・RAとして担当する選考", generalStaff, 1, false},
+		{"アライアンス先の担当者・CAとして担当する選考", allianceStaff, 1, false},
+		{"一般・担当していないアライアンス先の選考", generalStaff, 2, true},
+		{"他社の管理者・選考", otherStaff, 1, true},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeTask(tR, tgR, c.operator, c.taskID)
+		assertForbidden(t, c.name, err, c.wantForbidden)
+
+		task, _ := tR.FindByID(c.taskID)
+		err = policy.AuthorizeTaskGroup(tgR, c.operator, task.TaskGroupID)
+		assertForbidden(t, c.name+"（タスクグループ）", err, c.wantForbidden)
+	}
+
+	err := policy.AuthorizeTask(tR, tgR, generalStaff, 999)
+	if !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("存在しないタスク: ErrNotFoundを期待しましたが %v が返りました", err)
+	}
+}
+
+/****************************************************************************************/
+// 企業・請求先
+//
+func Test_Policy_AuthorizeEnterprise(t *testing.T) {
+	aaR := newAgentAllianceRepository()
+	epR := &stubEnterpriseProfileRepository{
+		enterpriseList: map[uint]*entity.EnterpriseProfile{
+			1: {ID: 1, AgentID: 1},
+			2: {ID: 2, AgentID: 2},
+			4: {ID: 4, AgentID: 4},
+		},
+	}
+	baR := &stubBillingAddressRepository{
+		billingAddressList: map[uint]*entity.BillingAddress{
+			1: {ID: 1, EnterpriseID: 1, AgentID: 1},
+			2: {ID: 2, EnterpriseID: 2, AgentID: 2},
+			4: {ID: 4, EnterpriseID: 4, AgentID: 4},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		id            uint
+		isWrite       bool
+		wantForbidden bool
+	}{
+		{"一般・自社・更新", generalStaff, 1, true, false},
+		{"一般・アライアンス先・参照", generalStaff, 2, false, false},
+		{"一般・アライアンス先・更新", generalStaff, 2, true, true},
+		{"管理者・他社・参照", adminStaff, 4, false, true},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeEnterprise(epR, aaR, c.operator, c.id, c.isWrite)
+		assertForbidden(t, c.name+"（企業）", err, c.wantForbidden)
+
+		err = policy.AuthorizeBillingAddress(baR, aaR, c.operator, c.id, c.isWrite)
+		assertForbidden(t, c.name+"（請求先）", err, c.wantForbidden)
+	}
+}
+
+/****************************************************************************************/
+// 売上
+//
+func Test_Policy_AuthorizeSale(t *testing.T) {
+	sR := &stubSaleRepository{
+		saleList: map[uint]*entity.Sale{
+			1: {ID: 1, RAAgentID: 1, CAAgentID: 2},
+			2: {ID: 2, RAAgentID: 2, CAAgentID: 2},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		saleID        uint
+		wantForbidden bool
+	}{
+		{"一般・RAとして担当する売上", generalStaff, 1, false},
+		{"アライアンス先の担当者・CAとして担当する売上", allianceStaff, 1, false},
+		{"一般・担当していないアライアンス先の売上", generalStaff, 2, true},
+		{"他社の管理者・売上", otherStaff, 1, true},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeSale(sR, c.operator, c.saleID)
+		assertForbidden(t, c.name, err, c.wantForbidden)
+	}
+}
+
+/****************************************************************************************/
+// 求職者に紐づくリソース（日程・選考後アンケート）
+//
+type stubJobSeekerScheduleRepository struct {
+	usecase.JobSeekerScheduleRepository
+	scheduleList map[uint]*entity.JobSeekerSchedule
+}
+
+func (r *stubJobSeekerScheduleRepository) FindByID(id uint) (*entity.JobSeekerSchedule, error) {
+	if schedule, ok := r.scheduleList[id]; ok {
+		return schedule, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubSelectionQuestionnaireRepository struct {
+	usecase.SelectionQuestionnaireRepository
+	questionnaireList map[uint]*entity.SelectionQuestionnaire
+}
+
+func (r *stubSelectionQuestionnaireRepository) FindByID(id uint) (*entity.SelectionQuestionnaire, error) {
+	if questionnaire, ok := r.questionnaireList[id]; ok {
+		return questionnaire, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+// /api/job_seeker_schedule/update/:schedule_id・/api/selection_questionnaire/update/:questionnaire_id
+func Test_Policy_AuthorizeJobSeekerResource(t *testing.T) {
+	jsR, jshR := newJobSeekerRepositories()
+	aaR := newAgentAllianceRepository()
+	jssR := &stubJobSeekerScheduleRepository{
+		scheduleList: map[uint]*entity.JobSeekerSchedule{
+			1: {ID: 1, JobSeekerID: 1},
+			2: {ID: 2, JobSeekerID: 2},
+			3: {ID: 3, JobSeekerID: 3},
+		},
+	}
+	sqR := &stubSelectionQuestionnaireRepository{
+		questionnaireList: map[uint]*entity.SelectionQuestionnaire{
+			1: {ID: 1, JobSeekerID: 1},
+			2: {ID: 2, JobSeekerID: 2},
+			3: {ID: 3, JobSeekerID: 3},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		id            uint
+		isWrite       bool
+		wantForbidden bool
+	}{
+		{"一般・自社の求職者・更新", generalStaff, 1, true, false},
+		{"一般・アライアンス先の公開求職者・参照", generalStaff, 2, false, false},
+		{"一般・アライアンス先の公開求職者・更新", generalStaff, 2, true, true},
+		{"管理者・アライアンス先の非公開求職者・参照", adminStaff, 3, false, true},
+		{"他社の管理者・求職者・参照", otherStaff, 1, false, true},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeJobSeekerSchedule(jssR, jsR, jshR, aaR, c.operator, c.id, c.isWrite)
+		assertForbidden(t, c.name+"（日程）", err, c.wantForbidden)
+
+		err = policy.AuthorizeSelectionQuestionnaire(sqR, jsR, jshR, aaR, c.operator, c.id, c.isWrite)
+		assertForbidden(t, c.name+"（選考後アンケート）", err, c.wantForbidden)
+	}
+}
+
+/****************************************************************************************/
+// 選考フロー
+//
+type stubSelectionFlowPatternRepository struct {
+	usecase.JobInformationSelectionFlowPatternRepository
+	selectionFlowList map[uint]*entity.JobInformationSelectionFlowPattern
+}
+
+func (r *stubSelectionFlowPatternRepository) FindByID(id uint) (*entity.JobInformationSelectionFlowPattern, error) {
+	if selectionFlow, ok := r.selectionFlowList[id]; ok {
+		return selectionFlow, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+// /api/job_information/selection_flow/update/:selection_flow_id・/api/task/active_task/count/selection/:selection_id
+func Test_Policy_AuthorizeSelectionFlowPattern(t *testing.T) {
+	jiR, jihR := newJobInformationRepositories()
+	aaR := newAgentAllianceRepository()
+	sfpR := &stubSelectionFlowPatternRepository{
+		selectionFlowList: map[uint]*entity.JobInformationSelectionFlowPattern{
+			1: {ID: 1, JobInformationID: 1},
+			2: {ID: 2, JobInformationID: 2},
+			3: {ID: 3, JobInformationID: 3},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		id            uint
+		isWrite       bool
+		wantForbidden bool
+	}{
+		{"一般・自社の求人・更新", generalStaff, 1, true, false},
+		{"一般・アライアンス先の公開求人・参照", generalStaff, 2, false, false},
+		{"一般・アライアンス先の公開求人・更新", generalStaff, 2, true, true},
+		{"管理者・アライアンス先の非公開求人・参照", adminStaff, 3, false, true},
+		{"他社の管理者・求人・参照", otherStaff, 1, false, true},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeSelectionFlowPattern(sfpR, jiR, jihR, aaR, c.operator, c.id, c.isWrite)
+		assertForbidden(t, c.name, err, c.wantForbidden)
+	}
+}
+
+/****************************************************************************************/
+// チャット（求職者・エージェント間）
+//
+type stubChatGroupWithJobSeekerRepository struct {
+	usecase.ChatGroupWithJobSeekerRepository
+	chatGroupList map[uint]*entity.ChatGroupWithJobSeeker
+}
+
+func (r *stubChatGroupWithJobSeekerRepository) FindByID(id uint) (*entity.ChatGroupWithJobSeeker, error) {
+	if chatGroup, ok := r.chatGroupList[id]; ok {
+		return chatGroup, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubChatGroupWithAgentRepository struct {
+	usecase.ChatGroupWithAgentRepository
+	chatGroupList map[uint]*entity.ChatGroupWithAgent
+}
+
+func (r *stubChatGroupWithAgentRepository) FindByID(id uint) (*entity.ChatGroupWithAgent, error) {
+	if chatGroup, ok := r.chatGroupList[id]; ok {
+		return chatGroup, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubChatThreadWithAgentRepository struct {
+	usecase.ChatThreadWithAgentRepository
+	chatThreadList map[uint]*entity.ChatThreadWithAgent
+}
+
+func (r *stubChatThreadWithAgentRepository) FindByID(id uint) (*entity.ChatThreadWithAgent, error) {
+	if chatThread, ok := r.chatThreadList[id]; ok {
+		return chatThread, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+// /api/chat_message_with_job_seeker/list/group/:group_id・/api/chat_group_with_job_seeker/:id
+// /api/chat_thread_with_agent/:thread_id・/api/chat_message_with_agent/list/thread/:thread_id/:agent_staff_id
+func Test_Policy_AuthorizeChat(t *testing.T) {
+	cgjR := &stubChatGroupWithJobSeekerRepository{
+		chatGroupList: map[uint]*entity.ChatGroupWithJobSeeker{
+			1: {ID: 1, AgentID: 1},
+			2: {ID: 2, AgentID: 2},
+		},
+	}
+	cgaR := &stubChatGroupWithAgentRepository{
+		chatGroupList: map[uint]*entity.ChatGroupWithAgent{
+			1: {ID: 1, Agent1ID: 1, Agent2ID: 2},
+			2: {ID: 2, Agent1ID: 2, Agent2ID: 4},
+		},
+	}
+	ctaR := &stubChatThreadWithAgentRepository{
+		chatThreadList: map[uint]*entity.ChatThreadWithAgent{
+			1: {ID: 1, GroupID: 1},
+			2: {ID: 2, GroupID: 2},
+		},
+	}
+
+	jobSeekerCases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		id            uint
+		wantForbidden bool
+	}{
+		{"一般・自社のチャット", generalStaff, 1, false},
+		{"一般・アライアンス先のチャット", generalStaff, 2, true},
+		{"他社の管理者・チャット", otherStaff, 1, true},
+	}
+
+	for _, c := range jobSeekerCases {
+		err := policy.AuthorizeChatGroupWithJobSeeker(cgjR, c.operator, c.id)
+		assertForbidden(t, c.name+"（求職者とのチャット）", err, c.wantForbidden)
+	}
+
+	agentCases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		id            uint
+		wantForbidden bool
+	}{
+		{"一般・自社が参加するグループ", generalStaff, 1, false},
+		{"アライアンス先の担当者・参加するグループ", allianceStaff, 1, false},
+		{"一般・参加していないグループ", generalStaff, 2, true},
+		{"申請中のエージェントの担当者・グループ", pendingStaff, 1, true},
+	}
+
+	for _, c := range agentCases {
+		err := policy.AuthorizeChatGroupWithAgent(cgaR, c.operator, c.id)
+		assertForbidden(t, c.name+"（グループ）", err, c.wantForbidden)
+
+		err = policy.AuthorizeChatThreadWithAgent(ctaR, cgaR, c.operator, c.id)
+		assertForbidden(t, c.name+"（スレッド）", err, c.wantForbidden)
+	}
+}
+
+/****************************************************************************************/
+// 自社のみ利用できるリソース
+//
+type stubInterviewTaskGroupRepository struct {
+	usecase.InterviewTaskGroupRepository
+	interviewTaskGroupList map[uint]*entity.InterviewTaskGroup
+}
+
+func (r *stubInterviewTaskGroupRepository) FindByID(id uint) (*entity.InterviewTaskGroup, error) {
+	if interviewTaskGroup, ok := r.interviewTaskGroupList[id]; ok {
+		return interviewTaskGroup, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubAgentRobotRepository struct {
+	usecase.AgentRobotRepository
+	agentRobotList map[uint]*entity.AgentRobot
+}
+
+func (r *stubAgentRobotRepository) FindByID(id uint) (*entity.AgentRobot, error) {
+	if agentRobot, ok := r.agentRobotList[id]; ok {
+		return agentRobot, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubScoutServiceRepository struct {
+	usecase.ScoutServiceRepository
+	scoutServiceList map[uint]*entity.ScoutService
+}
+
+func (r *stubScoutServiceRepository) FindByID(id uint) (*entity.ScoutService, error) {
+	if scoutService, ok := r.scoutServiceList[id]; ok {
+		return scoutService, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubAgentInflowChannelOptionRepository struct {
+	usecase.AgentInflowChannelOptionRepository
+	optionList map[uint]*entity.AgentInflowChannelOption
+}
+
+func (r *stubAgentInflowChannelOptionRepository) FindByID(id uint) (*entity.AgentInflowChannelOption, error) {
+	if option, ok := r.optionList[id]; ok {
+		return option, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubInterviewAdjustmentTemplateRepository struct {
+	usecase.InterviewAdjustmentTemplateRepository
+	templateList map[uint]*entity.InterviewAdjustmentTemplate
+}
+
+func (r *stubInterviewAdjustmentTemplateRepository) FindByID(id uint) (*entity.InterviewAdjustmentTemplate, error) {
+	if template, ok := r.templateList[id]; ok {
+		return template, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubMessageTemplateRepository struct {
+	usecase.MessageTemplateRepository
+	templateList map[uint]*entity.MessageTemplate
+}
+
+func (r *stubMessageTemplateRepository) FindByID(id uint) (*entity.MessageTemplate, error) {
+	if template, ok := r.templateList[id]; ok {
+		return template, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+type stubAgentSaleManagementRepository struct {
+	usecase.AgentSaleManagementRepository
+	saleManagementList map[uint]*entity.AgentSaleManagement
+}
+
+func (r *stubAgentSaleManagementRepository) FindByID(id uint) (*entity.AgentSaleManagement, error) {
+	if saleManagement, ok := r.saleManagementList[id]; ok {
+		return saleManagement, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+// ID1: 自社、ID2: アライアンス先（アライアンス先でも他社のものは利用できない）
+// /api/interview_task/list/group/:group_id・/api/agent_robot/:agent_robot_id・/api/scout_service/:scout_service_id
+// /api/agent_inflow_channel_option/:agent_inflow_channel_option_id・/api/interview_template/:template_id
+// /api/template/:template_id・/api/sale_management/:management_id
+func Test_Policy_AuthorizeOwnAgentResource(t *testing.T) {
+	asR := newAgentStaffRepository()
+	itgR := &stubInterviewTaskGroupRepository{
+		interviewTaskGroupList: map[uint]*entity.InterviewTaskGroup{
+			1: {ID: 1, AgentID: 1},
+			2: {ID: 2, AgentID: 2},
+		},
+	}
+	arR := &stubAgentRobotRepository{
+		agentRobotList: map[uint]*entity.AgentRobot{
+			1: {ID: 1, AgentID: 1},
+			2: {ID: 2, AgentID: 2},
+		},
+	}
+	ssR := &stubScoutServiceRepository{
+		scoutServiceList: map[uint]*entity.ScoutService{
+			1: {ID: 1, AgentRobotID: 1},
+			2: {ID: 2, AgentRobotID: 2},
+		},
+	}
+	aicoR := &stubAgentInflowChannelOptionRepository{
+		optionList: map[uint]*entity.AgentInflowChannelOption{
+			1: {ID: 1, AgentID: 1},
+			2: {ID: 2, AgentID: 2},
+		},
+	}
+	iatR := &stubInterviewAdjustmentTemplateRepository{
+		templateList: map[uint]*entity.InterviewAdjustmentTemplate{
+			1: {ID: 1, AgentID: 1},
+			2: {ID: 2, AgentID: 2},
+		},
+	}
+	mtR := &stubMessageTemplateRepository{
+		templateList: map[uint]*entity.MessageTemplate{
+			1: {ID: 1, AgentStaffID: adminStaff.ID},
+			2: {ID: 2, AgentStaffID: allianceStaff.ID},
+		},
+	}
+	asmR := &stubAgentSaleManagementRepository{
+		saleManagementList: map[uint]*entity.AgentSaleManagement{
+			1: {ID: 1, AgentID: 1},
+			2: {ID: 2, AgentID: 2},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		id            uint
+		wantForbidden bool
+	}{
+		{"一般・自社", generalStaff, 1, false},
+		{"一般・アライアンス先", generalStaff, 2, true},
+		{"アライアンス先の担当者・自社", allianceStaff, 2, false},
+		{"他社の管理者", otherStaff, 1, true},
+	}
+
+	for _, c := range cases {
+		assertForbidden(t, c.name+"（面談調整）", policy.AuthorizeInterviewTaskGroup(itgR, c.operator, c.id), c.wantForbidden)
+		assertForbidden(t, c.name+"（ロボット）", policy.AuthorizeAgentRobot(arR, c.operator, c.id), c.wantForbidden)
+		assertForbidden(t, c.name+"（スカウトサービス）", policy.AuthorizeScoutService(ssR, arR, c.operator, c.id), c.wantForbidden)
+		assertForbidden(t, c.name+"（流入経路）", policy.AuthorizeAgentInflowChannelOption(aicoR, c.operator, c.id), c.wantForbidden)
+		assertForbidden(t, c.name+"（面談調整テンプレート）", policy.AuthorizeInterviewAdjustmentTemplate(iatR, c.operator, c.id), c.wantForbidden)
+		assertForbidden(t, c.name+"（メッセージテンプレート）", policy.AuthorizeMessageTemplate(mtR, asR, c.operator, c.id), c.wantForbidden)
+		assertForbidden(t, c.name+"（売上管理）", policy.AuthorizeSaleManagement(asmR, c.operator, c.id), c.wantForbidden)
+	}
+}
+
+/****************************************************************************************/
+// アライアンス
+//
+type stubAgentAllianceByIDRepository struct {
+	usecase.AgentAllianceRepository
+	allianceList map[uint]*entity.AgentAlliance
+}
+
+func (r *stubAgentAllianceByIDRepository) FindByID(id uint) (*entity.AgentAlliance, error) {
+	if alliance, ok := r.allianceList[id]; ok {
+		return alliance, nil
+	}
+	return nil, entity.ErrNotFound
+}
+
+// /api/agent_alliance/:agent_alliance_id
+func Test_Policy_AuthorizeAgentAlliance(t *testing.T) {
+	aaR := &stubAgentAllianceByIDRepository{
+		allianceList: map[uint]*entity.AgentAlliance{
+			1: {ID: 1, Agent1ID: 1, Agent2ID: 2},
+			2: {ID: 2, Agent1ID: 2, Agent2ID: 4},
+		},
+	}
+
+	assertForbidden(t, "一般・自社のアライアンス", policy.AuthorizeAgentAlliance(aaR, generalStaff, 1), false)
+	assertForbidden(t, "アライアンス先の担当者・相手先とのアライアンス", policy.AuthorizeAgentAlliance(aaR, allianceStaff, 1), false)
+	assertForbidden(t, "一般・他社間のアライアンス", policy.AuthorizeAgentAlliance(aaR, generalStaff, 2), true)
+}
@@ -0,0 +1,57 @@
+package policy_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+)
+
+/****************************************************************************************/
+// 重複推薦
+//
+func Test_Policy_GetDuplicateRecommendationSince(t *testing.T) {
+	now := time.Date(2024, 10, 23, 10, 0, 0, 0, time.UTC)
+
+	since, ok := policy.GetDuplicateRecommendationSince(180, now)
+	if !ok || !since.Equal(now.AddDate(0, 0, -180)) {
+		t.Errorf("180日前から確認すること: since=%v ok=%v", since, ok)
+	}
+
+	_, ok = policy.GetDuplicateRecommendationSince(0, now)
+	if ok {
+		t.Errorf("期間が0日の場合は確認しないこと")
+	}
+}
+
+func Test_Policy_RequireDuplicateRecommendationOverride(t *testing.T) {
+	duplicateList := []*entity.DuplicateRecommendation{
+		{TaskGroupID: 10, CompanyName: "株式会社テスト", Title: "営業職", CAAgentName: "アライアンス先", MatchType: entity.DuplicateRecommendationMatchPhoneNumber},
+		{CompanyName: "株式会社テスト", Title: "事務職", MatchType: entity.DuplicateRecommendationMatchSameRequest},
+	}
+
+	cases := []struct {
+		name          string
+		duplicateList []*entity.DuplicateRecommendation
+		reason        string
+		ok            bool
+	}{
+		{"重複なし", nil, "", true},
+		{"重複あり・理由なし", duplicateList, "", false},
+		{"重複あり・空白のみの理由", duplicateList, " 　", false},
+		{"重複あり・理由あり", duplicateList, "求職者本人から別部署への応募希望があったため", true},
+	}
+
+	for _, c := range cases {
+		err := policy.RequireDuplicateRecommendationOverride(c.duplicateList, c.reason)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: 推薦できること=%v を期待しましたが err=%v でした", c.name, c.ok, err)
+		}
+
+		if err != nil && !errors.Is(err, entity.ErrDuplicateEntry) {
+			t.Errorf("%s: ErrDuplicateEntry を期待しましたが err=%v でした", c.name, err)
+		}
+	}
+}
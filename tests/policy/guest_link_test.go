@@ -0,0 +1,237 @@
+package policy_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// ゲスト用リンク
+//
+// 求職者のマイページ用に発行したリンク（有効期限は2024-10-31 00:00 UTC）
+//
+const guestLinkTestSecret = "guest-link-test-secret"
+
+var (
+	guestLinkTestNow       = time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)
+	guestLinkTestExpiresAt = time.Date(2024, 10, 31, 0, 0, 0, 0, time.UTC)
+)
+
+func newTestGuestLink() *entity.GuestLink {
+	guestLink := entity.NewGuestLink(
+		1,
+		1,
+		entity.GuestLinkResourceJobSeeker,
+		uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		"read,write",
+		guestLinkTestExpiresAt,
+	)
+	guestLink.UUID = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	return guestLink
+}
+
+func newTestGuestLinkClaims(t *testing.T, guestLink *entity.GuestLink, secret string) *utility.GuestLinkClaims {
+	token, err := utility.NewGuestLinkJWT(
+		guestLink.UUID.String(),
+		guestLink.ResourceType,
+		guestLink.ResourceUUID.String(),
+		guestLink.PermissionList(),
+		guestLinkTestNow,
+		guestLink.ExpiresAt,
+		secret,
+	)
+	if err != nil {
+		t.Fatalf("トークンの発行に失敗しました: %v", err)
+	}
+
+	claims, err := utility.ParseGuestLinkJWT(token, guestLinkTestSecret)
+	if err != nil {
+		return nil
+	}
+	return claims
+}
+
+func Test_Utility_GuestLinkJWT(t *testing.T) {
+	guestLink := newTestGuestLink()
+
+	claims := newTestGuestLinkClaims(t, guestLink, guestLinkTestSecret)
+	if claims == nil {
+		t.Fatalf("発行したトークンを検証できませんでした")
+	}
+
+	if claims.Id != guestLink.UUID.String() ||
+		claims.ResourceType != guestLink.ResourceType ||
+		claims.ResourceUUID != guestLink.ResourceUUID.String() ||
+		len(claims.Permissions) != 2 {
+		t.Errorf("トークンの内容が一致しません: %+v", claims)
+	}
+
+	// 別の鍵で署名したトークンは検証に失敗すること
+	if newTestGuestLinkClaims(t, guestLink, "other-secret") != nil {
+		t.Errorf("別の鍵で署名したトークンを受け付けました")
+	}
+
+	// 改ざんしたトークンは検証に失敗すること
+	if _, err := utility.ParseGuestLinkJWT("invalid.token.value", guestLinkTestSecret); err == nil {
+		t.Errorf("不正なトークンを受け付けました")
+	}
+}
+
+func Test_Policy_VerifyGuestLink(t *testing.T) {
+	var (
+		resourceUUID = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+		otherUUID    = uuid.MustParse("33333333-3333-3333-3333-333333333333")
+	)
+
+	revoked := newTestGuestLink()
+	revoked.RevokedAt = null.NewTime(guestLinkTestNow.Add(-time.Hour), true)
+
+	cases := []struct {
+		name         string
+		guestLink    *entity.GuestLink
+		resourceType string
+		resourceUUID uuid.UUID
+		now          time.Time
+		want         string
+	}{
+		{"有効なリンク", newTestGuestLink(), entity.GuestLinkResourceJobSeeker, resourceUUID, guestLinkTestNow, entity.GuestLinkAccessResultSuccess},
+		{"有効期限切れ", newTestGuestLink(), entity.GuestLinkResourceJobSeeker, resourceUUID, guestLinkTestExpiresAt, entity.GuestLinkAccessResultExpired},
+		{"無効化済み", revoked, entity.GuestLinkResourceJobSeeker, resourceUUID, guestLinkTestNow, entity.GuestLinkAccessResultRevoked},
+		{"URLの対象が異なる", newTestGuestLink(), entity.GuestLinkResourceJobSeeker, otherUUID, guestLinkTestNow, entity.GuestLinkAccessResultResourceMismatch},
+		{"URLの種類が異なる", newTestGuestLink(), entity.GuestLinkResourceTaskGroup, resourceUUID, guestLinkTestNow, entity.GuestLinkAccessResultResourceMismatch},
+	}
+
+	for _, c := range cases {
+		claims := newTestGuestLinkClaims(t, newTestGuestLink(), guestLinkTestSecret)
+
+		result, err := policy.VerifyGuestLink(c.guestLink, claims, c.resourceType, c.resourceUUID, c.now)
+		if result != c.want {
+			t.Errorf("%s: %s を期待しましたが %s が返りました", c.name, c.want, result)
+		}
+
+		if c.want == entity.GuestLinkAccessResultSuccess && err != nil {
+			t.Errorf("%s: エラーが返りました: %v", c.name, err)
+		}
+
+		if c.want != entity.GuestLinkAccessResultSuccess && !errors.Is(err, entity.ErrUnauthorized) {
+			t.Errorf("%s: ErrUnauthorized を期待しましたが %v が返りました", c.name, err)
+		}
+	}
+
+	// 別のリンクのトークンは受け付けないこと
+	other := newTestGuestLink()
+	other.UUID = otherUUID
+	claims := newTestGuestLinkClaims(t, other, guestLinkTestSecret)
+
+	result, _ := policy.VerifyGuestLink(newTestGuestLink(), claims, entity.GuestLinkResourceJobSeeker, resourceUUID, guestLinkTestNow)
+	if result != entity.GuestLinkAccessResultResourceMismatch {
+		t.Errorf("別のリンクのトークン: %s を期待しましたが %s が返りました", entity.GuestLinkAccessResultResourceMismatch, result)
+	}
+}
+
+func Test_Policy_VerifyLegacyGuestLink(t *testing.T) {
+	cases := []struct {
+		name        string
+		legacyUntil string
+		now         time.Time
+		want        string
+	}{
+		{"移行期限の設定なし", "", guestLinkTestNow, entity.GuestLinkAccessResultLegacyExpired},
+		{"移行期限の形式が不正", "2024/10/15", guestLinkTestNow, entity.GuestLinkAccessResultLegacyExpired},
+		{"移行期限の当日（日本時間）", "2024-10-15", time.Date(2024, 10, 15, 14, 59, 0, 0, time.UTC), entity.GuestLinkAccessResultLegacy},
+		{"移行期限の翌日（日本時間）", "2024-10-15", time.Date(2024, 10, 15, 15, 0, 0, 0, time.UTC), entity.GuestLinkAccessResultLegacyExpired},
+	}
+
+	for _, c := range cases {
+		result, err := policy.VerifyLegacyGuestLink(c.legacyUntil, c.now)
+		if result != c.want {
+			t.Errorf("%s: %s を期待しましたが %s が返りました", c.name, c.want, result)
+		}
+
+		if (c.want == entity.GuestLinkAccessResultLegacy) != (err == nil) {
+			t.Errorf("%s: 想定外のエラーです: %v", c.name, err)
+		}
+	}
+}
+
+func Test_Policy_NormalizeGuestLinkPermissions(t *testing.T) {
+	cases := []struct {
+		name        string
+		permissions []string
+		want        string
+		wantErr     bool
+	}{
+		{"閲覧のみ", []string{"read"}, "read", false},
+		{"重複を除く", []string{"read", "write", "read"}, "read,write", false},
+		{"指定なし", []string{}, "", true},
+		{"不正な操作", []string{"read", "delete"}, "", true},
+	}
+
+	for _, c := range cases {
+		got, err := policy.NormalizeGuestLinkPermissions(c.permissions)
+		if got != c.want || (err != nil) != c.wantErr {
+			t.Errorf("%s: %q を期待しましたが %q（%v）が返りました", c.name, c.want, got, err)
+		}
+	}
+}
+
+func Test_Policy_AuthorizeGuestSession(t *testing.T) {
+	var (
+		jobSeekerUUID = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+		otherUUID     = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+		readOnly      = &utility.GuestSessionClaims{ResourceType: entity.GuestLinkResourceJobSeeker, ResourceUUID: jobSeekerUUID.String(), Permissions: []string{"read"}}
+		readWrite     = &utility.GuestSessionClaims{ResourceType: entity.GuestLinkResourceJobSeeker, ResourceUUID: jobSeekerUUID.String(), Permissions: []string{"read", "write"}}
+	)
+
+	cases := []struct {
+		name         string
+		claims       *utility.GuestSessionClaims
+		resourceType string
+		resourceUUID uuid.UUID
+		isWrite      bool
+		wantErr      error
+	}{
+		{"同じ求職者の閲覧", readOnly, entity.GuestLinkResourceJobSeeker, jobSeekerUUID, false, nil},
+		{"対象を問わないAPI", readOnly, entity.GuestLinkResourceJobSeeker, uuid.Nil, false, nil},
+		{"別の求職者", readWrite, entity.GuestLinkResourceJobSeeker, otherUUID, false, entity.ErrUnauthorized},
+		{"別の種類の対象", readWrite, entity.GuestLinkResourceTaskGroup, jobSeekerUUID, false, entity.ErrUnauthorized},
+		{"閲覧のみのリンクで更新", readOnly, entity.GuestLinkResourceJobSeeker, jobSeekerUUID, true, entity.ErrForbidden},
+		{"更新を許可したリンクで更新", readWrite, entity.GuestLinkResourceJobSeeker, jobSeekerUUID, true, nil},
+		{"トークンなし", nil, entity.GuestLinkResourceJobSeeker, jobSeekerUUID, false, entity.ErrUnauthorized},
+	}
+
+	for _, c := range cases {
+		err := policy.AuthorizeGuestSession(c.claims, c.resourceType, c.resourceUUID, c.isWrite)
+		if (c.wantErr == nil) != (err == nil) || (c.wantErr != nil && !errors.Is(err, c.wantErr)) {
+			t.Errorf("%s: %v を期待しましたが %v が返りました", c.name, c.wantErr, err)
+		}
+	}
+}
+
+func Test_Utility_GuestSessionJWT(t *testing.T) {
+	token, err := utility.NewGuestSessionJWT(entity.GuestLinkResourceJobSeeker, "11111111-1111-1111-1111-111111111111", []string{"read"}, "", time.Now(), time.Now().Add(time.Hour), guestLinkTestSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := utility.ParseGuestSessionJWT(token, guestLinkTestSecret); err != nil {
+		t.Errorf("発行したトークンを検証できることを期待しましたが %v でした", err)
+	}
+
+	expired, _ := utility.NewGuestSessionJWT(entity.GuestLinkResourceJobSeeker, "11111111-1111-1111-1111-111111111111", []string{"read"}, "", time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour), guestLinkTestSecret)
+	if _, err := utility.ParseGuestSessionJWT(expired, guestLinkTestSecret); err == nil {
+		t.Error("有効期限切れのトークンを拒否することを期待しましたが検証できました")
+	}
+
+	linkToken, _ := utility.NewGuestLinkJWT(uuid.NewString(), entity.GuestLinkResourceJobSeeker, "11111111-1111-1111-1111-111111111111", []string{"read"}, time.Now(), time.Now().Add(time.Hour), guestLinkTestSecret)
+	if _, err := utility.ParseGuestSessionJWT(linkToken, guestLinkTestSecret); err == nil {
+		t.Error("リンクのトークンをゲスト用APIのトークンとして拒否することを期待しましたが検証できました")
+	}
+}
@@ -0,0 +1,83 @@
+package policy_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// 内定
+//
+func Test_Policy_ValidateJobOfferStatusTransition(t *testing.T) {
+	cases := []struct {
+		name   string
+		offer  *entity.JobOffer
+		status int64
+		ok     bool
+	}{
+		{"提示中から条件交渉中", &entity.JobOffer{Status: entity.JobOfferStatusOffered}, entity.JobOfferStatusNegotiating, true},
+		{"入社日ありで承諾", &entity.JobOffer{Status: entity.JobOfferStatusNegotiating, StartDate: "2025-01-01"}, entity.JobOfferStatusAccepted, true},
+		{"入社日なしで承諾", &entity.JobOffer{Status: entity.JobOfferStatusOffered}, entity.JobOfferStatusAccepted, false},
+		{"同じ状態", &entity.JobOffer{Status: entity.JobOfferStatusOffered}, entity.JobOfferStatusOffered, false},
+		{"辞退済みから承諾", &entity.JobOffer{Status: entity.JobOfferStatusDeclined, StartDate: "2025-01-01"}, entity.JobOfferStatusAccepted, false},
+		{"承諾済みから辞退", &entity.JobOffer{Status: entity.JobOfferStatusAccepted, StartDate: "2025-01-01"}, entity.JobOfferStatusDeclined, false},
+		{"存在しない状態", &entity.JobOffer{Status: entity.JobOfferStatusOffered}, 99, false},
+	}
+
+	for _, c := range cases {
+		err := policy.ValidateJobOfferStatusTransition(c.offer, c.status)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: 変更できること=%v を期待しましたが err=%v でした", c.name, c.ok, err)
+		}
+
+		if err != nil && !errors.Is(err, entity.ErrRequestError) {
+			t.Errorf("%s: ErrRequestError を期待しましたが err=%v でした", c.name, err)
+		}
+	}
+}
+
+func Test_Policy_GetJobOfferEstimatedAnnualSalary(t *testing.T) {
+	offer := &entity.JobOffer{
+		BaseSalary:       null.IntFrom(300000),
+		FixedOvertimePay: null.IntFrom(50000),
+		Allowance:        null.IntFrom(10000),
+		Bonus:            null.IntFrom(600000),
+	}
+
+	if got := policy.GetJobOfferEstimatedAnnualSalary(offer); got != 360000*12+600000 {
+		t.Errorf("月額の12ヶ月分と賞与の合計を期待しましたが %d でした", got)
+	}
+
+	offer.AnnualSalary = null.IntFrom(5000000)
+	if got := policy.GetJobOfferEstimatedAnnualSalary(offer); got != 5000000 {
+		t.Errorf("提示年収を優先することを期待しましたが %d でした", got)
+	}
+}
+
+func Test_Policy_GetJobOfferReminderTypeList(t *testing.T) {
+	now := time.Date(2024, 10, 24, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		offer    *entity.JobOffer
+		expected []int64
+	}{
+		{"回答期限なし", &entity.JobOffer{Status: entity.JobOfferStatusOffered}, nil},
+		{"回答期限まで2日", &entity.JobOffer{Status: entity.JobOfferStatusOffered, AnswerDeadline: null.TimeFrom(now.Add(48 * time.Hour))}, nil},
+		{"回答期限まで24時間", &entity.JobOffer{Status: entity.JobOfferStatusNegotiating, AnswerDeadline: null.TimeFrom(now.Add(24 * time.Hour))}, []int64{entity.JobOfferReminderBeforeDeadline}},
+		{"回答期限切れ", &entity.JobOffer{Status: entity.JobOfferStatusOffered, AnswerDeadline: null.TimeFrom(now)}, []int64{entity.JobOfferReminderOverdue}},
+		{"承諾済み", &entity.JobOffer{Status: entity.JobOfferStatusAccepted, AnswerDeadline: null.TimeFrom(now.Add(-time.Hour))}, nil},
+	}
+
+	for _, c := range cases {
+		got := policy.GetJobOfferReminderTypeList(c.offer, now)
+		if len(got) != len(c.expected) || (len(got) > 0 && got[0] != c.expected[0]) {
+			t.Errorf("%s: %v を期待しましたが %v でした", c.name, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+)
+
+/****************************************************************************************/
+// 求職者の重複判定（氏名・フリガナのブラインドインデックス）
+//
+func Test_Utility_BlindIndexName(t *testing.T) {
+	t.Setenv("BLIND_INDEX_KEY", "test-blind-index-key")
+
+	cases := []struct {
+		name      string
+		a         string
+		b         string
+		wantEqual bool
+	}{
+		{"全角スペースの有無", "山田　太郎", "山田太郎", true},
+		{"半角英字と全角英字", "ＹＡＭＡＤＡ Taro", "YAMADATaro", true},
+		{"別人", "山田太郎", "山田次郎", false},
+	}
+
+	for _, c := range cases {
+		if got := utility.BlindIndexName(c.a) == utility.BlindIndexName(c.b); got != c.wantEqual {
+			t.Errorf("%s: 一致=%v を期待しましたが %v でした", c.name, c.wantEqual, got)
+		}
+	}
+
+	if utility.BlindIndexName("　") != "" {
+		t.Errorf("空白のみの氏名はインデックスを作成しないこと")
+	}
+}
+
+func Test_Utility_BlindIndexKana(t *testing.T) {
+	t.Setenv("BLIND_INDEX_KEY", "test-blind-index-key")
+
+	cases := []struct {
+		name      string
+		a         string
+		b         string
+		wantEqual bool
+	}{
+		{"ひらがなとカタカナ", "やまだ たろう", "ヤマダタロウ", true},
+		{"半角カナと全角カナ", "ﾔﾏﾀﾞ ﾀﾛｳ", "ヤマダ　タロウ", true},
+		{"別人", "ヤマダタロウ", "ヤマダジロウ", false},
+	}
+
+	for _, c := range cases {
+		if got := utility.BlindIndexKana(c.a) == utility.BlindIndexKana(c.b); got != c.wantEqual {
+			t.Errorf("%s: 一致=%v を期待しましたが %v でした", c.name, c.wantEqual, got)
+		}
+	}
+
+	if utility.BlindIndexName("山田太郎") == utility.BlindIndexKana("山田太郎") {
+		t.Errorf("氏名とフリガナのインデックスは項目ごとに区別すること")
+	}
+}
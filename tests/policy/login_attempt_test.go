@@ -0,0 +1,118 @@
+package policy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+)
+
+/****************************************************************************************/
+// ログイン試行の制限
+//
+// LPのログイン（アカウント単位は15分間に5回まで、初回のロックは15分、上限は24時間）
+//
+var loginAttemptTestNow = time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)
+
+func newTestLoginAttemptRule(t *testing.T) policy.LoginAttemptRule {
+	rule, ok := policy.GetLoginAttemptRule(entity.LoginAttemptScopeLPLogin, entity.LoginAttemptKeyAccount)
+	if !ok {
+		t.Fatalf("LPのログインの制限が定義されていません")
+	}
+	return rule
+}
+
+func Test_Policy_ApplyLoginAttempt(t *testing.T) {
+	rule := newTestLoginAttemptRule(t)
+	attempt := entity.NewLoginAttempt(entity.LoginAttemptScopeLPLogin, entity.LoginAttemptKeyAccount, "account", loginAttemptTestNow)
+
+	// 上限の1回前まではロックしないこと
+	for i := uint(1); i < rule.MaxAttempts; i++ {
+		policy.ApplyLoginAttempt(attempt, rule, loginAttemptTestNow)
+	}
+	if policy.IsLoginAttemptLocked(attempt, loginAttemptTestNow) {
+		t.Fatalf("上限に達する前にロックされました: %+v", attempt)
+	}
+
+	// 上限に達した時点でロックすること
+	policy.ApplyLoginAttempt(attempt, rule, loginAttemptTestNow)
+	if !policy.IsLoginAttemptLocked(attempt, loginAttemptTestNow) {
+		t.Fatalf("上限に達してもロックされませんでした: %+v", attempt)
+	}
+	if want := loginAttemptTestNow.Add(rule.BaseLockDuration); !attempt.LockedUntil.Time.Equal(want) {
+		t.Errorf("初回のロック期限 %v を期待しましたが %v でした", want, attempt.LockedUntil.Time)
+	}
+	if policy.IsLoginAttemptLocked(attempt, attempt.LockedUntil.Time) {
+		t.Errorf("ロック期限を過ぎてもロックされています")
+	}
+
+	// ロックのたびにロック時間が2倍になること
+	now := attempt.LockedUntil.Time
+	for i := uint(0); i < rule.MaxAttempts; i++ {
+		policy.ApplyLoginAttempt(attempt, rule, now)
+	}
+	if want := now.Add(rule.BaseLockDuration * 2); !attempt.LockedUntil.Time.Equal(want) {
+		t.Errorf("2回目のロック期限 %v を期待しましたが %v でした", want, attempt.LockedUntil.Time)
+	}
+
+	// ロック時間は上限を超えないこと
+	attempt.LockCount = 20
+	for i := uint(0); i < rule.MaxAttempts; i++ {
+		policy.ApplyLoginAttempt(attempt, rule, now)
+	}
+	if want := now.Add(rule.MaxLockDuration); !attempt.LockedUntil.Time.Equal(want) {
+		t.Errorf("ロック期限 %v を期待しましたが %v でした", want, attempt.LockedUntil.Time)
+	}
+}
+
+func Test_Policy_ApplyLoginAttempt_Window(t *testing.T) {
+	rule := newTestLoginAttemptRule(t)
+	attempt := entity.NewLoginAttempt(entity.LoginAttemptScopeLPLogin, entity.LoginAttemptKeyAccount, "account", loginAttemptTestNow)
+
+	for i := uint(1); i < rule.MaxAttempts; i++ {
+		policy.ApplyLoginAttempt(attempt, rule, loginAttemptTestNow)
+	}
+
+	// 期間を過ぎた後の試行は1回目から数え直すこと
+	later := loginAttemptTestNow.Add(rule.Window)
+	policy.ApplyLoginAttempt(attempt, rule, later)
+	if attempt.AttemptCount != 1 || !attempt.WindowStartedAt.Equal(later) {
+		t.Errorf("期間を過ぎても回数が数え直されていません: %+v", attempt)
+	}
+	if policy.IsLoginAttemptLocked(attempt, later) {
+		t.Errorf("期間を過ぎた試行でロックされました")
+	}
+}
+
+func Test_Policy_RequiresLoginAttemptCaptcha(t *testing.T) {
+	rule := newTestLoginAttemptRule(t)
+
+	cases := []struct {
+		name  string
+		count uint
+		now   time.Time
+		want  bool
+	}{
+		{"試行回数が少ない", rule.CaptchaAfter - 1, loginAttemptTestNow, false},
+		{"試行回数が基準に達した", rule.CaptchaAfter, loginAttemptTestNow, true},
+		{"期間を過ぎた", rule.CaptchaAfter, loginAttemptTestNow.Add(rule.Window), false},
+	}
+
+	for _, c := range cases {
+		attempt := entity.NewLoginAttempt(entity.LoginAttemptScopeLPLogin, entity.LoginAttemptKeyAccount, "account", loginAttemptTestNow)
+		attempt.AttemptCount = c.count
+
+		if got := policy.RequiresLoginAttemptCaptcha(attempt, rule, c.now); got != c.want {
+			t.Errorf("%s: %v を期待しましたが %v が返りました", c.name, c.want, got)
+		}
+	}
+
+	// CAPTCHAを求めない制限は常にfalseを返すこと
+	noCaptchaRule, _ := policy.GetLoginAttemptRule(entity.LoginAttemptScopeLPResetPasswordEmail, entity.LoginAttemptKeyAccount)
+	attempt := entity.NewLoginAttempt(entity.LoginAttemptScopeLPResetPasswordEmail, entity.LoginAttemptKeyAccount, "account", loginAttemptTestNow)
+	attempt.AttemptCount = 100
+	if policy.RequiresLoginAttemptCaptcha(attempt, noCaptchaRule, loginAttemptTestNow) {
+		t.Errorf("CAPTCHAを求めない制限でtrueが返りました")
+	}
+}
@@ -0,0 +1,117 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// 個人情報のマスキング
+//
+// 求職者1: 自社（担当CAは一般担当者）、求職者2: エージェント2
+//
+func newMaskingJobSeeker(id, agentID uint, agentStaffID int64) *entity.JobSeeker {
+	return &entity.JobSeeker{
+		ID:                    id,
+		AgentID:               agentID,
+		AgentStaffID:          null.NewInt(agentStaffID, agentStaffID != 0),
+		PhoneNumber:           "090-1234-5678",
+		Email:                 "taro@example.com",
+		Address:               "渋谷区1-2-3",
+		SecretMemo:            "社内メモ",
+		MedicalHistoryRemarks: "既往歴",
+	}
+}
+
+func Test_Policy_MaskJobSeeker(t *testing.T) {
+	var (
+		plain = newMaskingJobSeeker(1, 1, int64(generalStaff.ID))
+		memo  = &entity.JobSeeker{
+			PhoneNumber:           plain.PhoneNumber,
+			Email:                 plain.Email,
+			Address:               plain.Address,
+			SecretMemo:            "********",
+			MedicalHistoryRemarks: "********",
+		}
+		alliance = &entity.JobSeeker{
+			PhoneNumber:           "***-****-5678",
+			Email:                 "t***@example.com",
+			Address:               "********",
+			SecretMemo:            "********",
+			MedicalHistoryRemarks: "********",
+		}
+	)
+
+	cases := []struct {
+		name      string
+		operator  *entity.AgentStaff
+		jobSeeker *entity.JobSeeker
+		want      *entity.JobSeeker
+	}{
+		{"管理者・自社の求職者", adminStaff, newMaskingJobSeeker(1, 1, int64(generalStaff.ID)), plain},
+		{"一般・担当CAの求職者", generalStaff, newMaskingJobSeeker(1, 1, int64(generalStaff.ID)), plain},
+		{"一般・他の担当者の求職者", generalStaff, newMaskingJobSeeker(1, 1, int64(adminStaff.ID)), memo},
+		{"一般・担当CA未設定の求職者", generalStaff, newMaskingJobSeeker(1, 1, 0), memo},
+		{"管理者・アライアンス先の求職者", adminStaff, newMaskingJobSeeker(2, 2, int64(allianceStaff.ID)), alliance},
+		{"アライアンス先の担当者・自社の求職者", allianceStaff, newMaskingJobSeeker(1, 1, int64(generalStaff.ID)), alliance},
+		{"未認証（ゲストページ）", nil, newMaskingJobSeeker(1, 1, int64(generalStaff.ID)), plain},
+	}
+
+	for _, c := range cases {
+		policy.MaskJobSeeker(c.operator, c.jobSeeker)
+
+		if c.jobSeeker.PhoneNumber != c.want.PhoneNumber ||
+			c.jobSeeker.Email != c.want.Email ||
+			c.jobSeeker.Address != c.want.Address ||
+			c.jobSeeker.SecretMemo != c.want.SecretMemo ||
+			c.jobSeeker.MedicalHistoryRemarks != c.want.MedicalHistoryRemarks {
+			t.Errorf("%s: %+v を期待しましたが %+v が返りました", c.name, c.want, c.jobSeeker)
+		}
+	}
+}
+
+func Test_Policy_MaskJobSeekerInResponse(t *testing.T) {
+	type responseWithList struct {
+		MaxPageNumber uint                `json:"max_page_number"`
+		JobSeekerList []*entity.JobSeeker `json:"job_seeker_list"`
+	}
+
+	response := responseWithList{
+		MaxPageNumber: 1,
+		JobSeekerList: []*entity.JobSeeker{
+			newMaskingJobSeeker(1, 1, int64(generalStaff.ID)),
+			newMaskingJobSeeker(2, 2, int64(allianceStaff.ID)),
+		},
+	}
+
+	policy.MaskJobSeekerInResponse(generalStaff, &response)
+
+	if response.JobSeekerList[0].PhoneNumber != "090-1234-5678" {
+		t.Errorf("自社の求職者の電話番号が伏せられています: %s", response.JobSeekerList[0].PhoneNumber)
+	}
+	if response.JobSeekerList[1].PhoneNumber != "***-****-5678" {
+		t.Errorf("アライアンス先の求職者の電話番号が伏せられていません: %s", response.JobSeekerList[1].PhoneNumber)
+	}
+}
+
+func Test_Policy_RestoreMaskedJobSeekerFields(t *testing.T) {
+	current := newMaskingJobSeeker(1, 1, int64(adminStaff.ID))
+
+	// 伏せた値がそのまま送られてきた項目は現在の値に戻し、変更された項目はそのまま更新する
+	updated := newMaskingJobSeeker(1, 1, int64(adminStaff.ID))
+	updated.PhoneNumber = "080-1111-2222"
+	updated.SecretMemo = "********"
+	updated.MedicalHistoryRemarks = "********"
+
+	policy.RestoreMaskedJobSeekerFields(current, updated)
+
+	if updated.PhoneNumber != "080-1111-2222" {
+		t.Errorf("変更した電話番号が戻されています: %s", updated.PhoneNumber)
+	}
+	if updated.SecretMemo != current.SecretMemo || updated.MedicalHistoryRemarks != current.MedicalHistoryRemarks {
+		t.Errorf("伏せた値が現在の値に戻されていません: %s, %s", updated.SecretMemo, updated.MedicalHistoryRemarks)
+	}
+}
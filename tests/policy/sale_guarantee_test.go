@@ -0,0 +1,122 @@
+package policy_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// 売上の自動作成と返金保証期間
+//
+func Test_Policy_GetDraftSaleBillingAmount(t *testing.T) {
+	cases := []struct {
+		name           string
+		commission     null.Int
+		commissionRate null.Int
+		annualIncome   int64
+		expected       null.Float
+	}{
+		{"料率と年収", null.NewInt(0, false), null.IntFrom(35), 5000000, null.FloatFrom(1750000)},
+		{"料率のみで年収が不明な場合は固定の手数料", null.IntFrom(500000), null.IntFrom(35), 0, null.FloatFrom(500000)},
+		{"固定の手数料", null.IntFrom(500000), null.NewInt(0, false), 5000000, null.FloatFrom(500000)},
+		{"手数料の設定なし", null.NewInt(0, false), null.NewInt(0, false), 5000000, null.NewFloat(0, false)},
+	}
+
+	for _, c := range cases {
+		got := policy.GetDraftSaleBillingAmount(c.commission, c.commissionRate, c.annualIncome)
+		if got != c.expected {
+			t.Errorf("%s: %v を期待しましたが %v でした", c.name, c.expected, got)
+		}
+	}
+}
+
+func Test_Policy_ValidateSalePolicyParam(t *testing.T) {
+	cases := []struct {
+		name  string
+		param entity.UpdateSalePolicyParam
+		ok    bool
+	}{
+		{"初期設定", entity.UpdateSalePolicyParam{RASalesRatio: 50}, true},
+		{"段階あり", entity.UpdateSalePolicyParam{RASalesRatio: 60, RefundTierList: []entity.AgentRefundTierParam{{WithinDays: 90, RefundRate: 50}, {WithinDays: 30, RefundRate: 100}}}, true},
+		{"比率が100%超", entity.UpdateSalePolicyParam{RASalesRatio: 101}, false},
+		{"日数の重複", entity.UpdateSalePolicyParam{RefundTierList: []entity.AgentRefundTierParam{{WithinDays: 30, RefundRate: 80}, {WithinDays: 30, RefundRate: 50}}}, false},
+		{"長い段階ほど返金率が高い", entity.UpdateSalePolicyParam{RefundTierList: []entity.AgentRefundTierParam{{WithinDays: 30, RefundRate: 50}, {WithinDays: 90, RefundRate: 80}}}, false},
+		{"0日", entity.UpdateSalePolicyParam{RefundTierList: []entity.AgentRefundTierParam{{WithinDays: 0, RefundRate: 80}}}, false},
+	}
+
+	for _, c := range cases {
+		err := policy.ValidateSalePolicyParam(c.param)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: 設定できること=%v を期待しましたが err=%v でした", c.name, c.ok, err)
+		}
+
+		if err != nil && !errors.Is(err, entity.ErrRequestError) {
+			t.Errorf("%s: ErrRequestError を期待しましたが err=%v でした", c.name, err)
+		}
+	}
+}
+
+func Test_Policy_GetSaleRefund(t *testing.T) {
+	tierList, isDefault := policy.GetSaleRefundTierList(nil)
+	if !isDefault {
+		t.Errorf("設定がない場合は初期設定を使用すること")
+	}
+
+	cases := []struct {
+		name            string
+		resignationDate string
+		daysEmployed    uint
+		refundRate      uint
+		ok              bool
+	}{
+		{"入社から30日", "2024-05-01", 30, 80, true},
+		{"入社から31日", "2024-05-02", 31, 50, true},
+		{"入社から91日", "2024-07-01", 91, 0, true},
+		{"入社日より前", "2024-03-31", 0, 0, false},
+	}
+
+	for _, c := range cases {
+		daysEmployed, refundRate, err := policy.GetSaleRefund(tierList, "2024-04-01", c.resignationDate)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: 記録できること=%v を期待しましたが err=%v でした", c.name, c.ok, err)
+			continue
+		}
+
+		if daysEmployed != c.daysEmployed || refundRate != c.refundRate {
+			t.Errorf("%s: 在籍%d日・返金率%d%% を期待しましたが 在籍%d日・返金率%d%% でした", c.name, c.daysEmployed, c.refundRate, daysEmployed, refundRate)
+		}
+	}
+
+	if got := policy.GetSaleRefundAmount(null.FloatFrom(1750000), 80); got != null.FloatFrom(1400000) {
+		t.Errorf("請求金額の80%%を期待しましたが %v でした", got)
+	}
+}
+
+func Test_Policy_GetSaleGuaranteeAlertTierList(t *testing.T) {
+	tierList, _ := policy.GetSaleRefundTierList(nil)
+
+	// 入社から30日の段階が5日後に終わる
+	now := time.Date(2024, 4, 26, 10, 0, 0, 0, utility.Tokyo)
+	alertList := policy.GetSaleGuaranteeAlertTierList(tierList, "2024-04-01", now)
+	if len(alertList) != 1 || alertList[0].WithinDays != 30 {
+		t.Errorf("30日の段階の通知を期待しましたが %v でした", alertList)
+	}
+
+	// 全ての段階が終わった後は通知しない
+	now = time.Date(2024, 8, 1, 10, 0, 0, 0, utility.Tokyo)
+	if alertList := policy.GetSaleGuaranteeAlertTierList(tierList, "2024-04-01", now); len(alertList) != 0 {
+		t.Errorf("通知しないことを期待しましたが %v でした", alertList)
+	}
+
+	guarantee := &entity.SaleGuarantee{JoiningDate: "2024-04-01"}
+	policy.SetSaleGuaranteeStatus(guarantee, tierList, time.Date(2024, 5, 10, 10, 0, 0, 0, utility.Tokyo))
+	if guarantee.Status != entity.SaleGuaranteeStatusActive || guarantee.CurrentRefundRate != 50 || guarantee.GuaranteeEndDate != "2024-06-30" {
+		t.Errorf("保証期間中・返金率50%%・最終日2024-06-30を期待しましたが %+v でした", guarantee)
+	}
+}
@@ -0,0 +1,175 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// タスクの一括操作
+//
+func Test_Policy_ValidateTaskBulkJobParam(t *testing.T) {
+	var (
+		phase     = func(phase entity.TaskCategory) null.Int { return null.NewInt(int64(phase), true) }
+		phaseSub  = func(phaseSub int64) null.Int { return null.NewInt(phaseSub, true) }
+		staffID   = null.NewInt(1, true)
+		tooMany   = make([]uint, entity.TaskBulkJobMaxItemCount+1)
+		groupList = []uint{1, 2, 3}
+	)
+
+	for index := range tooMany {
+		tooMany[index] = uint(index + 1)
+	}
+
+	cases := []struct {
+		name  string
+		param entity.CreateTaskBulkJobParam
+		ok    bool
+	}{
+		{
+			"フェーズの遷移",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionTransition, TaskGroupIDList: groupList, PhaseCategory: phase(entity.HoldJobOffer), PhaseSubCategory: phaseSub(int64(entity.RequestJobOfferNotification))},
+			true,
+		},
+		{
+			"対象の選考がない",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionDeadline, DeadlineDay: "2024-10-25"},
+			false,
+		},
+		{
+			"対象の選考が上限を超える",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionDeadline, TaskGroupIDList: tooMany, DeadlineDay: "2024-10-25"},
+			false,
+		},
+		{
+			"対象の選考が重複",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionDeadline, TaskGroupIDList: []uint{1, 1}, DeadlineDay: "2024-10-25"},
+			false,
+		},
+		{
+			"遷移先のフェーズがない",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionTransition, TaskGroupIDList: groupList},
+			false,
+		},
+		{
+			"終了には終了のサブフェーズのみ指定できる",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionClose, TaskGroupIDList: groupList, PhaseCategory: phase(entity.DocumentSelection), PhaseSubCategory: phaseSub(int64(entity.RequestRecommendations))},
+			false,
+		},
+		{
+			"終了",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionClose, TaskGroupIDList: groupList, PhaseCategory: phase(entity.DocumentSelection), PhaseSubCategory: phaseSub(entity.CloseDocumentPhase)},
+			true,
+		},
+		{
+			"遷移で終了のサブフェーズは指定できない",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionTransition, TaskGroupIDList: groupList, PhaseCategory: phase(entity.DocumentSelection), PhaseSubCategory: phaseSub(entity.CloseDocumentPhase)},
+			false,
+		},
+		{
+			"担当者の変更は担当者の指定が必要",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionReassign, TaskGroupIDList: groupList},
+			false,
+		},
+		{
+			"担当者の変更は反映範囲の確認が必要",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionReassign, TaskGroupIDList: groupList, CAStaffID: staffID},
+			false,
+		},
+		{
+			"担当者の変更",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionReassign, TaskGroupIDList: groupList, CAStaffID: staffID, IsConfirmedReassignScope: true},
+			true,
+		},
+		{
+			"期限日の形式が不正",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionDeadline, TaskGroupIDList: groupList, DeadlineDay: "2024/10/25"},
+			false,
+		},
+		{
+			"期限の時刻が範囲外",
+			entity.CreateTaskBulkJobParam{ActionType: entity.TaskBulkActionDeadline, TaskGroupIDList: groupList, DeadlineDay: "2024-10-25", DeadlineTime: null.NewInt(24, true)},
+			false,
+		},
+		{
+			"定義にない操作",
+			entity.CreateTaskBulkJobParam{ActionType: 99, TaskGroupIDList: groupList},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		err := policy.ValidateTaskBulkJobParam(c.param)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: 登録できること=%v を期待しましたが err=%v でした", c.name, c.ok, err)
+		}
+	}
+}
+
+func Test_Policy_ValidateTaskBulkTransition(t *testing.T) {
+	var (
+		ca = null.NewInt(int64(entity.CA), true)
+		ra = null.NewInt(int64(entity.RA), true)
+	)
+
+	cases := []struct {
+		name      string
+		from      entity.TaskPhaseState
+		to        entity.TaskPhaseState
+		staffType null.Int
+		ok        bool
+	}{
+		{
+			"書類選考不合格（評価点の登録のみ）",
+			entity.NewTaskPhaseState(entity.DocumentSelection, int64(entity.CollectResultOfDocumentSelection)),
+			entity.NewTaskPhaseState(entity.DocumentSelection, int64(entity.FailingNotification)),
+			ca, true,
+		},
+		{
+			"終了（ヨミの更新のみ）",
+			entity.NewTaskPhaseState(entity.DocumentSelection, int64(entity.RequestRecommendations)),
+			entity.NewTaskPhaseState(entity.DocumentSelection, entity.CloseDocumentPhase),
+			ra, true,
+		},
+		{
+			"再選考（評価点の登録のみ）",
+			entity.NewTaskPhaseState(entity.FirstSelection, int64(entity.RequestCollectSelectionThought)),
+			entity.NewTaskPhaseState(entity.FirstSelection, int64(entity.RequestGuidance)),
+			ca, true,
+		},
+		{
+			"内定承諾（ヨミの更新・売上の下書きの作成）",
+			entity.NewTaskPhaseState(entity.HoldJobOffer, int64(entity.AcceptJobOfferMind)),
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.Accept)),
+			ca, true,
+		},
+		{
+			"企業へのメールを伴う遷移は一括で行えない",
+			entity.NewTaskPhaseState(entity.DocumentSelection, int64(entity.RequestRecommendations)),
+			entity.NewTaskPhaseState(entity.DocumentSelection, int64(entity.CollectResultOfDocumentSelection)),
+			ra, false,
+		},
+		{
+			"候補日時の登録を伴う遷移は一括で行えない",
+			entity.NewTaskPhaseState(entity.FirstSelection, int64(entity.CollectingSchedule)),
+			entity.NewTaskPhaseState(entity.FirstSelection, int64(entity.RequestScheduleAdjustmentForSelection)),
+			ra, false,
+		},
+		{
+			"定義にない遷移",
+			entity.NewTaskPhaseState(entity.Entry, int64(entity.SoundOutMask)),
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.Accept)),
+			ra, false,
+		},
+	}
+
+	for _, c := range cases {
+		_, err := policy.ValidateTaskBulkTransition(c.from, c.to, c.staffType)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: 遷移できること=%v を期待しましたが err=%v でした", c.name, c.ok, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package policy_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// 辞退・不合格の理由
+//
+func Test_Policy_GetTaskLossType(t *testing.T) {
+	cases := []struct {
+		name     string
+		to       entity.TaskPhaseState
+		lossType int64
+		ok       bool
+	}{
+		{"エントリー辞退終了", entity.TaskPhaseState{Phase: int64(entity.Entry), PhaseSub: entity.CloseEntryPhaseForDeclineEntry}, entity.TaskLossTypeDecline, true},
+		{"合格可能性が低いためNG", entity.TaskPhaseState{Phase: int64(entity.Entry), PhaseSub: entity.CloseEntryPhaseForUnlikely}, entity.TaskLossTypeRejection, true},
+		{"書類選考の不合格終了", entity.TaskPhaseState{Phase: int64(entity.DocumentSelection), PhaseSub: entity.CloseDocumentPhaseForFailingNotice}, entity.TaskLossTypeRejection, true},
+		{"2次選考の不合格終了", entity.TaskPhaseState{Phase: int64(entity.SecondSelection), PhaseSub: entity.CloseSelectionPhaseForFailingNoticeOfSelection}, entity.TaskLossTypeRejection, true},
+		{"内定承諾後の辞退", entity.TaskPhaseState{Phase: int64(entity.AcceptJobOffer), PhaseSub: entity.AcceptJobOfferPhaseClose}, entity.TaskLossTypeDecline, true},
+		{"打診せず終了", entity.TaskPhaseState{Phase: int64(entity.Entry), PhaseSub: entity.CloseEntryPhaseForWithoutSoundOut}, 0, false},
+	}
+
+	for _, c := range cases {
+		lossType, ok := policy.GetTaskLossType(c.to)
+		if ok != c.ok || lossType != c.lossType {
+			t.Errorf("%s: (%d, %v) を期待しましたが (%d, %v) でした", c.name, c.lossType, c.ok, lossType, ok)
+		}
+	}
+}
+
+func Test_Policy_ValidateTaskLossReason(t *testing.T) {
+	var (
+		declineEntry  = entity.TaskPhaseState{Phase: int64(entity.Entry), PhaseSub: entity.CloseEntryPhaseForDeclineEntry}
+		failSelection = entity.TaskPhaseState{Phase: int64(entity.FirstSelection), PhaseSub: entity.CloseSelectionPhaseForFailingNoticeOfSelection}
+		failDocument  = entity.TaskPhaseState{Phase: int64(entity.DocumentSelection), PhaseSub: entity.CloseDocumentPhaseForFailingNotice}
+	)
+
+	cases := []struct {
+		name       string
+		to         entity.TaskPhaseState
+		lossReason null.Int
+		ok         bool
+	}{
+		{"辞退の理由", declineEntry, null.IntFrom(entity.TaskLossReasonSalary), true},
+		{"その他", declineEntry, null.IntFrom(entity.TaskLossReasonOther), true},
+		{"理由なし", declineEntry, null.NewInt(0, false), false},
+		{"辞退に不合格の理由", declineEntry, null.IntFrom(entity.TaskLossReasonSkill), false},
+		{"面接前に面接の印象", declineEntry, null.IntFrom(entity.TaskLossReasonImpression), false},
+		{"面接の不合格に人物面", failSelection, null.IntFrom(entity.TaskLossReasonPersonality), true},
+		{"書類選考の不合格に人物面", failDocument, null.IntFrom(entity.TaskLossReasonPersonality), false},
+		{"存在しない理由", failSelection, null.IntFrom(500), false},
+		{"打診せず終了は理由なしで可", entity.TaskPhaseState{Phase: int64(entity.Entry), PhaseSub: entity.CloseEntryPhaseForWithoutSoundOut}, null.NewInt(0, false), true},
+	}
+
+	for _, c := range cases {
+		err := policy.ValidateTaskLossReason(c.to, c.lossReason)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: 選択できること=%v を期待しましたが err=%v でした", c.name, c.ok, err)
+		}
+
+		if err != nil && !errors.Is(err, entity.ErrRequestError) {
+			t.Errorf("%s: ErrRequestError を期待しましたが err=%v でした", c.name, err)
+		}
+	}
+}
+
+func Test_Policy_AggregateTaskLossReason(t *testing.T) {
+	lossReasonList := []*entity.TaskLossReason{
+		{EnterpriseID: null.IntFrom(1), CompanyName: "A社", LossType: entity.TaskLossTypeDecline, LossReason: entity.TaskLossReasonSalary},
+		{EnterpriseID: null.IntFrom(2), CompanyName: "B社", LossType: entity.TaskLossTypeRejection, LossReason: entity.TaskLossReasonSkill},
+		{EnterpriseID: null.IntFrom(2), CompanyName: "B社", LossType: entity.TaskLossTypeRejection, LossReason: entity.TaskLossReasonSkill},
+		{EnterpriseID: null.IntFrom(2), CompanyName: "B社", LossType: entity.TaskLossTypeDecline, LossReason: entity.TaskLossReasonLocation},
+	}
+
+	analyticsList, summary := policy.AggregateTaskLossReason(lossReasonList, entity.TaskLossGroupByEnterprise)
+
+	if len(analyticsList) != 2 || analyticsList[0].GroupName != "B社" {
+		t.Fatalf("件数の多いB社が先頭であることを期待しましたが %+v でした", analyticsList)
+	}
+
+	b := analyticsList[0]
+	if b.TotalCount != 3 || b.DeclineCount != 1 || b.RejectionCount != 2 {
+		t.Errorf("B社の件数が正しくありません: %+v", b)
+	}
+
+	if b.ReasonCountList[0].LossReason != entity.TaskLossReasonSkill || b.ReasonCountList[0].Rate != 66.7 {
+		t.Errorf("スキル・経験不足が66.7%%で先頭であることを期待しましたが %+v でした", b.ReasonCountList[0])
+	}
+
+	if summary.TotalCount != 4 || len(summary.ReasonCountList) != 3 {
+		t.Errorf("全体の内訳が正しくありません: %+v", summary)
+	}
+}
@@ -0,0 +1,257 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// タスクのフェーズ遷移
+//
+func Test_Policy_ValidateTaskPhaseTransition(t *testing.T) {
+	var (
+		ca = null.NewInt(int64(entity.CA), true)
+		ra = null.NewInt(int64(entity.RA), true)
+	)
+
+	cases := []struct {
+		name      string
+		from      entity.TaskPhaseState
+		to        entity.TaskPhaseState
+		staffType null.Int
+		ok        bool
+	}{
+		{
+			"マスクレジュメ合格",
+			entity.NewTaskPhaseState(entity.Entry, int64(entity.CollectResultOfMask)),
+			entity.NewTaskPhaseState(entity.Entry, int64(entity.SoundOutJobInformation)),
+			ca, true,
+		},
+		{
+			"書類選考合格で2次選考へ（1次選考なし）",
+			entity.NewTaskPhaseState(entity.DocumentSelection, int64(entity.CollectResultOfDocumentSelection)),
+			entity.NewTaskPhaseState(entity.SecondSelection, int64(entity.RequestConfirmScheduleForSelection)),
+			ca, true,
+		},
+		{
+			"選考合格で前の選考には戻れない",
+			entity.NewTaskPhaseState(entity.ThirdSelection, int64(entity.RequestCollectSelectionThought)),
+			entity.NewTaskPhaseState(entity.SecondSelection, int64(entity.RequestCollectionOfSchedule)),
+			ca, false,
+		},
+		{
+			"上長はCAとして扱う",
+			entity.NewTaskPhaseState(entity.FinalSelection, int64(entity.RequestCollectSelectionThought)),
+			entity.NewTaskPhaseState(entity.HoldJobOffer, int64(entity.RequestJobOfferNotification)),
+			null.NewInt(int64(entity.CA_Boss), true), true,
+		},
+		{
+			"RAのタスクをCAに作成できない",
+			entity.NewTaskPhaseState(entity.FirstSelection, int64(entity.CollectingSchedule)),
+			entity.NewTaskPhaseState(entity.FirstSelection, int64(entity.RequestScheduleAdjustmentForSelection)),
+			ca, false,
+		},
+		{
+			"定義にない遷移",
+			entity.NewTaskPhaseState(entity.Entry, int64(entity.SoundOutMask)),
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.Accept)),
+			ra, false,
+		},
+		{
+			"終了したタスクからは遷移できない",
+			entity.NewTaskPhaseState(entity.DocumentSelection, entity.CloseDocumentPhase),
+			entity.NewTaskPhaseState(entity.DocumentSelection, int64(entity.RequestRecommendations)),
+			ra, false,
+		},
+		{
+			"辞退意思確認から選考継続",
+			entity.NewTaskPhaseState(entity.FirstSelection, int64(entity.ConfirmDeclineIntention)),
+			entity.NewTaskPhaseState(entity.FirstSelection, entity.ContinueSelection),
+			ca, true,
+		},
+	}
+
+	for _, c := range cases {
+		err := policy.ValidateTaskPhaseTransition(c.from, c.to, c.staffType)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: 遷移できること=%v を期待しましたが err=%v でした", c.name, c.ok, err)
+		}
+	}
+}
+
+func Test_Policy_ValidateTaskPhaseOperator(t *testing.T) {
+	var (
+		taskGroup   = &entity.TaskGroup{RAAgentID: 1, CAAgentID: 2}
+		sameAgent   = &entity.TaskGroup{RAAgentID: 1, CAAgentID: 1}
+		raOperator  = &entity.AgentStaff{ID: 10, AgentID: 1}
+		caOperator  = &entity.AgentStaff{ID: 20, AgentID: 2}
+		otherAgent  = &entity.AgentStaff{ID: 30, AgentID: 3}
+		raTask      = null.NewInt(int64(entity.RA), true)
+		caTask      = null.NewInt(int64(entity.CA), true)
+		caBossTask  = null.NewInt(int64(entity.CA_Boss), true)
+		noStaffType = null.NewInt(0, false)
+	)
+
+	cases := []struct {
+		name          string
+		operator      *entity.AgentStaff
+		taskGroup     *entity.TaskGroup
+		fromStaffType null.Int
+		ok            bool
+	}{
+		{"RAのタスクをRA側が進める", raOperator, taskGroup, raTask, true},
+		{"RAのタスクをCA側は進められない", caOperator, taskGroup, raTask, false},
+		{"CAのタスクをCA側が進める", caOperator, taskGroup, caTask, true},
+		{"CAのタスクをRA側は進められない", raOperator, taskGroup, caTask, false},
+		{"CA上長のタスクはCA側が進める", caOperator, taskGroup, caBossTask, true},
+		{"担当者タイプのないタスクはどちらでも進められる", caOperator, taskGroup, noStaffType, true},
+		{"RA・CAが同じエージェントの場合はどちらのタスクも進められる", raOperator, sameAgent, caTask, true},
+		{"他社の担当者は進められない", otherAgent, taskGroup, noStaffType, false},
+		{"操作者が不明な場合は進められない", nil, taskGroup, raTask, false},
+	}
+
+	for _, c := range cases {
+		err := policy.ValidateTaskPhaseOperator(c.operator, c.taskGroup, c.fromStaffType)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: 進められること=%v を期待しましたが err=%v でした", c.name, c.ok, err)
+		}
+	}
+}
+
+func Test_Policy_GetTaskPhaseActionList(t *testing.T) {
+	actionList := policy.GetTaskPhaseActionList(entity.NewTaskPhaseState(entity.SecondSelection, int64(entity.RequestCollectSelectionThought)))
+
+	has := func(phase entity.TaskCategory, phaseSub int64) bool {
+		for _, action := range actionList {
+			if action.PhaseCategory == int64(phase) && action.PhaseSubCategory == phaseSub {
+				return true
+			}
+		}
+		return false
+	}
+
+	// 後の選考と内定には進めること
+	for _, phase := range []entity.TaskCategory{entity.ThirdSelection, entity.FinalSelection} {
+		if !has(phase, int64(entity.RequestCollectionOfSchedule)) {
+			t.Errorf("フェーズ %d の候補日回収依頼がありません", phase)
+		}
+	}
+	if !has(entity.HoldJobOffer, int64(entity.RequestJobOfferNotification)) {
+		t.Errorf("内定通知依頼がありません")
+	}
+
+	// 前の選考には戻れないこと
+	if has(entity.FirstSelection, int64(entity.RequestCollectionOfSchedule)) {
+		t.Errorf("前の選考に戻る操作があります")
+	}
+
+	// 操作は重複しないこと
+	seen := map[[2]int64]bool{}
+	for _, action := range actionList {
+		key := [2]int64{action.PhaseCategory, action.PhaseSubCategory}
+		if seen[key] {
+			t.Errorf("操作が重複しています: %+v", action)
+		}
+		seen[key] = true
+	}
+
+	// 終了したタスクには操作がないこと
+	if list := policy.GetTaskPhaseActionList(entity.NewTaskPhaseState(entity.AcceptJobOffer, entity.Decision)); len(list) != 0 {
+		t.Errorf("決定終了のタスクに操作があります: %+v", list)
+	}
+}
+
+// 定義のサブフェーズはマスタに存在すること
+func Test_Policy_TaskPhaseTransitionList(t *testing.T) {
+	phaseListOf := func(phase entity.TaskCategory) []entity.TaskCategory {
+		switch phase {
+		case entity.TaskPhaseAnySelection, entity.TaskPhaseNextSelection:
+			return []entity.TaskCategory{entity.FirstSelection, entity.FinalSelection}
+		}
+		return []entity.TaskCategory{phase}
+	}
+
+	exists := func(phase entity.TaskCategory, phaseSub int64) bool {
+		if phaseSub == entity.TaskPhaseSubAny || phaseSub == entity.ContinueSelection {
+			return true
+		}
+		if phase == entity.AcceptJobOffer && phaseSub == entity.Decision {
+			return true
+		}
+		_, ok := entity.TaskPhaseSub[uint(phase)][uint(phaseSub)]
+		return ok
+	}
+
+	for _, transition := range entity.TaskPhaseTransitionList {
+		for _, phase := range phaseListOf(transition.FromPhase) {
+			if !exists(phase, transition.FromPhaseSub) {
+				t.Errorf("遷移元がマスタにありません: %+v", transition)
+			}
+
+			toPhase := transition.ToPhase
+			if toPhase == entity.TaskPhaseSameAsFrom {
+				toPhase = phase
+			}
+			for _, to := range phaseListOf(toPhase) {
+				if !exists(to, transition.ToPhaseSub) {
+					t.Errorf("遷移先がマスタにありません: %+v", transition)
+				}
+			}
+		}
+	}
+}
+
+// 売上の下書きは内定承諾・入社確認の遷移でのみ作成すること
+func Test_Policy_TaskPhaseDraftSale(t *testing.T) {
+	cases := []struct {
+		name string
+		from entity.TaskPhaseState
+		to   entity.TaskPhaseState
+		want bool
+	}{
+		{
+			"内定承諾",
+			entity.NewTaskPhaseState(entity.HoldJobOffer, int64(entity.AcceptJobOfferMind)),
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.Accept)),
+			true,
+		},
+		{
+			"入社確認",
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.RequestConfirmJoiningCompany)),
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.ConfirmJoiningCompany)),
+			true,
+		},
+		{
+			"入社フォロー",
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.Accept)),
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.FollowJoiningCompany)),
+			false,
+		},
+		{
+			"入社後の状況確認を依頼",
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.ConfirmJoiningCompany)),
+			entity.NewTaskPhaseState(entity.Decline, 0),
+			false,
+		},
+		{
+			"承諾後辞退で終了",
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, int64(entity.FollowJoiningCompany)),
+			entity.NewTaskPhaseState(entity.AcceptJobOffer, entity.AcceptJobOfferPhaseClose),
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		transition, ok := policy.FindTaskPhaseTransition(c.from, c.to)
+		if !ok {
+			t.Errorf("%s: 遷移の定義がありません", c.name)
+			continue
+		}
+		if got := policy.HasTaskPhaseSideEffect(transition, entity.TaskPhaseSideEffectDraftSale); got != c.want {
+			t.Errorf("%s: 売上の下書きを作成すること=%v を期待しましたが %v でした", c.name, c.want, got)
+		}
+	}
+}
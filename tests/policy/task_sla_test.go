@@ -0,0 +1,152 @@
+package policy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+// タスクの期限（SLA）
+//
+// 2024-10-11は金曜日、2024-10-14は祝日（月曜日）
+var taskSLACalendar = policy.NewTaskSLACalendar(0, 0, []string{"2024-10-14"})
+
+func taskSLATime(day, hour, minute int) time.Time {
+	return time.Date(2024, 10, day, hour, minute, 0, 0, utility.Tokyo)
+}
+
+func Test_Policy_AddTaskSLABusinessHours(t *testing.T) {
+	cases := []struct {
+		name  string
+		start time.Time
+		hours int
+		want  time.Time
+	}{
+		{"同じ営業日内", taskSLATime(10, 10, 0), 3, taskSLATime(10, 13, 0)},
+		{"営業時間前の開始は営業開始から数える", taskSLATime(10, 7, 0), 2, taskSLATime(10, 11, 0)},
+		{"翌営業日に繰り越す", taskSLATime(10, 16, 30), 3, taskSLATime(11, 10, 30)},
+		{"土日と休業日を飛ばす", taskSLATime(11, 17, 0), 2, taskSLATime(15, 10, 0)},
+		{"営業時間後の開始は翌営業日から数える", taskSLATime(10, 20, 0), 9, taskSLATime(11, 18, 0)},
+		{"遡る場合も土日と休業日を飛ばす", taskSLATime(15, 10, 0), -2, taskSLATime(11, 17, 0)},
+		{"0の場合はそのまま", taskSLATime(12, 12, 0), 0, taskSLATime(12, 12, 0)},
+	}
+
+	for _, c := range cases {
+		got := policy.AddTaskSLABusinessHours(taskSLACalendar, c.start, c.hours)
+		if !got.Equal(c.want) {
+			t.Errorf("%s: %v を期待しましたが %v でした", c.name, c.want, got)
+		}
+	}
+}
+
+func Test_Policy_GetTaskSLADueAt(t *testing.T) {
+	rule := entity.TaskSLARule{DeadlineHours: 9}
+	createdAt := taskSLATime(10, 15, 0)
+
+	// 期限日が設定されている場合はその日時
+	dueAt, isDefault, ok := policy.GetTaskSLADueAt(taskSLACalendar, "2024-10-16", null.NewInt(12, true), createdAt, rule)
+	if !ok || isDefault || !dueAt.Equal(taskSLATime(16, 12, 0)) {
+		t.Errorf("期限日の期限が正しくありません: %v %v %v", dueAt, isDefault, ok)
+	}
+
+	// 未設定の場合は作成から1営業日後
+	dueAt, isDefault, ok = policy.GetTaskSLADueAt(taskSLACalendar, "", null.NewInt(0, false), createdAt, rule)
+	if !ok || !isDefault || !dueAt.Equal(taskSLATime(11, 15, 0)) {
+		t.Errorf("既定の期限が正しくありません: %v %v %v", dueAt, isDefault, ok)
+	}
+
+	// 既定の期限がない設定の場合は期限なし
+	if _, _, ok := policy.GetTaskSLADueAt(taskSLACalendar, "", null.NewInt(0, false), createdAt, entity.TaskSLARule{}); ok {
+		t.Errorf("期限がない設定で期限が算出されました")
+	}
+}
+
+func Test_Policy_GetTaskSLANotificationTypeList(t *testing.T) {
+	rule := entity.TaskSLARule{ReminderHours: 2, EscalationHours: 3}
+	dueAt := taskSLATime(11, 17, 0)
+
+	cases := []struct {
+		name string
+		now  time.Time
+		want []int64
+	}{
+		{"リマインド前", taskSLATime(11, 14, 59), []int64{}},
+		{"リマインド", taskSLATime(11, 15, 0), []int64{entity.TaskSLANotificationReminder}},
+		{"期限切れ", taskSLATime(11, 17, 0), []int64{entity.TaskSLANotificationOverdue}},
+		{"休業日は営業時間に数えない", taskSLATime(14, 12, 0), []int64{entity.TaskSLANotificationOverdue}},
+		{"エスカレーション", taskSLATime(15, 11, 0), []int64{entity.TaskSLANotificationOverdue, entity.TaskSLANotificationEscalation}},
+	}
+
+	for _, c := range cases {
+		got := policy.GetTaskSLANotificationTypeList(taskSLACalendar, dueAt, rule, c.now)
+		if len(got) != len(c.want) {
+			t.Errorf("%s: %v を期待しましたが %v でした", c.name, c.want, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("%s: %v を期待しましたが %v でした", c.name, c.want, got)
+			}
+		}
+	}
+}
+
+func Test_Policy_FindTaskSLARule(t *testing.T) {
+	ruleList := []*entity.TaskSLARule{
+		{ID: 1, TargetType: entity.TaskSLATargetTask, PhaseCategory: int64(entity.DocumentSelection), DeadlineHours: 9},
+		{ID: 2, TargetType: entity.TaskSLATargetTask, PhaseCategory: int64(entity.DocumentSelection), PhaseSubCategory: null.NewInt(int64(entity.RequestRecommendations), true), DeadlineHours: 3},
+	}
+
+	// サブフェーズ指定の設定を優先する
+	rule, ok := policy.FindTaskSLARule(ruleList, entity.TaskSLATargetTask, int64(entity.DocumentSelection), int64(entity.RequestRecommendations))
+	if !ok || rule.ID != 2 {
+		t.Errorf("サブフェーズの設定が使われていません: %+v", rule)
+	}
+
+	// フェーズ全体の設定
+	rule, ok = policy.FindTaskSLARule(ruleList, entity.TaskSLATargetTask, int64(entity.DocumentSelection), int64(entity.CollectResultOfDocumentSelection))
+	if !ok || rule.ID != 1 {
+		t.Errorf("フェーズの設定が使われていません: %+v", rule)
+	}
+
+	// 設定がない場合は初期設定
+	rule, ok = policy.FindTaskSLARule(ruleList, entity.TaskSLATargetInterviewTask, int64(entity.InvitationInterview), 0)
+	if !ok || rule.ID != 0 || rule.DeadlineHours == 0 {
+		t.Errorf("初期設定が使われていません: %+v", rule)
+	}
+}
+
+func Test_Policy_GetTaskSLAManagerList(t *testing.T) {
+	var (
+		available = null.NewInt(int64(entity.UsageStatusAvailable), true)
+		admin     = null.NewInt(int64(entity.AuthorityAdmin), true)
+		general   = null.NewInt(int64(entity.AuthorityGeneral), true)
+	)
+
+	staff := &entity.AgentStaff{ID: 1, AgentID: 1, Authority: general, UsageStatus: available}
+	agentStaffList := []*entity.AgentStaff{
+		staff,
+		{ID: 2, AgentID: 1, Authority: admin, UsageStatus: available},
+		{ID: 3, AgentID: 1, Authority: general, UsageStatus: available},
+		{ID: 4, AgentID: 2, Authority: admin, UsageStatus: available},
+		{ID: 5, AgentID: 1, Authority: admin, UsageStatus: available, IsDeleted: true},
+	}
+
+	// 上長が未設定の場合は自社の利用中の管理者
+	managerList := policy.GetTaskSLAManagerList(staff, agentStaffList)
+	if len(managerList) != 1 || managerList[0].ID != 2 {
+		t.Errorf("自社の管理者を期待しましたが %+v でした", managerList)
+	}
+
+	// 上長が設定されている場合はその担当者のみ
+	staff.ManagerStaffID = null.NewInt(3, true)
+	managerList = policy.GetTaskSLAManagerList(staff, agentStaffList)
+	if len(managerList) != 1 || managerList[0].ID != 3 {
+		t.Errorf("設定した上長を期待しましたが %+v でした", managerList)
+	}
+}
@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/interfaces/repository"
+)
+
+// 一覧の取得結果を返すだけのSQLExecuter（DBを使わずにリポジトリの後処理を確認する）
+type jobSeekerListExecuter struct {
+	jobSeekerList []*entity.JobSeeker
+}
+
+func (ex *jobSeekerListExecuter) Get(name string, dest interface{}, query string, args ...interface{}) error {
+	return nil
+}
+
+func (ex *jobSeekerListExecuter) Select(name string, dest interface{}, query string, args ...interface{}) error {
+	*dest.(*[]*entity.JobSeeker) = ex.jobSeekerList
+	return nil
+}
+
+func (ex *jobSeekerListExecuter) Exec(name string, query string, args ...interface{}) (int64, error) {
+	return 0, nil
+}
+
+/****************************************************************************************/
+// 複数取得
+//
+// 一覧の取得で個人情報を復号して返す（未暗号化の値はそのまま返す）
+func Test_JobSeeker_GetByAgentID(t *testing.T) {
+	ex := &jobSeekerListExecuter{
+		jobSeekerList: []*entity.JobSeeker{
+			{ID: 1, AgentID: 1, PhoneNumber: "090-1234-5678", Email: "test1@example.com"},
+			{ID: 2, AgentID: 1, PhoneNumber: "080-1234-5678", Email: "test2@example.com"},
+		},
+	}
+
+	jobSeekerList, err := repository.NewJobSeekerRepositoryImpl(ex).GetByAgentID(1)
+	if err != nil {
+		t.Fatal("GetByAgentID error!", err)
+	}
+
+	if len(jobSeekerList) != 2 || jobSeekerList[1].Email != "test2@example.com" {
+		t.Errorf("取得した一覧をそのまま返すことを期待しましたが %+v でした", jobSeekerList)
+	}
+}
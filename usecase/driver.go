@@ -19,9 +19,17 @@ type Firebase interface {
 	DeleteUser(uid string) error
 	UploadToStorageForJobSeekerLine(content io.ReadCloser, messageID string) (string, error)
 	UploadToStorageForAgentLine(file *multipart.FileHeader, agentUUID string) (string, error)
+	DownloadFromStorage(fileURL string) ([]byte, error)
+	DeleteFromStorage(fileURL string) error
 	SignOut(uid string) error
 }
 
+// ログイン試行が続いた場合のCAPTCHAの検証
+type Captcha interface {
+	IsEnabled() bool
+	Verify(token, remoteIP string) (bool, error)
+}
+
 type Cache interface {
 	GetBytes(key string) ([]byte, error)
 	GetString(key string) (string, error)
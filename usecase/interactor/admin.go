@@ -1,59 +1,435 @@
 package interactor
 
 import (
-	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // AdminInteractor is an Interface
 type AdminInteractor interface {
+	// 認証 API
 	Authorize(input AdminAuthorizeInput) (AdminAuthorizeOutput, error)
+	EnrollTOTP(input AdminEnrollTOTPInput) (AdminEnrollTOTPOutput, error)
+	VerifyTOTP(input AdminVerifyTOTPInput) (AdminVerifyTOTPOutput, error)
+	SignOut(input AdminSignOutInput) (AdminSignOutOutput, error)
+	AuthenticateAdminSession(input AuthenticateAdminSessionInput) (AuthenticateAdminSessionOutput, error)
+
+	// 管理者 API
+	CreateAdminUser(input CreateAdminUserInput) (CreateAdminUserOutput, error)
+	RecordAdminAction(input RecordAdminActionInput) (RecordAdminActionOutput, error)
+	GetAdminActionLogList(input GetAdminActionLogListInput) (GetAdminActionLogListOutput, error)
 }
 
 // AdminInteractorImpl is an implementation of AdminInteractor
 type AdminInteractorImpl struct {
-	basicUsers     []string
-	basicPasswords []string
+	adminConfig              config.Admin
+	adminUserRepository      usecase.AdminUserRepository
+	adminSessionRepository   usecase.AdminSessionRepository
+	adminActionLogRepository usecase.AdminActionLogRepository
 }
 
 // NewAdminInteractorImpl is an initializer for AdminInteractorImpl
-func NewAdminInteractorImpl(appConfig config.App) AdminInteractor {
+func NewAdminInteractorImpl(
+	adc config.Admin,
+	auR usecase.AdminUserRepository,
+	asR usecase.AdminSessionRepository,
+	aalR usecase.AdminActionLogRepository,
+) AdminInteractor {
 	return &AdminInteractorImpl{
-		basicUsers:     appConfig.BasicUsers,
-		basicPasswords: appConfig.BasicPasswords,
+		adminConfig:              adc,
+		adminUserRepository:      auR,
+		adminSessionRepository:   asR,
+		adminActionLogRepository: aalR,
 	}
 }
 
+// 認証アプリに表示する発行者名
+const adminTOTPIssuer = "autoscout admin"
+
+/****************************************************************************************/
+/// 認証 API
+//
+// ログインIDとパスワードで認証し、二要素認証前のセッションを発行する
 type AdminAuthorizeInput struct {
-	Username string
+	LoginID  string
 	Password string
+	Access   entity.AdminAccess
 }
 
 type AdminAuthorizeOutput struct {
-	OK bool
+	Token     string
+	MFAStatus string
+	ExpiresAt time.Time
+	AdminUser *entity.AdminUser
 }
 
 func (i *AdminInteractorImpl) Authorize(input AdminAuthorizeInput) (AdminAuthorizeOutput, error) {
 	var (
-		output = AdminAuthorizeOutput{}
-		u, p   string
+		output  = AdminAuthorizeOutput{}
+		now     = time.Now().In(time.UTC)
+		errAuth = fmt.Errorf("%w:%s", entity.ErrUnauthorized, "ログインIDまたはパスワードが正しくありません")
+	)
+
+	adminUser, err := i.adminUserRepository.FindByLoginID(strings.TrimSpace(input.LoginID))
+	if errors.Is(err, entity.ErrNotFound) {
+		return output, errAuth
+	} else if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(adminUser.Password), []byte(input.Password))
+	if err != nil {
+		return output, errAuth
+	}
+
+	token, err := utility.GenerateAdminSessionToken()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	session := entity.NewAdminSession(
+		adminUser.ID,
+		utility.HashAdminSessionToken(token),
+		now.Add(policy.AdminPreMFASessionDuration),
+		input.Access.IPAddress,
+		input.Access.UserAgent,
+	)
+
+	err = i.adminSessionRepository.Create(session)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.Token = token
+	output.MFAStatus = policy.GetAdminMFAStatus(adminUser, session)
+	output.ExpiresAt = session.ExpiresAt
+	output.AdminUser = adminUser
+
+	return output, nil
+}
+
+// 二要素認証を登録する（秘密鍵を発行し、VerifyTOTPで最初のワンタイムパスワードを確認した時点で登録完了とする）
+type AdminEnrollTOTPInput struct {
+	AdminUser *entity.AdminUser
+}
+
+type AdminEnrollTOTPOutput struct {
+	Secret string
+	URI    string
+}
+
+func (i *AdminInteractorImpl) EnrollTOTP(input AdminEnrollTOTPInput) (AdminEnrollTOTPOutput, error) {
+	var (
+		output AdminEnrollTOTPOutput
+	)
+
+	if input.AdminUser.TOTPEnabledAt.Valid {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "二要素認証は登録済みです")
+		return output, wrapped
+	}
+
+	secret, err := utility.GenerateTOTPSecret()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	encrypted, err := utility.EncryptString(secret)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.adminUserRepository.UpdateTOTPSecret(input.AdminUser.ID, encrypted)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.Secret = secret
+	output.URI = utility.TOTPURI(adminTOTPIssuer, input.AdminUser.LoginID, secret)
+
+	return output, nil
+}
+
+// ワンタイムパスワードを検証し、セッションの二要素認証を完了する
+type AdminVerifyTOTPInput struct {
+	AdminUser *entity.AdminUser
+	Session   *entity.AdminSession
+	Code      string
+}
+
+type AdminVerifyTOTPOutput struct {
+	ExpiresAt time.Time
+}
+
+func (i *AdminInteractorImpl) VerifyTOTP(input AdminVerifyTOTPInput) (AdminVerifyTOTPOutput, error) {
+	var (
+		output    AdminVerifyTOTPOutput
+		adminUser = input.AdminUser
+		now       = time.Now().In(time.UTC)
 	)
 
-	for idx, name := range i.basicUsers {
-		if input.Username == name {
-			u = i.basicUsers[idx]
-			p = i.basicPasswords[idx]
+	if adminUser.TOTPSecret == "" {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "二要素認証を登録してください")
+		return output, wrapped
+	}
+
+	secret, err := utility.DecryptSecret(adminUser.TOTPSecret)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	step, ok := utility.VerifyTOTP(secret, input.Code, now, adminUser.TOTPLastStep)
+	if !ok {
+		err = i.adminSessionRepository.IncrementMFAFailedCount(input.Session.ID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
 		}
+
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "ワンタイムパスワードが正しくありません")
 	}
-	if p == "" || u == "" {
-		return output, nil
+
+	if adminUser.TOTPEnabledAt.Valid {
+		err = i.adminUserRepository.UpdateTOTPLastStep(adminUser.ID, step)
+	} else {
+		err = i.adminUserRepository.EnableTOTP(adminUser.ID, step)
+	}
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	expiresAt := policy.ExtendAdminSession(input.Session, i.adminConfig.SessionIdleMinutes, i.adminConfig.SessionMaxHours, now)
+
+	err = i.adminSessionRepository.VerifyMFA(input.Session.ID, expiresAt)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
-	if subtle.ConstantTimeCompare([]byte(input.Username), []byte(u)) == 1 &&
-		subtle.ConstantTimeCompare([]byte(input.Password), []byte(p)) == 1 {
-		output.OK = true
+	err = i.adminUserRepository.UpdateLastLoginAt(adminUser.ID, now)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
+	output.ExpiresAt = expiresAt
+
+	return output, nil
+}
+
+// ログアウトする
+type AdminSignOutInput struct {
+	Session *entity.AdminSession
+}
+
+type AdminSignOutOutput struct {
+	OK bool
+}
+
+func (i *AdminInteractorImpl) SignOut(input AdminSignOutInput) (AdminSignOutOutput, error) {
+	var (
+		output AdminSignOutOutput
+	)
+
+	err := i.adminSessionRepository.Revoke(input.Session.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// セッショントークンから管理者を認証する（二要素認証済みの場合は有効期限を延長する）
+// RequireMFAがfalseの場合は二要素認証前のセッションも許可する
+type AuthenticateAdminSessionInput struct {
+	Token      string
+	RequireMFA bool
+}
+
+type AuthenticateAdminSessionOutput struct {
+	AdminUser *entity.AdminUser
+	Session   *entity.AdminSession
+}
+
+func (i *AdminInteractorImpl) AuthenticateAdminSession(input AuthenticateAdminSessionInput) (AuthenticateAdminSessionOutput, error) {
+	var (
+		output AuthenticateAdminSessionOutput
+		now    = time.Now().In(time.UTC)
+	)
+
+	if input.Token == "" {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "ログインしてください")
+	}
+
+	session, err := i.adminSessionRepository.FindByTokenHash(utility.HashAdminSessionToken(input.Token))
+	if errors.Is(err, entity.ErrNotFound) {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "ログインしてください")
+	} else if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	adminUser, err := i.adminUserRepository.FindByID(session.AdminUserID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.VerifyAdminSession(adminUser, session, input.RequireMFA, now)
+	if err != nil {
+		return output, err
+	}
+
+	// 操作するたびに有効期限を延長する（1分未満の延長は書き込まない）
+	if session.MFAVerifiedAt.Valid {
+		expiresAt := policy.ExtendAdminSession(session, i.adminConfig.SessionIdleMinutes, i.adminConfig.SessionMaxHours, now)
+		if expiresAt.Sub(session.ExpiresAt) >= time.Minute {
+			err = i.adminSessionRepository.UpdateExpiresAt(session.ID, expiresAt)
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+			session.ExpiresAt = expiresAt
+		}
+	}
+
+	output.AdminUser = adminUser
+	output.Session = session
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 管理者 API
+//
+// 管理者を作成する（管理者サイト、または service=create_admin_user のコマンドから実行する）
+type CreateAdminUserInput struct {
+	CreateParam entity.CreateAdminUserParam
+}
+
+type CreateAdminUserOutput struct {
+	AdminUser *entity.AdminUser
+}
+
+func (i *AdminInteractorImpl) CreateAdminUser(input CreateAdminUserInput) (CreateAdminUserOutput, error) {
+	var (
+		output CreateAdminUserOutput
+		param  = input.CreateParam
+	)
+
+	loginID := strings.TrimSpace(param.LoginID)
+	name := strings.TrimSpace(param.Name)
+	if loginID == "" || name == "" {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "ログインIDと名前を入力してください")
+		return output, wrapped
+	}
+
+	err := policy.ValidateAdminPassword(param.Password)
+	if err != nil {
+		return output, err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(param.Password), bcrypt.DefaultCost)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	adminUser := entity.NewAdminUser(loginID, name, string(hashedPassword))
+
+	err = i.adminUserRepository.Create(adminUser)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.AdminUser = adminUser
+
+	return output, nil
+}
+
+// 管理者の操作を記録する
+type RecordAdminActionInput struct {
+	AdminUser   *entity.AdminUser
+	Method      string
+	Path        string
+	URI         string
+	RequestBody string
+	StatusCode  int
+	Access      entity.AdminAccess
+}
+
+type RecordAdminActionOutput struct {
+	OK bool
+}
+
+func (i *AdminInteractorImpl) RecordAdminAction(input RecordAdminActionInput) (RecordAdminActionOutput, error) {
+	var (
+		output RecordAdminActionOutput
+	)
+
+	actionLog := entity.NewAdminActionLog(
+		input.AdminUser.ID,
+		input.Method,
+		input.Path,
+		input.URI,
+		input.RequestBody,
+		input.StatusCode,
+		input.Access.IPAddress,
+		input.Access.UserAgent,
+	)
+
+	err := i.adminActionLogRepository.Create(actionLog)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// 管理者の操作ログを新しい順に取得する
+type GetAdminActionLogListInput struct {
+	Page uint
+}
+
+type GetAdminActionLogListOutput struct {
+	ActionLogList []*entity.AdminActionLog
+}
+
+func (i *AdminInteractorImpl) GetAdminActionLogList(input GetAdminActionLogListInput) (GetAdminActionLogListOutput, error) {
+	var (
+		output GetAdminActionLogListOutput
+	)
+
+	actionLogList, err := i.adminActionLogRepository.GetListByPage(input.Page)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.ActionLogList = actionLogList
+
 	return output, nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/spaceaiinc/autoscout-server/domain/config"
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
 	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -22,6 +23,7 @@ type AgentInteractor interface {
 	UpdateAgent(input UpdateAgentInput) (UpdateAgentOutput, error)
 	UpdateAgentAgreementFileURL(input UpdateAgentAgreementFileURLInput) (UpdateAgentAgreementFileURLOutput, error)
 	UpdateAgentForAdmin(input UpdateAgentForAdminInput) (UpdateAgentForAdminOutput, error)
+	UpdateAgentDuplicateRecommendation(input UpdateAgentDuplicateRecommendationInput) (UpdateAgentDuplicateRecommendationOutput, error)
 	GetAllianceAgentListByAgentID(input GetAllianceAgentListByAgentIDInput) (GetAllianceAgentListByAgentIDOutput, error)
 	GetAllianceAgentListByAgentIDForSelect(input GetAllianceAgentListByAgentIDForSelectInput) (GetAllianceAgentListByAgentIDForSelectOutput, error)
 	GetAgreementFileURL(input GetAgreementFileURLInput) (GetAgreementFileURLOutput, error)
@@ -576,6 +578,42 @@ func (i *AgentInteractorImpl) UpdateAgentAgreementFileURL(input UpdateAgentAgree
 	return output, nil
 }
 
+// 重複推薦を確認する期間を更新する（自社の管理者のみ）
+type UpdateAgentDuplicateRecommendationInput struct {
+	Operator *entity.AgentStaff
+	Param    entity.AgentDuplicateRecommendationParam
+}
+
+type UpdateAgentDuplicateRecommendationOutput struct {
+	OK bool
+}
+
+func (i *AgentInteractorImpl) UpdateAgentDuplicateRecommendation(input UpdateAgentDuplicateRecommendationInput) (UpdateAgentDuplicateRecommendationOutput, error) {
+	var (
+		output UpdateAgentDuplicateRecommendationOutput
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, input.Param.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	err = i.agentRepository.UpdateDuplicateRecommendationDays(input.Param.AgentID, input.Param.DuplicateRecommendationDays)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
 type UpdateAgentForAdminInput struct {
 	Param entity.AgentForAdminParam
 }
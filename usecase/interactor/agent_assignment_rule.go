@@ -0,0 +1,329 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+type AgentAssignmentRuleInteractor interface {
+	// 汎用系 API
+	UpdateAgentAssignmentRule(input UpdateAgentAssignmentRuleInput) (UpdateAgentAssignmentRuleOutput, error)
+	GetAgentAssignmentRuleByAgentID(input GetAgentAssignmentRuleByAgentIDInput) (GetAgentAssignmentRuleByAgentIDOutput, error)
+	OverrideJobSeekerAssignment(input OverrideJobSeekerAssignmentInput) (OverrideJobSeekerAssignmentOutput, error)
+	GetJobSeekerAssignmentLogListByJobSeekerID(input GetJobSeekerAssignmentLogListByJobSeekerIDInput) (GetJobSeekerAssignmentLogListByJobSeekerIDOutput, error)
+}
+
+type AgentAssignmentRuleInteractorImpl struct {
+	firebase                                usecase.Firebase
+	sendgrid                                config.Sendgrid
+	oneSignal                               config.OneSignal
+	agentStaffRepository                    usecase.AgentStaffRepository
+	jobSeekerRepository                     usecase.JobSeekerRepository
+	agentAssignmentRuleRepository           usecase.AgentAssignmentRuleRepository
+	agentAssignmentStaffRepository          usecase.AgentAssignmentStaffRepository
+	agentAssignmentStaffSpecialtyRepository usecase.AgentAssignmentStaffSpecialtyRepository
+	jobSeekerAssignmentLogRepository        usecase.JobSeekerAssignmentLogRepository
+}
+
+// AgentAssignmentRuleInteractorImpl is an implementation of AgentAssignmentRuleInteractor
+func NewAgentAssignmentRuleInteractorImpl(
+	fb usecase.Firebase,
+	sg config.Sendgrid,
+	os config.OneSignal,
+	asR usecase.AgentStaffRepository,
+	jsR usecase.JobSeekerRepository,
+	aarR usecase.AgentAssignmentRuleRepository,
+	aasR usecase.AgentAssignmentStaffRepository,
+	aassR usecase.AgentAssignmentStaffSpecialtyRepository,
+	jsalR usecase.JobSeekerAssignmentLogRepository,
+) AgentAssignmentRuleInteractor {
+	return &AgentAssignmentRuleInteractorImpl{
+		firebase:                                fb,
+		sendgrid:                                sg,
+		oneSignal:                               os,
+		agentStaffRepository:                    asR,
+		jobSeekerRepository:                     jsR,
+		agentAssignmentRuleRepository:           aarR,
+		agentAssignmentStaffRepository:          aasR,
+		agentAssignmentStaffSpecialtyRepository: aassR,
+		jobSeekerAssignmentLogRepository:        jsalR,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 担当CAの自動割り振りルールを更新（未作成の場合は作成）
+// 割り振り対象の担当者と得意分野は全件入れ替える
+type UpdateAgentAssignmentRuleInput struct {
+	Param entity.UpdateAgentAssignmentRuleParam
+}
+
+type UpdateAgentAssignmentRuleOutput struct {
+	AssignmentRule *entity.AgentAssignmentRule
+}
+
+func (i *AgentAssignmentRuleInteractorImpl) UpdateAgentAssignmentRule(input UpdateAgentAssignmentRuleInput) (UpdateAgentAssignmentRuleOutput, error) {
+	var (
+		output UpdateAgentAssignmentRuleOutput
+		param  = input.Param
+	)
+
+	switch param.AssignmentType.Int64 {
+	case entity.AssignmentTypeRoundRobin, entity.AssignmentTypeLeastOpenTask, entity.AssignmentTypeSpecialty:
+	default:
+		err := fmt.Errorf("%w:%s", entity.ErrRequestError, "割り振り方法が不正です")
+		return output, err
+	}
+
+	for _, staffParam := range param.StaffList {
+		_, startErr := time.Parse("15:04", staffParam.WorkingStartTime)
+		_, endErr := time.Parse("15:04", staffParam.WorkingEndTime)
+		if startErr != nil || endErr != nil {
+			err := fmt.Errorf("%w:%s", entity.ErrRequestError, "勤務時間はHH:MMの形式で入力してください")
+			return output, err
+		}
+
+		agentStaff, err := i.agentStaffRepository.FindByID(staffParam.AgentStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		if agentStaff.AgentID != param.AgentID {
+			err = fmt.Errorf("%w:%s", entity.ErrRequestError, "他のエージェントの担当者は割り振り対象にできません")
+			return output, err
+		}
+	}
+
+	assignmentRule := entity.NewAgentAssignmentRule(
+		param.AgentID,
+		param.AssignmentType,
+		param.IsWorkingHoursAware,
+		param.IsActive,
+	)
+
+	existing, err := i.agentAssignmentRuleRepository.FindByAgentID(param.AgentID)
+	if errors.Is(err, entity.ErrNotFound) {
+		err = i.agentAssignmentRuleRepository.Create(assignmentRule)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	} else if err != nil {
+		fmt.Println(err)
+		return output, err
+	} else {
+		err = i.agentAssignmentRuleRepository.Update(existing.ID, assignmentRule)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	// 割り振り対象の担当者を入れ替え
+	err = i.agentAssignmentStaffRepository.DeleteByAgentID(param.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, staffParam := range param.StaffList {
+		assignmentStaff := entity.NewAgentAssignmentStaff(
+			param.AgentID,
+			staffParam.AgentStaffID,
+			staffParam.WorkingStartTime,
+			staffParam.WorkingEndTime,
+		)
+
+		err = i.agentAssignmentStaffRepository.Create(assignmentStaff)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		for _, occupation := range staffParam.Occupations {
+			err = i.agentAssignmentStaffSpecialtyRepository.Create(entity.NewAgentAssignmentStaffSpecialty(
+				assignmentStaff.ID,
+				null.NewInt(entity.AssignmentSpecialtyTypeOccupation, true),
+				occupation,
+			))
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+		}
+
+		for _, prefecture := range staffParam.Prefectures {
+			err = i.agentAssignmentStaffSpecialtyRepository.Create(entity.NewAgentAssignmentStaffSpecialty(
+				assignmentStaff.ID,
+				null.NewInt(entity.AssignmentSpecialtyTypePrefecture, true),
+				prefecture,
+			))
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+		}
+	}
+
+	getOutput, err := i.GetAgentAssignmentRuleByAgentID(GetAgentAssignmentRuleByAgentIDInput{
+		AgentID: param.AgentID,
+	})
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.AssignmentRule = getOutput.AssignmentRule
+
+	return output, nil
+}
+
+// エージェントの自動割り振りルールを取得（未作成の場合は無効なルールを返す）
+type GetAgentAssignmentRuleByAgentIDInput struct {
+	AgentID uint
+}
+
+type GetAgentAssignmentRuleByAgentIDOutput struct {
+	AssignmentRule *entity.AgentAssignmentRule
+}
+
+func (i *AgentAssignmentRuleInteractorImpl) GetAgentAssignmentRuleByAgentID(input GetAgentAssignmentRuleByAgentIDInput) (GetAgentAssignmentRuleByAgentIDOutput, error) {
+	var (
+		output GetAgentAssignmentRuleByAgentIDOutput
+	)
+
+	assignmentRule, err := i.agentAssignmentRuleRepository.FindByAgentID(input.AgentID)
+	if errors.Is(err, entity.ErrNotFound) {
+		assignmentRule = entity.NewAgentAssignmentRule(
+			input.AgentID,
+			null.NewInt(entity.AssignmentTypeRoundRobin, true),
+			false,
+			false,
+		)
+	} else if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	staffList, err := getAgentAssignmentStaffList(i.agentAssignmentStaffRepository, i.agentAssignmentStaffSpecialtyRepository, input.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	assignmentRule.StaffList = staffList
+	output.AssignmentRule = assignmentRule
+
+	return output, nil
+}
+
+// 求職者の担当CAを手動で変更する（自動割り振りの上書き）
+type OverrideJobSeekerAssignmentInput struct {
+	Token string
+	Param entity.OverrideJobSeekerAssignmentParam
+}
+
+type OverrideJobSeekerAssignmentOutput struct {
+	OK bool
+}
+
+func (i *AgentAssignmentRuleInteractorImpl) OverrideJobSeekerAssignment(input OverrideJobSeekerAssignmentInput) (OverrideJobSeekerAssignmentOutput, error) {
+	var (
+		output OverrideJobSeekerAssignmentOutput
+		param  = input.Param
+	)
+
+	firebaseID, err := i.firebase.VerifyIDToken(input.Token)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	operatedStaff, err := i.agentStaffRepository.FindByFirebaseID(firebaseID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	jobSeeker, err := i.jobSeekerRepository.FindByID(param.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	agentStaff, err := i.agentStaffRepository.FindByID(param.AgentStaffID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if jobSeeker.AgentID != operatedStaff.AgentID || agentStaff.AgentID != operatedStaff.AgentID {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "他のエージェントの求職者・担当者は変更できません")
+		return output, err
+	}
+
+	err = i.jobSeekerRepository.UpdateStaffID(jobSeeker.ID, null.NewInt(int64(agentStaff.ID), true))
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	assignmentLog := entity.NewJobSeekerAssignmentLog(
+		jobSeeker.AgentID,
+		jobSeeker.ID,
+		agentStaff.ID,
+		jobSeeker.AgentStaffID,
+		null.NewInt(entity.AssignmentTypeOverride, true),
+		null.NewInt(int64(operatedStaff.ID), true),
+		param.Reason,
+	)
+
+	err = recordJobSeekerAssignment(
+		i.jobSeekerAssignmentLogRepository,
+		i.agentStaffRepository,
+		i.oneSignal,
+		jobSeeker,
+		assignmentLog,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// 求職者の担当CAの割り振り履歴を取得
+type GetJobSeekerAssignmentLogListByJobSeekerIDInput struct {
+	JobSeekerID uint
+}
+
+type GetJobSeekerAssignmentLogListByJobSeekerIDOutput struct {
+	AssignmentLogList []*entity.JobSeekerAssignmentLog
+}
+
+func (i *AgentAssignmentRuleInteractorImpl) GetJobSeekerAssignmentLogListByJobSeekerID(input GetJobSeekerAssignmentLogListByJobSeekerIDInput) (GetJobSeekerAssignmentLogListByJobSeekerIDOutput, error) {
+	var (
+		output GetJobSeekerAssignmentLogListByJobSeekerIDOutput
+	)
+
+	assignmentLogList, err := i.jobSeekerAssignmentLogRepository.GetByJobSeekerID(input.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.AssignmentLogList = assignmentLogList
+
+	return output, nil
+}
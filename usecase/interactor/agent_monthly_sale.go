@@ -9,6 +9,7 @@ import (
 	"github.com/spaceaiinc/autoscout-server/domain/config"
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
 	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -73,6 +74,7 @@ func NewAgentMonthlySaleInteractorImpl(
 
 // 売上情報の登録
 type CreateAgentMonthlySaleInput struct {
+	Operator     *entity.AgentStaff // ログイン中の担当者（管理者のみ実行可能）
 	CreateParam  entity.CreateOrUpdateAgentMonthlyManagementParam
 	ManagementID uint
 }
@@ -87,6 +89,19 @@ func (i *AgentMonthlySaleInteractorImpl) CreateAgentMonthlySale(input CreateAgen
 		err    error
 	)
 
+	// 売上目標は管理者が自社分のみ作成できる
+	err = policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, input.CreateParam.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	agentSaleManagement := entity.NewAgentSaleManagement(
 		input.CreateParam.AgentID,
 		input.CreateParam.FiscalYear,
@@ -154,6 +169,7 @@ func (i *AgentMonthlySaleInteractorImpl) CreateAgentMonthlySale(input CreateAgen
 
 // 売上情報の更新
 type UpdateAgentMonthlySaleInput struct {
+	Operator    *entity.AgentStaff // ログイン中の担当者（管理者のみ実行可能）
 	UpdateParam entity.CreateOrUpdateAgentMonthlyManagementParam
 }
 
@@ -167,6 +183,27 @@ func (i *AgentMonthlySaleInteractorImpl) UpdateAgentMonthlySale(input UpdateAgen
 		err    error
 	)
 
+	// 売上目標は管理者が自社分のみ更新できる
+	err = policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	currentManagement, err := i.agentSaleManagementRepository.FindByID(input.UpdateParam.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, agentID := range []uint{currentManagement.AgentID, input.UpdateParam.AgentID} {
+		err = policy.RequireOwnAgent(input.Operator, agentID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
 	saleManagement := entity.NewAgentSaleManagement(
 		input.UpdateParam.AgentID,
 		input.UpdateParam.FiscalYear,
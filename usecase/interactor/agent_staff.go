@@ -9,6 +9,7 @@ import (
 
 	// "github.com/spaceaiinc/autoscout-server/domain/utility"
 	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
 	// "github.com/sendgrid/sendgrid-go/helpers/mail"
 )
 
@@ -24,6 +25,7 @@ type AgentStaffInteractor interface {
 	UpdateAgentStaffNotificationJobSeeker(input UpdateAgentStaffNotificationJobSeekerInput) (UpdateAgentStaffNotificationJobSeekerOutput, error)
 	UpdateAgentStaffNotificationUnwatched(input UpdateAgentStaffNotificationUnwatchedInput) (UpdateAgentStaffNotificationUnwatchedOutput, error)
 	UpdateAgentStaffAuthority(input UpdateAgentStaffAuthorityInput) (UpdateAgentStaffAuthorityOutput, error)
+	UpdateAgentStaffSLASetting(input UpdateAgentStaffSLASettingInput) (UpdateAgentStaffSLASettingOutput, error)
 	DeleteAgentStaff(input DeleteAgentStaffInput) (DeleteAgentStaffOutput, error) // 担当者削除　*firebaseのアイパスを削除&DBのis_deletedをtrueにする
 	GetAllAgentStaffList() (GetAllAgentStaffListOutput, error)
 	GetOhterAgentStaffListByAgentIDAndAllianceAgentID(input GetOhterAgentStaffListByAgentIDAndAllianceAgentIDInput) (GetOhterAgentStaffListByAgentIDAndAllianceAgentIDOutput, error)
@@ -416,8 +418,74 @@ func (i *AgentStaffInteractorImpl) UpdateAgentStaffNotificationUnwatched(input U
 	return output, nil
 }
 
+// タスクの期限（SLA）に関する通知設定の更新 body: {agent_staff_id, manager_staff_id, notification_sla_reminder, notification_sla_digest}
+// 通知の設定は本人または管理者、上長の変更は管理者のみ実行できる
+type UpdateAgentStaffSLASettingInput struct {
+	Operator    *entity.AgentStaff // ログイン中の担当者
+	UpdateParam entity.UpdateAgentStaffSLASettingParam
+}
+
+type UpdateAgentStaffSLASettingOutput struct {
+	OK bool
+}
+
+func (i *AgentStaffInteractorImpl) UpdateAgentStaffSLASetting(input UpdateAgentStaffSLASettingInput) (UpdateAgentStaffSLASettingOutput, error) {
+	var (
+		output UpdateAgentStaffSLASettingOutput
+		param  = input.UpdateParam
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	agentStaff, err := i.agentStaffRepository.FindByID(param.AgentStaffID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if input.Operator.ID != agentStaff.ID || agentStaff.ManagerStaffID != param.ManagerStaffID {
+		err = i.authorizeAdminForAgentStaff(input.Operator, agentStaff.ID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	// 上長は自社の利用中の担当者（本人以外）
+	if param.ManagerStaffID.Valid {
+		if uint(param.ManagerStaffID.Int64) == agentStaff.ID {
+			wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "本人を上長に設定することはできません")
+			return output, wrapped
+		}
+
+		manager, err := i.agentStaffRepository.FindByID(uint(param.ManagerStaffID.Int64))
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		if manager.AgentID != agentStaff.AgentID || manager.IsDeleted || manager.UsageStatus.Int64 == int64(entity.UsageStatusNotAvailable) {
+			wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "上長には自社の利用中の担当者を設定してください")
+			return output, wrapped
+		}
+	}
+
+	err = i.agentStaffRepository.UpdateSLASetting(agentStaff.ID, param.ManagerStaffID, param.NotificationSLAReminder, param.NotificationSLADigest)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
 // 管理権限の更新 body: {agent_staff_id, authority}
 type UpdateAgentStaffAuthorityInput struct {
+	Operator    *entity.AgentStaff // ログイン中の担当者（管理者のみ実行可能）
 	UpdateParam entity.UpdateAgentStaffAuthorityParam
 }
 
@@ -430,7 +498,14 @@ func (i *AgentStaffInteractorImpl) UpdateAgentStaffAuthority(input UpdateAgentSt
 		output UpdateAgentStaffAuthorityOutput
 	)
 
-	err := i.agentStaffRepository.UpdateAuthority(input.UpdateParam.AgentStaffID, input.UpdateParam.Authority)
+	// 管理者が自社の担当者に対してのみ実行できる
+	err := i.authorizeAdminForAgentStaff(input.Operator, input.UpdateParam.AgentStaffID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.agentStaffRepository.UpdateAuthority(input.UpdateParam.AgentStaffID, input.UpdateParam.Authority)
 	if err != nil {
 		return output, err
 	}
@@ -442,7 +517,8 @@ func (i *AgentStaffInteractorImpl) UpdateAgentStaffAuthority(input UpdateAgentSt
 
 // 担当者削除　*firebaseのアイパスを削除&DBのis_deletedをtrueにする
 type DeleteAgentStaffInput struct {
-	Param entity.DeleteAgentStaffParam
+	Operator *entity.AgentStaff // ログイン中の担当者（管理者のみ実行可能）
+	Param    entity.DeleteAgentStaffParam
 }
 
 type DeleteAgentStaffOutput struct {
@@ -455,6 +531,25 @@ func (i *AgentStaffInteractorImpl) DeleteAgentStaff(input DeleteAgentStaffInput)
 		err    error
 	)
 
+	// 管理者が自社の担当者に対してのみ実行できる（引き継ぎ先の担当者も自社に限る）
+	err = i.authorizeAdminForAgentStaff(input.Operator, input.Param.AgentStaffID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, newStaffID := range []int64{input.Param.NewRAStaffID.Int64, input.Param.NewCAStaffID.Int64} {
+		if newStaffID == 0 {
+			continue
+		}
+
+		err = i.authorizeAdminForAgentStaff(input.Operator, uint(newStaffID))
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
 	// RA引き継ぎ処理
 	if input.Param.NewRAStaffID.Int64 != 0 {
 		enterpriseList, err := i.enterpriseProfileRepository.GetByAgentStaffID(input.Param.AgentStaffID)
@@ -805,3 +900,18 @@ func (i *AgentStaffInteractorImpl) GetAgentStaffMe(input GetAgentStaffMeInput) (
 }
 
 /****************************************************************************************/
+
+// 管理者権限を持ち、対象の担当者が自社に所属しているかを判定する
+func (i *AgentStaffInteractorImpl) authorizeAdminForAgentStaff(operator *entity.AgentStaff, agentStaffID uint) error {
+	err := policy.RequireAdmin(operator)
+	if err != nil {
+		return err
+	}
+
+	agentStaff, err := i.agentStaffRepository.FindByID(agentStaffID)
+	if err != nil {
+		return err
+	}
+
+	return policy.RequireOwnAgent(operator, agentStaff.AgentID)
+}
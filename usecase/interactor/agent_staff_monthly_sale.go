@@ -8,6 +8,7 @@ import (
 	"github.com/spaceaiinc/autoscout-server/domain/config"
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
 	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -61,6 +62,7 @@ func NewAgentStaffMonthlySaleInteractorImpl(
 //
 //求職者の作成
 type CreateAgentStaffMonthlySaleInput struct {
+	Operator    *entity.AgentStaff // ログイン中の担当者（管理者のみ実行可能）
 	CreateParam entity.CreateOrUpdateStaffMonthlyManagementParam
 }
 
@@ -74,6 +76,13 @@ func (i *AgentStaffMonthlySaleInteractorImpl) CreateAgentStaffMonthlySale(input
 		err    error
 	)
 
+	// 担当者の売上目標は管理者が自社分のみ作成できる
+	err = i.authorizeAdminForSaleManagement(input.Operator, input.CreateParam.ManagementID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	staffSaleManagement := entity.NewAgentStaffSaleManagement(
 		input.CreateParam.ManagementID,
 		input.CreateParam.AgentStaffID,
@@ -132,6 +141,7 @@ func (i *AgentStaffMonthlySaleInteractorImpl) CreateAgentStaffMonthlySale(input
 
 // 個人売上の更新
 type UpdateAgentStaffMonthlySaleInput struct {
+	Operator    *entity.AgentStaff // ログイン中の担当者（管理者のみ実行可能）
 	UpdateParam entity.CreateOrUpdateStaffMonthlyManagementParam
 }
 
@@ -145,6 +155,13 @@ func (i *AgentStaffMonthlySaleInteractorImpl) UpdateAgentStaffMonthlySale(input
 		err    error
 	)
 
+	// 担当者の売上目標は管理者が自社分のみ更新できる
+	err = i.authorizeAdminForSaleManagement(input.Operator, input.UpdateParam.ManagementID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	for _, monthlySale := range input.UpdateParam.StaffMonthlySales {
 		err = i.agentStaffMonthlySaleRepository.Update(&monthlySale, monthlySale.ID)
 		if err != nil {
@@ -798,3 +815,18 @@ func (i *AgentStaffMonthlySaleInteractorImpl) GetStaffSaleManagementAndAgentMont
 
 	return output, nil
 }
+
+// 管理者権限を持ち、対象の売上管理が自社のものかを判定する
+func (i *AgentStaffMonthlySaleInteractorImpl) authorizeAdminForSaleManagement(operator *entity.AgentStaff, managementID uint) error {
+	err := policy.RequireAdmin(operator)
+	if err != nil {
+		return err
+	}
+
+	agentSaleManagement, err := i.agentSaleManagementRepository.FindByID(managementID)
+	if err != nil {
+		return err
+	}
+
+	return policy.RequireOwnAgent(operator, agentSaleManagement.AgentID)
+}
@@ -0,0 +1,274 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+)
+
+type APIKeyInteractor interface {
+	// 汎用系 API
+	AuthenticateAPIKey(input AuthenticateAPIKeyInput) (AuthenticateAPIKeyOutput, error)
+
+	// Admin API
+	CreateAPIKey(input CreateAPIKeyInput) (CreateAPIKeyOutput, error)
+	RevokeAPIKey(input RevokeAPIKeyInput) (RevokeAPIKeyOutput, error)
+	GetAPIKeyList(input GetAPIKeyListInput) (GetAPIKeyListOutput, error)
+}
+
+type APIKeyInteractorImpl struct {
+	apiKeyRepository     usecase.APIKeyRepository
+	agentStaffRepository usecase.AgentStaffRepository
+	agentRepository      usecase.AgentRepository
+}
+
+// APIKeyInteractorImpl is an implementation of APIKeyInteractor
+func NewAPIKeyInteractorImpl(
+	akR usecase.APIKeyRepository,
+	asR usecase.AgentStaffRepository,
+	aR usecase.AgentRepository,
+) APIKeyInteractor {
+	return &APIKeyInteractorImpl{
+		apiKeyRepository:     akR,
+		agentStaffRepository: asR,
+		agentRepository:      aR,
+	}
+}
+
+// APIキーの有効期限の初期値（日数）
+const defaultAPIKeyExpireDays = 90
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// APIキーを検証し、発行した担当者とエージェントを取得する
+// Scopeは利用するAPIに必要な操作（APIキーで利用できないAPIの場合は空文字）
+type AuthenticateAPIKeyInput struct {
+	Key       string
+	Scope     string
+	IPAddress string
+}
+
+type AuthenticateAPIKeyOutput struct {
+	APIKey     *entity.APIKey
+	AgentStaff *entity.AgentStaff
+	Agent      *entity.Agent
+}
+
+func (i *APIKeyInteractorImpl) AuthenticateAPIKey(input AuthenticateAPIKeyInput) (AuthenticateAPIKeyOutput, error) {
+	var (
+		output AuthenticateAPIKeyOutput
+		now    = time.Now().In(time.UTC)
+	)
+
+	if !utility.IsAPIKey(input.Key) {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "APIキーが正しくありません")
+	}
+
+	apiKey, err := i.apiKeyRepository.FindByKeyHash(utility.HashAPIKey(input.Key))
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "APIキーが正しくありません")
+		}
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.VerifyAPIKey(apiKey, input.Scope, now)
+	if err != nil {
+		return output, err
+	}
+
+	// 発行した担当者が利用できなくなった場合はAPIキーも利用できない
+	agentStaff, err := i.agentStaffRepository.FindByID(apiKey.AgentStaffID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "APIキーを発行した担当者が見つかりません")
+		}
+		fmt.Println(err)
+		return output, err
+	}
+
+	if agentStaff.IsDeleted || agentStaff.UsageStatus.Int64 == int64(entity.UsageStatusNotAvailable) || agentStaff.AgentID != apiKey.AgentID {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "APIキーを発行した担当者が利用できません")
+	}
+
+	agent, err := i.agentRepository.FindByID(apiKey.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 最終利用日時の更新に失敗してもリクエスト自体は続ける
+	if policy.ShouldUpdateAPIKeyLastUsed(apiKey, now) {
+		err = i.apiKeyRepository.UpdateLastUsed(apiKey.ID, now, input.IPAddress)
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	output.APIKey = apiKey
+	output.AgentStaff = agentStaff
+	output.Agent = agent
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// Admin API
+//
+// APIキーを発行する（管理者のみ）
+// キーは発行時のみ返し、DBにはハッシュのみを保存する
+type CreateAPIKeyInput struct {
+	Operator    *entity.AgentStaff
+	CreateParam entity.CreateAPIKeyParam
+}
+
+type CreateAPIKeyOutput struct {
+	APIKey *entity.APIKey
+}
+
+func (i *APIKeyInteractorImpl) CreateAPIKey(input CreateAPIKeyInput) (CreateAPIKeyOutput, error) {
+	var (
+		output CreateAPIKeyOutput
+		param  = input.CreateParam
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	name := strings.TrimSpace(param.Name)
+	if name == "" {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "名前を入力してください")
+		return output, wrapped
+	}
+
+	scopes, err := policy.NormalizeAPIKeyScopes(param.Scopes)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 有効期限
+	expireDays := param.ExpireDays
+	if expireDays == 0 {
+		expireDays = defaultAPIKeyExpireDays
+	}
+	if expireDays > entity.APIKeyMaxExpireDays {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("有効期限は%d日以内で指定してください", entity.APIKeyMaxExpireDays))
+		return output, wrapped
+	}
+
+	key, keyPrefix, err := utility.GenerateAPIKey()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	apiKey := entity.NewAPIKey(
+		input.Operator.AgentID,
+		input.Operator.ID,
+		name,
+		keyPrefix,
+		utility.HashAPIKey(key),
+		scopes,
+		time.Now().In(time.UTC).AddDate(0, 0, int(expireDays)),
+	)
+
+	err = i.apiKeyRepository.Create(apiKey)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	apiKey.Key = key
+	apiKey.StaffName = input.Operator.StaffName
+
+	output.APIKey = apiKey
+
+	return output, nil
+}
+
+// APIキーを無効化する（管理者のみ・自社のキーのみ）
+type RevokeAPIKeyInput struct {
+	Operator *entity.AgentStaff
+	APIKeyID uint
+}
+
+type RevokeAPIKeyOutput struct {
+	OK bool
+}
+
+func (i *APIKeyInteractorImpl) RevokeAPIKey(input RevokeAPIKeyInput) (RevokeAPIKeyOutput, error) {
+	var (
+		output RevokeAPIKeyOutput
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	apiKey, err := i.apiKeyRepository.FindByID(input.APIKeyID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, apiKey.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.apiKeyRepository.Revoke(apiKey.ID, input.Operator.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// 自社のAPIキー一覧を取得する（管理者のみ）
+type GetAPIKeyListInput struct {
+	Operator *entity.AgentStaff
+}
+
+type GetAPIKeyListOutput struct {
+	APIKeyList []*entity.APIKey
+}
+
+func (i *APIKeyInteractorImpl) GetAPIKeyList(input GetAPIKeyListInput) (GetAPIKeyListOutput, error) {
+	var (
+		output GetAPIKeyListOutput
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	apiKeyList, err := i.apiKeyRepository.GetByAgentID(input.Operator.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.APIKeyList = apiKeyList
+
+	return output, nil
+}
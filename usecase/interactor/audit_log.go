@@ -0,0 +1,277 @@
+package interactor
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+)
+
+type AuditLogInteractor interface {
+	// 汎用系 API
+	GetAuditSnapshot(input GetAuditSnapshotInput) (GetAuditSnapshotOutput, error)
+	RecordAuditLog(input RecordAuditLogInput) (RecordAuditLogOutput, error)
+
+	// Admin API
+	GetSearchAuditLogList(input GetSearchAuditLogListInput) (GetSearchAuditLogListOutput, error)
+	ExportAuditLogCSV(input ExportAuditLogCSVInput) (ExportAuditLogCSVOutput, error)
+}
+
+type AuditLogInteractorImpl struct {
+	auditLogRepository          usecase.AuditLogRepository
+	agentStaffRepository        usecase.AgentStaffRepository
+	jobSeekerRepository         usecase.JobSeekerRepository
+	jobSeekerDocumentRepository usecase.JobSeekerDocumentRepository
+	saleRepository              usecase.SaleRepository
+}
+
+// AuditLogInteractorImpl is an implementation of AuditLogInteractor
+func NewAuditLogInteractorImpl(
+	alR usecase.AuditLogRepository,
+	asR usecase.AgentStaffRepository,
+	jsR usecase.JobSeekerRepository,
+	jsdR usecase.JobSeekerDocumentRepository,
+	sR usecase.SaleRepository,
+) AuditLogInteractor {
+	return &AuditLogInteractorImpl{
+		auditLogRepository:          alR,
+		agentStaffRepository:        asR,
+		jobSeekerRepository:         jsR,
+		jobSeekerDocumentRepository: jsdR,
+		saleRepository:              sR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// 変更前後の差分を取るために対象の現在の値を取得する
+type GetAuditSnapshotInput struct {
+	EntityType string
+	EntityID   uint
+}
+
+type GetAuditSnapshotOutput struct {
+	Snapshot map[string]interface{} // 対象が存在しない場合はnil
+}
+
+func (i *AuditLogInteractorImpl) GetAuditSnapshot(input GetAuditSnapshotInput) (GetAuditSnapshotOutput, error) {
+	var (
+		output GetAuditSnapshotOutput
+		target interface{}
+		err    error
+	)
+
+	if input.EntityID == 0 {
+		return output, nil
+	}
+
+	switch input.EntityType {
+	case entity.AuditLogEntityJobSeeker:
+		target, err = i.jobSeekerRepository.FindByID(input.EntityID)
+	case entity.AuditLogEntityJobSeekerDocument:
+		target, err = i.jobSeekerDocumentRepository.FindByJobSeekerID(input.EntityID)
+	case entity.AuditLogEntitySale:
+		target, err = i.saleRepository.FindByID(input.EntityID)
+	case entity.AuditLogEntityAgentStaff:
+		target, err = i.agentStaffRepository.FindByID(input.EntityID)
+	default:
+		return output, nil
+	}
+
+	if errors.Is(err, entity.ErrNotFound) {
+		return output, nil
+	} else if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.Snapshot, err = toAuditSnapshot(target)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	return output, nil
+}
+
+// 監査ログを記録する
+type RecordAuditLogInput struct {
+	Operator   *entity.AgentStaff
+	Action     uint
+	EntityType string
+	EntityID   uint
+	Before     map[string]interface{} // 変更前の値（参照・作成時はnil）
+	After      map[string]interface{} // 変更後の値（参照・削除時はnil）
+	Method     string
+	Path       string
+	IPAddress  string
+	UserAgent  string
+}
+
+type RecordAuditLogOutput struct {
+	AuditLog *entity.AuditLog
+}
+
+func (i *AuditLogInteractorImpl) RecordAuditLog(input RecordAuditLogInput) (RecordAuditLogOutput, error) {
+	var (
+		output RecordAuditLogOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	diffList := []entity.AuditLogFieldDiff{}
+	// 参照・開示は変更がなく、匿名化は削除した個人情報を残さないため差分を記録しない
+	if input.Action != entity.AuditLogActionRead && input.Action != entity.AuditLogActionExport && input.Action != entity.AuditLogActionAnonymize {
+		diffList = getAuditLogFieldDiffList(input.Before, input.After)
+	}
+
+	diff, err := json.Marshal(diffList)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	auditLog := entity.NewAuditLog(
+		input.Operator.AgentID,
+		input.Operator.ID,
+		input.Action,
+		input.EntityType,
+		input.EntityID,
+		string(diff),
+		input.Method,
+		input.Path,
+		input.IPAddress,
+		input.UserAgent,
+	)
+
+	err = i.auditLogRepository.Create(auditLog)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.AuditLog = auditLog
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// Admin API
+//
+// 自社の監査ログを検索する（管理者のみ）
+type GetSearchAuditLogListInput struct {
+	Operator    *entity.AgentStaff
+	SearchParam entity.SearchAuditLogParam
+}
+
+type GetSearchAuditLogListOutput struct {
+	AuditLogList  []*entity.AuditLog
+	MaxPageNumber uint
+}
+
+func (i *AuditLogInteractorImpl) GetSearchAuditLogList(input GetSearchAuditLogListInput) (GetSearchAuditLogListOutput, error) {
+	var (
+		output GetSearchAuditLogListOutput
+	)
+
+	auditLogList, err := i.getSearchAuditLogList(input.Operator, input.SearchParam)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.MaxPageNumber = getAuditLogListMaxPage(auditLogList)
+	output.AuditLogList = getAuditLogListWithPage(auditLogList, input.SearchParam.PageNumber)
+
+	return output, nil
+}
+
+// 自社の監査ログをCSVで出力する（管理者のみ）
+type ExportAuditLogCSVInput struct {
+	Operator    *entity.AgentStaff
+	SearchParam entity.SearchAuditLogParam
+}
+
+type ExportAuditLogCSVOutput struct {
+	FilePath *entity.FilePath
+}
+
+func (i *AuditLogInteractorImpl) ExportAuditLogCSV(input ExportAuditLogCSVInput) (ExportAuditLogCSVOutput, error) {
+	var (
+		output  ExportAuditLogCSVOutput
+		records [][]string
+	)
+
+	auditLogList, err := i.getSearchAuditLogList(input.Operator, input.SearchParam)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	records = append(records, []string{
+		"ID", "日時", "担当者ID", "担当者名", "操作", "対象", "対象ID", "変更内容", "メソッド", "パス", "IPアドレス", "ユーザーエージェント",
+	})
+
+	for _, auditLog := range auditLogList {
+		records = append(records, []string{
+			fmt.Sprint(auditLog.ID),
+			auditLog.CreatedAt.In(utility.Tokyo).Format("2006-01-02 15:04:05"),
+			fmt.Sprint(auditLog.AgentStaffID),
+			auditLog.StaffName,
+			getAuditLogActionLabel(auditLog.Action),
+			auditLog.EntityType,
+			fmt.Sprint(auditLog.EntityID),
+			auditLog.Diff,
+			auditLog.Method,
+			auditLog.Path,
+			auditLog.IPAddress,
+			auditLog.UserAgent,
+		})
+	}
+
+	//CSVファイルを作成
+	filePath := ("./audit-log-" + fmt.Sprint(utility.CreateUUID()) + ".csv")
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	defer file.Close()
+
+	cw := csv.NewWriter(file)
+	defer cw.Flush()
+
+	err = cw.WriteAll(records)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.FilePath = entity.NewFilePath(filePath)
+
+	return output, nil
+}
+
+// 管理者の権限を確認して自社の監査ログを検索する
+func (i *AuditLogInteractorImpl) getSearchAuditLogList(operator *entity.AgentStaff, searchParam entity.SearchAuditLogParam) ([]*entity.AuditLog, error) {
+	err := policy.RequireAdmin(operator)
+	if err != nil {
+		return nil, err
+	}
+
+	// 検索対象は常にログイン中の担当者のエージェントに限定する
+	searchParam.AgentID = operator.AgentID
+
+	return i.auditLogRepository.GetSearchByAgentID(searchParam)
+}
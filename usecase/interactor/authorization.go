@@ -0,0 +1,356 @@
+package interactor
+
+import (
+	"fmt"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+)
+
+type AuthorizationInteractor interface {
+	// 汎用系 API
+	AuthorizeResource(input AuthorizeResourceInput) (AuthorizeResourceOutput, error)
+}
+
+type AuthorizationInteractorImpl struct {
+	agentStaffRepository                usecase.AgentStaffRepository
+	agentAllianceRepository             usecase.AgentAllianceRepository
+	jobSeekerRepository                 usecase.JobSeekerRepository
+	jobSeekerHideToAgentRepository      usecase.JobSeekerHideToAgentRepository
+	jobInformationRepository            usecase.JobInformationRepository
+	jobInformationHideToAgentRepository usecase.JobInformationHideToAgentRepository
+	taskRepository                      usecase.TaskRepository
+	taskGroupRepository                 usecase.TaskGroupRepository
+	enterpriseProfileRepository         usecase.EnterpriseProfileRepository
+	billingAddressRepository            usecase.BillingAddressRepository
+	saleRepository                      usecase.SaleRepository
+	jobSeekerScheduleRepository         usecase.JobSeekerScheduleRepository
+	selectionQuestionnaireRepository    usecase.SelectionQuestionnaireRepository
+	selectionFlowPatternRepository      usecase.JobInformationSelectionFlowPatternRepository
+	interviewTaskGroupRepository        usecase.InterviewTaskGroupRepository
+	chatGroupWithJobSeekerRepository    usecase.ChatGroupWithJobSeekerRepository
+	chatGroupWithAgentRepository        usecase.ChatGroupWithAgentRepository
+	chatThreadWithAgentRepository       usecase.ChatThreadWithAgentRepository
+	agentRobotRepository                usecase.AgentRobotRepository
+	scoutServiceRepository              usecase.ScoutServiceRepository
+	agentInflowChannelOptionRepository  usecase.AgentInflowChannelOptionRepository
+	interviewTemplateRepository         usecase.InterviewAdjustmentTemplateRepository
+	messageTemplateRepository           usecase.MessageTemplateRepository
+	agentSaleManagementRepository       usecase.AgentSaleManagementRepository
+}
+
+// AuthorizationInteractorImpl is an implementation of AuthorizationInteractor
+func NewAuthorizationInteractorImpl(
+	asR usecase.AgentStaffRepository,
+	aaR usecase.AgentAllianceRepository,
+	jsR usecase.JobSeekerRepository,
+	jshR usecase.JobSeekerHideToAgentRepository,
+	jiR usecase.JobInformationRepository,
+	jihR usecase.JobInformationHideToAgentRepository,
+	tR usecase.TaskRepository,
+	tgR usecase.TaskGroupRepository,
+	epR usecase.EnterpriseProfileRepository,
+	baR usecase.BillingAddressRepository,
+	sR usecase.SaleRepository,
+	jssR usecase.JobSeekerScheduleRepository,
+	sqR usecase.SelectionQuestionnaireRepository,
+	sfpR usecase.JobInformationSelectionFlowPatternRepository,
+	itgR usecase.InterviewTaskGroupRepository,
+	cgjR usecase.ChatGroupWithJobSeekerRepository,
+	cgaR usecase.ChatGroupWithAgentRepository,
+	ctaR usecase.ChatThreadWithAgentRepository,
+	arR usecase.AgentRobotRepository,
+	ssR usecase.ScoutServiceRepository,
+	aicoR usecase.AgentInflowChannelOptionRepository,
+	iatR usecase.InterviewAdjustmentTemplateRepository,
+	mtR usecase.MessageTemplateRepository,
+	asmR usecase.AgentSaleManagementRepository,
+) AuthorizationInteractor {
+	return &AuthorizationInteractorImpl{
+		agentStaffRepository:                asR,
+		agentAllianceRepository:             aaR,
+		jobSeekerRepository:                 jsR,
+		jobSeekerHideToAgentRepository:      jshR,
+		jobInformationRepository:            jiR,
+		jobInformationHideToAgentRepository: jihR,
+		taskRepository:                      tR,
+		taskGroupRepository:                 tgR,
+		enterpriseProfileRepository:         epR,
+		billingAddressRepository:            baR,
+		saleRepository:                      sR,
+		jobSeekerScheduleRepository:         jssR,
+		selectionQuestionnaireRepository:    sqR,
+		selectionFlowPatternRepository:      sfpR,
+		interviewTaskGroupRepository:        itgR,
+		chatGroupWithJobSeekerRepository:    cgjR,
+		chatGroupWithAgentRepository:        cgaR,
+		chatThreadWithAgentRepository:       ctaR,
+		agentRobotRepository:                arR,
+		scoutServiceRepository:              ssR,
+		agentInflowChannelOptionRepository:  aicoR,
+		interviewTemplateRepository:         iatR,
+		messageTemplateRepository:           mtR,
+		agentSaleManagementRepository:       asmR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// URL・リクエストボディで指定されたリソースにログイン中の担当者がアクセスできるかを判定する
+type AuthorizeResourceInput struct {
+	Operator         *entity.AgentStaff
+	IsWrite          bool // 更新系のリクエストか（アライアンス先のリソースは参照のみ許可）
+	AgentID          uint
+	AgentStaffID     uint
+	JobSeekerID      uint
+	JobInformationID uint
+	TaskID           uint
+	TaskGroupID      uint
+	EnterpriseID     uint
+	BillingAddressID uint
+	SaleID           uint
+
+	JobSeekerScheduleID        uint
+	SelectionQuestionnaireID   uint
+	SelectionFlowPatternID     uint
+	InterviewTaskGroupID       uint
+	ChatGroupWithJobSeekerID   uint
+	ChatGroupWithAgentID       uint
+	ChatThreadWithAgentID      uint
+	AgentRobotID               uint
+	ScoutServiceID             uint
+	AgentAllianceID            uint
+	AgentInflowChannelOptionID uint
+	InterviewTemplateID        uint
+	MessageTemplateID          uint
+	SaleManagementID           uint
+
+	// 一覧取得でクエリに指定されたIDのリスト（参照のみのため、1件ずつ参照できる範囲内かを判定する）
+	JobSeekerIDList      []uint
+	JobInformationIDList []uint
+	SaleIDList           []uint
+}
+
+type AuthorizeResourceOutput struct {
+	OK bool
+}
+
+func (i *AuthorizationInteractorImpl) AuthorizeResource(input AuthorizeResourceInput) (AuthorizeResourceOutput, error) {
+	var (
+		output AuthorizeResourceOutput
+	)
+
+	if input.AgentID != 0 {
+		err := policy.AuthorizeAgent(i.agentAllianceRepository, input.Operator, input.AgentID, input.IsWrite)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.AgentStaffID != 0 {
+		err := policy.AuthorizeAgentStaff(i.agentStaffRepository, i.agentAllianceRepository, input.Operator, input.AgentStaffID, input.IsWrite)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.JobSeekerID != 0 {
+		err := policy.AuthorizeJobSeeker(i.jobSeekerRepository, i.jobSeekerHideToAgentRepository, i.agentAllianceRepository, input.Operator, input.JobSeekerID, input.IsWrite)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.JobInformationID != 0 {
+		err := policy.AuthorizeJobInformation(i.jobInformationRepository, i.jobInformationHideToAgentRepository, i.agentAllianceRepository, input.Operator, input.JobInformationID, input.IsWrite)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.TaskID != 0 {
+		err := policy.AuthorizeTask(i.taskRepository, i.taskGroupRepository, input.Operator, input.TaskID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.TaskGroupID != 0 {
+		err := policy.AuthorizeTaskGroup(i.taskGroupRepository, input.Operator, input.TaskGroupID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.EnterpriseID != 0 {
+		err := policy.AuthorizeEnterprise(i.enterpriseProfileRepository, i.agentAllianceRepository, input.Operator, input.EnterpriseID, input.IsWrite)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.BillingAddressID != 0 {
+		err := policy.AuthorizeBillingAddress(i.billingAddressRepository, i.agentAllianceRepository, input.Operator, input.BillingAddressID, input.IsWrite)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.SaleID != 0 {
+		err := policy.AuthorizeSale(i.saleRepository, input.Operator, input.SaleID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.JobSeekerScheduleID != 0 {
+		err := policy.AuthorizeJobSeekerSchedule(i.jobSeekerScheduleRepository, i.jobSeekerRepository, i.jobSeekerHideToAgentRepository, i.agentAllianceRepository, input.Operator, input.JobSeekerScheduleID, input.IsWrite)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.SelectionQuestionnaireID != 0 {
+		err := policy.AuthorizeSelectionQuestionnaire(i.selectionQuestionnaireRepository, i.jobSeekerRepository, i.jobSeekerHideToAgentRepository, i.agentAllianceRepository, input.Operator, input.SelectionQuestionnaireID, input.IsWrite)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.SelectionFlowPatternID != 0 {
+		err := policy.AuthorizeSelectionFlowPattern(i.selectionFlowPatternRepository, i.jobInformationRepository, i.jobInformationHideToAgentRepository, i.agentAllianceRepository, input.Operator, input.SelectionFlowPatternID, input.IsWrite)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.InterviewTaskGroupID != 0 {
+		err := policy.AuthorizeInterviewTaskGroup(i.interviewTaskGroupRepository, input.Operator, input.InterviewTaskGroupID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.ChatGroupWithJobSeekerID != 0 {
+		err := policy.AuthorizeChatGroupWithJobSeeker(i.chatGroupWithJobSeekerRepository, input.Operator, input.ChatGroupWithJobSeekerID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.ChatGroupWithAgentID != 0 {
+		err := policy.AuthorizeChatGroupWithAgent(i.chatGroupWithAgentRepository, input.Operator, input.ChatGroupWithAgentID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.ChatThreadWithAgentID != 0 {
+		err := policy.AuthorizeChatThreadWithAgent(i.chatThreadWithAgentRepository, i.chatGroupWithAgentRepository, input.Operator, input.ChatThreadWithAgentID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.AgentRobotID != 0 {
+		err := policy.AuthorizeAgentRobot(i.agentRobotRepository, input.Operator, input.AgentRobotID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.ScoutServiceID != 0 {
+		err := policy.AuthorizeScoutService(i.scoutServiceRepository, i.agentRobotRepository, input.Operator, input.ScoutServiceID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.AgentAllianceID != 0 {
+		err := policy.AuthorizeAgentAlliance(i.agentAllianceRepository, input.Operator, input.AgentAllianceID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.AgentInflowChannelOptionID != 0 {
+		err := policy.AuthorizeAgentInflowChannelOption(i.agentInflowChannelOptionRepository, input.Operator, input.AgentInflowChannelOptionID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.InterviewTemplateID != 0 {
+		err := policy.AuthorizeInterviewAdjustmentTemplate(i.interviewTemplateRepository, input.Operator, input.InterviewTemplateID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.MessageTemplateID != 0 {
+		err := policy.AuthorizeMessageTemplate(i.messageTemplateRepository, i.agentStaffRepository, input.Operator, input.MessageTemplateID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	if input.SaleManagementID != 0 {
+		err := policy.AuthorizeSaleManagement(i.agentSaleManagementRepository, input.Operator, input.SaleManagementID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	for _, jobSeekerID := range input.JobSeekerIDList {
+		err := policy.AuthorizeJobSeeker(i.jobSeekerRepository, i.jobSeekerHideToAgentRepository, i.agentAllianceRepository, input.Operator, jobSeekerID, false)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	for _, jobInformationID := range input.JobInformationIDList {
+		err := policy.AuthorizeJobInformation(i.jobInformationRepository, i.jobInformationHideToAgentRepository, i.agentAllianceRepository, input.Operator, jobInformationID, false)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	for _, saleID := range input.SaleIDList {
+		err := policy.AuthorizeSale(i.saleRepository, input.Operator, saleID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	output.OK = true
+
+	return output, nil
+}
@@ -0,0 +1,193 @@
+package interactor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type EncryptionKeyInteractor interface {
+	// 起動時の確認
+	ValidateEncryptionKey(input ValidateEncryptionKeyInput) (ValidateEncryptionKeyOutput, error)
+
+	// 鍵の切り替え
+	RotateEncryptionKey(input RotateEncryptionKeyInput) (RotateEncryptionKeyOutput, error)
+}
+
+type EncryptionKeyInteractorImpl struct {
+	encryptionKeyRepository usecase.EncryptionKeyRepository
+}
+
+// EncryptionKeyInteractorImpl is an implementation of EncryptionKeyInteractor
+func NewEncryptionKeyInteractorImpl(
+	ekR usecase.EncryptionKeyRepository,
+) EncryptionKeyInteractor {
+	return &EncryptionKeyInteractorImpl{
+		encryptionKeyRepository: ekR,
+	}
+}
+
+/****************************************************************************************/
+/// 起動時の確認
+//
+// 鍵束を読み込み、保存されている暗号文が参照する鍵が全て揃っているかを確認する
+// 鍵が足りない状態で起動すると復号できないデータが出るため、エラーの場合は起動を中止する
+type ValidateEncryptionKeyInput struct{}
+
+type ValidateEncryptionKeyOutput struct {
+	CurrentVersion int
+	VersionList    []int
+}
+
+func (i *EncryptionKeyInteractorImpl) ValidateEncryptionKey(input ValidateEncryptionKeyInput) (ValidateEncryptionKeyOutput, error) {
+	var (
+		output             ValidateEncryptionKeyOutput
+		missingVersionList []string
+	)
+
+	err := utility.LoadEncryptionKeyRing()
+	if err != nil {
+		fmt.Println(err)
+		return output, fmt.Errorf("%w:%s", entity.ErrServerError, err.Error())
+	}
+
+	if !utility.HasBlindIndexKey() {
+		return output, fmt.Errorf("%w:%s", entity.ErrServerError, "ブラインドインデックスの鍵（BLIND_INDEX_KEY）が設定されていません")
+	}
+
+	ring, err := utility.GetEncryptionKeyRing()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	referencedVersionList, err := i.encryptionKeyRepository.GetReferencedKeyVersionList()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, version := range referencedVersionList {
+		if !ring.HasVersion(version) {
+			missingVersionList = append(missingVersionList, fmt.Sprintf("v%d", version))
+		}
+	}
+
+	if len(missingVersionList) > 0 {
+		return output, fmt.Errorf(
+			"%w:%s",
+			entity.ErrServerError,
+			"保存されている暗号文が参照する鍵が設定されていません（"+strings.Join(missingVersionList, ", ")+"）",
+		)
+	}
+
+	output.CurrentVersion = ring.CurrentVersion
+	output.VersionList = ring.VersionList()
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 鍵の切り替え
+//
+// 現在のバージョン以外の鍵で暗号化された値を、現在のバージョンの鍵で再暗号化する
+// 項目ごとにID順でBatchSize件ずつ処理するため、途中で止まっても再実行すれば続きから処理される
+// 復号できない値は件数を記録して読み飛ばす（古い鍵を鍵束から外す前に FailedCount が0であることを確認する）
+type RotateEncryptionKeyInput struct {
+	BatchSize uint
+}
+
+type RotateEncryptionKeyOutput struct {
+	CurrentVersion int
+	ResultList     []entity.EncryptionKeyRotationResult
+}
+
+func (i *EncryptionKeyInteractorImpl) RotateEncryptionKey(input RotateEncryptionKeyInput) (RotateEncryptionKeyOutput, error) {
+	var (
+		output    RotateEncryptionKeyOutput
+		batchSize = input.BatchSize
+	)
+
+	if batchSize == 0 {
+		batchSize = 100
+	}
+
+	ring, err := utility.GetEncryptionKeyRing()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.CurrentVersion = ring.CurrentVersion
+
+	for _, column := range entity.EncryptedColumnList {
+		result, err := i.rotateEncryptedColumn(column, ring.CurrentVersion, batchSize)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		fmt.Printf("%s.%s: 再暗号化 %d件, 失敗 %d件\n", column.Table, column.Column, result.RotatedCount, result.FailedCount)
+		output.ResultList = append(output.ResultList, result)
+	}
+
+	return output, nil
+}
+
+// 項目の値を現在のバージョンの鍵で再暗号化する
+func (i *EncryptionKeyInteractorImpl) rotateEncryptedColumn(column entity.EncryptedColumn, currentVersion int, batchSize uint) (entity.EncryptionKeyRotationResult, error) {
+	var (
+		result = entity.EncryptionKeyRotationResult{
+			Table:  column.Table,
+			Column: column.Column,
+		}
+		afterID uint
+	)
+
+	for {
+		valueList, err := i.encryptionKeyRepository.GetValueListNotEncryptedWithVersion(column, currentVersion, afterID, batchSize)
+		if err != nil {
+			fmt.Println(err)
+			return result, err
+		}
+
+		for _, value := range valueList {
+			afterID = value.ID
+
+			var plainText string
+			if column.IsLegacyEncrypted {
+				plainText, err = utility.DecryptSecret(value.Value)
+			} else {
+				plainText, err = utility.DecryptString(value.Value)
+			}
+			if err != nil {
+				fmt.Printf("%s.%s id=%d の復号に失敗しました: %v\n", column.Table, column.Column, value.ID, err)
+				result.FailedCount++
+				continue
+			}
+
+			encrypted, err := utility.EncryptString(plainText)
+			if err != nil {
+				fmt.Println(err)
+				return result, err
+			}
+
+			err = i.encryptionKeyRepository.UpdateEncryptedValue(column, value.ID, value.Value, encrypted)
+			if err != nil {
+				fmt.Println(err)
+				return result, err
+			}
+
+			result.RotatedCount++
+		}
+
+		if uint(len(valueList)) < batchSize {
+			break
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,292 @@
+package interactor
+
+import (
+	"fmt"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type EntryScreeningRuleInteractor interface {
+	// 汎用系 API
+	CreateEntryScreeningRule(input CreateEntryScreeningRuleInput) (CreateEntryScreeningRuleOutput, error)
+	UpdateEntryScreeningRule(input UpdateEntryScreeningRuleInput) (UpdateEntryScreeningRuleOutput, error)
+	DeleteEntryScreeningRule(input DeleteEntryScreeningRuleInput) (DeleteEntryScreeningRuleOutput, error)
+	GetEntryScreeningRuleListByAgentID(input GetEntryScreeningRuleListByAgentIDInput) (GetEntryScreeningRuleListByAgentIDOutput, error)
+}
+
+type EntryScreeningRuleInteractorImpl struct {
+	firebase                              usecase.Firebase
+	entryScreeningRuleRepository          usecase.EntryScreeningRuleRepository
+	entryScreeningRuleConditionRepository usecase.EntryScreeningRuleConditionRepository
+	entryScreeningResultRepository        usecase.EntryScreeningResultRepository
+}
+
+// EntryScreeningRuleInteractorImpl is an implementation of EntryScreeningRuleInteractor
+func NewEntryScreeningRuleInteractorImpl(
+	fb usecase.Firebase,
+	esrR usecase.EntryScreeningRuleRepository,
+	esrcR usecase.EntryScreeningRuleConditionRepository,
+	esresR usecase.EntryScreeningResultRepository,
+) EntryScreeningRuleInteractor {
+	return &EntryScreeningRuleInteractorImpl{
+		firebase:                              fb,
+		entryScreeningRuleRepository:          esrR,
+		entryScreeningRuleConditionRepository: esrcR,
+		entryScreeningResultRepository:        esresR,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 選考ルールの作成
+type CreateEntryScreeningRuleInput struct {
+	Param entity.CreateOrUpdateEntryScreeningRuleParam
+}
+
+type CreateEntryScreeningRuleOutput struct {
+	ScreeningRule *entity.EntryScreeningRule
+}
+
+func (i *EntryScreeningRuleInteractorImpl) CreateEntryScreeningRule(input CreateEntryScreeningRuleInput) (CreateEntryScreeningRuleOutput, error) {
+	var (
+		output CreateEntryScreeningRuleOutput
+		param  = input.Param
+	)
+
+	err := validateEntryScreeningRuleParam(param)
+	if err != nil {
+		return output, err
+	}
+
+	screeningRule := entity.NewEntryScreeningRule(
+		param.AgentID,
+		param.Title,
+		param.Tag,
+		param.ResultPhase,
+		param.ResultUserStatus,
+		param.IsSkipInterviewAdjustment,
+		param.IsActive,
+	)
+
+	err = i.entryScreeningRuleRepository.Create(screeningRule)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	conditionList, err := i.createEntryScreeningRuleConditionList(screeningRule.ID, param.ConditionList)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	screeningRule.ConditionList = conditionList
+	output.ScreeningRule = screeningRule
+
+	return output, nil
+}
+
+// 選考ルールの更新（条件は全件入れ替える）
+type UpdateEntryScreeningRuleInput struct {
+	ScreeningRuleID uint
+	Param           entity.CreateOrUpdateEntryScreeningRuleParam
+}
+
+type UpdateEntryScreeningRuleOutput struct {
+	ScreeningRule *entity.EntryScreeningRule
+}
+
+func (i *EntryScreeningRuleInteractorImpl) UpdateEntryScreeningRule(input UpdateEntryScreeningRuleInput) (UpdateEntryScreeningRuleOutput, error) {
+	var (
+		output UpdateEntryScreeningRuleOutput
+		param  = input.Param
+	)
+
+	err := validateEntryScreeningRuleParam(param)
+	if err != nil {
+		return output, err
+	}
+
+	screeningRule, err := i.entryScreeningRuleRepository.FindByID(input.ScreeningRuleID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if screeningRule.AgentID != param.AgentID {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "他のエージェントの選考ルールは更新できません")
+		return output, err
+	}
+
+	updatedRule := entity.NewEntryScreeningRule(
+		param.AgentID,
+		param.Title,
+		param.Tag,
+		param.ResultPhase,
+		param.ResultUserStatus,
+		param.IsSkipInterviewAdjustment,
+		param.IsActive,
+	)
+
+	err = i.entryScreeningRuleRepository.Update(screeningRule.ID, updatedRule)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.entryScreeningRuleConditionRepository.DeleteByRuleID(screeningRule.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	conditionList, err := i.createEntryScreeningRuleConditionList(screeningRule.ID, param.ConditionList)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	updatedRule.ID = screeningRule.ID
+	updatedRule.ConditionList = conditionList
+	output.ScreeningRule = updatedRule
+
+	return output, nil
+}
+
+// 選考ルールの削除
+type DeleteEntryScreeningRuleInput struct {
+	ScreeningRuleID uint
+}
+
+type DeleteEntryScreeningRuleOutput struct {
+	OK bool
+}
+
+func (i *EntryScreeningRuleInteractorImpl) DeleteEntryScreeningRule(input DeleteEntryScreeningRuleInput) (DeleteEntryScreeningRuleOutput, error) {
+	var (
+		output DeleteEntryScreeningRuleOutput
+	)
+
+	err := i.entryScreeningRuleRepository.Delete(input.ScreeningRuleID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// エージェントIDから選考ルール一覧と、ルールごとの該当件数を取得
+type GetEntryScreeningRuleListByAgentIDInput struct {
+	AgentID uint
+}
+
+type GetEntryScreeningRuleListByAgentIDOutput struct {
+	ScreeningRuleList []*entity.EntryScreeningRule
+}
+
+func (i *EntryScreeningRuleInteractorImpl) GetEntryScreeningRuleListByAgentID(input GetEntryScreeningRuleListByAgentIDInput) (GetEntryScreeningRuleListByAgentIDOutput, error) {
+	var (
+		output GetEntryScreeningRuleListByAgentIDOutput
+	)
+
+	screeningRuleList, err := i.entryScreeningRuleRepository.GetByAgentID(input.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	conditionList, err := i.entryScreeningRuleConditionRepository.GetByAgentID(input.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	hitCountList, err := i.entryScreeningResultRepository.GetHitCountByAgentID(input.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, screeningRule := range screeningRuleList {
+		screeningRule.ConditionList = []*entity.EntryScreeningRuleCondition{}
+		for _, condition := range conditionList {
+			if condition.RuleID == screeningRule.ID {
+				screeningRule.ConditionList = append(screeningRule.ConditionList, condition)
+			}
+		}
+
+		for _, hitCount := range hitCountList {
+			if hitCount.RuleID == screeningRule.ID {
+				screeningRule.HitCount = hitCount.Count
+				break
+			}
+		}
+	}
+
+	output.ScreeningRuleList = screeningRuleList
+
+	return output, nil
+}
+
+/****************************************************************************************/
+// 共通処理
+//
+// 選考ルールの条件を作成
+func (i *EntryScreeningRuleInteractorImpl) createEntryScreeningRuleConditionList(ruleID uint, paramList []entity.EntryScreeningConditionParam) ([]*entity.EntryScreeningRuleCondition, error) {
+	var conditionList []*entity.EntryScreeningRuleCondition
+
+	for _, conditionParam := range paramList {
+		condition := entity.NewEntryScreeningRuleCondition(
+			ruleID,
+			conditionParam.Target,
+			conditionParam.Operator,
+			conditionParam.Value,
+		)
+
+		err := i.entryScreeningRuleConditionRepository.Create(condition)
+		if err != nil {
+			fmt.Println(err)
+			return nil, err
+		}
+
+		conditionList = append(conditionList, condition)
+	}
+
+	return conditionList, nil
+}
+
+// 選考ルールの入力チェック
+func validateEntryScreeningRuleParam(param entity.CreateOrUpdateEntryScreeningRuleParam) error {
+	if len(param.ConditionList) == 0 {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "条件を1つ以上設定してください")
+	}
+
+	for _, condition := range param.ConditionList {
+		if !condition.Target.Valid || !condition.Operator.Valid || !condition.Value.Valid {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "条件の項目・比較方法・値を入力してください")
+		}
+
+		if condition.Target.Int64 < entity.EntryScreeningTargetAge || entity.EntryScreeningTargetStateOfEmployment < condition.Target.Int64 {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "条件の項目が不正です")
+		}
+
+		if condition.Operator.Int64 < entity.EntryScreeningOperatorEqual || entity.EntryScreeningOperatorLessOrEqual < condition.Operator.Int64 {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "条件の比較方法が不正です")
+		}
+	}
+
+	if param.ResultPhase.Valid && (param.ResultPhase.Int64 < int64(entity.EntryInterview) || int64(entity.QuitedAfterInterview) < param.ResultPhase.Int64) {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "変更後のフェーズが不正です")
+	}
+
+	if param.ResultUserStatus.Valid && (param.ResultUserStatus.Int64 < 0 || int64(len(entity.UserStatus)) <= param.ResultUserStatus.Int64) {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "変更後のユーザーステータスが不正です")
+	}
+
+	return nil
+}
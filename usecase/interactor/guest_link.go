@@ -0,0 +1,299 @@
+package interactor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+)
+
+type GuestLinkInteractor interface {
+	// 汎用系 API
+	CreateGuestLink(input CreateGuestLinkInput) (CreateGuestLinkOutput, error)
+	RevokeGuestLink(input RevokeGuestLinkInput) (RevokeGuestLinkOutput, error)
+	GetGuestLinkList(input GetGuestLinkListInput) (GetGuestLinkListOutput, error)
+	GetGuestLinkAccessLogList(input GetGuestLinkAccessLogListInput) (GetGuestLinkAccessLogListOutput, error)
+}
+
+type GuestLinkInteractorImpl struct {
+	guestLinkConfig              config.GuestLink
+	guestLinkRepository          usecase.GuestLinkRepository
+	guestLinkAccessLogRepository usecase.GuestLinkAccessLogRepository
+	jobInformationRepository     usecase.JobInformationRepository
+	jobSeekerRepository          usecase.JobSeekerRepository
+}
+
+// GuestLinkInteractorImpl is an implementation of GuestLinkInteractor
+func NewGuestLinkInteractorImpl(
+	glc config.GuestLink,
+	glR usecase.GuestLinkRepository,
+	glalR usecase.GuestLinkAccessLogRepository,
+	jiR usecase.JobInformationRepository,
+	jsR usecase.JobSeekerRepository,
+) GuestLinkInteractor {
+	return &GuestLinkInteractorImpl{
+		guestLinkConfig:              glc,
+		guestLinkRepository:          glR,
+		guestLinkAccessLogRepository: glalR,
+		jobInformationRepository:     jiR,
+		jobSeekerRepository:          jsR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// ゲスト用リンクを発行する（自社の求人・選考・求職者のみ）
+type CreateGuestLinkInput struct {
+	Operator    *entity.AgentStaff
+	CreateParam entity.CreateGuestLinkParam
+}
+
+type CreateGuestLinkOutput struct {
+	GuestLink *entity.GuestLink
+}
+
+func (i *GuestLinkInteractorImpl) CreateGuestLink(input CreateGuestLinkInput) (CreateGuestLinkOutput, error) {
+	var (
+		output CreateGuestLinkOutput
+		param  = input.CreateParam
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	if i.guestLinkConfig.Secret == "" {
+		return output, fmt.Errorf("%w:%s", entity.ErrServerError, "ゲスト用リンクの署名用の鍵が設定されていません")
+	}
+
+	permissions, err := policy.NormalizeGuestLinkPermissions(param.Permissions)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 有効期限
+	expireDays := param.ExpireDays
+	if expireDays == 0 {
+		expireDays = i.guestLinkConfig.ExpireDays
+	}
+	if expireDays == 0 {
+		expireDays = 30
+	}
+	if expireDays > entity.GuestLinkMaxExpireDays {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("有効期限は%d日以内で指定してください", entity.GuestLinkMaxExpireDays))
+		return output, wrapped
+	}
+
+	// 自社の対象かを確認
+	err = i.requireOwnGuestLinkResource(input.Operator, param.ResourceType, param.ResourceUUID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	now := time.Now().In(time.UTC)
+	guestLink := entity.NewGuestLink(
+		input.Operator.AgentID,
+		input.Operator.ID,
+		param.ResourceType,
+		param.ResourceUUID,
+		permissions,
+		now.AddDate(0, 0, int(expireDays)),
+	)
+
+	err = i.guestLinkRepository.Create(guestLink)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	token, err := utility.NewGuestLinkJWT(
+		guestLink.UUID.String(),
+		guestLink.ResourceType,
+		guestLink.ResourceUUID.String(),
+		guestLink.PermissionList(),
+		now,
+		guestLink.ExpiresAt,
+		i.guestLinkConfig.Secret,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	guestLink.Token = token
+	guestLink.StaffName = input.Operator.StaffName
+
+	output.GuestLink = guestLink
+
+	return output, nil
+}
+
+// ゲスト用リンクを無効化する（自社のリンクのみ）
+type RevokeGuestLinkInput struct {
+	Operator    *entity.AgentStaff
+	GuestLinkID uint
+}
+
+type RevokeGuestLinkOutput struct {
+	OK bool
+}
+
+func (i *GuestLinkInteractorImpl) RevokeGuestLink(input RevokeGuestLinkInput) (RevokeGuestLinkOutput, error) {
+	var (
+		output RevokeGuestLinkOutput
+	)
+
+	guestLink, err := i.guestLinkRepository.FindByID(input.GuestLinkID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, guestLink.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.guestLinkRepository.Revoke(guestLink.ID, input.Operator.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// 自社のゲスト用リンク一覧を取得する（対象を指定した場合は絞り込む）
+type GetGuestLinkListInput struct {
+	Operator     *entity.AgentStaff
+	ResourceType string
+	ResourceUUID uuid.UUID
+}
+
+type GetGuestLinkListOutput struct {
+	GuestLinkList []*entity.GuestLink
+}
+
+func (i *GuestLinkInteractorImpl) GetGuestLinkList(input GetGuestLinkListInput) (GetGuestLinkListOutput, error) {
+	var (
+		output GetGuestLinkListOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	guestLinkList, err := i.guestLinkRepository.GetByAgentID(input.Operator.AgentID, input.ResourceType, input.ResourceUUID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.GuestLinkList = guestLinkList
+
+	return output, nil
+}
+
+// ゲスト用リンクのアクセスログを取得する（自社のリンクのみ）
+type GetGuestLinkAccessLogListInput struct {
+	Operator    *entity.AgentStaff
+	GuestLinkID uint
+}
+
+type GetGuestLinkAccessLogListOutput struct {
+	AccessLogList []*entity.GuestLinkAccessLog
+}
+
+func (i *GuestLinkInteractorImpl) GetGuestLinkAccessLogList(input GetGuestLinkAccessLogListInput) (GetGuestLinkAccessLogListOutput, error) {
+	var (
+		output GetGuestLinkAccessLogListOutput
+	)
+
+	guestLink, err := i.guestLinkRepository.FindByID(input.GuestLinkID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, guestLink.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	accessLogList, err := i.guestLinkAccessLogRepository.GetByGuestLinkID(guestLink.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.AccessLogList = accessLogList
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 非公開
+//
+// ゲスト用リンクの対象が自社のものかを確認する
+// 選考は求職者の担当（CA）・求人の担当（RA）のどちらのエージェントでも発行できる
+func (i *GuestLinkInteractorImpl) requireOwnGuestLinkResource(operator *entity.AgentStaff, resourceType string, resourceUUID uuid.UUID) error {
+	switch resourceType {
+	case entity.GuestLinkResourceJobInformation:
+		jobInformation, err := i.jobInformationRepository.FindByUUID(resourceUUID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+
+		return policy.RequireOwnAgent(operator, jobInformation.AgentID)
+
+	case entity.GuestLinkResourceTaskGroup:
+		jobSeeker, err := i.jobSeekerRepository.FindByTaskGroupUUID(resourceUUID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+
+		if jobSeeker.AgentID == operator.AgentID {
+			return nil
+		}
+
+		jobInformation, err := i.jobInformationRepository.FindByTaskGroupUUID(resourceUUID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+
+		// FindByTaskGroupUUIDは担当者の情報を含まないためUUIDで取得し直す
+		jobInformation, err = i.jobInformationRepository.FindByUUID(jobInformation.UUID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+
+		return policy.RequireOwnAgent(operator, jobInformation.AgentID)
+
+	case entity.GuestLinkResourceJobSeeker:
+		jobSeeker, err := i.jobSeekerRepository.FindByUUID(resourceUUID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+
+		return policy.RequireOwnAgent(operator, jobSeeker.AgentID)
+	}
+
+	return fmt.Errorf("%w:%s", entity.ErrRequestError, "ゲスト用リンクの対象の指定が不正です")
+}
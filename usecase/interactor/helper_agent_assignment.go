@@ -0,0 +1,307 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+// エージェントの自動割り振りルールに従って新規エントリーの求職者に担当CAをセットする
+// ルールが無効・未設定、または割り振り対象の担当者がいない場合は何もせずnilを返す（既存の担当者のまま登録）
+// 戻り値の割り振り履歴は求職者の作成後にrecordJobSeekerAssignmentに渡す
+func assignCAStaffByRule(
+	agentAssignmentRuleRepository usecase.AgentAssignmentRuleRepository,
+	agentAssignmentStaffRepository usecase.AgentAssignmentStaffRepository,
+	agentAssignmentStaffSpecialtyRepository usecase.AgentAssignmentStaffSpecialtyRepository,
+	jobSeekerRepository usecase.JobSeekerRepository,
+	jobSeeker *entity.JobSeeker,
+) (*entity.JobSeekerAssignmentLog, error) {
+	assignmentRule, err := agentAssignmentRuleRepository.FindByAgentID(jobSeeker.AgentID)
+	if errors.Is(err, entity.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	if !assignmentRule.IsActive {
+		return nil, nil
+	}
+
+	staffList, err := getAgentAssignmentStaffList(agentAssignmentStaffRepository, agentAssignmentStaffSpecialtyRepository, jobSeeker.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	if len(staffList) == 0 {
+		return nil, nil
+	}
+
+	var staffIDList []uint
+	for _, staff := range staffList {
+		staffIDList = append(staffIDList, staff.AgentStaffID)
+	}
+
+	openTaskCountList, err := jobSeekerRepository.GetOpenTaskCountByAgentStaffIDList(staffIDList)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	selectedStaff, reason := chooseAssignmentStaff(assignmentRule, staffList, openTaskCountList, jobSeeker, time.Now().In(utility.Tokyo))
+
+	// ラウンドロビンの次回の起点を更新
+	err = agentAssignmentRuleRepository.UpdateLastAssignedStaffID(assignmentRule.ID, selectedStaff.AgentStaffID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	assignmentLog := entity.NewJobSeekerAssignmentLog(
+		jobSeeker.AgentID,
+		0, // 求職者の作成後にセット
+		selectedStaff.AgentStaffID,
+		jobSeeker.AgentStaffID,
+		assignmentRule.AssignmentType,
+		null.NewInt(0, false),
+		reason,
+	)
+
+	jobSeeker.AgentStaffID = null.NewInt(int64(selectedStaff.AgentStaffID), true)
+
+	return assignmentLog, nil
+}
+
+// 自動割り振りの履歴を保存して、割り振られた担当者に通知する
+func recordJobSeekerAssignment(
+	jobSeekerAssignmentLogRepository usecase.JobSeekerAssignmentLogRepository,
+	agentStaffRepository usecase.AgentStaffRepository,
+	oneSignal config.OneSignal,
+	jobSeeker *entity.JobSeeker,
+	assignmentLog *entity.JobSeekerAssignmentLog,
+) error {
+	if assignmentLog == nil {
+		return nil
+	}
+
+	assignmentLog.JobSeekerID = jobSeeker.ID
+
+	err := jobSeekerAssignmentLogRepository.Create(assignmentLog)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	notifyJobSeekerAssignment(agentStaffRepository, oneSignal, jobSeeker, assignmentLog.AgentStaffID)
+
+	return nil
+}
+
+// 担当CAに割り振られたことを通知する（通知の失敗で登録処理は止めない）
+func notifyJobSeekerAssignment(
+	agentStaffRepository usecase.AgentStaffRepository,
+	oneSignal config.OneSignal,
+	jobSeeker *entity.JobSeeker,
+	agentStaffID uint,
+) {
+	agentStaff, err := agentStaffRepository.FindByID(agentStaffID)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	err = utility.WebPush(
+		oneSignal.AppID,
+		oneSignal.APIKey,
+		agentStaff.FirebaseID,
+		"担当求職者の通知",
+		fmt.Sprintf("%s %s様の担当に割り振られました。", jobSeeker.LastName, jobSeeker.FirstName),
+		"TaskCA",
+		os.Getenv("BASE_DOMAIN"),
+	)
+	if err != nil {
+		fmt.Println("WebPushの通知でエラー")
+		fmt.Println(err)
+	}
+}
+
+// 割り振り対象の担当者に得意分野をセットして取得
+func getAgentAssignmentStaffList(
+	agentAssignmentStaffRepository usecase.AgentAssignmentStaffRepository,
+	agentAssignmentStaffSpecialtyRepository usecase.AgentAssignmentStaffSpecialtyRepository,
+	agentID uint,
+) ([]*entity.AgentAssignmentStaff, error) {
+	staffList, err := agentAssignmentStaffRepository.GetByAgentID(agentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	specialtyList, err := agentAssignmentStaffSpecialtyRepository.GetByAgentID(agentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	for _, staff := range staffList {
+		staff.Occupations = []null.Int{}
+		staff.Prefectures = []null.Int{}
+
+		for _, specialty := range specialtyList {
+			if specialty.AssignmentStaffID != staff.ID {
+				continue
+			}
+
+			switch specialty.SpecialtyType.Int64 {
+			case entity.AssignmentSpecialtyTypeOccupation:
+				staff.Occupations = append(staff.Occupations, specialty.Value)
+			case entity.AssignmentSpecialtyTypePrefecture:
+				staff.Prefectures = append(staff.Prefectures, specialty.Value)
+			}
+		}
+	}
+
+	return staffList, nil
+}
+
+// ルールに従って担当者を選択し、選択した理由と合わせて返す
+// staffListは1件以上、担当者ID順で渡す
+func chooseAssignmentStaff(
+	assignmentRule *entity.AgentAssignmentRule,
+	staffList []*entity.AgentAssignmentStaff,
+	openTaskCountList []*entity.AgentStaffOpenTaskCount,
+	jobSeeker *entity.JobSeeker,
+	now time.Time,
+) (*entity.AgentAssignmentStaff, string) {
+	var (
+		candidateList = staffList
+		reason        = entity.AssignmentTypeLabel[assignmentRule.AssignmentType.Int64]
+	)
+
+	// 勤務時間内の担当者に絞り込む（全員が勤務時間外の場合は全員を対象にする）
+	if assignmentRule.IsWorkingHoursAware {
+		var workingStaffList []*entity.AgentAssignmentStaff
+		for _, staff := range staffList {
+			if isWithinWorkingHours(staff, now) {
+				workingStaffList = append(workingStaffList, staff)
+			}
+		}
+
+		if len(workingStaffList) > 0 {
+			candidateList = workingStaffList
+		} else {
+			reason += "（勤務時間内の担当者がいないため全員から選択）"
+		}
+	}
+
+	switch assignmentRule.AssignmentType.Int64 {
+	case entity.AssignmentTypeRoundRobin:
+		// 前回の担当者の次の担当者（最後まで回ったら先頭に戻る）
+		for _, staff := range candidateList {
+			if int64(staff.AgentStaffID) > assignmentRule.LastAssignedStaffID.Int64 {
+				return staff, reason
+			}
+		}
+		return candidateList[0], reason
+
+	case entity.AssignmentTypeSpecialty:
+		var (
+			maxScore           = 0
+			specialtyStaffList []*entity.AgentAssignmentStaff
+		)
+
+		for _, staff := range candidateList {
+			score := getSpecialtyScore(staff, jobSeeker)
+			if score == 0 || score < maxScore {
+				continue
+			}
+			if score > maxScore {
+				maxScore = score
+				specialtyStaffList = []*entity.AgentAssignmentStaff{}
+			}
+			specialtyStaffList = append(specialtyStaffList, staff)
+		}
+
+		if len(specialtyStaffList) > 0 {
+			return getLeastOpenTaskStaff(specialtyStaffList, openTaskCountList), reason
+		}
+
+		reason += "（得意分野が一致する担当者がいないため未完了タスク数で選択）"
+		return getLeastOpenTaskStaff(candidateList, openTaskCountList), reason
+
+	default:
+		return getLeastOpenTaskStaff(candidateList, openTaskCountList), reason
+	}
+}
+
+// 未完了タスクが最も少ない担当者を返す（同数の場合は担当者ID順で先頭）
+func getLeastOpenTaskStaff(staffList []*entity.AgentAssignmentStaff, openTaskCountList []*entity.AgentStaffOpenTaskCount) *entity.AgentAssignmentStaff {
+	var (
+		selectedStaff *entity.AgentAssignmentStaff
+		minCount      uint
+	)
+
+	for _, staff := range staffList {
+		var count uint
+		for _, openTaskCount := range openTaskCountList {
+			if openTaskCount.AgentStaffID == staff.AgentStaffID {
+				count = openTaskCount.Count
+				break
+			}
+		}
+
+		if selectedStaff == nil || count < minCount {
+			selectedStaff = staff
+			minCount = count
+		}
+	}
+
+	return selectedStaff
+}
+
+// 求職者の希望職種・希望勤務地・居住地と担当者の得意分野の一致数
+func getSpecialtyScore(staff *entity.AgentAssignmentStaff, jobSeeker *entity.JobSeeker) int {
+	var score int
+
+	for _, occupation := range staff.Occupations {
+		for _, desiredOccupation := range jobSeeker.DesiredOccupations {
+			if occupation == desiredOccupation.DesiredOccupation {
+				score++
+			}
+		}
+	}
+
+	for _, prefecture := range staff.Prefectures {
+		if jobSeeker.Prefecture.Valid && prefecture == jobSeeker.Prefecture {
+			score++
+			continue
+		}
+		for _, desiredWorkLocation := range jobSeeker.DesiredWorkLocations {
+			if prefecture == desiredWorkLocation.DesiredWorkLocation {
+				score++
+				break
+			}
+		}
+	}
+
+	return score
+}
+
+// 担当者の勤務時間内かどうか（日をまたぐ勤務時間にも対応）
+func isWithinWorkingHours(staff *entity.AgentAssignmentStaff, now time.Time) bool {
+	current := now.Format("15:04")
+
+	if staff.WorkingStartTime <= staff.WorkingEndTime {
+		return staff.WorkingStartTime <= current && current < staff.WorkingEndTime
+	}
+
+	return staff.WorkingStartTime <= current || current < staff.WorkingEndTime
+}
@@ -0,0 +1,157 @@
+package interactor
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+)
+
+// 差分の対象外とする項目
+var auditLogIgnoreFieldList = []string{
+	"created_at",
+	"updated_at",
+}
+
+// 暗号化して保存する求職者の個人情報（監査ログには変更の有無のみを記録し、値は残さない）
+var auditLogSensitiveFieldList = []string{
+	"phone_number",
+	"email",
+	"address",
+	"secret_memo",
+	"medical_history_remarks",
+}
+
+// 監査ログの最大ページ数を返す（1ページあたり20件）
+func getAuditLogListMaxPage(auditLogList []*entity.AuditLog) uint {
+	var maxPage = len(auditLogList) / 20
+
+	if 0 < (len(auditLogList) % 20) {
+		maxPage++
+	}
+
+	return uint(maxPage)
+}
+
+// 指定ページの監査ログを返す（1ページあたり20件）
+func getAuditLogListWithPage(auditLogList []*entity.AuditLog, page uint) []*entity.AuditLog {
+	var (
+		perPage uint = 20
+		listLen uint = uint(len(auditLogList))
+		first        = (page * perPage) - perPage
+		last         = (page * perPage)
+	)
+
+	if listLen <= perPage {
+		return auditLogList[0:]
+	}
+
+	// リストが開始位置より少ない場合は空のスライスを返す
+	if listLen <= first {
+		return []*entity.AuditLog{}
+	}
+
+	if (listLen - first) <= perPage {
+		return auditLogList[first:]
+	}
+	return auditLogList[first:last]
+}
+
+// JSONの項目名をキーにした値のmapに変換する
+func toAuditSnapshot(target interface{}) (map[string]interface{}, error) {
+	var snapshot map[string]interface{}
+
+	b, err := json.Marshal(target)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// 変更前後で値が異なる項目を項目名順に返す
+func getAuditLogFieldDiffList(before, after map[string]interface{}) []entity.AuditLogFieldDiff {
+	var (
+		diffList  = []entity.AuditLogFieldDiff{}
+		fieldList []string
+		fieldMap  = map[string]bool{}
+	)
+
+	for field := range before {
+		fieldMap[field] = true
+	}
+	for field := range after {
+		fieldMap[field] = true
+	}
+	for _, field := range auditLogIgnoreFieldList {
+		delete(fieldMap, field)
+	}
+
+	for field := range fieldMap {
+		fieldList = append(fieldList, field)
+	}
+	sort.Strings(fieldList)
+
+	for _, field := range fieldList {
+		beforeValue, afterValue := before[field], after[field]
+
+		// 作成時・削除時は片方のみの値を記録する
+		if after == nil || before == nil || !reflect.DeepEqual(beforeValue, afterValue) {
+			if isAuditLogSensitiveField(field) {
+				beforeValue, afterValue = redactAuditLogValue(beforeValue), redactAuditLogValue(afterValue)
+			}
+
+			diffList = append(diffList, entity.AuditLogFieldDiff{
+				Field:  field,
+				Before: beforeValue,
+				After:  afterValue,
+			})
+		}
+	}
+
+	return diffList
+}
+
+func isAuditLogSensitiveField(field string) bool {
+	for _, sensitiveField := range auditLogSensitiveFieldList {
+		if field == sensitiveField {
+			return true
+		}
+	}
+	return false
+}
+
+// 個人情報の値を伏せる（空の値はそのまま残す）
+func redactAuditLogValue(value interface{}) interface{} {
+	if value == nil || value == "" {
+		return value
+	}
+
+	return "********"
+}
+
+// 操作の表示名
+func getAuditLogActionLabel(action uint) string {
+	switch action {
+	case entity.AuditLogActionRead:
+		return "参照"
+	case entity.AuditLogActionCreate:
+		return "作成"
+	case entity.AuditLogActionUpdate:
+		return "更新"
+	case entity.AuditLogActionDelete:
+		return "削除"
+	case entity.AuditLogActionExport:
+		return "個人情報の開示"
+	case entity.AuditLogActionAnonymize:
+		return "個人情報の削除"
+	default:
+		return ""
+	}
+}
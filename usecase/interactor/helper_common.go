@@ -1,19 +1,15 @@
 package interactor
 
 import (
-	"crypto/aes"
-	"crypto/cipher"
 	"crypto/rand"
-	"encoding/base64"
 	"fmt"
-	"io"
-	"os"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
 	"golang.org/x/crypto/bcrypt"
 	"gopkg.in/guregu/null.v4"
 )
@@ -54,72 +50,25 @@ func MakeRandomStr(strRange uint32) (string, error) {
 
 // トークンの暗号化
 func encrypt(token string) (cipherText string, err error) {
-	tokenBytes := []byte(token)
-
-	encryptionText := os.Getenv("ENCRYPTION_KEY")
-	encryptionKey := []byte(encryptionText)
-
-	block, err := aes.NewCipher(encryptionKey)
-	if err != nil {
-		fmt.Println(err)
-		return "", err
-	}
-
-	gcm, err := cipher.NewGCM(block)
+	cipherText, err = utility.EncryptString(token)
 	if err != nil {
 		fmt.Println(err)
 		return "", err
 	}
 
-	nonce := make([]byte, gcm.NonceSize())
-	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
-		fmt.Println(err)
-		return "", err
-	}
-
-	encryptedToken := gcm.Seal(nonce, nonce, tokenBytes, nil)
-
-	return base64.StdEncoding.EncodeToString(encryptedToken), nil
+	return cipherText, nil
 }
 
 // トークンの復号化
+// 鍵のバージョンがない値は ENCRYPTION_KEY で暗号化された値として復号する
 func decryption(encryptedToken string) (decodeText string, err error) {
-	encryptionText := os.Getenv("ENCRYPTION_KEY")
-	encryptionKey := []byte(encryptionText)
-
-	// 1. 復号化するためのAES鍵を生成する
-	block, err := aes.NewCipher(encryptionKey)
-	if err != nil {
-		fmt.Println(err)
-		return "", err
-	}
-
-	// 2. 暗号化されたトークンをデコードする
-	ciphertext, err := base64.StdEncoding.DecodeString(encryptedToken)
-	if err != nil {
-		fmt.Println(err)
-		return "", err
-	}
-
-	// 3. 暗号化されたトークンを復号化する
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
-		fmt.Println(err)
-		return "", err
-	}
-	nonceSize := gcm.NonceSize()
-	if len(ciphertext) < nonceSize {
-		fmt.Println(err)
-		return "", err
-	}
-	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
-	decryptedTokenBytes, err := gcm.Open(nil, nonce, ciphertext, nil)
+	decodeText, err = utility.DecryptSecret(encryptedToken)
 	if err != nil {
 		fmt.Println(err)
 		return "", err
 	}
 
-	return string(decryptedTokenBytes), nil
+	return decodeText, nil
 }
 
 func hashPassword(password string) (hashedPassword string, err error) {
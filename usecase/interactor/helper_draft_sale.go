@@ -0,0 +1,107 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 内定承諾時に売上の下書きを作成する
+// 請求金額は求人の手数料と内定の年収から、RA/CAの売上比率はRA側のエージェントの設定から算出する
+// 売上は求職者ごとに1件のため、登録済みの場合は下書きの請求月のみ入社日に合わせて更新する
+func createDraftSale(
+	taskGroupRepository usecase.TaskGroupRepository,
+	jobOfferRepository usecase.JobOfferRepository,
+	saleRepository usecase.SaleRepository,
+	jobInformationRepository usecase.JobInformationRepository,
+	agentRepository usecase.AgentRepository,
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	taskGroupID uint,
+	agentStaffID uint,
+) error {
+	taskGroup, err := taskGroupRepository.FindByID(taskGroupID)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	var (
+		annualIncome int64
+		startDate    = taskGroup.JoiningDate
+	)
+
+	offer, err := jobOfferRepository.FindByTaskGroupID(taskGroup.ID)
+	if err == nil {
+		annualIncome = policy.GetJobOfferEstimatedAnnualSalary(offer)
+		if startDate == "" {
+			startDate = offer.StartDate
+		}
+	} else if !errors.Is(err, entity.ErrNotFound) {
+		fmt.Println(err)
+		return err
+	}
+
+	billingMonth := getDraftSaleBillingMonth(startDate)
+
+	sale, err := saleRepository.FindByJobSeekerID(taskGroup.JobSeekerID)
+	if err == nil {
+		if !sale.IsDraft || sale.JobInformationID != taskGroup.JobInformationID || billingMonth == "" || sale.BillingMonth == billingMonth {
+			return nil
+		}
+
+		before := *sale
+		sale.BillingMonth = billingMonth
+
+		return updateSaleWithEvent(saleRepository, taskGroupEventRepository, sale.ID, &before, sale, agentStaffID)
+	} else if !errors.Is(err, entity.ErrNotFound) {
+		fmt.Println(err)
+		return err
+	}
+
+	jobInformation, err := jobInformationRepository.FindByID(taskGroup.JobInformationID)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	raAgent, err := agentRepository.FindByID(taskGroup.RAAgentID)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	raSalesRatio, caSalesRatio := policy.GetDraftSaleSalesRatio(raAgent.RASalesRatio)
+
+	sale = entity.NewSale(
+		taskGroup.JobSeekerID,
+		taskGroup.JobInformationID,
+		null.IntFrom(entity.AccuracyAccept),
+		time.Now().In(utility.Tokyo).Format("2006-01"),
+		billingMonth,
+		policy.GetDraftSaleBillingAmount(jobInformation.Commission, jobInformation.CommissionRate, annualIncome),
+		null.NewFloat(0, false),
+		null.NewFloat(0, false),
+		taskGroup.RAStaffID,
+		raSalesRatio,
+		taskGroup.CAStaffID,
+		caSalesRatio,
+	)
+	sale.IsDraft = true
+
+	return createSaleWithEvent(saleRepository, taskGroupEventRepository, sale, agentStaffID)
+}
+
+// 入社日（2006-01-02）から請求月（2006-01）を返す
+func getDraftSaleBillingMonth(startDate string) string {
+	if len(startDate) < len("2006-01") {
+		return ""
+	}
+
+	return startDate[:len("2006-01")]
+}
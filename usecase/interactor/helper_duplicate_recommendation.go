@@ -0,0 +1,287 @@
+package interactor
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 推薦の対象（求職者×求人）ごとの重複の可能性がある選考
+type duplicateRecommendationKey struct {
+	jobSeekerID      uint
+	jobInformationID uint
+}
+
+// 推薦の対象ごとに重複の可能性がある選考を取得する
+// 期間はRA側（求人のエージェント）の設定を使う。外部求人は企業を特定できないため確認しない
+// 同じ依頼内で同じ求職者を同じ企業の別求人へ推薦する場合も重複とする
+func getDuplicateRecommendationMap(
+	i *TaskInteractorImpl,
+	targetList []entity.DuplicateRecommendationTarget,
+	excludeTaskGroupID uint,
+) (map[duplicateRecommendationKey][]*entity.DuplicateRecommendation, error) {
+	var (
+		duplicateMap = map[duplicateRecommendationKey][]*entity.DuplicateRecommendation{}
+		agentDaysMap = map[uint]uint{}
+		requestMap   = map[[2]uint]*entity.JobInformation{} // 同じ依頼内の[求職者ID, 企業ID]ごとの最初の求人
+		now          = time.Now().In(utility.Tokyo)
+	)
+
+	for _, target := range targetList {
+		var (
+			key = duplicateRecommendationKey{target.JobSeekerID, target.JobInformationID}
+		)
+
+		jobInformation, err := i.jobInformationRepository.FindByID(target.JobInformationID)
+		if err != nil {
+			fmt.Println(err)
+			return nil, err
+		}
+
+		if jobInformation.IsExternal {
+			continue
+		}
+
+		// 同じ依頼内の重複
+		enterpriseKey := [2]uint{target.JobSeekerID, jobInformation.EnterpriseID}
+		if otherJobInformation, ok := requestMap[enterpriseKey]; ok && otherJobInformation.ID != jobInformation.ID {
+			duplicateMap[key] = append(duplicateMap[key], &entity.DuplicateRecommendation{
+				JobSeekerID:      target.JobSeekerID,
+				JobInformationID: otherJobInformation.ID,
+				Title:            otherJobInformation.Title,
+				CompanyName:      otherJobInformation.CompanyName,
+				RAAgentID:        otherJobInformation.AgentID,
+				MatchType:        entity.DuplicateRecommendationMatchSameRequest,
+			})
+		} else if !ok {
+			requestMap[enterpriseKey] = jobInformation
+		}
+
+		days, ok := agentDaysMap[jobInformation.AgentID]
+		if !ok {
+			agent, err := i.agentRepository.FindByID(jobInformation.AgentID)
+			if err != nil {
+				fmt.Println(err)
+				return nil, err
+			}
+
+			days = agent.DuplicateRecommendationDays
+			agentDaysMap[jobInformation.AgentID] = days
+		}
+
+		since, ok := policy.GetDuplicateRecommendationSince(days, now)
+		if !ok {
+			continue
+		}
+
+		duplicateList, err := i.taskGroupRepository.GetDuplicateRecommendationList(entity.SearchDuplicateRecommendationParam{
+			JobSeekerID:        target.JobSeekerID,
+			EnterpriseID:       jobInformation.EnterpriseID,
+			Since:              since,
+			ExcludeTaskGroupID: excludeTaskGroupID,
+		})
+		if err != nil {
+			fmt.Println(err)
+			return nil, err
+		}
+
+		duplicateMap[key] = append(duplicateMap[key], duplicateList...)
+	}
+
+	for _, duplicateList := range duplicateMap {
+		setDuplicateRecommendationName(duplicateList)
+	}
+
+	return duplicateMap, nil
+}
+
+// 重複の可能性がある選考がある場合、理由が入力されていなければ推薦を止める
+func guardDuplicateRecommendation(
+	i *TaskInteractorImpl,
+	targetList []entity.DuplicateRecommendationTarget,
+	excludeTaskGroupID uint,
+	overrideReason string,
+) (map[duplicateRecommendationKey][]*entity.DuplicateRecommendation, error) {
+	var (
+		allDuplicateList []*entity.DuplicateRecommendation
+	)
+
+	duplicateMap, err := getDuplicateRecommendationMap(i, targetList, excludeTaskGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, target := range targetList {
+		allDuplicateList = append(allDuplicateList, duplicateMap[duplicateRecommendationKey{target.JobSeekerID, target.JobInformationID}]...)
+	}
+
+	err = policy.RequireDuplicateRecommendationOverride(allDuplicateList, overrideReason)
+	if err != nil {
+		return nil, err
+	}
+
+	return duplicateMap, nil
+}
+
+// 重複推薦を理由付きで進めた場合、理由と重複した選考を活動履歴に記録する
+func createDuplicateOverrideEvent(
+	i *TaskInteractorImpl,
+	taskGroup *entity.TaskGroup,
+	duplicateList []*entity.DuplicateRecommendation,
+	overrideReason string,
+	agentStaffID uint,
+) error {
+	var (
+		duplicateTaskGroupIDList = []uint{}
+		duplicateJobInfoIDList   = []uint{}
+	)
+
+	if len(duplicateList) == 0 {
+		return nil
+	}
+
+	for _, duplicate := range duplicateList {
+		if duplicate.TaskGroupID == 0 {
+			duplicateJobInfoIDList = append(duplicateJobInfoIDList, duplicate.JobInformationID)
+			continue
+		}
+		duplicateTaskGroupIDList = append(duplicateTaskGroupIDList, duplicate.TaskGroupID)
+	}
+
+	diff, err := json.Marshal([]entity.AuditLogFieldDiff{
+		{Field: "duplicate_override_reason", Before: nil, After: overrideReason},
+		{Field: "duplicate_task_group_id_list", Before: nil, After: duplicateTaskGroupIDList},
+		{Field: "duplicate_job_information_id_list", Before: nil, After: duplicateJobInfoIDList},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	event := entity.NewTaskGroupEvent(
+		taskGroup.ID,
+		taskGroup.JobSeekerID,
+		taskGroup.JobInformationID,
+		entity.TaskGroupEventDuplicateOverride,
+		getTaskGroupEventStaffID(agentStaffID),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		string(diff),
+	)
+
+	err = i.taskGroupEventRepository.Create(event)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 選考が既に重複推薦の承認を受けているか
+func hasDuplicateOverrideEvent(i *TaskInteractorImpl, taskGroupID uint) (bool, error) {
+	eventList, err := i.taskGroupEventRepository.GetByTaskGroupID(taskGroupID)
+	if err != nil {
+		fmt.Println(err)
+		return false, err
+	}
+
+	for _, event := range eventList {
+		if event.EventType == entity.TaskGroupEventDuplicateOverride {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// 打診の対象を推薦の対象に変換する
+func getSoundOutDuplicateRecommendationTargetList(groupList []entity.SoundOutGroup) []entity.DuplicateRecommendationTarget {
+	var (
+		targetList = []entity.DuplicateRecommendationTarget{}
+	)
+
+	for _, group := range groupList {
+		targetList = append(targetList, entity.DuplicateRecommendationTarget{
+			JobSeekerID:      group.JobSeekerID,
+			JobInformationID: group.JobInformationID,
+		})
+	}
+
+	return targetList
+}
+
+// フェーズ名・照合の種類の表示名を設定する
+func setDuplicateRecommendationName(duplicateList []*entity.DuplicateRecommendation) {
+	for _, duplicate := range duplicateList {
+		if duplicate.TaskGroupID != 0 {
+			duplicate.PhaseName, duplicate.PhaseSubName = getStrTaskPhaseAndPhaseSub(
+				null.NewInt(duplicate.PhaseCategory, true),
+				null.NewInt(duplicate.PhaseSubCategory, true),
+			)
+		}
+
+		duplicate.MatchTypeName = entity.DuplicateRecommendationMatchTypeName[duplicate.MatchType]
+	}
+}
+
+// 複数のタスクを順番に処理する場合の重複推薦の確認
+// エントリーフェーズから終了以外のタスクを作成する場合のみ確認し、既に承認済みの選考は再度確認しない
+func guardDuplicateRecommendationInBatchProcessing(i *TaskInteractorImpl, param entity.CreateTaskInBatchProcessingParam, agentStaffID uint) error {
+	var (
+		isEntryTask bool
+	)
+
+	for _, task := range param.TaskList {
+		var (
+			isEntryPhase = task.PrevPhaseCategory == null.NewInt(int64(entity.Entry), true)
+			isClosePhase = task.PhaseSubCategory.Valid && task.PhaseSubCategory.Int64 >= 90 && task.PhaseSubCategory.Int64 <= 99
+		)
+
+		if isEntryPhase && !isClosePhase {
+			isEntryTask = true
+			break
+		}
+	}
+
+	if !isEntryTask {
+		return nil
+	}
+
+	isOverridden, err := hasDuplicateOverrideEvent(i, param.TaskGroupID)
+	if err != nil || isOverridden {
+		return err
+	}
+
+	target := entity.DuplicateRecommendationTarget{
+		JobSeekerID:      param.JobSeekerID,
+		JobInformationID: param.JobInformationID,
+	}
+
+	duplicateMap, err := guardDuplicateRecommendation(i, []entity.DuplicateRecommendationTarget{target}, param.TaskGroupID, param.DuplicateOverrideReason)
+	if err != nil {
+		return err
+	}
+
+	taskGroup := &entity.TaskGroup{
+		ID:               param.TaskGroupID,
+		JobSeekerID:      param.JobSeekerID,
+		JobInformationID: param.JobInformationID,
+	}
+
+	return createDuplicateOverrideEvent(
+		i,
+		taskGroup,
+		duplicateMap[duplicateRecommendationKey{target.JobSeekerID, target.JobInformationID}],
+		param.DuplicateOverrideReason,
+		agentStaffID,
+	)
+}
@@ -0,0 +1,167 @@
+package interactor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+// エージェントの選考ルールで新規エントリーの求職者を判定する
+// 最初に該当したルールのタグをメモに付け、フェーズ・ユーザーステータスを変更して返す（該当しない場合はnil）
+// 戻り値のルールは求職者の作成後にrecordEntryScreeningResultに渡す
+func screenEntryJobSeeker(
+	entryScreeningRuleRepository usecase.EntryScreeningRuleRepository,
+	entryScreeningRuleConditionRepository usecase.EntryScreeningRuleConditionRepository,
+	jobSeeker *entity.JobSeeker,
+) (*entity.EntryScreeningRule, error) {
+	screeningRuleList, err := entryScreeningRuleRepository.GetActiveByAgentID(jobSeeker.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	if len(screeningRuleList) == 0 {
+		return nil, nil
+	}
+
+	conditionList, err := entryScreeningRuleConditionRepository.GetByAgentID(jobSeeker.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	now := time.Now().In(utility.Tokyo)
+
+	for _, screeningRule := range screeningRuleList {
+		screeningRule.ConditionList = []*entity.EntryScreeningRuleCondition{}
+		for _, condition := range conditionList {
+			if condition.RuleID == screeningRule.ID {
+				screeningRule.ConditionList = append(screeningRule.ConditionList, condition)
+			}
+		}
+
+		if !matchEntryScreeningRule(screeningRule, jobSeeker, now) {
+			continue
+		}
+
+		jobSeeker.SecretMemo = fmt.Sprintf("【エントリー選考】%s（%s）\n\n%s", screeningRule.Tag, screeningRule.Title, jobSeeker.SecretMemo)
+
+		if screeningRule.ResultPhase.Valid {
+			jobSeeker.Phase = screeningRule.ResultPhase
+		}
+
+		if screeningRule.ResultUserStatus.Valid {
+			jobSeeker.UserStatus = screeningRule.ResultUserStatus
+		}
+
+		return screeningRule, nil
+	}
+
+	return nil, nil
+}
+
+// 選考ルールに該当した求職者を記録する（ルールごとの該当件数の集計に使用）
+func recordEntryScreeningResult(
+	entryScreeningResultRepository usecase.EntryScreeningResultRepository,
+	jobSeeker *entity.JobSeeker,
+	screeningRule *entity.EntryScreeningRule,
+) error {
+	if screeningRule == nil {
+		return nil
+	}
+
+	screeningResult := entity.NewEntryScreeningResult(
+		jobSeeker.AgentID,
+		jobSeeker.ID,
+		screeningRule.ID,
+		screeningRule.Tag,
+	)
+
+	err := entryScreeningResultRepository.Create(screeningResult)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 選考ルールに該当して面談調整を行わない求職者かどうか
+func isSkipInterviewAdjustmentByScreening(screeningRule *entity.EntryScreeningRule) bool {
+	return screeningRule != nil && screeningRule.IsSkipInterviewAdjustment
+}
+
+// 求職者が選考ルールの条件をすべて満たすか
+// 同じ項目の「一致」条件はいずれかに一致すれば満たしたものとし、項目の値が未入力の場合は該当しない
+func matchEntryScreeningRule(screeningRule *entity.EntryScreeningRule, jobSeeker *entity.JobSeeker, now time.Time) bool {
+	if len(screeningRule.ConditionList) == 0 {
+		return false
+	}
+
+	var (
+		equalTargetList      []int64
+		equalMatchedByTarget = map[int64]bool{}
+	)
+
+	for _, condition := range screeningRule.ConditionList {
+		value, ok := getEntryScreeningTargetValue(condition.Target.Int64, jobSeeker, now)
+		if !ok {
+			return false
+		}
+
+		switch condition.Operator.Int64 {
+		case entity.EntryScreeningOperatorEqual:
+			if _, exists := equalMatchedByTarget[condition.Target.Int64]; !exists {
+				equalTargetList = append(equalTargetList, condition.Target.Int64)
+			}
+			equalMatchedByTarget[condition.Target.Int64] = equalMatchedByTarget[condition.Target.Int64] || value == condition.Value.Int64
+		case entity.EntryScreeningOperatorNotEqual:
+			if value == condition.Value.Int64 {
+				return false
+			}
+		case entity.EntryScreeningOperatorGreaterOrEqual:
+			if value < condition.Value.Int64 {
+				return false
+			}
+		case entity.EntryScreeningOperatorLessOrEqual:
+			if value > condition.Value.Int64 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	for _, target := range equalTargetList {
+		if !equalMatchedByTarget[target] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// 選考ルールで判定する項目の値を取得（未入力の場合はfalse）
+func getEntryScreeningTargetValue(target int64, jobSeeker *entity.JobSeeker, now time.Time) (int64, bool) {
+	var value null.Int
+
+	switch target {
+	case entity.EntryScreeningTargetAge:
+		age, ok := utility.GetAgeFromBirthday(jobSeeker.Birthday, now)
+		return int64(age), ok
+	case entity.EntryScreeningTargetPrefecture:
+		value = jobSeeker.Prefecture
+	case entity.EntryScreeningTargetNationality:
+		value = jobSeeker.Nationality
+	case entity.EntryScreeningTargetAnnualIncome:
+		value = jobSeeker.AnnualIncome
+	case entity.EntryScreeningTargetStateOfEmployment:
+		value = jobSeeker.StateOfEmployment
+	}
+
+	return value.Int64, value.Valid
+}
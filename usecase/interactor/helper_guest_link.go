@@ -0,0 +1,85 @@
+package interactor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+// ゲストログイン時にURLのトークンを検証する
+// トークンがない場合はUUIDのみのリンクとして移行期間内かを判定する（guestLinkはnilを返す）
+// 戻り値のresultはアクセスログに記録する判定結果
+func verifyGuestLinkAccess(
+	guestLinkRepository usecase.GuestLinkRepository,
+	guestLinkConfig config.GuestLink,
+	resourceType string,
+	resourceUUID uuid.UUID,
+	access entity.GuestLinkAccess,
+) (guestLink *entity.GuestLink, result string, err error) {
+	now := time.Now().In(time.UTC)
+
+	if access.Token == "" {
+		result, err = policy.VerifyLegacyGuestLink(guestLinkConfig.LegacyUntil, now)
+		return nil, result, err
+	}
+
+	claims, err := utility.ParseGuestLinkJWT(access.Token, guestLinkConfig.Secret)
+	if err != nil {
+		fmt.Println(err)
+		return nil, entity.GuestLinkAccessResultInvalidToken, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "URLが正しくありません。\nご確認の上もう一度お試しください。")
+	}
+
+	linkUUID, err := uuid.Parse(claims.Id)
+	if err != nil {
+		fmt.Println(err)
+		return nil, entity.GuestLinkAccessResultInvalidToken, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "URLが正しくありません。\nご確認の上もう一度お試しください。")
+	}
+
+	guestLink, err = guestLinkRepository.FindByUUID(linkUUID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, entity.GuestLinkAccessResultInvalidToken, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "URLが正しくありません。\nご確認の上もう一度お試しください。")
+	}
+
+	result, err = policy.VerifyGuestLink(guestLink, claims, resourceType, resourceUUID, now)
+	return guestLink, result, err
+}
+
+// ゲスト用リンクのアクセスログを記録する
+func recordGuestLinkAccess(
+	guestLinkAccessLogRepository usecase.GuestLinkAccessLogRepository,
+	guestLink *entity.GuestLink,
+	resourceType string,
+	resourceUUID uuid.UUID,
+	result string,
+	access entity.GuestLinkAccess,
+) error {
+	var guestLinkID null.Int
+	if guestLink != nil {
+		guestLinkID = null.NewInt(int64(guestLink.ID), true)
+	}
+
+	accessLog := entity.NewGuestLinkAccessLog(
+		guestLinkID,
+		resourceType,
+		resourceUUID,
+		result,
+		access.IPAddress,
+		access.UserAgent,
+	)
+
+	err := guestLinkAccessLogRepository.Create(accessLog)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
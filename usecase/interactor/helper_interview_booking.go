@@ -0,0 +1,278 @@
+package interactor
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 新規エントリーした求職者に、面談調整テンプレートと面談予約リンクをメールで送る
+// テンプレート未設定・CA未割り当て・メールアドレス未入力の場合は送らない
+// 予約リンクの作成とメール送信を行うため、エントリーの登録をコミットした後に呼び出す
+// 送信の失敗はエントリー取り込みを止めないよう呼び出し元でログ出力のみとする
+func sendInterviewBookingLink(
+	interviewBookingLinkRepository usecase.InterviewBookingLinkRepository,
+	interviewAdjustmentTemplateRepository usecase.InterviewAdjustmentTemplateRepository,
+	agentRepository usecase.AgentRepository,
+	agentStaffRepository usecase.AgentStaffRepository,
+	sendgridAPIKey string,
+	scoutService *entity.ScoutService,
+	jobSeeker *entity.JobSeeker,
+) error {
+	if scoutService == nil {
+		return nil
+	}
+
+	if !jobSeeker.AgentStaffID.Valid || jobSeeker.Email == "" {
+		return nil
+	}
+
+	template, err := selectInterviewBookingTemplate(interviewAdjustmentTemplateRepository, scoutService, jobSeeker)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	if template == nil {
+		return nil
+	}
+
+	agent, err := agentRepository.FindByID(jobSeeker.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	staff, err := agentStaffRepository.FindByID(uint(jobSeeker.AgentStaffID.Int64))
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	bookingLink := entity.NewInterviewBookingLink(
+		jobSeeker.AgentID,
+		jobSeeker.ID,
+		staff.ID,
+		null.NewInt(int64(template.ID), true),
+		time.Now().In(time.UTC).AddDate(0, 0, entity.InterviewBookingPeriodDays),
+		utility.EarliestTime(), // 初期値
+	)
+
+	err = interviewBookingLinkRepository.Create(bookingLink)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	bookingURL := fmt.Sprintf("%s/guest_js/interview_booking/?booking=%s", os.Getenv("BASE_DOMAIN"), bookingLink.UUID)
+
+	// テンプレートに{booking_url}がある場合は置き換え、ない場合は末尾に追記する
+	content := template.Content
+	if strings.Contains(content, "{booking_url}") {
+		content = strings.ReplaceAll(content, "{booking_url}", bookingURL)
+	} else {
+		content = fmt.Sprintf("%s\n\n▼面談のご予約はこちら\n%s", content, bookingURL)
+	}
+
+	// 送信元は面談調整用のメールアドレス、未設定の場合は担当CAのメールアドレス
+	fromEmail := agent.InterviewAdjustmentEmail
+	if fromEmail == "" {
+		fromEmail = staff.Email
+	}
+
+	err = utility.SendMailToSingleWithoutCC(
+		sendgridAPIKey,
+		template.Subject,
+		content,
+		entity.EmailUser{
+			Name:  agent.AgentName,
+			Email: fromEmail,
+		},
+		entity.EmailUser{
+			Name:  jobSeeker.LastName + jobSeeker.FirstName + "様",
+			Email: jobSeeker.Email,
+		},
+		nil,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 求職者の就業状況に合わせて面談調整テンプレートを選ぶ
+// スカウトサービスの「就業中」「離職中」のテンプレート名と同じタイトルのテンプレートを優先し、
+// 一致するテンプレートがない場合はスカウトサービスで選択した面談調整テンプレートを使う（どちらもない場合はnil）
+func selectInterviewBookingTemplate(
+	interviewAdjustmentTemplateRepository usecase.InterviewAdjustmentTemplateRepository,
+	scoutService *entity.ScoutService,
+	jobSeeker *entity.JobSeeker,
+) (*entity.InterviewAdjustmentTemplate, error) {
+	title := scoutService.TemplateTitleForEmployed
+	if jobSeeker.StateOfEmployment == null.NewInt(1, true) { // 離職中
+		title = scoutService.TemplateTitleForUnemployed
+	}
+
+	if title != "" {
+		templateList, err := interviewAdjustmentTemplateRepository.GetByAgentID(jobSeeker.AgentID)
+		if err != nil {
+			fmt.Println(err)
+			return nil, err
+		}
+
+		for _, template := range templateList {
+			if template.Title == title {
+				return template, nil
+			}
+		}
+	}
+
+	if !scoutService.InterviewAdjustmentTemplateID.Valid {
+		return nil, nil
+	}
+
+	template, err := interviewAdjustmentTemplateRepository.FindByID(uint(scoutService.InterviewAdjustmentTemplateID.Int64))
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// LP・提携求人媒体・自社フォームからのエントリーで使う面談調整テンプレートの設定
+// 同じ媒体のスカウトサービスがある場合はその設定を、ない場合はテンプレートを設定したスカウトサービスのうちIDが最も小さいものの設定を使う
+func findInterviewBookingScoutService(
+	scoutServiceRepository usecase.ScoutServiceRepository,
+	agentID uint,
+	externalType int64,
+) (*entity.ScoutService, error) {
+	scoutServiceList, err := scoutServiceRepository.GetByAgentID(agentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	var fallback *entity.ScoutService
+	for _, scoutService := range scoutServiceList {
+		if !scoutService.InterviewAdjustmentTemplateID.Valid &&
+			scoutService.TemplateTitleForEmployed == "" &&
+			scoutService.TemplateTitleForUnemployed == "" {
+			continue
+		}
+
+		if scoutService.ServiceType == null.NewInt(externalType, true) {
+			return scoutService, nil
+		}
+
+		if fallback == nil || scoutService.ID < fallback.ID {
+			fallback = scoutService
+		}
+	}
+
+	return fallback, nil
+}
+
+// 担当CAの予約可能な面談枠を取得する
+// 翌日から有効期限までの平日の勤務時間内で、既存の面談と重ならない枠を返す
+func getInterviewBookingSlotList(
+	agentAssignmentStaffRepository usecase.AgentAssignmentStaffRepository,
+	interviewTaskGroupRepository usecase.InterviewTaskGroupRepository,
+	bookingLink *entity.InterviewBookingLink,
+	now time.Time,
+) ([]*entity.InterviewBookingSlot, error) {
+	var (
+		slotList     = []*entity.InterviewBookingSlot{}
+		workingStart = entity.InterviewBookingDefaultStartAt
+		workingEnd   = entity.InterviewBookingDefaultEndAt
+		slotDuration = time.Duration(entity.InterviewBookingSlotMinutes) * time.Minute
+	)
+
+	// 自動割り当てで勤務時間が設定されている場合はその時間内で予約を受け付ける
+	assignmentStaffList, err := agentAssignmentStaffRepository.GetByAgentID(bookingLink.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	for _, assignmentStaff := range assignmentStaffList {
+		if assignmentStaff.AgentStaffID == bookingLink.AgentStaffID &&
+			assignmentStaff.WorkingStartTime != "" && assignmentStaff.WorkingEndTime != "" {
+			workingStart = assignmentStaff.WorkingStartTime
+			workingEnd = assignmentStaff.WorkingEndTime
+			break
+		}
+	}
+
+	startClock, err := time.Parse("15:04", workingStart)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	endClock, err := time.Parse("15:04", workingEnd)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	now = now.In(utility.Tokyo)
+	periodStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, utility.Tokyo).AddDate(0, 0, 1)
+	periodEnd := periodStart.AddDate(0, 0, entity.InterviewBookingPeriodDays)
+	if bookingLink.ExpiredAt.Before(periodEnd) {
+		periodEnd = bookingLink.ExpiredAt.In(utility.Tokyo)
+	}
+
+	// 担当CAの期間内の面談
+	interviewTaskGroupList, err := interviewTaskGroupRepository.GetByStaffIDAndPeriod(bookingLink.AgentStaffID, periodStart.Add(-slotDuration), periodEnd.Add(slotDuration))
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	for day := periodStart; day.Before(periodEnd); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+
+		dayStart := time.Date(day.Year(), day.Month(), day.Day(), startClock.Hour(), startClock.Minute(), 0, 0, utility.Tokyo)
+		dayEnd := time.Date(day.Year(), day.Month(), day.Day(), endClock.Hour(), endClock.Minute(), 0, 0, utility.Tokyo)
+
+		for slotStart := dayStart; !slotStart.Add(slotDuration).After(dayEnd); slotStart = slotStart.Add(slotDuration) {
+			if !slotStart.Before(periodEnd) {
+				break
+			}
+
+			if isInterviewBookingSlotBusy(slotStart, slotDuration, interviewTaskGroupList) {
+				continue
+			}
+
+			slotList = append(slotList, &entity.InterviewBookingSlot{
+				StartTime: slotStart,
+				EndTime:   slotStart.Add(slotDuration),
+			})
+		}
+	}
+
+	return slotList, nil
+}
+
+// 面談枠が担当CAの既存の面談と重なるか
+func isInterviewBookingSlotBusy(slotStart time.Time, slotDuration time.Duration, interviewTaskGroupList []*entity.InterviewTaskGroup) bool {
+	for _, interviewTaskGroup := range interviewTaskGroupList {
+		diff := interviewTaskGroup.InterviewDate.Sub(slotStart)
+		if -slotDuration < diff && diff < slotDuration {
+			return true
+		}
+	}
+
+	return false
+}
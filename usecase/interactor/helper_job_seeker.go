@@ -2,9 +2,15 @@ package interactor
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"path"
+	"strings"
 
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
 )
 
 // 求人の最大ページ数を返す（本番実装までは1ページあたり5件）
@@ -486,3 +492,226 @@ func getJobSeekerChildTableData(
 
 	return jobSeeker, nil
 }
+
+/****************************************************************************************/
+/// 取り込み時の重複判定
+//
+// 正規化した氏名・フリガナ・電話番号・メールアドレスで取り込み対象と既存の求職者を比較する
+//
+// 同一人物: 氏名またはフリガナ + 電話番号またはメールアドレスが一致
+// 重複の疑い: 電話番号またはメールアドレスのみ一致 / 氏名とフリガナが一致 / 氏名と生年月日が一致
+func judgeDuplicateJobSeeker(
+	target *entity.JobSeeker,
+	candidateList []*entity.JobSeeker,
+) (*entity.JobSeeker, entity.JobSeekerDuplicateMatchType, string) {
+	var (
+		targetName     = utility.NormalizeName(target.LastName + target.FirstName)
+		targetFurigana = utility.NormalizeKana(target.LastFurigana + target.FirstFurigana)
+		targetPhone    = utility.NormalizePhoneNumber(target.PhoneNumber)
+		targetEmail    = utility.NormalizeEmail(target.Email)
+
+		suspected       *entity.JobSeeker
+		suspectedReason string
+	)
+
+	for _, candidate := range candidateList {
+		if candidate.ID == target.ID {
+			continue
+		}
+
+		var (
+			reasonList      []string
+			isNameMatch     = targetName != "" && targetName == utility.NormalizeName(candidate.LastName+candidate.FirstName)
+			isKanaMatch     = targetFurigana != "" && targetFurigana == utility.NormalizeKana(candidate.LastFurigana+candidate.FirstFurigana)
+			isPhoneMatch    = targetPhone != "" && targetPhone == utility.NormalizePhoneNumber(candidate.PhoneNumber)
+			isEmailMatch    = targetEmail != "" && targetEmail == utility.NormalizeEmail(candidate.Email)
+			isBirthdayMatch = target.Birthday != "" && strings.HasPrefix(candidate.Birthday, target.Birthday)
+		)
+
+		if isNameMatch {
+			reasonList = append(reasonList, "氏名")
+		}
+		if isKanaMatch {
+			reasonList = append(reasonList, "フリガナ")
+		}
+		if isPhoneMatch {
+			reasonList = append(reasonList, "電話番号")
+		}
+		if isEmailMatch {
+			reasonList = append(reasonList, "メールアドレス")
+		}
+		if isBirthdayMatch {
+			reasonList = append(reasonList, "生年月日")
+		}
+
+		reason := strings.Join(reasonList, "・") + "が一致"
+
+		if (isNameMatch || isKanaMatch) && (isPhoneMatch || isEmailMatch) {
+			return candidate, entity.JobSeekerDuplicateMatchCertain, reason
+		}
+
+		if suspected == nil &&
+			(isPhoneMatch || isEmailMatch || (isNameMatch && isKanaMatch) || (isNameMatch && isBirthdayMatch)) {
+			suspected = candidate
+			suspectedReason = reason
+		}
+	}
+
+	if suspected != nil {
+		return suspected, entity.JobSeekerDuplicateMatchSuspected, suspectedReason
+	}
+
+	return nil, entity.JobSeekerDuplicateMatchNone, ""
+}
+
+// 取り込み対象と重複する既存の求職者を取得する
+func findDuplicateJobSeekerOnEntry(
+	jobSeekerRepository usecase.JobSeekerRepository,
+	agentID uint,
+	target *entity.JobSeeker,
+) (*entity.JobSeeker, entity.JobSeekerDuplicateMatchType, string, error) {
+	candidateList, err := jobSeekerRepository.GetDuplicateCandidateByAgentID(
+		agentID,
+		utility.NormalizeName(target.LastName+target.FirstName),
+		utility.NormalizeKana(target.LastFurigana+target.FirstFurigana),
+		utility.NormalizePhoneNumber(target.PhoneNumber),
+		utility.NormalizeEmail(target.Email),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return nil, entity.JobSeekerDuplicateMatchNone, "", err
+	}
+
+	matched, matchType, reason := judgeDuplicateJobSeeker(target, candidateList)
+
+	return matched, matchType, reason, nil
+}
+
+// 同一人物と判定した既存の求職者に流入元を紐付ける（取り込み内容のメモは既存の求職者のメモに追記）
+func attachEntrySourceToJobSeeker(
+	jobSeekerRepository usecase.JobSeekerRepository,
+	jobSeekerExternalIDRepository usecase.JobSeekerExternalIDRepository,
+	existing *entity.JobSeeker,
+	externalType int64,
+	externalID string,
+	inflowChannelID null.Int,
+	entryMemo string,
+) error {
+	// 媒体の求職者IDが取得できなかった場合も流入経路を残すため記録する（媒体IDはNULL）
+	err := jobSeekerExternalIDRepository.Create(entity.NewJobSeekerExternalID(
+		existing.ID,
+		existing.AgentID,
+		null.NewInt(externalType, true),
+		externalID,
+		inflowChannelID,
+	))
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	if entryMemo == "" {
+		return nil
+	}
+
+	secretMemo := fmt.Sprintf(
+		"%s\n\n【%sから再エントリー】\n%s",
+		existing.SecretMemo,
+		entity.JobSeekerExternalTypeLabel[externalType],
+		entryMemo,
+	)
+
+	err = jobSeekerRepository.UpdateSecretMemo(existing.ID, secretMemo)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 新しく作成した求職者の流入元を記録し、重複の疑いがある場合は統合候補を登録する
+func registerEntrySourceOfNewJobSeeker(
+	jobSeekerExternalIDRepository usecase.JobSeekerExternalIDRepository,
+	jobSeekerMergeSuggestionRepository usecase.JobSeekerMergeSuggestionRepository,
+	jobSeeker *entity.JobSeeker,
+	externalType int64,
+	externalID string,
+	suspected *entity.JobSeeker,
+	matchReason string,
+) error {
+	// 媒体の求職者IDが取得できなかった場合も流入経路を残すため記録する（媒体IDはNULL）
+	err := jobSeekerExternalIDRepository.Create(entity.NewJobSeekerExternalID(
+		jobSeeker.ID,
+		jobSeeker.AgentID,
+		null.NewInt(externalType, true),
+		externalID,
+		jobSeeker.InflowChannelID,
+	))
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	if suspected == nil {
+		return nil
+	}
+
+	err = jobSeekerMergeSuggestionRepository.Create(entity.NewJobSeekerMergeSuggestion(
+		jobSeeker.AgentID,
+		suspected.ID,
+		jobSeeker.ID,
+		matchReason,
+	))
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 求職者の個人情報を含むデータとファイルを削除し、求職者を匿名化する
+// 匿名化日時は最後に更新するため、途中で失敗した場合も再実行で続きから処理される
+// ファイルはDBの削除後に削除し、失敗してもログのみ出力する
+func anonymizeJobSeeker(
+	jobSeekerID uint,
+	i *JobSeekerInteractorImpl,
+) error {
+	fileURLList, err := i.jobSeekerPersonalDataRepository.GetFileURLListByJobSeekerID(jobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	err = i.jobSeekerPersonalDataRepository.DeleteByJobSeekerID(jobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	err = i.jobSeekerRepository.Anonymize(jobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	for _, fileURL := range fileURLList {
+		err = i.firebase.DeleteFromStorage(fileURL)
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	return nil
+}
+
+// 個人情報の開示請求で出力するファイルのファイル名（URLのファイルパスの末尾）
+func getPersonalDataFileName(fileURL string) string {
+	u, err := url.Parse(fileURL)
+	if err != nil || path.Base(u.Path) == "/" || path.Base(u.Path) == "." {
+		return "file"
+	}
+
+	return path.Base(u.Path)
+}
@@ -0,0 +1,219 @@
+package interactor
+
+import (
+	"log"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"gopkg.in/guregu/null.v4"
+)
+
+// スカウトサービスから取り込んだ求職者を登録する（RAN・AMBI・マイナビエージェントスカウトで共通）
+// 他媒体から取り込み済みの求職者と同一人物の場合は既存の求職者に流入元を紐付け、新規の登録はしない（falseを返す）
+// 新規の場合は選考ルールの判定・担当CAの割り振りを行って登録し、面談調整タスクの作成と面談予約リンクの送信まで行う
+func registerScoutServiceEntryJobSeeker(
+	i *ScoutServiceInteractorImpl,
+	jobSeeker *entity.JobSeeker,
+	scoutService *entity.ScoutService,
+	externalType int64,
+) (bool, error) {
+	// 他媒体から取り込み済みの求職者と重複していないかを判定
+	duplicateJobSeeker, matchType, matchReason, err := findDuplicateJobSeekerOnEntry(i.jobSeekerRepository, jobSeeker.AgentID, jobSeeker)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	// 同一人物の場合は既存の求職者に流入元を紐付けて、新規の登録はスキップ
+	if matchType == entity.JobSeekerDuplicateMatchCertain {
+		log.Println("他媒体から取り込み済みの求職者と重複しています。", jobSeeker.LastName+jobSeeker.FirstName, matchReason)
+		err = attachEntrySourceToJobSeeker(
+			i.jobSeekerRepository,
+			i.jobSeekerExternalIDRepository,
+			duplicateJobSeeker,
+			externalType,
+			jobSeeker.ExternalID,
+			jobSeeker.InflowChannelID,
+			jobSeeker.SecretMemo,
+		)
+		if err != nil {
+			log.Println(err)
+			return false, err
+		}
+		return false, nil
+	}
+
+	// 選考ルールで対象外の求職者を判定（該当した場合はフェーズ・ユーザーステータスを変更）
+	screeningRule, err := screenEntryJobSeeker(
+		i.entryScreeningRuleRepository,
+		i.entryScreeningRuleConditionRepository,
+		jobSeeker,
+	)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	// 自動割り振りルールに従って担当CAをセット
+	assignmentLog, err := assignCAStaffByRule(
+		i.agentAssignmentRuleRepository,
+		i.agentAssignmentStaffRepository,
+		i.agentAssignmentStaffSpecialtyRepository,
+		i.jobSeekerRepository,
+		jobSeeker,
+	)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	err = i.jobSeekerRepository.Create(jobSeeker)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	// 流入元を記録（重複の疑いがある場合は統合候補に登録）
+	if matchType != entity.JobSeekerDuplicateMatchSuspected {
+		duplicateJobSeeker = nil
+	}
+
+	err = registerEntrySourceOfNewJobSeeker(
+		i.jobSeekerExternalIDRepository,
+		i.jobSeekerMergeSuggestionRepository,
+		jobSeeker,
+		externalType,
+		jobSeeker.ExternalID,
+		duplicateJobSeeker,
+		matchReason,
+	)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	// 割り振り履歴を保存して担当CAに通知
+	err = recordJobSeekerAssignment(
+		i.jobSeekerAssignmentLogRepository,
+		i.agentStaffRepository,
+		i.oneSignal,
+		jobSeeker,
+		assignmentLog,
+	)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	// 選考ルールに該当した求職者を記録
+	err = recordEntryScreeningResult(
+		i.entryScreeningResultRepository,
+		jobSeeker,
+		screeningRule,
+	)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	jobSeekerDocument := entity.NewJobSeekerDocument(
+		jobSeeker.ID,
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+	)
+
+	err = i.jobSeekerDocumentRepository.Create(jobSeekerDocument)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	// 求職者作成時にメッセージグループ作成
+	// エージェントと求職者のチャットグループを作成
+	chatGroup := entity.NewChatGroupWithJobSeeker(
+		jobSeeker.AgentID,
+		jobSeeker.ID,
+		false, // 初めはLINE連携してないから false
+	)
+
+	err = i.chatGroupWithJobSeekerRepository.Create(chatGroup)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	// 選考ルールで対象外となった求職者は面談調整を行わない
+	if isSkipInterviewAdjustmentByScreening(screeningRule) {
+		return true, nil
+	}
+
+	// 面談実施待ちより前のフェーズの場合は「面談調整タスク」を作成
+	var (
+		phaseSub null.Int
+		date     string
+	)
+
+	phaseSub = null.NewInt(0, true) //日程調整依頼
+
+	// タスクの期限は当日で登録
+	now := time.Now()
+	date = now.Format("2006-01-02")
+
+	// 面談調整タスクの作成
+	interviewTaskGroup := entity.NewInterviewTaskGroup(
+		jobSeeker.AgentID,
+		jobSeeker.ID,
+		jobSeeker.InterviewDate,
+		utility.EarliestTime(), // 初期値,
+	)
+
+	err = i.interviewTaskGroupRepository.Create(interviewTaskGroup)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	interviewTask := entity.NewInterviewTask(
+		interviewTaskGroup.ID,
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		jobSeeker.Phase,
+		phaseSub,
+		"",
+		date,
+		null.NewInt(99, true),
+		getStrPhaseForJobSeeker(jobSeeker.Phase),
+	)
+
+	err = i.interviewTaskRepository.Create(interviewTask)
+	if err != nil {
+		log.Println(err)
+		return false, err
+	}
+
+	// スカウトサービスで面談調整テンプレートを設定している場合は就業状況に合わせたテンプレートで面談予約リンクを送る
+	// （エントリーの取り込みはトランザクションを使わないため、ここまでの登録は確定している）
+	err = sendInterviewBookingLink(
+		i.interviewBookingLinkRepository,
+		i.interviewAdjustmentTemplateRepository,
+		i.agentRepository,
+		i.agentStaffRepository,
+		i.sendgrid.APIKey,
+		scoutService,
+		jobSeeker,
+	)
+	if err != nil {
+		log.Println("面談予約リンクの送信に失敗しました:", err)
+	}
+
+	return true, nil
+}
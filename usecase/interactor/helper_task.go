@@ -9,6 +9,7 @@ import (
 	"github.com/line/line-bot-sdk-go/v7/linebot"
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
 	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -139,6 +140,54 @@ func isPhaseSelection(nextTaskPhase null.Int) bool {
 		nextTaskPhase == null.NewInt(int64(entity.FinalSelection), true))
 }
 
+// タスクグループの最新タスクから次のタスクへ遷移できるかを確認し、遷移の定義を返す
+// 遷移元はリクエストの値ではなく、DBに保存されている最新タスクのフェーズを使用する
+// 辞退・不合格で終了する場合は理由の選択も確認する
+// 操作者はログイン中の担当者とし、遷移元のタスクを実行する側（RA・CA）のエージェントの担当者に限る
+func validateNextTaskPhase(i *TaskInteractorImpl, taskGroupID uint, operator *entity.AgentStaff, nextPhase, nextPhaseSub, staffType, lossReason null.Int) (entity.TaskPhaseTransition, error) {
+	taskGroup, err := i.taskGroupRepository.FindByID(taskGroupID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "選考が見つかりません")
+			return entity.TaskPhaseTransition{}, wrapped
+		}
+		fmt.Println(err)
+		return entity.TaskPhaseTransition{}, err
+	}
+
+	latestTask, err := i.taskRepository.FindLatestByGroupID(taskGroupID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "タスクが見つかりません")
+			return entity.TaskPhaseTransition{}, wrapped
+		}
+		fmt.Println(err)
+		return entity.TaskPhaseTransition{}, err
+	}
+
+	err = policy.ValidateTaskPhaseOperator(operator, taskGroup, latestTask.StaffType)
+	if err != nil {
+		return entity.TaskPhaseTransition{}, err
+	}
+
+	from := entity.TaskPhaseState{Phase: latestTask.PhaseCategory.Int64, PhaseSub: latestTask.PhaseSubCategory.Int64}
+	to := entity.TaskPhaseState{Phase: nextPhase.Int64, PhaseSub: nextPhaseSub.Int64}
+
+	err = policy.ValidateTaskPhaseTransition(from, to, staffType)
+	if err != nil {
+		return entity.TaskPhaseTransition{}, err
+	}
+
+	err = policy.ValidateTaskLossReason(to, lossReason)
+	if err != nil {
+		return entity.TaskPhaseTransition{}, err
+	}
+
+	transition, _ := policy.FindTaskPhaseTransition(from, to)
+
+	return transition, nil
+}
+
 // 「2023-06-29T17:00」を「2023年6月29日(日) 17:00」に変更
 func dateTimeAndDayNameFormat(date string) string {
 	weekdays := []string{"日", "月", "火", "水", "木", "金", "土"}
@@ -209,20 +258,26 @@ func convertPastDateTime(startDateTimeStr, endDateTimeStr string) string {
 // }
 
 // エントリーフェーズのタスクを作成する処理
-func createEntryPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam) error {
+func createEntryPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam, operator *entity.AgentStaff) error {
 	var (
 		err          error
 		newTask      *entity.Task
 		nextPhase    = taskParam.PhaseCategory
 		nextPhaseSub = taskParam.PhaseSubCategory
 		prevPhase    = taskParam.PrevPhaseCategory
-		prevPhaseSub = taskParam.PrevPhaseSubCategory
 	)
 
 	if prevPhase != null.NewInt(int64(entity.Entry), true) {
 		return errors.New("現在のフェーズがエントリーではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, batchParam.TaskGroupID, operator, taskParam.PhaseCategory, taskParam.PhaseSubCategory, taskParam.StaffType, taskParam.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
 	/************ タスク情報の作成（選考継続時のタスク作成 or 通常タスク作成） **************/
 
 	// アクション: 選考継続処理
@@ -285,7 +340,7 @@ func createEntryPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInB
 				)
 
 				// タスク作成
-				err = i.taskRepository.Create(confirmApplicationIntentionTask)
+				err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, confirmApplicationIntentionTask)
 				if err != nil {
 					fmt.Println(err)
 					return err
@@ -311,62 +366,35 @@ func createEntryPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInB
 	}
 
 	// タスク作成
-	err = i.taskRepository.Create(newTask)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, newTask)
 	if err != nil {
 		fmt.Println(err)
 		return err
 	}
 
-	/************ 評価点の登録 **************/
-
-	// 次が「マスクレジュメ合格」の場合
-	var IsMaskResumePassNext = (prevPhase == null.NewInt(int64(entity.Entry), true) &&
-		prevPhaseSub == null.NewInt(int64(entity.CollectResultOfMask), true)) &&
-		(nextPhase == null.NewInt(int64(entity.Entry), true) &&
-			nextPhaseSub == null.NewInt(int64(entity.SoundOutJobInformation), true))
-
-	// 次が「マスクレジュメ不合格」の場合
-	var IsMaskResumeFailNext = (prevPhase == null.NewInt(int64(entity.Entry), true) &&
-		prevPhaseSub == null.NewInt(int64(entity.CollectResultOfMask), true)) &&
-		(nextPhase == null.NewInt(int64(entity.Entry), true) &&
-			nextPhaseSub == null.NewInt(int64(entity.EnterpriseNG), true))
-
-	if IsMaskResumePassNext {
-		// マスクレジュメ合格の場合　合格時の評価点を作成
-		evaluationPoint := entity.NewEvaluationPoint(
-			newTask.ID,
-			NullInt, // エントリーフェーズの時は選考フローを選択していないためnullが入る
-			batchParam.JobSeekerID,
-			batchParam.JobInformationID,
-			taskParam.GoodPoint,
-			taskParam.NGPoint,
-			true,  // 選考通過のためtrue
-			false, // 再面接は発生しないためfalse
-		)
-
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return err
-		}
-	} else if IsMaskResumeFailNext {
-		// マスクレジュメ不合格の場合 不合格時の評価点の作成
-		evaluationPoint := entity.NewEvaluationPoint(
-			newTask.ID,
-			NullInt, // エントリーフェーズの時は選考フローを選択していないためnullが入る
-			batchParam.JobSeekerID,
-			batchParam.JobInformationID,
-			taskParam.GoodPoint,
-			taskParam.NGPoint,
-			false, // 選考不合格のためfalse
-			false, // 再面接は発生しないためfalse
-		)
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, newTask, taskParam.LossReason, taskParam.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
 
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return err
-		}
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
+
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		newTaskPhaseSideEffectParamInBatchProcessing(newTask, batchParam, taskParam),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return err
 	}
 
 	/************ マスクレジュメ打診時のメール送付 **************/
@@ -647,7 +675,7 @@ func createEntryPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInB
 }
 
 // 書類選考フェーズのタスクを作成する処理
-func createDocumentSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam) error {
+func createDocumentSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam, operator *entity.AgentStaff) error {
 	var (
 		err          error
 		newTask      *entity.Task
@@ -661,6 +689,13 @@ func createDocumentSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.C
 		return errors.New("現在のフェーズが書類選考ではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, batchParam.TaskGroupID, operator, taskParam.PhaseCategory, taskParam.PhaseSubCategory, taskParam.StaffType, taskParam.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
 	/************ タスク情報の作成（選考継続時のタスク作成 or 通常タスク作成） **************/
 
 	if nextPhase == null.NewInt(int64(entity.DocumentSelection), true) && nextPhaseSub == null.NewInt(int64(entity.ContinueSelection), true) {
@@ -705,29 +740,26 @@ func createDocumentSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.C
 	}
 
 	// タスク作成
-	err = i.taskRepository.Create(newTask)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, newTask)
 	if err != nil {
 		fmt.Println(err)
 		return err
 	}
 
-	/************ 評価点の登録 **************/
-
-	// 次が「書類選考合格」の場合
-	var IsDocumentSelectionPassNext = (prevPhase == null.NewInt(int64(entity.DocumentSelection), true) &&
-		prevPhaseSub == null.NewInt(int64(entity.CollectResultOfDocumentSelection), true)) &&
-		(isPhaseSelection(nextPhase) && (nextPhaseSub == null.NewInt(int64(entity.RequestGuidance), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestCollectionOfSchedule), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestConfirmScheduleAndSelectionDetail), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestConfirmScheduleForSelection), true)))
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, newTask, taskParam.LossReason, taskParam.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
 
-		// 次が「書類選考不合格」の場合
-	var IsDocumentSelectionFailNext = (nextPhase == null.NewInt(int64(entity.DocumentSelection), true) &&
-		nextPhaseSub == null.NewInt(int64(entity.FailingNotification), true))
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
 
-	if IsDocumentSelectionPassNext {
-		var selectionInformationID null.Int
+	sideEffectParam := newTaskPhaseSideEffectParamInBatchProcessing(newTask, batchParam, taskParam)
+	sideEffectParam.SelectionInformationID = null.NewInt(0, false)
 
+	// 書類選考合格の場合は「書類選考の選考情報の紐付け + 選考フローパターンの紐付け」
+	if policy.HasTaskPhaseSideEffect(transition, entity.TaskPhaseSideEffectEvaluationPass) {
 		if batchParam.SelectionFlowPatternID.Valid {
 			selectionInformation, err := i.jobInfoSelectionInformationRepository.FindBySelectionFlowIDAndSelectionType(uint(batchParam.SelectionFlowPatternID.Int64), uint(entity.DocumentSelection))
 			if err != nil {
@@ -735,52 +767,30 @@ func createDocumentSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.C
 				return err
 			}
 
-			selectionInformationID = null.NewInt(int64(selectionInformation.ID), true)
+			sideEffectParam.SelectionInformationID = null.NewInt(int64(selectionInformation.ID), true)
 		}
 
-		// 書類選考合格の場合「合格時の評価点を作成 + 選考フローパターンの紐付け」
-		evaluationPoint := entity.NewEvaluationPoint(
-			newTask.ID,
-			selectionInformationID, // エントリーフェーズの時は選考フローを選択していないためnullが入る
-			batchParam.JobSeekerID,
-			batchParam.JobInformationID,
-			taskParam.GoodPoint,
-			taskParam.NGPoint,
-			true,  // 選考通過のためtrue
-			false, // 再面接は発生しないためfalse
-		)
-
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return err
-		}
-
-		// 選考フローパターンの紐付け
 		err = i.taskGroupRepository.UpdateSelectionFlowPatternID(batchParam.TaskGroupID, batchParam.SelectionFlowPatternID)
 		if err != nil {
 			fmt.Println(err)
 			return err
 		}
+	}
 
-	} else if IsDocumentSelectionFailNext {
-		// 書類選考不合格の場合 「不合格時の評価点の作成」
-		evaluationPoint := entity.NewEvaluationPoint(
-			newTask.ID,
-			null.NewInt(0, false), // エントリーフェーズの時は選考フローを選択していないためnullが入る
-			batchParam.JobSeekerID,
-			batchParam.JobInformationID,
-			taskParam.GoodPoint,
-			taskParam.NGPoint,
-			false, // 選考不合格のためfalse
-			false, // 再面接は発生しないためfalse
-		)
-
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return err
-		}
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		sideEffectParam,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return err
 	}
 
 	/************ 推薦メールの送信 **************/
@@ -952,6 +962,12 @@ func createDocumentSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.C
 			fmt.Println(err)
 			return err
 		}
+
+		err = createSelectionDateEvent(i.taskGroupEventRepository, batchParam.TaskGroupID, jobSeekerSchedule, taskParam.ExecutedStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
 	}
 
 	/************ 両面タスクに更新する **************/
@@ -1099,7 +1115,7 @@ func createDocumentSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.C
 }
 
 // 選考フェーズのタスクを作成する処理
-func createSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam) error {
+func createSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam, operator *entity.AgentStaff) error {
 	var (
 		err          error
 		newTask      *entity.Task
@@ -1116,6 +1132,13 @@ func createSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTas
 		return errors.New("現在フェーズが選考フェーズではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, batchParam.TaskGroupID, operator, taskParam.PhaseCategory, taskParam.PhaseSubCategory, taskParam.StaffType, taskParam.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
 	/************ 新リスケ処理（リスケ + 削除） 元のリスケ処理も残しておく **************/
 
 	if taskParam.TaskOption == null.NewInt(entity.OptionRescheduleAndDelete, true) {
@@ -1150,7 +1173,7 @@ func createSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTas
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(rescheduleTask)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, rescheduleTask)
 		if err != nil {
 			fmt.Println(err)
 			return err
@@ -1234,7 +1257,7 @@ func createSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTas
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(skipTask)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, skipTask)
 		if err != nil {
 			fmt.Println(err)
 			return err
@@ -1242,57 +1265,35 @@ func createSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTas
 	}
 
 	// タスク作成
-	err = i.taskRepository.Create(newTask)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, newTask)
 	if err != nil {
 		fmt.Println(err)
 		return err
 	}
 
-	/************ 評価点の登録（※再設定の場合は合格として扱う） **************/
-
-	// 現在が「⚪︎次選考の結果回収中」（次が「⚪︎次選考の合格・不合格」）
-	var IsSelectionCollectResultNow = prevPhaseSub == null.NewInt(int64(entity.RequestCollectSelectionThought), true)
-
-	// 再選考の場合
-	var IsReSelection = nextPhase == prevPhase &&
-		(nextPhaseSub == null.NewInt(int64(entity.RequestGuidance), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestCollectionOfSchedule), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestConfirmScheduleForSelection), true))
-
-	if IsSelectionCollectResultNow {
-		// 再設定の有無
-		var isReInterview = false
-
-		// 合格可否
-		var isPass = false
-
-		// 再設定のタスクの場合は「true」に変更
-		if IsReSelection {
-			isReInterview = true
-		}
-
-		// 現在のフェーズと次のフェーズが同一でなければisPassを「true」に変更
-		if !(nextPhase == prevPhase) {
-			isPass = true
-		}
-
-		// 評価点（合格）
-		evaluationPoint := entity.NewEvaluationPoint(
-			newTask.ID,
-			taskParam.SelectionInformationID,
-			batchParam.JobSeekerID,
-			batchParam.JobInformationID,
-			taskParam.GoodPoint,
-			taskParam.NGPoint,
-			isPass,        // 選考通過のためtrue
-			isReInterview, // 再面接かどうかで値が変化
-		)
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, newTask, taskParam.LossReason, taskParam.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
 
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return err
-		}
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
+
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		newTaskPhaseSideEffectParamInBatchProcessing(newTask, batchParam, taskParam),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return err
 	}
 
 	/************ 選考の候補日時を登録する **************/
@@ -1376,6 +1377,12 @@ func createSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTas
 			fmt.Println(err)
 			return err
 		}
+
+		err = createSelectionDateEvent(i.taskGroupEventRepository, batchParam.TaskGroupID, jobSeekerSchedule, taskParam.ExecutedStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
 	}
 
 	/************ 選考の確定日時と当日詳細を更新する **************/
@@ -1403,6 +1410,12 @@ func createSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTas
 			fmt.Println(err)
 			return err
 		}
+
+		err = createSelectionDateEvent(i.taskGroupEventRepository, batchParam.TaskGroupID, jobSeekerSchedule, taskParam.ExecutedStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
 	}
 
 	/************ リスケ時の処理（確定日時と同じ日時にリスケ日程を作成） **************/
@@ -1655,7 +1668,7 @@ func createSelectionPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTas
 }
 
 // 内定保留選考フェーズのタスクを作成する処理
-func createHoldJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam) error {
+func createHoldJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam, operator *entity.AgentStaff) error {
 	var (
 		err          error
 		nextPhase    = taskParam.PhaseCategory
@@ -1668,6 +1681,13 @@ func createHoldJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.Create
 		return errors.New("現在フェーズが内定保留フェーズではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, batchParam.TaskGroupID, operator, taskParam.PhaseCategory, taskParam.PhaseSubCategory, taskParam.StaffType, taskParam.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
 	/************ 新リスケ処理（リスケ + 削除） 元のリスケ処理も残しておく **************/
 
 	if taskParam.TaskOption == null.NewInt(entity.OptionRescheduleAndDelete, true) {
@@ -1702,7 +1722,7 @@ func createHoldJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.Create
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(rescheduleTask)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, rescheduleTask)
 		if err != nil {
 			fmt.Println(err)
 			return err
@@ -1725,30 +1745,35 @@ func createHoldJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.Create
 	)
 
 	// タスク作成
-	err = i.taskRepository.Create(task)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 	if err != nil {
 		fmt.Println(err)
 		return err
 	}
 
-	// 内定承諾/内定承諾の場合はヨミ情報を更新する
-	if nextPhase == null.NewInt(int64(entity.AcceptJobOffer), true) && nextPhaseSub == null.NewInt(int64(entity.Accept), true) {
-		sale, err := i.saleRepository.FindByJobSeekerIDAndJobInformationID(batchParam.JobSeekerID, batchParam.JobInformationID)
-		if err != nil {
-			if errors.Is(err, entity.ErrNotFound) {
-				fmt.Println("ヨミの登録がありません")
-			} else {
-				fmt.Println(err)
-				return err
-			}
-		} else {
-			sale.Accuracy = null.NewInt(entity.AccuracyAccept, true) // ヨミのみ「内定承諾: 0」に更新
-			err = i.saleRepository.Update(sale.ID, sale)
-			if err != nil {
-				fmt.Println(err)
-				return err
-			}
-		}
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, task, taskParam.LossReason, taskParam.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
+
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		newTaskPhaseSideEffectParamInBatchProcessing(task, batchParam, taskParam),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return err
 	}
 
 	/************ 選考の候補日時を登録する **************/
@@ -1830,6 +1855,12 @@ func createHoldJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.Create
 			fmt.Println(err)
 			return err
 		}
+
+		err = createSelectionDateEvent(i.taskGroupEventRepository, batchParam.TaskGroupID, jobSeekerSchedule, taskParam.ExecutedStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
 	}
 
 	/************ メール or LINEの送付 **************/
@@ -1967,7 +1998,7 @@ func createHoldJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.Create
 }
 
 // 内定承諾選考フェーズのタスクを作成する処理
-func createAcceptJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam) error {
+func createAcceptJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam, operator *entity.AgentStaff) error {
 	var (
 		err error
 	)
@@ -1977,6 +2008,13 @@ func createAcceptJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.Crea
 		return errors.New("現在フェーズが内定承諾フェーズではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, batchParam.TaskGroupID, operator, taskParam.PhaseCategory, taskParam.PhaseSubCategory, taskParam.StaffType, taskParam.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
 	task := entity.NewTask(
 		batchParam.TaskGroupID,
 		taskParam.PhaseCategory,
@@ -1993,21 +2031,35 @@ func createAcceptJobOfferPhaseTask(i *TaskInteractorImpl, batchParam entity.Crea
 	)
 
 	// タスク作成
-	err = i.taskRepository.Create(task)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 	if err != nil {
 		fmt.Println(err)
 		return err
 	}
 
-	// 内定承諾/入社確認
-	if taskParam.PhaseCategory == null.NewInt(int64(entity.AcceptJobOffer), true) && taskParam.PhaseSubCategory == null.NewInt(int64(entity.ConfirmJoiningCompany), true) {
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, task, taskParam.LossReason, taskParam.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
 
-		// 入社日の登録
-		err = i.taskGroupRepository.UpdateJoiningDate(batchParam.TaskGroupID, taskParam.JoiningDate)
-		if err != nil {
-			fmt.Println(err)
-			return err
-		}
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
+
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		newTaskPhaseSideEffectParamInBatchProcessing(task, batchParam, taskParam),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return err
 	}
 
 	return nil
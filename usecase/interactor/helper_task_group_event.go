@@ -0,0 +1,383 @@
+package interactor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 活動履歴に差分を残す項目
+var (
+	taskGroupEventSelectionDateFieldList   = []string{"title", "start_time", "end_time"}
+	taskGroupEventEvaluationPointFieldList = []string{"good_point", "ng_point", "is_passed", "is_re_interview"}
+	taskGroupEventSaleFieldList            = []string{
+		"accuracy",
+		"contract_signed_month",
+		"billing_month",
+		"billing_amount",
+		"cost",
+		"gross_profit",
+		"ra_staff_id",
+		"ca_staff_id",
+		"ra_sales_ratio",
+		"ca_sales_ratio",
+	}
+)
+
+// タスクを作成し、フェーズの変更を活動履歴に記録する
+// 変更前のフェーズは作成前のタスクグループの最新タスク、操作した担当者はタスクの実行者とする
+func createTaskWithEvent(
+	taskRepository usecase.TaskRepository,
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	task *entity.Task,
+) error {
+	var (
+		prevPhase    = null.NewInt(0, false)
+		prevPhaseSub = null.NewInt(0, false)
+	)
+
+	latestTask, err := taskRepository.FindLatestByGroupID(task.TaskGroupID)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			fmt.Println(err)
+			return err
+		}
+	} else {
+		prevPhase = latestTask.PhaseCategory
+		prevPhaseSub = latestTask.PhaseSubCategory
+	}
+
+	err = taskRepository.Create(task)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	event := entity.NewTaskGroupEvent(
+		task.TaskGroupID,
+		0,
+		0,
+		entity.TaskGroupEventPhaseChange,
+		getTaskGroupEventStaffID(task.ExecutedStaffID),
+		null.NewInt(int64(task.ID), true),
+		prevPhase,
+		prevPhaseSub,
+		task.PhaseCategory,
+		task.PhaseSubCategory,
+		"[]",
+	)
+
+	err = taskGroupEventRepository.Create(event)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// タスクの削除（フェーズの取り消し）を活動履歴に記録する
+// latestTaskは削除後の最新タスク（タスクが残っていない場合はnil）
+func createTaskCancelEvent(
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	deletedTask *entity.Task,
+	latestTask *entity.Task,
+	agentStaffID uint,
+) error {
+	var (
+		phase    = null.NewInt(0, false)
+		phaseSub = null.NewInt(0, false)
+	)
+
+	if latestTask != nil {
+		phase = latestTask.PhaseCategory
+		phaseSub = latestTask.PhaseSubCategory
+	}
+
+	event := entity.NewTaskGroupEvent(
+		deletedTask.TaskGroupID,
+		0,
+		0,
+		entity.TaskGroupEventPhaseCancel,
+		getTaskGroupEventStaffID(agentStaffID),
+		null.NewInt(int64(deletedTask.ID), true),
+		deletedTask.PhaseCategory,
+		deletedTask.PhaseSubCategory,
+		phase,
+		phaseSub,
+		"[]",
+	)
+
+	err := taskGroupEventRepository.Create(event)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// タスクグループの書類の更新を活動履歴に記録する
+func createDocumentUpdateEvent(
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	taskGroupID uint,
+	documentType uint,
+	beforeURL string,
+	afterURL string,
+	agentStaffID uint,
+) error {
+	diff, err := json.Marshal([]entity.AuditLogFieldDiff{
+		{
+			Field:  fmt.Sprintf("document%d_url", documentType),
+			Before: beforeURL,
+			After:  afterURL,
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	event := entity.NewTaskGroupEvent(
+		taskGroupID,
+		0,
+		0,
+		entity.TaskGroupEventDocumentUpdate,
+		getTaskGroupEventStaffID(agentStaffID),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		string(diff),
+	)
+
+	err = taskGroupEventRepository.Create(event)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 選考の確定日時の登録・変更を活動履歴に記録する（変更前の日時は直前の同じイベントで確認する）
+func createSelectionDateEvent(
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	taskGroupID uint,
+	schedule *entity.JobSeekerSchedule,
+	agentStaffID uint,
+) error {
+	diff, err := getTaskGroupEventDiff(nil, schedule, taskGroupEventSelectionDateFieldList)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	event := entity.NewTaskGroupEvent(
+		taskGroupID,
+		0,
+		0,
+		entity.TaskGroupEventSelectionDateChange,
+		getTaskGroupEventStaffID(agentStaffID),
+		schedule.TaskID,
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		diff,
+	)
+
+	err = taskGroupEventRepository.Create(event)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 評価ポイントを作成し、活動履歴に記録する
+func createEvaluationPointWithEvent(
+	evaluationPointRepository usecase.EvaluationPointRepository,
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	evaluationPoint *entity.EvaluationPoint,
+	agentStaffID uint,
+) error {
+	err := evaluationPointRepository.Create(evaluationPoint)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	diff, err := getTaskGroupEventDiff(nil, evaluationPoint, taskGroupEventEvaluationPointFieldList)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	event := entity.NewTaskGroupEvent(
+		0,
+		evaluationPoint.JobSeekerID,
+		evaluationPoint.JobInformationID,
+		entity.TaskGroupEventEvaluationPoint,
+		getTaskGroupEventStaffID(agentStaffID),
+		null.NewInt(int64(evaluationPoint.TaskID), true),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		diff,
+	)
+
+	err = taskGroupEventRepository.Create(event)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 売上を作成し、活動履歴に記録する
+func createSaleWithEvent(
+	saleRepository usecase.SaleRepository,
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	sale *entity.Sale,
+	agentStaffID uint,
+) error {
+	err := saleRepository.Create(sale)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return createSaleEvent(taskGroupEventRepository, entity.TaskGroupEventSaleCreate, nil, sale, agentStaffID)
+}
+
+// 売上を更新し、変更前後の値を活動履歴に記録する
+func updateSaleWithEvent(
+	saleRepository usecase.SaleRepository,
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	saleID uint,
+	before *entity.Sale,
+	after *entity.Sale,
+	agentStaffID uint,
+) error {
+	err := saleRepository.Update(saleID, after)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return createSaleEvent(taskGroupEventRepository, entity.TaskGroupEventSaleUpdate, before, after, agentStaffID)
+}
+
+func createSaleEvent(
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	eventType uint,
+	before *entity.Sale,
+	after *entity.Sale,
+	agentStaffID uint,
+) error {
+	var beforeTarget interface{}
+	if before != nil {
+		beforeTarget = before
+	}
+
+	diff, err := getTaskGroupEventDiff(beforeTarget, after, taskGroupEventSaleFieldList)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	event := entity.NewTaskGroupEvent(
+		0,
+		after.JobSeekerID,
+		after.JobInformationID,
+		eventType,
+		getTaskGroupEventStaffID(agentStaffID),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		diff,
+	)
+
+	err = taskGroupEventRepository.Create(event)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 指定した項目の変更前後の値をJSON配列で返す（beforeがnilの場合は作成として変更後の値のみを記録する）
+func getTaskGroupEventDiff(before, after interface{}, fieldList []string) (string, error) {
+	var (
+		beforeSnapshot map[string]interface{}
+		afterSnapshot  map[string]interface{}
+	)
+
+	if before != nil {
+		snapshot, err := toAuditSnapshot(before)
+		if err != nil {
+			return "", err
+		}
+		beforeSnapshot = pickTaskGroupEventField(snapshot, fieldList)
+	}
+
+	if after != nil {
+		snapshot, err := toAuditSnapshot(after)
+		if err != nil {
+			return "", err
+		}
+		afterSnapshot = pickTaskGroupEventField(snapshot, fieldList)
+	}
+
+	diff, err := json.Marshal(getAuditLogFieldDiffList(beforeSnapshot, afterSnapshot))
+	if err != nil {
+		return "", err
+	}
+
+	return string(diff), nil
+}
+
+func pickTaskGroupEventField(snapshot map[string]interface{}, fieldList []string) map[string]interface{} {
+	picked := map[string]interface{}{}
+
+	for _, field := range fieldList {
+		picked[field] = snapshot[field]
+	}
+
+	return picked
+}
+
+// 操作した担当者（0の場合は特定できないためnull）
+func getTaskGroupEventStaffID(agentStaffID uint) null.Int {
+	return null.NewInt(int64(agentStaffID), agentStaffID > 0)
+}
+
+// 操作した担当者のID（ログイン中の担当者がいない場合は0）
+func getOperatorID(operator *entity.AgentStaff) uint {
+	if operator == nil {
+		return 0
+	}
+
+	return operator.ID
+}
+
+// 活動履歴に表示用の名称を設定する
+func setTaskGroupEventName(eventList []*entity.TaskGroupEvent) {
+	for _, event := range eventList {
+		event.EventName = entity.TaskGroupEventName[event.EventType]
+		event.PrevPhaseName, event.PrevPhaseSubName = getStrTaskPhaseAndPhaseSub(event.PrevPhaseCategory, event.PrevPhaseSubCategory)
+		event.PhaseName, event.PhaseSubName = getStrTaskPhaseAndPhaseSub(event.PhaseCategory, event.PhaseSubCategory)
+	}
+}
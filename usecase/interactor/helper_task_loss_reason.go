@@ -0,0 +1,46 @@
+package interactor
+
+import (
+	"fmt"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 作成したタスクが辞退・不合格の終了の場合は理由を記録する（それ以外のタスクの場合は何もしない）
+// 理由の選択は validateNextTaskPhase で確認済みのものとする
+func createTaskLossReason(
+	taskLossReasonRepository usecase.TaskLossReasonRepository,
+	task *entity.Task,
+	lossReason null.Int,
+	remarks string,
+) error {
+	lossType, ok := policy.GetTaskLossType(entity.TaskPhaseState{
+		Phase:    task.PhaseCategory.Int64,
+		PhaseSub: task.PhaseSubCategory.Int64,
+	})
+	if !ok || !lossReason.Valid {
+		return nil
+	}
+
+	taskLossReason := entity.NewTaskLossReason(
+		task.TaskGroupID,
+		task.ID,
+		task.PhaseCategory.Int64,
+		task.PhaseSubCategory.Int64,
+		lossType,
+		lossReason.Int64,
+		remarks,
+		getTaskGroupEventStaffID(task.ExecutedStaffID),
+	)
+
+	err := taskLossReasonRepository.Create(taskLossReason)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 遷移に伴う処理の入力（タスクの個別作成・一括操作で共通）
+type taskPhaseSideEffectParam struct {
+	Task                   *entity.Task // 遷移で作成したタスク
+	JobSeekerID            uint
+	JobInformationID       uint
+	SelectionInformationID null.Int // 評価点に紐付ける選考情報（選考フローを選択していない場合はnull）
+	GoodPoint              string
+	NGPoint                string
+	JoiningDate            string
+	ExecutedStaffID        uint // 活動履歴に記録する担当者
+}
+
+// 次のタスクのリクエストから遷移に伴う処理の入力を作成する
+func newTaskPhaseSideEffectParam(task *entity.Task, nextTask entity.NextTaskParam) taskPhaseSideEffectParam {
+	return taskPhaseSideEffectParam{
+		Task:                   task,
+		JobSeekerID:            nextTask.JobSeekerID,
+		JobInformationID:       nextTask.JobInformationID,
+		SelectionInformationID: nextTask.SelectionInformationID,
+		GoodPoint:              nextTask.GoodPoint,
+		NGPoint:                nextTask.NGPoint,
+		JoiningDate:            nextTask.JoiningDate,
+		ExecutedStaffID:        nextTask.ExecutedStaffID,
+	}
+}
+
+// タスクの一括処理（CreateTaskInBatchProcessing）のリクエストから遷移に伴う処理の入力を作成する
+func newTaskPhaseSideEffectParamInBatchProcessing(task *entity.Task, batchParam entity.CreateTaskInBatchProcessingParam, taskParam entity.TaskParam) taskPhaseSideEffectParam {
+	return taskPhaseSideEffectParam{
+		Task:                   task,
+		JobSeekerID:            batchParam.JobSeekerID,
+		JobInformationID:       batchParam.JobInformationID,
+		SelectionInformationID: taskParam.SelectionInformationID,
+		GoodPoint:              taskParam.GoodPoint,
+		NGPoint:                taskParam.NGPoint,
+		JoiningDate:            taskParam.JoiningDate,
+		ExecutedStaffID:        taskParam.ExecutedStaffID,
+	}
+}
+
+// 遷移の定義（SideEffectList）に従って、評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成を行う
+// 候補日時の登録やメール・メッセージの送信など、リクエストごとの入力が必要な処理は各フェーズのタスク作成で行う
+func runTaskPhaseSideEffects(
+	taskGroupRepository usecase.TaskGroupRepository,
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	evaluationPointRepository usecase.EvaluationPointRepository,
+	saleRepository usecase.SaleRepository,
+	jobOfferRepository usecase.JobOfferRepository,
+	jobInformationRepository usecase.JobInformationRepository,
+	agentRepository usecase.AgentRepository,
+	transition entity.TaskPhaseTransition,
+	param taskPhaseSideEffectParam,
+) error {
+	for _, sideEffect := range transition.SideEffectList {
+		var err error
+
+		switch sideEffect {
+		case entity.TaskPhaseSideEffectEvaluationPass:
+			err = createTaskPhaseEvaluationPoint(evaluationPointRepository, taskGroupEventRepository, param, true, false)
+		case entity.TaskPhaseSideEffectEvaluationFail:
+			err = createTaskPhaseEvaluationPoint(evaluationPointRepository, taskGroupEventRepository, param, false, false)
+		case entity.TaskPhaseSideEffectEvaluationReInterview:
+			err = createTaskPhaseEvaluationPoint(evaluationPointRepository, taskGroupEventRepository, param, false, true)
+		case entity.TaskPhaseSideEffectSaleAccept:
+			err = updateTaskPhaseSaleAccuracy(saleRepository, taskGroupEventRepository, param, entity.AccuracyAccept)
+		case entity.TaskPhaseSideEffectSaleFailure:
+			err = updateTaskPhaseSaleAccuracy(saleRepository, taskGroupEventRepository, param, entity.AccuracyFailure)
+		case entity.TaskPhaseSideEffectJoiningDate:
+			err = taskGroupRepository.UpdateJoiningDate(param.Task.TaskGroupID, param.JoiningDate)
+		case entity.TaskPhaseSideEffectDraftSale:
+			// 請求月に入社日を使うため、入社日の登録より後に定義する
+			err = createDraftSale(
+				taskGroupRepository,
+				jobOfferRepository,
+				saleRepository,
+				jobInformationRepository,
+				agentRepository,
+				taskGroupEventRepository,
+				param.Task.TaskGroupID,
+				param.ExecutedStaffID,
+			)
+		}
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// タスクグループの依頼時間を更新する
+// 同じ側（RA→RA・CA→CA）への依頼は両方、反対側への依頼は依頼した側の時間を更新する
+func updateTaskLastRequestAt(
+	taskGroupRepository usecase.TaskGroupRepository,
+	taskGroupID uint,
+	prevStaffType null.Int,
+	staffType null.Int,
+) error {
+	var err error
+
+	if prevStaffType == null.NewInt(int64(entity.CA), true) {
+		if staffType == null.NewInt(int64(entity.CA), true) {
+			// CAからCAにタスク作成する場合
+			err = taskGroupRepository.UpdateLastRequestAt(taskGroupID)
+		} else {
+			// CAからRAにタスク作成する場合
+			err = taskGroupRepository.UpdateCALastRequestAt(taskGroupID)
+		}
+	} else {
+		if staffType == null.NewInt(int64(entity.RA), true) {
+			// RAからRAにタスク作成する場合
+			err = taskGroupRepository.UpdateLastRequestAt(taskGroupID)
+		} else {
+			// RAからCAにタスク作成する場合
+			err = taskGroupRepository.UpdateRALastRequestAt(taskGroupID)
+		}
+	}
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 作成したタスクを実行する担当者にプッシュ通知を送る
+// 通知の失敗ではタスクの作成を止めないため、送信エラーはログのみ出力する
+func pushTaskRequestNotification(
+	agentStaffRepository usecase.AgentStaffRepository,
+	oneSignal config.OneSignal,
+	staffType null.Int,
+	caStaffID uint,
+	raStaffID uint,
+) error {
+	var (
+		firebaseID  = ""
+		contents    = ""
+		topic       = ""
+		redirectURL = os.Getenv("BASE_DOMAIN")
+	)
+
+	if staffType == null.NewInt(int64(entity.CA), true) {
+		contents = "新着CAタスクの依頼があります。"
+		topic = "TaskCA"
+
+		caStaff, err := agentStaffRepository.FindByID(caStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+
+		firebaseID = caStaff.FirebaseID
+
+	} else if staffType == null.NewInt(int64(entity.RA), true) {
+		contents = "新着RAタスクの依頼があります。"
+		topic = "TaskRA"
+
+		raStaff, err := agentStaffRepository.FindByID(raStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+
+		firebaseID = raStaff.FirebaseID
+	}
+
+	err := utility.WebPush(
+		oneSignal.AppID,
+		oneSignal.APIKey,
+		firebaseID,
+		"タスク通知",
+		contents,
+		topic,
+		redirectURL,
+	)
+	if err != nil {
+		fmt.Println("WebPushの通知")
+		fmt.Println(err)
+	}
+
+	return nil
+}
+
+// 評価点を作成する
+func createTaskPhaseEvaluationPoint(
+	evaluationPointRepository usecase.EvaluationPointRepository,
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	param taskPhaseSideEffectParam,
+	isPassed bool,
+	isReInterview bool,
+) error {
+	evaluationPoint := entity.NewEvaluationPoint(
+		param.Task.ID,
+		param.SelectionInformationID,
+		param.JobSeekerID,
+		param.JobInformationID,
+		param.GoodPoint,
+		param.NGPoint,
+		isPassed,
+		isReInterview,
+	)
+
+	return createEvaluationPointWithEvent(evaluationPointRepository, taskGroupEventRepository, evaluationPoint, param.ExecutedStaffID)
+}
+
+// ヨミを更新する（ヨミの登録がない場合は何もしない）
+func updateTaskPhaseSaleAccuracy(
+	saleRepository usecase.SaleRepository,
+	taskGroupEventRepository usecase.TaskGroupEventRepository,
+	param taskPhaseSideEffectParam,
+	accuracy int64,
+) error {
+	sale, err := saleRepository.FindByJobSeekerIDAndJobInformationID(param.JobSeekerID, param.JobInformationID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			fmt.Println("ヨミの登録がありません")
+			return nil
+		}
+		fmt.Println(err)
+		return err
+	}
+
+	beforeSale := *sale
+	sale.Accuracy = null.NewInt(accuracy, true)
+
+	return updateSaleWithEvent(saleRepository, taskGroupEventRepository, sale.ID, &beforeSale, sale, param.ExecutedStaffID)
+}
@@ -0,0 +1,534 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+type InboundEntryInteractor interface {
+	// 汎用系 API
+	CreateJobSeekerFromInboundEntry(input CreateJobSeekerFromInboundEntryInput) (CreateJobSeekerFromInboundEntryOutput, error)
+}
+
+type InboundEntryInteractorImpl struct {
+	firebase                                usecase.Firebase
+	sendgrid                                config.Sendgrid
+	oneSignal                               config.OneSignal
+	agentStaffRepository                    usecase.AgentStaffRepository
+	jobSeekerRepository                     usecase.JobSeekerRepository
+	jobSeekerExternalIDRepository           usecase.JobSeekerExternalIDRepository
+	jobSeekerMergeSuggestionRepository      usecase.JobSeekerMergeSuggestionRepository
+	jobSeekerStudentHistoryRepository       usecase.JobSeekerStudentHistoryRepository
+	jobSeekerWorkHistoryRepository          usecase.JobSeekerWorkHistoryRepository
+	jobSeekerDepartmentHistoryRepository    usecase.JobSeekerDepartmentHistoryRepository
+	jobSeekerLicenseRepository              usecase.JobSeekerLicenseRepository
+	jobSeekerDesiredIndustryRepository      usecase.JobSeekerDesiredIndustryRepository
+	jobSeekerDesiredOccupationRepository    usecase.JobSeekerDesiredOccupationRepository
+	jobSeekerDesiredWorkLocationRepository  usecase.JobSeekerDesiredWorkLocationRepository
+	jobSeekerDocumentRepository             usecase.JobSeekerDocumentRepository
+	chatGroupWithJobSeekerRepository        usecase.ChatGroupWithJobSeekerRepository
+	interviewTaskGroupRepository            usecase.InterviewTaskGroupRepository
+	interviewTaskRepository                 usecase.InterviewTaskRepository
+	userEntryRepository                     usecase.UserEntryRepository
+	agentAssignmentRuleRepository           usecase.AgentAssignmentRuleRepository
+	agentAssignmentStaffRepository          usecase.AgentAssignmentStaffRepository
+	agentAssignmentStaffSpecialtyRepository usecase.AgentAssignmentStaffSpecialtyRepository
+	jobSeekerAssignmentLogRepository        usecase.JobSeekerAssignmentLogRepository
+	entryScreeningRuleRepository            usecase.EntryScreeningRuleRepository
+	entryScreeningRuleConditionRepository   usecase.EntryScreeningRuleConditionRepository
+	entryScreeningResultRepository          usecase.EntryScreeningResultRepository
+}
+
+// InboundEntryInteractorImpl is an implementation of InboundEntryInteractor
+func NewInboundEntryInteractorImpl(
+	fb usecase.Firebase,
+	sg config.Sendgrid,
+	os config.OneSignal,
+	asR usecase.AgentStaffRepository,
+	jsR usecase.JobSeekerRepository,
+	jseiR usecase.JobSeekerExternalIDRepository,
+	jsmsR usecase.JobSeekerMergeSuggestionRepository,
+	jsshR usecase.JobSeekerStudentHistoryRepository,
+	jswhR usecase.JobSeekerWorkHistoryRepository,
+	jsdhR usecase.JobSeekerDepartmentHistoryRepository,
+	jslR usecase.JobSeekerLicenseRepository,
+	jsdiR usecase.JobSeekerDesiredIndustryRepository,
+	jsdoR usecase.JobSeekerDesiredOccupationRepository,
+	jsdwlR usecase.JobSeekerDesiredWorkLocationRepository,
+	jsdR usecase.JobSeekerDocumentRepository,
+	cgjsR usecase.ChatGroupWithJobSeekerRepository,
+	itgR usecase.InterviewTaskGroupRepository,
+	itR usecase.InterviewTaskRepository,
+	ueR usecase.UserEntryRepository,
+	aarR usecase.AgentAssignmentRuleRepository,
+	aasR usecase.AgentAssignmentStaffRepository,
+	aassR usecase.AgentAssignmentStaffSpecialtyRepository,
+	jsalR usecase.JobSeekerAssignmentLogRepository,
+	esrR usecase.EntryScreeningRuleRepository,
+	esrcR usecase.EntryScreeningRuleConditionRepository,
+	esresR usecase.EntryScreeningResultRepository,
+) InboundEntryInteractor {
+	return &InboundEntryInteractorImpl{
+		firebase:                                fb,
+		sendgrid:                                sg,
+		oneSignal:                               os,
+		agentStaffRepository:                    asR,
+		jobSeekerRepository:                     jsR,
+		jobSeekerExternalIDRepository:           jseiR,
+		jobSeekerMergeSuggestionRepository:      jsmsR,
+		jobSeekerStudentHistoryRepository:       jsshR,
+		jobSeekerWorkHistoryRepository:          jswhR,
+		jobSeekerDepartmentHistoryRepository:    jsdhR,
+		jobSeekerLicenseRepository:              jslR,
+		jobSeekerDesiredIndustryRepository:      jsdiR,
+		jobSeekerDesiredOccupationRepository:    jsdoR,
+		jobSeekerDesiredWorkLocationRepository:  jsdwlR,
+		jobSeekerDocumentRepository:             jsdR,
+		chatGroupWithJobSeekerRepository:        cgjsR,
+		interviewTaskGroupRepository:            itgR,
+		interviewTaskRepository:                 itR,
+		userEntryRepository:                     ueR,
+		agentAssignmentRuleRepository:           aarR,
+		agentAssignmentStaffRepository:          aasR,
+		agentAssignmentStaffSpecialtyRepository: aassR,
+		jobSeekerAssignmentLogRepository:        jsalR,
+		entryScreeningRuleRepository:            esrR,
+		entryScreeningRuleConditionRepository:   esrcR,
+		entryScreeningResultRepository:          esresR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// 提携求人媒体・自社フォームからの応募者を取り込む
+type CreateJobSeekerFromInboundEntryInput struct {
+	Operator *entity.AgentStaff // 提携媒体はAPIキーの発行者、自社フォームはログイン中の担当者
+	Param    entity.CreateJobSeekerFromInboundEntryParam
+}
+
+type CreateJobSeekerFromInboundEntryOutput struct {
+	Result entity.InboundEntryResult
+}
+
+func (i *InboundEntryInteractorImpl) CreateJobSeekerFromInboundEntry(input CreateJobSeekerFromInboundEntryInput) (CreateJobSeekerFromInboundEntryOutput, error) {
+	var (
+		output CreateJobSeekerFromInboundEntryOutput
+		param  = input.Param
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrRequestError, "認証情報がありません")
+	}
+
+	// 流入元媒体のタイプをチェック
+	if _, ok := entity.JobSeekerExternalTypeLabel[param.ExternalType.Int64]; !ok || !param.ExternalType.Valid {
+		return output, fmt.Errorf("%w:%s", entity.ErrRequestError, "流入元媒体のタイプが正しくありません")
+	}
+
+	// 取り込み先のエージェントは実行した担当者のエージェント
+	agentStaff := input.Operator
+
+	/********* 重複チェック *********/
+
+	// 同じ媒体IDで取り込み済みの場合は既存の求職者を返す（再送されたエントリーは取り込み履歴も記録しない）
+	// 同時に再送された場合は (agent_id, external_type, external_id) のユニークキーで後続の登録が失敗する
+	externalID, err := i.jobSeekerExternalIDRepository.FindByAgentIDAndExternalTypeAndExternalID(agentStaff.AgentID, param.ExternalType, param.ExternalID)
+	if err == nil {
+		jobSeeker, err := i.jobSeekerRepository.FindByID(externalID.JobSeekerID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		output.Result = entity.InboundEntryResult{
+			JobSeekerID: jobSeeker.ID,
+			UUID:        jobSeeker.UUID,
+			IsDuplicate: true,
+		}
+		return output, nil
+	} else if !errors.Is(err, entity.ErrNotFound) {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 正規化した氏名・フリガナ・電話番号・メールアドレスで重複を判定
+	target := &entity.JobSeeker{
+		LastName:      param.LastName,
+		FirstName:     param.FirstName,
+		LastFurigana:  param.LastFurigana,
+		FirstFurigana: param.FirstFurigana,
+		Birthday:      param.Birthday,
+		PhoneNumber:   param.PhoneNumber,
+		Email:         param.Email,
+	}
+
+	duplicateJobSeeker, matchType, matchReason, err := findDuplicateJobSeekerOnEntry(i.jobSeekerRepository, agentStaff.AgentID, target)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 取り込み履歴を記録（バッチの取り込み対象外にするため処理済みで登録）
+	userEntry := entity.NewUserEntry(param.ExternalID, param.ExternalType)
+	userEntry.IsProcessed = true
+
+	err = i.userEntryRepository.Create(userEntry)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 同一人物の場合は既存の求職者に流入元を紐付ける
+	if matchType == entity.JobSeekerDuplicateMatchCertain {
+		err = attachEntrySourceToJobSeeker(
+			i.jobSeekerRepository,
+			i.jobSeekerExternalIDRepository,
+			duplicateJobSeeker,
+			param.ExternalType.Int64,
+			param.ExternalID,
+			param.InflowChannelID,
+			param.Memo,
+		)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		output.Result = entity.InboundEntryResult{
+			JobSeekerID: duplicateJobSeeker.ID,
+			UUID:        duplicateJobSeeker.UUID,
+			IsDuplicate: true,
+		}
+		return output, nil
+	}
+
+	/********* 求職者の作成 *********/
+
+	jobSeeker := entity.NewJobSeeker(
+		agentStaff.AgentID,
+		null.NewInt(int64(agentStaff.ID), true), // 担当CAは実行した担当者
+		NullInt,
+		param.LastName,
+		param.FirstName,
+		param.LastFurigana,
+		param.FirstFurigana,
+		param.Gender,
+		"",
+		param.Birthday,
+		NullInt,                   // 配偶者
+		NullInt,                   // 配偶者扶養義務
+		NullInt,                   // 扶養家族人数
+		param.PhoneNumber,         // 電話番号
+		param.Email,               // メールアドレス
+		"",                        // 緊急連絡先
+		param.PostCode,            // 郵便番号
+		param.Prefecture,          // 都道府県
+		param.Address,             // 住所
+		"",                        // 住所フリガナ
+		param.StateOfEmployment,   // 就業状況
+		param.JobSummary,          // 職務要約
+		"",                        // 経歴補足
+		"",                        // 研究内容・学チカ
+		NullInt,                   // 入社可能時期
+		param.JobChange,           // 転職回数
+		param.AnnualIncome,        // 直近の年収
+		param.DesiredAnnualIncome, // 希望年収
+		NullInt,                   // 転職可否
+		"",                        // 転勤条件
+		NullInt,                   // 短期離職
+		"",                        // 短期離職補足
+		NullInt,                   // 既往歴
+		param.Nationality,         // 国籍
+		NullInt,                   // アピアランス
+		NullInt,                   // コミュ力
+		NullInt,                   // 論理的思考力
+		"",                        // 人物像（推薦状用）
+		"",                        // 人物像（本音）
+		fmt.Sprintf("【%s】媒体ID: %s\n%s", entity.JobSeekerExternalTypeLabel[param.ExternalType.Int64], param.ExternalID, param.Memo), // メモ
+		NullInt, // 転職・就活状況
+		"",      // 推薦理由
+		null.NewInt(int64(entity.EntryInterview), true), // フェーズ（エントリーで登録）
+		time.Now().UTC(),      // 面談日時
+		null.NewInt(1, true),  // 登録状況（下書きで登録）
+		NullInt,               // 専攻学科
+		NullInt,               // ワードスキル
+		NullInt,               // エクセルスキル
+		NullInt,               // パワーポイントスキル
+		param.InflowChannelID, // 流入経路
+		"",                    // 国籍備考
+		"",                    // 既往歴備考
+		"",                    // 応募承諾のポイント
+	)
+
+	// 得意分野での割り振りに使うため希望職種・希望勤務地をセット
+	for _, desiredOccupation := range param.DesiredOccupations {
+		jobSeeker.DesiredOccupations = append(jobSeeker.DesiredOccupations, entity.JobSeekerDesiredOccupation{
+			DesiredOccupation: desiredOccupation,
+		})
+	}
+	for _, desiredWorkLocation := range param.DesiredWorkLocations {
+		jobSeeker.DesiredWorkLocations = append(jobSeeker.DesiredWorkLocations, entity.JobSeekerDesiredWorkLocation{
+			DesiredWorkLocation: desiredWorkLocation,
+		})
+	}
+
+	// 選考ルールで対象外の求職者を判定（該当した場合はフェーズ・ユーザーステータスを変更）
+	screeningRule, err := screenEntryJobSeeker(
+		i.entryScreeningRuleRepository,
+		i.entryScreeningRuleConditionRepository,
+		jobSeeker,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 自動割り振りルールに従って担当CAをセット（ルールがない場合は実行した担当者）
+	assignmentLog, err := assignCAStaffByRule(
+		i.agentAssignmentRuleRepository,
+		i.agentAssignmentStaffRepository,
+		i.agentAssignmentStaffSpecialtyRepository,
+		i.jobSeekerRepository,
+		jobSeeker,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.jobSeekerRepository.Create(jobSeeker)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 割り振り履歴を保存して担当CAに通知
+	err = recordJobSeekerAssignment(
+		i.jobSeekerAssignmentLogRepository,
+		i.agentStaffRepository,
+		i.oneSignal,
+		jobSeeker,
+		assignmentLog,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 選考ルールに該当した求職者を記録
+	err = recordEntryScreeningResult(
+		i.entryScreeningResultRepository,
+		jobSeeker,
+		screeningRule,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 流入元を記録（重複の疑いがある場合は統合候補に登録）
+	var suspected *entity.JobSeeker
+	if matchType == entity.JobSeekerDuplicateMatchSuspected {
+		suspected = duplicateJobSeeker
+	}
+
+	err = registerEntrySourceOfNewJobSeeker(
+		i.jobSeekerExternalIDRepository,
+		i.jobSeekerMergeSuggestionRepository,
+		jobSeeker,
+		param.ExternalType.Int64,
+		param.ExternalID,
+		suspected,
+		matchReason,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 学歴
+	for _, sh := range param.StudentHistories {
+		studentHistory := entity.NewJobSeekerStudentHistory(
+			jobSeeker.ID,
+			sh.SchoolCategory,
+			sh.SchoolName,
+			NullInt, // 学校レベル
+			sh.Subject,
+			sh.EntranceYear,
+			null.NewInt(0, true), // 入学ステータス（入学）
+			sh.GraduationYear,
+			null.NewInt(0, true), // 卒業ステータス（卒業）
+		)
+
+		err = i.jobSeekerStudentHistoryRepository.Create(studentHistory)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	// 職歴
+	for _, wh := range param.WorkHistories {
+		lastStatus := null.NewInt(0, true) // 現在に至る
+		if wh.RetireYear != "" {
+			lastStatus = null.NewInt(1, true) // 一身上の都合により退職
+		}
+
+		workHistory := entity.NewJobSeekerWorkHistory(
+			jobSeeker.ID,
+			wh.CompanyName,
+			NullInt, // 従業員数（単体）
+			NullInt, // 従業員数（連結）
+			NullInt, // 株式公開
+			wh.JoiningYear,
+			wh.EmploymentStatus,
+			"", // 退職理由（本音）
+			"", // 退職理由（建前）
+			wh.RetireYear,
+			null.NewInt(0, true), // 入社ステータス（入社）
+			lastStatus,
+		)
+
+		err = i.jobSeekerWorkHistoryRepository.Create(workHistory)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		if wh.JobDescription == "" {
+			continue
+		}
+
+		departmentHistory := entity.NewJobSeekerDepartmentHistory(
+			workHistory.ID,
+			"",      // 部署
+			NullInt, // マネジメント人数
+			"",      // マネジメントの詳細
+			wh.JobDescription,
+			wh.JoiningYear,
+			wh.RetireYear,
+		)
+
+		err = i.jobSeekerDepartmentHistoryRepository.Create(departmentHistory)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	// 資格
+	duplicateLicense := make(map[null.Int]bool)
+	for _, l := range param.Licenses {
+		if duplicateLicense[l] {
+			continue
+		}
+
+		err = i.jobSeekerLicenseRepository.Create(entity.NewJobSeekerLicense(jobSeeker.ID, l, ""))
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+		duplicateLicense[l] = true
+	}
+
+	// 希望業界
+	for rank, industry := range param.DesiredIndustries {
+		err = i.jobSeekerDesiredIndustryRepository.Create(entity.NewJobSeekerDesiredIndustry(jobSeeker.ID, industry, null.NewInt(int64(rank+1), true)))
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	// 希望職種
+	for rank, occupation := range param.DesiredOccupations {
+		err = i.jobSeekerDesiredOccupationRepository.Create(entity.NewJobSeekerDesiredOccupation(jobSeeker.ID, occupation, null.NewInt(int64(rank+1), true)))
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	// 希望勤務地
+	for rank, location := range param.DesiredWorkLocations {
+		err = i.jobSeekerDesiredWorkLocationRepository.Create(entity.NewJobSeekerDesiredWorkLocation(jobSeeker.ID, location, null.NewInt(int64(rank+1), true)))
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	// 書類テーブルの作成
+	document := entity.NewJobSeekerDocument(
+		jobSeeker.ID, "", "", "", "", "", "", "", "", "", "",
+	)
+
+	err = i.jobSeekerDocumentRepository.Create(document)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// エージェントと求職者のチャットグループを作成
+	chatGroup := entity.NewChatGroupWithJobSeeker(
+		jobSeeker.AgentID,
+		jobSeeker.ID,
+		false, // 初めはLINE連携してないから false
+	)
+
+	err = i.chatGroupWithJobSeekerRepository.Create(chatGroup)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 面談調整タスクの作成（タスクの期限は当日、選考ルールで対象外となった求職者は面談調整を行わない）
+	if !isSkipInterviewAdjustmentByScreening(screeningRule) {
+		interviewTaskGroup := entity.NewInterviewTaskGroup(
+			jobSeeker.AgentID,
+			jobSeeker.ID,
+			jobSeeker.InterviewDate,
+			utility.EarliestTime(), // 初期値
+		)
+
+		err = i.interviewTaskGroupRepository.Create(interviewTaskGroup)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		interviewTask := entity.NewInterviewTask(
+			interviewTaskGroup.ID,
+			NullInt,              // タスク実行者ID
+			NullInt,              // CAのID
+			jobSeeker.Phase,      // フェーズ
+			null.NewInt(0, true), // 日程調整依頼
+			"",
+			time.Now().In(utility.Tokyo).Format("2006-01-02"),
+			null.NewInt(99, true),
+			getStrPhaseForJobSeeker(jobSeeker.Phase),
+		)
+
+		err = i.interviewTaskRepository.Create(interviewTask)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	output.Result = entity.InboundEntryResult{
+		JobSeekerID: jobSeeker.ID,
+		UUID:        jobSeeker.UUID,
+		IsDuplicate: false,
+	}
+
+	return output, nil
+}
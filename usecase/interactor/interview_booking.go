@@ -0,0 +1,548 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/line/line-bot-sdk-go/v7/linebot"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"gopkg.in/guregu/null.v4"
+)
+
+type InterviewBookingInteractor interface {
+	// Guest API
+	GetInterviewBookingByUUID(input GetInterviewBookingByUUIDInput) (GetInterviewBookingByUUIDOutput, error)
+	BookInterview(input BookInterviewInput) (BookInterviewOutput, error)
+	NotifyInterviewBooked(input NotifyInterviewBookedInput) (NotifyInterviewBookedOutput, error)
+
+	// エントリー
+	SendInterviewBookingLinkOnEntry(input SendInterviewBookingLinkOnEntryInput) (SendInterviewBookingLinkOnEntryOutput, error)
+}
+
+type InterviewBookingInteractorImpl struct {
+	firebase                              usecase.Firebase
+	sendgrid                              config.Sendgrid
+	oneSignal                             config.OneSignal
+	interviewBookingLinkRepository        usecase.InterviewBookingLinkRepository
+	interviewAdjustmentTemplateRepository usecase.InterviewAdjustmentTemplateRepository
+	scoutServiceRepository                usecase.ScoutServiceRepository
+	agentRepository                       usecase.AgentRepository
+	agentStaffRepository                  usecase.AgentStaffRepository
+	agentAssignmentStaffRepository        usecase.AgentAssignmentStaffRepository
+	jobSeekerRepository                   usecase.JobSeekerRepository
+	jobSeekerScheduleRepository           usecase.JobSeekerScheduleRepository
+	interviewTaskGroupRepository          usecase.InterviewTaskGroupRepository
+	interviewTaskRepository               usecase.InterviewTaskRepository
+	chatGroupWithJobSeekerRepository      usecase.ChatGroupWithJobSeekerRepository
+	chatMessageWithJobSeekerRepository    usecase.ChatMessageWithJobSeekerRepository
+}
+
+// InterviewBookingInteractorImpl is an implementation of InterviewBookingInteractor
+func NewInterviewBookingInteractorImpl(
+	fb usecase.Firebase,
+	sg config.Sendgrid,
+	os config.OneSignal,
+	iblR usecase.InterviewBookingLinkRepository,
+	iatR usecase.InterviewAdjustmentTemplateRepository,
+	ssR usecase.ScoutServiceRepository,
+	aR usecase.AgentRepository,
+	asR usecase.AgentStaffRepository,
+	aasR usecase.AgentAssignmentStaffRepository,
+	jsR usecase.JobSeekerRepository,
+	jssR usecase.JobSeekerScheduleRepository,
+	itgR usecase.InterviewTaskGroupRepository,
+	itR usecase.InterviewTaskRepository,
+	cgjsR usecase.ChatGroupWithJobSeekerRepository,
+	cmjsR usecase.ChatMessageWithJobSeekerRepository,
+) InterviewBookingInteractor {
+	return &InterviewBookingInteractorImpl{
+		firebase:                              fb,
+		sendgrid:                              sg,
+		oneSignal:                             os,
+		interviewBookingLinkRepository:        iblR,
+		interviewAdjustmentTemplateRepository: iatR,
+		scoutServiceRepository:                ssR,
+		agentRepository:                       aR,
+		agentStaffRepository:                  asR,
+		agentAssignmentStaffRepository:        aasR,
+		jobSeekerRepository:                   jsR,
+		jobSeekerScheduleRepository:           jssR,
+		interviewTaskGroupRepository:          itgR,
+		interviewTaskRepository:               itR,
+		chatGroupWithJobSeekerRepository:      cgjsR,
+		chatMessageWithJobSeekerRepository:    cmjsR,
+	}
+}
+
+/****************************************************************************************/
+// Guest API
+//
+// 面談予約リンクの情報と予約可能な面談枠を取得
+type GetInterviewBookingByUUIDInput struct {
+	BookingUUID uuid.UUID
+}
+
+type GetInterviewBookingByUUIDOutput struct {
+	BookingLink *entity.InterviewBookingLink
+}
+
+func (i *InterviewBookingInteractorImpl) GetInterviewBookingByUUID(input GetInterviewBookingByUUIDInput) (GetInterviewBookingByUUIDOutput, error) {
+	var (
+		output GetInterviewBookingByUUIDOutput
+	)
+
+	bookingLink, err := i.interviewBookingLinkRepository.FindByUUID(input.BookingUUID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	bookingLink.SlotList = []*entity.InterviewBookingSlot{}
+
+	// 予約済み・期限切れの場合は面談枠を返さない
+	if !bookingLink.IsBooked && time.Now().Before(bookingLink.ExpiredAt) {
+		slotList, err := getInterviewBookingSlotList(
+			i.agentAssignmentStaffRepository,
+			i.interviewTaskGroupRepository,
+			bookingLink,
+			time.Now(),
+		)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		bookingLink.SlotList = slotList
+	}
+
+	output.BookingLink = bookingLink
+
+	return output, nil
+}
+
+// 面談枠を予約する
+// 面談予約完了のタスクと求職者のスケジュールを作成する（予約完了の通知はコミット後に NotifyInterviewBooked で送る）
+type BookInterviewInput struct {
+	BookingUUID uuid.UUID
+	Param       entity.BookInterviewParam
+}
+
+type BookInterviewOutput struct {
+	BookingLink *entity.InterviewBookingLink
+}
+
+func (i *InterviewBookingInteractorImpl) BookInterview(input BookInterviewInput) (BookInterviewOutput, error) {
+	var (
+		output               BookInterviewOutput
+		reservationInterview = null.NewInt(int64(entity.ReservationInterview), true) // 面談予約完了
+		slotDuration         = time.Duration(entity.InterviewBookingSlotMinutes) * time.Minute
+	)
+
+	// 同じリンク・同じ担当CAの枠への同時予約で二重に予約されないよう、リンクと担当CAの行をロックしてから空き枠を確認する
+	bookingLink, err := i.interviewBookingLinkRepository.FindByUUIDForUpdate(input.BookingUUID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if bookingLink.IsBooked {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "すでに面談を予約済みです")
+		return output, err
+	}
+
+	if !time.Now().Before(bookingLink.ExpiredAt) {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "面談予約リンクの有効期限が切れています")
+		return output, err
+	}
+
+	// 予約時点で空いている枠か確認
+	slotList, err := getInterviewBookingSlotList(
+		i.agentAssignmentStaffRepository,
+		i.interviewTaskGroupRepository,
+		bookingLink,
+		time.Now(),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	var selectedSlot *entity.InterviewBookingSlot
+	for _, slot := range slotList {
+		if slot.StartTime.Equal(input.Param.StartTime) {
+			selectedSlot = slot
+			break
+		}
+	}
+
+	if selectedSlot == nil {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "選択した日時は予約できません。別の日時を選択してください")
+		return output, err
+	}
+
+	interviewDate := selectedSlot.StartTime.In(time.UTC)
+
+	jobSeeker, err := i.jobSeekerRepository.FindByID(bookingLink.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 面談日時を更新
+	err = i.jobSeekerRepository.UpdateInterviewDate(jobSeeker.ID, interviewDate)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// フェーズを更新する
+	err = i.jobSeekerRepository.UpdatePhase(jobSeeker.ID, reservationInterview)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// タスクの期限は当日で登録
+	date := time.Now().Format("2006-01-02")
+
+	// 面談調整タスクの作成
+	interviewTaskGroup, err := i.interviewTaskGroupRepository.FindByAgentIDAndJobSeekerID(jobSeeker.AgentID, jobSeeker.ID)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotFound) {
+			fmt.Println(err)
+			return output, err
+		}
+
+		interviewTaskGroup = entity.NewInterviewTaskGroup(
+			jobSeeker.AgentID,
+			jobSeeker.ID,
+			interviewDate,
+			interviewDate, // 初期値
+		)
+
+		err = i.interviewTaskGroupRepository.Create(interviewTaskGroup)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		err = i.createReservationInterviewTask(interviewTaskGroup.ID, bookingLink.AgentStaffID, date)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	} else {
+		err = i.interviewTaskGroupRepository.UpdateInterviewDate(interviewTaskGroup.ID, interviewDate)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		// 最新の面談タスクを取得
+		latestInterviewTask, err := i.interviewTaskRepository.FindLatestByAgentIDAndJobSeekerID(jobSeeker.AgentID, jobSeeker.ID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		// 最新のタスクと予約後のタスクが違う場合はタスクを作成する
+		if latestInterviewTask.PhaseCategory != reservationInterview {
+			err = i.createReservationInterviewTask(interviewTaskGroup.ID, bookingLink.AgentStaffID, date)
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+
+			// すでにタスクグループが存在する場合は依頼時間を更新
+			err = i.interviewTaskGroupRepository.UpdateLastRequestAt(interviewTaskGroup.ID)
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+		}
+	}
+
+	// 求職者のスケジュールを作成（例: 2023-04-01T12:00）
+	schedule := entity.NewJobSeekerSchedule(
+		jobSeeker.ID,
+		null.NewInt(0, false),
+		null.NewInt(entity.ScheduleTypeByInterview, true),
+		fmt.Sprintf("%s 面談", bookingLink.AgentName),
+		selectedSlot.StartTime.In(utility.Tokyo).Format("2006-01-02T15:04"),
+		selectedSlot.StartTime.Add(slotDuration).In(utility.Tokyo).Format("2006-01-02T15:04"),
+		"",
+		"",
+		true,
+		null.NewInt(0, true),
+	)
+
+	err = i.jobSeekerScheduleRepository.Create(schedule)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.interviewBookingLinkRepository.UpdateBooked(bookingLink.ID, interviewDate)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	bookingLink.IsBooked = true
+	bookingLink.InterviewDate = interviewDate
+	bookingLink.SlotList = []*entity.InterviewBookingSlot{}
+	output.BookingLink = bookingLink
+
+	return output, nil
+}
+
+// 求職者にメール・LINEで予約完了を通知する
+// 予約のトランザクションをコミットした後に呼び出す（ロールバックされた予約を通知しないため）
+type NotifyInterviewBookedInput struct {
+	BookingUUID uuid.UUID
+}
+
+type NotifyInterviewBookedOutput struct {
+	OK bool
+}
+
+func (i *InterviewBookingInteractorImpl) NotifyInterviewBooked(input NotifyInterviewBookedInput) (NotifyInterviewBookedOutput, error) {
+	var (
+		output NotifyInterviewBookedOutput
+	)
+
+	bookingLink, err := i.interviewBookingLinkRepository.FindByUUID(input.BookingUUID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if !bookingLink.IsBooked {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "面談が予約されていません")
+		return output, err
+	}
+
+	jobSeeker, err := i.jobSeekerRepository.FindByID(bookingLink.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.notifyInterviewBooked(bookingLink, jobSeeker, bookingLink.InterviewDate)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+// エントリー
+//
+// LP・提携求人媒体・自社フォームからエントリーした求職者に面談予約リンクを送る
+// エントリーのトランザクションをコミットした後に呼び出す（選考ルールで面談調整の対象外となった求職者には送らない）
+type SendInterviewBookingLinkOnEntryInput struct {
+	JobSeekerUUID uuid.UUID
+	ExternalType  int64 // 流入元媒体のタイプ
+}
+
+type SendInterviewBookingLinkOnEntryOutput struct {
+	OK bool
+}
+
+func (i *InterviewBookingInteractorImpl) SendInterviewBookingLinkOnEntry(input SendInterviewBookingLinkOnEntryInput) (SendInterviewBookingLinkOnEntryOutput, error) {
+	var (
+		output SendInterviewBookingLinkOnEntryOutput
+	)
+
+	jobSeeker, err := i.jobSeekerRepository.FindByUUID(input.JobSeekerUUID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 面談調整タスクがない場合は送らない
+	_, err = i.interviewTaskGroupRepository.FindByAgentIDAndJobSeekerID(jobSeeker.AgentID, jobSeeker.ID)
+	if errors.Is(err, entity.ErrNotFound) {
+		output.OK = true
+		return output, nil
+	} else if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	scoutService, err := findInterviewBookingScoutService(i.scoutServiceRepository, jobSeeker.AgentID, input.ExternalType)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = sendInterviewBookingLink(
+		i.interviewBookingLinkRepository,
+		i.interviewAdjustmentTemplateRepository,
+		i.agentRepository,
+		i.agentStaffRepository,
+		i.sendgrid.APIKey,
+		scoutService,
+		jobSeeker,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+// 共通処理
+//
+// 面談予約完了のタスクを作成
+func (i *InterviewBookingInteractorImpl) createReservationInterviewTask(interviewTaskGroupID, staffID uint, date string) error {
+	reservationInterview := null.NewInt(int64(entity.ReservationInterview), true)
+
+	interviewTask := entity.NewInterviewTask(
+		interviewTaskGroupID,
+		null.NewInt(int64(staffID), true),
+		null.NewInt(int64(staffID), true),
+		reservationInterview,
+		null.NewInt(0, true),
+		"",
+		date,
+		null.NewInt(99, true),
+		getStrPhaseForJobSeeker(reservationInterview), // SelectActionLabelは求職者のフェーズにする
+	)
+
+	err := i.interviewTaskRepository.Create(interviewTask)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 求職者にメール・LINE（連携済みの場合）で予約完了を通知し、担当CAにプッシュ通知を送る
+func (i *InterviewBookingInteractorImpl) notifyInterviewBooked(bookingLink *entity.InterviewBookingLink, jobSeeker *entity.JobSeeker, startTime time.Time) error {
+	agent, err := i.agentRepository.FindByID(bookingLink.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	staff, err := i.agentStaffRepository.FindStaffAndAgentLine(bookingLink.AgentStaffID)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	content := fmt.Sprintf(
+		"%s%s様\n\n面談のご予約を承りました。\n\n面談日時: %s\n担当: %s\n\n当日はどうぞよろしくお願いいたします。\n\n%s",
+		jobSeeker.LastName,
+		jobSeeker.FirstName,
+		startTime.In(utility.Tokyo).Format("2006/01/02 15:04"),
+		staff.StaffName,
+		agent.AgentName,
+	)
+
+	// 送信元は面談調整用のメールアドレス、未設定の場合は担当CAのメールアドレス
+	fromEmail := agent.InterviewAdjustmentEmail
+	if fromEmail == "" {
+		fromEmail = staff.Email
+	}
+
+	if jobSeeker.Email != "" {
+		err = utility.SendMailToSingleWithoutCC(
+			i.sendgrid.APIKey,
+			"面談予約完了のお知らせ",
+			content,
+			entity.EmailUser{
+				Name:  agent.AgentName,
+				Email: fromEmail,
+			},
+			entity.EmailUser{
+				Name:  jobSeeker.LastName + jobSeeker.FirstName + "様",
+				Email: jobSeeker.Email,
+			},
+			nil,
+		)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	chatGroup, err := i.chatGroupWithJobSeekerRepository.FindByAgentIDAndJobSeekerID(bookingLink.AgentID, jobSeeker.ID)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		fmt.Println(err)
+		return err
+	}
+
+	if chatGroup != nil && chatGroup.LineActive {
+		// LINE連携済みでブロックされていない場合
+		bot, err := linebot.New(staff.LineMessagingChannelSecret, staff.LineMessagingChannelAccessToken)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+
+		call, err := bot.PushMessage(
+			jobSeeker.LineID,
+			linebot.NewTextMessage(content),
+		).Do()
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+		fmt.Println("メッセージの送信に成功しました:", call)
+
+		// エージェントから求職者へのチャットメッセージを作成
+		chatMessage := entity.NewChatMessageWithJobSeeker(
+			chatGroup.ID,
+			null.NewInt(0, true), // エージェント
+			content,
+			"",
+			"",
+			"",
+			"",
+			null.NewInt(0, false),
+			"",
+			null.NewInt(0, true),
+			time.Now().In(time.UTC),
+		)
+
+		err = i.chatMessageWithJobSeekerRepository.Create(chatMessage)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	// 担当CAにプッシュ通知
+	err = utility.WebPush(
+		i.oneSignal.AppID,
+		i.oneSignal.APIKey,
+		staff.FirebaseID,
+		"面談予約通知",
+		fmt.Sprintf("%s%sさんが面談を予約しました", jobSeeker.LastName, jobSeeker.FirstName),
+		"Task",
+		os.Getenv("BASE_DOMAIN")+"/",
+	)
+	if err != nil {
+		fmt.Println("WebPushの通知でエラー")
+		fmt.Println(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,707 @@
+package interactor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/sendgrid/sendgrid-go/helpers/mail"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+type JobOfferInteractor interface {
+	// 汎用系 API
+	CreateJobOffer(input CreateJobOfferInput) (CreateJobOfferOutput, error)
+	UpdateJobOffer(input UpdateJobOfferInput) (UpdateJobOfferOutput, error)
+	UpdateJobOfferStatus(input UpdateJobOfferStatusInput) (UpdateJobOfferStatusOutput, error)
+	GetJobOfferByTaskGroupID(input GetJobOfferByTaskGroupIDInput) (GetJobOfferByTaskGroupIDOutput, error)
+	GetJobOfferComparisonByJobSeekerID(input GetJobOfferComparisonByJobSeekerIDInput) (GetJobOfferComparisonByJobSeekerIDOutput, error)
+
+	// Batch API
+	BatchNotifyJobOfferDeadline(input BatchNotifyJobOfferDeadlineInput) (BatchNotifyJobOfferDeadlineOutput, error)
+}
+
+type JobOfferInteractorImpl struct {
+	sendgrid                        config.Sendgrid
+	jobOfferRepository              usecase.JobOfferRepository
+	jobOfferStatusHistoryRepository usecase.JobOfferStatusHistoryRepository
+	jobOfferReminderRepository      usecase.JobOfferReminderRepository
+	taskGroupRepository             usecase.TaskGroupRepository
+	taskGroupEventRepository        usecase.TaskGroupEventRepository
+	jobSeekerRepository             usecase.JobSeekerRepository
+	agentStaffRepository            usecase.AgentStaffRepository
+}
+
+// JobOfferInteractorImpl is an implementation of JobOfferInteractor
+func NewJobOfferInteractorImpl(
+	sg config.Sendgrid,
+	joR usecase.JobOfferRepository,
+	joshR usecase.JobOfferStatusHistoryRepository,
+	jorR usecase.JobOfferReminderRepository,
+	tgR usecase.TaskGroupRepository,
+	tgeR usecase.TaskGroupEventRepository,
+	jsR usecase.JobSeekerRepository,
+	asR usecase.AgentStaffRepository,
+) JobOfferInteractor {
+	return &JobOfferInteractorImpl{
+		sendgrid:                        sg,
+		jobOfferRepository:              joR,
+		jobOfferStatusHistoryRepository: joshR,
+		jobOfferReminderRepository:      jorR,
+		taskGroupRepository:             tgR,
+		taskGroupEventRepository:        tgeR,
+		jobSeekerRepository:             jsR,
+		agentStaffRepository:            asR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// 選考の内定を作成する（CA側・RA側のエージェントの担当者のみ）
+type CreateJobOfferInput struct {
+	TaskGroupID uint
+	Operator    *entity.AgentStaff
+	CreateParam entity.CreateOrUpdateJobOfferParam
+}
+
+type CreateJobOfferOutput struct {
+	JobOffer *entity.JobOffer
+}
+
+func (i *JobOfferInteractorImpl) CreateJobOffer(input CreateJobOfferInput) (CreateJobOfferOutput, error) {
+	var (
+		output CreateJobOfferOutput
+		param  = input.CreateParam
+	)
+
+	taskGroup, err := i.authorizeJobOfferTaskGroup(input.Operator, input.TaskGroupID)
+	if err != nil {
+		return output, err
+	}
+
+	err = policy.ValidateJobOfferParam(param)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	_, err = i.jobOfferRepository.FindByTaskGroupID(taskGroup.ID)
+	if err == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrDuplicateEntry, "この選考の内定は登録済みです")
+	} else if !errors.Is(err, entity.ErrNotFound) {
+		fmt.Println(err)
+		return output, err
+	}
+
+	offer := entity.NewJobOffer(
+		taskGroup.ID,
+		param.Position,
+		param.EmploymentStatus,
+		param.WorkLocation,
+		param.AnnualSalary,
+		param.BaseSalary,
+		param.FixedOvertimePay,
+		param.FixedOvertimeHours,
+		param.Allowance,
+		param.Bonus,
+		param.SalaryRemarks,
+		param.StartDate,
+		param.AnswerDeadline,
+		entity.JobOfferStatusOffered,
+		param.Remarks,
+	)
+
+	err = i.jobOfferRepository.Create(offer)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.createJobOfferStatusHistory(taskGroup, offer.ID, null.NewInt(0, false), offer.Status, input.Operator, "")
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	offer, err = i.jobOfferRepository.FindByID(offer.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	setJobOfferDisplay(offer)
+
+	output.JobOffer = offer
+
+	return output, nil
+}
+
+// 選考の内定の条件を更新する（承諾済みの場合は入社日をタスクグループへ反映する）
+type UpdateJobOfferInput struct {
+	TaskGroupID uint
+	Operator    *entity.AgentStaff
+	UpdateParam entity.CreateOrUpdateJobOfferParam
+}
+
+type UpdateJobOfferOutput struct {
+	JobOffer *entity.JobOffer
+}
+
+func (i *JobOfferInteractorImpl) UpdateJobOffer(input UpdateJobOfferInput) (UpdateJobOfferOutput, error) {
+	var (
+		output UpdateJobOfferOutput
+		param  = input.UpdateParam
+	)
+
+	taskGroup, err := i.authorizeJobOfferTaskGroup(input.Operator, input.TaskGroupID)
+	if err != nil {
+		return output, err
+	}
+
+	err = policy.ValidateJobOfferParam(param)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	offer, err := i.jobOfferRepository.FindByTaskGroupID(taskGroup.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireJobOfferEditable(offer)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if offer.Status == entity.JobOfferStatusAccepted && param.StartDate == "" {
+		return output, fmt.Errorf("%w:%s", entity.ErrRequestError, "承諾済みの内定の入社日は削除できません")
+	}
+
+	isStartDateChanged := offer.StartDate != param.StartDate
+
+	offer.Position = param.Position
+	offer.EmploymentStatus = param.EmploymentStatus
+	offer.WorkLocation = param.WorkLocation
+	offer.AnnualSalary = param.AnnualSalary
+	offer.BaseSalary = param.BaseSalary
+	offer.FixedOvertimePay = param.FixedOvertimePay
+	offer.FixedOvertimeHours = param.FixedOvertimeHours
+	offer.Allowance = param.Allowance
+	offer.Bonus = param.Bonus
+	offer.SalaryRemarks = param.SalaryRemarks
+	offer.StartDate = param.StartDate
+	offer.AnswerDeadline = param.AnswerDeadline
+	offer.Remarks = param.Remarks
+
+	err = i.jobOfferRepository.Update(offer.ID, offer)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if offer.Status == entity.JobOfferStatusAccepted && isStartDateChanged {
+		err = i.taskGroupRepository.UpdateJoiningDate(taskGroup.ID, offer.StartDate)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	setJobOfferDisplay(offer)
+
+	output.JobOffer = offer
+
+	return output, nil
+}
+
+// 選考の内定の状態を変更する（承諾の場合は入社日をタスクグループへ反映する）
+type UpdateJobOfferStatusInput struct {
+	TaskGroupID uint
+	Operator    *entity.AgentStaff
+	UpdateParam entity.UpdateJobOfferStatusParam
+}
+
+type UpdateJobOfferStatusOutput struct {
+	JobOffer *entity.JobOffer
+}
+
+func (i *JobOfferInteractorImpl) UpdateJobOfferStatus(input UpdateJobOfferStatusInput) (UpdateJobOfferStatusOutput, error) {
+	var (
+		output UpdateJobOfferStatusOutput
+		param  = input.UpdateParam
+	)
+
+	taskGroup, err := i.authorizeJobOfferTaskGroup(input.Operator, input.TaskGroupID)
+	if err != nil {
+		return output, err
+	}
+
+	offer, err := i.jobOfferRepository.FindByTaskGroupID(taskGroup.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.ValidateJobOfferStatusTransition(offer, param.Status)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	prevStatus := offer.Status
+
+	err = i.jobOfferRepository.UpdateStatus(offer.ID, param.Status)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.createJobOfferStatusHistory(taskGroup, offer.ID, null.IntFrom(prevStatus), param.Status, input.Operator, param.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if param.Status == entity.JobOfferStatusAccepted {
+		err = i.taskGroupRepository.UpdateJoiningDate(taskGroup.ID, offer.StartDate)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	offer.Status = param.Status
+	setJobOfferDisplay(offer)
+
+	output.JobOffer = offer
+
+	return output, nil
+}
+
+// 選考の内定と状態の変更履歴を取得する
+type GetJobOfferByTaskGroupIDInput struct {
+	TaskGroupID uint
+	Operator    *entity.AgentStaff
+}
+
+type GetJobOfferByTaskGroupIDOutput struct {
+	JobOffer *entity.JobOffer
+}
+
+func (i *JobOfferInteractorImpl) GetJobOfferByTaskGroupID(input GetJobOfferByTaskGroupIDInput) (GetJobOfferByTaskGroupIDOutput, error) {
+	var (
+		output GetJobOfferByTaskGroupIDOutput
+	)
+
+	taskGroup, err := i.authorizeJobOfferTaskGroup(input.Operator, input.TaskGroupID)
+	if err != nil {
+		return output, err
+	}
+
+	offer, err := i.jobOfferRepository.FindByTaskGroupID(taskGroup.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	historyList, err := i.jobOfferStatusHistoryRepository.GetByJobOfferID(offer.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, history := range historyList {
+		if history.PrevStatus.Valid {
+			history.PrevStatusName = entity.JobOfferStatusName[history.PrevStatus.Int64]
+		}
+		history.StatusName = entity.JobOfferStatusName[history.Status]
+	}
+
+	setJobOfferDisplay(offer)
+	offer.StatusHistoryList = historyList
+
+	output.JobOffer = offer
+
+	return output, nil
+}
+
+// 求職者の内定を比較する（求職者を担当するエージェントの担当者のみ）
+// 比較に使う年収の高い順に並べ、回答待ち・承諾の内定の中で年収が最も高い内定と、回答待ちの内定の中で回答期限が最も早い内定に印をつける
+type GetJobOfferComparisonByJobSeekerIDInput struct {
+	JobSeekerID uint
+	Operator    *entity.AgentStaff
+}
+
+type GetJobOfferComparisonByJobSeekerIDOutput struct {
+	JobOfferList []*entity.JobOffer
+}
+
+func (i *JobOfferInteractorImpl) GetJobOfferComparisonByJobSeekerID(input GetJobOfferComparisonByJobSeekerIDInput) (GetJobOfferComparisonByJobSeekerIDOutput, error) {
+	var (
+		output GetJobOfferComparisonByJobSeekerIDOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	jobSeeker, err := i.jobSeekerRepository.FindByID(input.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, jobSeeker.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	offerList, err := i.jobOfferRepository.GetByJobSeekerID(input.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	var (
+		highestSalaryOffer    *entity.JobOffer
+		earliestDeadlineOffer *entity.JobOffer
+	)
+
+	for _, offer := range offerList {
+		setJobOfferDisplay(offer)
+
+		isOpen := policy.IsJobOfferOpen(offer.Status)
+		if !isOpen && offer.Status != entity.JobOfferStatusAccepted {
+			continue
+		}
+
+		if highestSalaryOffer == nil || offer.EstimatedAnnualSalary > highestSalaryOffer.EstimatedAnnualSalary {
+			highestSalaryOffer = offer
+		}
+
+		if isOpen && offer.AnswerDeadline.Valid &&
+			(earliestDeadlineOffer == nil || offer.AnswerDeadline.Time.Before(earliestDeadlineOffer.AnswerDeadline.Time)) {
+			earliestDeadlineOffer = offer
+		}
+	}
+
+	if highestSalaryOffer != nil {
+		highestSalaryOffer.IsHighestSalary = true
+	}
+	if earliestDeadlineOffer != nil {
+		earliestDeadlineOffer.IsEarliestDeadline = true
+	}
+
+	sort.SliceStable(offerList, func(a, b int) bool {
+		return offerList[a].EstimatedAnnualSalary > offerList[b].EstimatedAnnualSalary
+	})
+
+	output.JobOfferList = offerList
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// Batch API
+//
+/*
+	内定の回答期限の通知
+	回答期限の24時間前と期限切れをCA・RAの担当者へ通知する
+	同じ回答期限に対する同じ通知は一度だけ送る（期限が変更された場合は再度通知する）
+	1時間ごとに実行
+*/
+type BatchNotifyJobOfferDeadlineInput struct {
+	Now time.Time
+}
+
+type BatchNotifyJobOfferDeadlineOutput struct {
+	OK bool
+}
+
+func (i *JobOfferInteractorImpl) BatchNotifyJobOfferDeadline(input BatchNotifyJobOfferDeadlineInput) (BatchNotifyJobOfferDeadlineOutput, error) {
+	var (
+		output BatchNotifyJobOfferDeadlineOutput
+	)
+
+	offerList, err := i.jobOfferRepository.GetOpenByAnswerDeadlineUntil(input.Now.Add(entity.JobOfferReminderHours * time.Hour))
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if len(offerList) == 0 {
+		output.OK = true
+		return output, nil
+	}
+
+	var (
+		offerIDList   []uint
+		sentMap       = map[string]bool{}
+		agentStaffMap = map[uint]*entity.AgentStaff{}
+	)
+
+	for _, offer := range offerList {
+		offerIDList = append(offerIDList, offer.ID)
+	}
+
+	reminderList, err := i.jobOfferReminderRepository.GetByJobOfferIDList(offerIDList)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, reminder := range reminderList {
+		sentMap[getJobOfferReminderKey(reminder.JobOfferID, reminder.AgentStaffID, reminder.ReminderType, reminder.AnswerDeadline)] = true
+	}
+
+	agentStaffList, err := i.agentStaffRepository.All()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, agentStaff := range agentStaffList {
+		agentStaffMap[agentStaff.ID] = agentStaff
+	}
+
+	// 通知先の担当者ごとにまとめて送る
+	type reminderItem struct {
+		offer        *entity.JobOffer
+		reminderType int64
+	}
+
+	var (
+		recipientIDList []uint
+		itemListMap     = map[uint][]reminderItem{}
+	)
+
+	for _, offer := range offerList {
+		for _, reminderType := range policy.GetJobOfferReminderTypeList(offer, input.Now) {
+			for _, staffID := range []uint{offer.CAStaffID, offer.RAStaffID} {
+				recipient, ok := agentStaffMap[staffID]
+				// 通知の可否はタスクの期限のリマインドの設定に従う
+				if !ok || !policy.IsTaskSLANotifiable(recipient, false) {
+					continue
+				}
+
+				key := getJobOfferReminderKey(offer.ID, recipient.ID, reminderType, offer.AnswerDeadline.Time)
+				if sentMap[key] {
+					continue
+				}
+				sentMap[key] = true
+
+				if _, ok := itemListMap[recipient.ID]; !ok {
+					recipientIDList = append(recipientIDList, recipient.ID)
+				}
+				itemListMap[recipient.ID] = append(itemListMap[recipient.ID], reminderItem{
+					offer:        offer,
+					reminderType: reminderType,
+				})
+			}
+		}
+	}
+
+	for _, recipientID := range recipientIDList {
+		var (
+			recipient                       = agentStaffMap[recipientID]
+			beforeDeadlineList, overdueList []*entity.JobOffer
+		)
+
+		for _, item := range itemListMap[recipientID] {
+			switch item.reminderType {
+			case entity.JobOfferReminderBeforeDeadline:
+				beforeDeadlineList = append(beforeDeadlineList, item.offer)
+			case entity.JobOfferReminderOverdue:
+				overdueList = append(overdueList, item.offer)
+			}
+		}
+
+		mailBody := fmt.Sprintf(
+			"%s\n%s様\n\n平素よりautoscoutをご利用いただきありがとうございます。\nautoscout事務局でございます。\n\n内定の回答期限についてお知らせいたします。\n\n%s%s以上でございます。\n入れ違いで処理済みでしたら申し訳ございません。\n\n引き続きどうぞよろしくお願い申し上げます。\n\n通知の設定は、autoscout内の「設定 / 基本情報」より変更できます。\nhttps://autoscout.spaceai.jp/account/?panel=basic_information",
+			recipient.AgentName,
+			recipient.StaffName,
+			formatJobOfferMailSection("まもなく回答期限の内定", beforeDeadlineList),
+			formatJobOfferMailSection("回答期限を過ぎた内定", overdueList),
+		)
+
+		err = i.sendJobOfferMail(recipient, "内定の回答期限のお知らせ", mailBody)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		// 送信した通知を記録する
+		for _, item := range itemListMap[recipientID] {
+			reminder := entity.NewJobOfferReminder(
+				item.offer.ID,
+				recipient.ID,
+				item.reminderType,
+				item.offer.AnswerDeadline.Time,
+			)
+
+			err = i.jobOfferReminderRepository.Create(reminder)
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+		}
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+// タスクグループを取得し、CA側・RA側のエージェントの担当者かを確認する
+func (i *JobOfferInteractorImpl) authorizeJobOfferTaskGroup(operator *entity.AgentStaff, taskGroupID uint) (*entity.TaskGroup, error) {
+	if operator == nil {
+		return nil, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	taskGroup, err := i.taskGroupRepository.FindByID(taskGroupID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	// CA側のエージェントでない場合はRA側のエージェントかを確認する
+	err = policy.RequireOwnAgent(operator, taskGroup.CAAgentID)
+	if err != nil {
+		err = policy.RequireOwnAgent(operator, taskGroup.RAAgentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return taskGroup, nil
+}
+
+// 状態の変更履歴とタスクグループの活動履歴を記録する
+func (i *JobOfferInteractorImpl) createJobOfferStatusHistory(
+	taskGroup *entity.TaskGroup,
+	jobOfferID uint,
+	prevStatus null.Int,
+	status int64,
+	operator *entity.AgentStaff,
+	remarks string,
+) error {
+	agentStaffID := getTaskGroupEventStaffID(getOperatorID(operator))
+
+	history := entity.NewJobOfferStatusHistory(jobOfferID, prevStatus, status, agentStaffID, remarks)
+
+	err := i.jobOfferStatusHistoryRepository.Create(history)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	var before interface{}
+	if prevStatus.Valid {
+		before = entity.JobOfferStatusName[prevStatus.Int64]
+	}
+
+	diff, err := json.Marshal([]entity.AuditLogFieldDiff{
+		{Field: "job_offer_status", Before: before, After: entity.JobOfferStatusName[status]},
+		{Field: "job_offer_remarks", Before: nil, After: remarks},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	event := entity.NewTaskGroupEvent(
+		taskGroup.ID,
+		taskGroup.JobSeekerID,
+		taskGroup.JobInformationID,
+		entity.TaskGroupEventJobOfferStatusChange,
+		agentStaffID,
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		null.NewInt(0, false),
+		string(diff),
+	)
+
+	err = i.taskGroupEventRepository.Create(event)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// 回答期限の通知メールを送る（本番環境のみ送信）
+func (i *JobOfferInteractorImpl) sendJobOfferMail(agentStaff *entity.AgentStaff, subject, mailBody string) error {
+	from := mail.Email{
+		Name:    "autoscout事務局",
+		Address: "info@spaceai.jp",
+	}
+
+	to := mail.Email{
+		Name:    agentStaff.StaffName,
+		Address: agentStaff.Email,
+	}
+
+	if os.Getenv("APP_ENV") == "prd" {
+		sendgrid := utility.NewSendGrid(i.sendgrid.APIKey)
+		err := sendgrid.SendMail(
+			&from,
+			&to,
+			subject,
+			mailBody,
+			"",
+		)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+	log.Println("メール送信成功。担当者ID:", agentStaff.ID, "件名:", subject)
+
+	return nil
+}
+
+// 表示用の項目を設定する
+func setJobOfferDisplay(offer *entity.JobOffer) {
+	offer.StatusName = entity.JobOfferStatusName[offer.Status]
+	offer.EstimatedAnnualSalary = policy.GetJobOfferEstimatedAnnualSalary(offer)
+}
+
+func getJobOfferReminderKey(jobOfferID, agentStaffID uint, reminderType int64, answerDeadline time.Time) string {
+	return fmt.Sprint(jobOfferID, "-", agentStaffID, "-", reminderType, "-", answerDeadline.Unix())
+}
+
+// メールに記載する内定の一覧。fmt:回答期限 / 企業名 求人タイトル / 求職者名 / 状態
+func formatJobOfferMailSection(title string, offerList []*entity.JobOffer) string {
+	if len(offerList) == 0 {
+		return ""
+	}
+
+	section := fmt.Sprintf("%s %d件\nhttps://autoscout.spaceai.jp/\n", title, len(offerList))
+	for _, offer := range offerList {
+		line := offer.AnswerDeadline.Time.In(utility.Tokyo).Format("01/02 15:04") + "回答期限"
+		line += " / " + offer.CompanyName + " " + offer.Title
+		line += " / " + offer.LastName + " " + offer.FirstName + "様 / " + entity.JobOfferStatusName[offer.Status]
+		section += "\t" + line + "\n"
+	}
+
+	return section + "\n"
+}
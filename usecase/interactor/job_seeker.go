@@ -1,6 +1,7 @@
 package interactor
 
 import (
+	"archive/zip"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
 	"github.com/spaceaiinc/autoscout-server/domain/utility"
 	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -94,6 +96,14 @@ type JobSeekerInteractor interface {
 	SendJobSeekerResetPasswordEmailForLP(input SendJobSeekerResetPasswordEmailForLPInput) (SendJobSeekerResetPasswordEmailForLPOutput, error)
 	ResetPasswordForLP(input ResetPasswordForLPInput) (ResetPasswordForLPOutput, error)
 	CheckResetPasswordToken(input CheckResetPasswordTokenInput) (CheckResetPasswordTokenOutput, error)
+
+	// 個人情報の開示・削除 API
+	ExportJobSeekerPersonalData(input ExportJobSeekerPersonalDataInput) (ExportJobSeekerPersonalDataOutput, error) // 求職者の個人情報をzipファイルで出力する
+	DeleteJobSeekerPersonalData(input DeleteJobSeekerPersonalDataInput) (DeleteJobSeekerPersonalDataOutput, error) // 求職者の個人情報を削除して匿名化する
+
+	// Batch処理 API
+	BatchEncryptJobSeekerPersonalInformation(input BatchEncryptJobSeekerPersonalInformationInput) (BatchEncryptJobSeekerPersonalInformationOutput, error)
+	BatchAnonymizeInactiveJobSeeker(input BatchAnonymizeInactiveJobSeekerInput) (BatchAnonymizeInactiveJobSeekerOutput, error)
 }
 
 type JobSeekerInteractorImpl struct {
@@ -158,6 +168,19 @@ type JobSeekerInteractorImpl struct {
 	taskRepository                                     usecase.TaskRepository
 	interviewTaskRepository                            usecase.InterviewTaskRepository
 	interviewTaskGroupRepository                       usecase.InterviewTaskGroupRepository
+	jobSeekerExternalIDRepository                      usecase.JobSeekerExternalIDRepository
+	jobSeekerMergeSuggestionRepository                 usecase.JobSeekerMergeSuggestionRepository
+	agentAssignmentRuleRepository                      usecase.AgentAssignmentRuleRepository
+	agentAssignmentStaffRepository                     usecase.AgentAssignmentStaffRepository
+	agentAssignmentStaffSpecialtyRepository            usecase.AgentAssignmentStaffSpecialtyRepository
+	jobSeekerAssignmentLogRepository                   usecase.JobSeekerAssignmentLogRepository
+	entryScreeningRuleRepository                       usecase.EntryScreeningRuleRepository
+	entryScreeningRuleConditionRepository              usecase.EntryScreeningRuleConditionRepository
+	entryScreeningResultRepository                     usecase.EntryScreeningResultRepository
+	emailWithJobSeekerRepository                       usecase.EmailWithJobSeekerRepository
+	jobSeekerScheduleRepository                        usecase.JobSeekerScheduleRepository
+	jobSeekerPersonalDataRepository                    usecase.JobSeekerPersonalDataRepository
+	taskGroupEventRepository                           usecase.TaskGroupEventRepository
 }
 
 // JobSeekerInteractorImpl is an implementation of JobSeekerInteractor
@@ -223,6 +246,19 @@ func NewJobSeekerInteractorImpl(
 	tR usecase.TaskRepository,
 	itR usecase.InterviewTaskRepository,
 	itgR usecase.InterviewTaskGroupRepository,
+	jseidR usecase.JobSeekerExternalIDRepository,
+	jsmsR usecase.JobSeekerMergeSuggestionRepository,
+	aarR usecase.AgentAssignmentRuleRepository,
+	aasR usecase.AgentAssignmentStaffRepository,
+	aassR usecase.AgentAssignmentStaffSpecialtyRepository,
+	jsalR usecase.JobSeekerAssignmentLogRepository,
+	esrR usecase.EntryScreeningRuleRepository,
+	esrcR usecase.EntryScreeningRuleConditionRepository,
+	esresR usecase.EntryScreeningResultRepository,
+	ewjsR usecase.EmailWithJobSeekerRepository,
+	jssR usecase.JobSeekerScheduleRepository,
+	jspdR usecase.JobSeekerPersonalDataRepository,
+	tgeR usecase.TaskGroupEventRepository,
 ) JobSeekerInteractor {
 	return &JobSeekerInteractorImpl{
 		firebase:                                           fb,
@@ -286,6 +322,19 @@ func NewJobSeekerInteractorImpl(
 		taskRepository:                                     tR,
 		interviewTaskRepository:                            itR,
 		interviewTaskGroupRepository:                       itgR,
+		jobSeekerExternalIDRepository:                      jseidR,
+		jobSeekerMergeSuggestionRepository:                 jsmsR,
+		agentAssignmentRuleRepository:                      aarR,
+		agentAssignmentStaffRepository:                     aasR,
+		agentAssignmentStaffSpecialtyRepository:            aassR,
+		jobSeekerAssignmentLogRepository:                   jsalR,
+		entryScreeningRuleRepository:                       esrR,
+		entryScreeningRuleConditionRepository:              esrcR,
+		entryScreeningResultRepository:                     esresR,
+		emailWithJobSeekerRepository:                       ewjsR,
+		jobSeekerScheduleRepository:                        jssR,
+		jobSeekerPersonalDataRepository:                    jspdR,
+		taskGroupEventRepository:                           tgeR,
 	}
 }
 
@@ -843,6 +892,15 @@ func (i *JobSeekerInteractorImpl) UpdateJobSeeker(input UpdateJobSeekerInput) (U
 		input.UpdateParam.AcceptancePoints,
 	)
 
+	// 伏せた状態で表示された個人情報がそのまま送られてきた場合は現在の値を維持する
+	currentJobSeeker, err := i.jobSeekerRepository.FindByID(input.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	policy.RestoreMaskedJobSeekerFields(currentJobSeeker, jobSeeker)
+
 	err = i.jobSeekerRepository.Update(input.JobSeekerID, jobSeeker)
 	if err != nil {
 		fmt.Println(err)
@@ -2476,7 +2534,7 @@ func (i *JobSeekerInteractorImpl) UpdateJobSeekerDocumentForTask(input UpdateJob
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(nextTask)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, nextTask)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -4529,12 +4587,86 @@ func (i *JobSeekerInteractorImpl) CreateJobSeekerFromLP(input CreateJobSeekerFro
 		"",                     // 応募承諾のポイント
 	)
 
+	// 他媒体から取り込み済みの求職者との重複チェック
+	// LPは本人確認ができないため既存の求職者には紐付けず、一致した場合は統合候補としてスタッフの確認に回す
+	duplicateJobSeeker, matchType, matchReason, err := findDuplicateJobSeekerOnEntry(i.jobSeekerRepository, systemAgentID, jobSeeker)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 選考ルールで対象外の求職者を判定（該当した場合はフェーズ・ユーザーステータスを変更）
+	screeningRule, err := screenEntryJobSeeker(
+		i.entryScreeningRuleRepository,
+		i.entryScreeningRuleConditionRepository,
+		jobSeeker,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 自動割り振りルールに従って担当CAをセット
+	assignmentLog, err := assignCAStaffByRule(
+		i.agentAssignmentRuleRepository,
+		i.agentAssignmentStaffRepository,
+		i.agentAssignmentStaffSpecialtyRepository,
+		i.jobSeekerRepository,
+		jobSeeker,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	err = i.jobSeekerRepository.Create(jobSeeker)
 	if err != nil {
 		fmt.Println(err)
 		return output, err
 	}
 
+	// 割り振り履歴を保存して担当CAに通知
+	err = recordJobSeekerAssignment(
+		i.jobSeekerAssignmentLogRepository,
+		i.agentStaffRepository,
+		i.oneSignal,
+		jobSeeker,
+		assignmentLog,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 選考ルールに該当した求職者を記録
+	err = recordEntryScreeningResult(
+		i.entryScreeningResultRepository,
+		jobSeeker,
+		screeningRule,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if matchType == entity.JobSeekerDuplicateMatchNone {
+		duplicateJobSeeker = nil
+	}
+
+	err = registerEntrySourceOfNewJobSeeker(
+		i.jobSeekerExternalIDRepository,
+		i.jobSeekerMergeSuggestionRepository,
+		jobSeeker,
+		entity.JobSeekerExternalTypeLP,
+		"",
+		duplicateJobSeeker,
+		matchReason,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	// 応募可能求人の閲覧権限をTRUEで更新
 	err = i.jobSeekerRepository.UpdateCanViewMatchingJob(jobSeeker.ID, true)
 	if err != nil {
@@ -4730,40 +4862,42 @@ func (i *JobSeekerInteractorImpl) CreateJobSeekerFromLP(input CreateJobSeekerFro
 		return output, err
 	}
 
-	// 面談調整タスク
-	phaseSub := null.NewInt(0, true) // 日程調整依頼
-	// 当日（2020-02-01）
-	date := jobSeeker.InterviewDate.Format("2006-01-02")
+	// 面談調整タスク（選考ルールで対象外となった求職者は面談調整を行わない）
+	if !isSkipInterviewAdjustmentByScreening(screeningRule) {
+		phaseSub := null.NewInt(0, true) // 日程調整依頼
+		// 当日（2020-02-01）
+		date := jobSeeker.InterviewDate.Format("2006-01-02")
 
-	interviewTaskGroup := entity.NewInterviewTaskGroup(
-		jobSeeker.AgentID,
-		jobSeeker.ID,
-		jobSeeker.InterviewDate,
-		jobSeeker.InterviewDate, // 面談実施済みの場合は初回面談日時に記録する
-	)
+		interviewTaskGroup := entity.NewInterviewTaskGroup(
+			jobSeeker.AgentID,
+			jobSeeker.ID,
+			jobSeeker.InterviewDate,
+			jobSeeker.InterviewDate, // 面談実施済みの場合は初回面談日時に記録する
+		)
 
-	err = i.interviewTaskGroupRepository.Create(interviewTaskGroup)
-	if err != nil {
-		fmt.Println(err)
-		return output, err
-	}
+		err = i.interviewTaskGroupRepository.Create(interviewTaskGroup)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
 
-	interviewTask := entity.NewInterviewTask(
-		interviewTaskGroup.ID,
-		NullInt, //　タスク実行者ID
-		NullInt, // CAのID
-		jobSeeker.Phase,
-		phaseSub,
-		"",
-		date,
-		null.NewInt(99, true),
-		getStrPhaseForJobSeeker(jobSeeker.Phase), // SelectActionLabelは求職者のフェーズにする
-	)
+		interviewTask := entity.NewInterviewTask(
+			interviewTaskGroup.ID,
+			NullInt, //　タスク実行者ID
+			NullInt, // CAのID
+			jobSeeker.Phase,
+			phaseSub,
+			"",
+			date,
+			null.NewInt(99, true),
+			getStrPhaseForJobSeeker(jobSeeker.Phase), // SelectActionLabelは求職者のフェーズにする
+		)
 
-	err = i.interviewTaskRepository.Create(interviewTask)
-	if err != nil {
-		fmt.Println(err)
-		return output, err
+		err = i.interviewTaskRepository.Create(interviewTask)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
 	}
 
 	// 書類テーブルの作成
@@ -5090,8 +5224,9 @@ func (i *JobSeekerInteractorImpl) SendJobSeekerResetPasswordEmailForLP(input Sen
 	jobSeeker, err := i.jobSeekerRepository.FindByEmailForLP(input.Param.Email, SystemAgentID)
 	if err != nil {
 		if errors.Is(err, entity.ErrNotFound) {
-			wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "メールアドレスが存在しません。")
-			return output, wrapped
+			// 登録の有無が分からないよう、存在しない場合も送信済みとして扱う
+			output.OK = true
+			return output, nil
 		} else {
 			// Not Found以外のエラーの場合はそのままサーバーエラー
 			fmt.Println(err)
@@ -5225,3 +5360,317 @@ func (i *JobSeekerInteractorImpl) CheckResetPasswordToken(input CheckResetPasswo
 
 	return output, nil
 }
+
+/****************************************************************************************/
+// 個人情報の開示・削除 API
+//
+// 求職者の個人情報（personal_data.json）と応募書類などのファイルをまとめたzipファイルを出力する（自社の管理者のみ）
+type ExportJobSeekerPersonalDataInput struct {
+	Operator    *entity.AgentStaff
+	JobSeekerID uint
+}
+
+type ExportJobSeekerPersonalDataOutput struct {
+	FilePath *entity.FilePath
+}
+
+func (i *JobSeekerInteractorImpl) ExportJobSeekerPersonalData(input ExportJobSeekerPersonalDataInput) (ExportJobSeekerPersonalDataOutput, error) {
+	var (
+		output ExportJobSeekerPersonalDataOutput
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		return output, err
+	}
+
+	jobSeeker, err := i.jobSeekerRepository.FindByID(input.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, jobSeeker.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	jobSeeker, err = getJobSeekerChildTableData(jobSeeker, i)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	personalData := entity.JobSeekerPersonalData{
+		ExportedAt: time.Now().In(utility.Tokyo),
+		JobSeeker:  jobSeeker,
+	}
+
+	initialQuestionnaire, err := i.initialQuestionnaireRepository.FindByJobSeekerID(jobSeeker.ID)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		fmt.Println(err)
+		return output, err
+	}
+	personalData.InitialQuestionnaire = initialQuestionnaire
+
+	personalData.ExternalIDList, err = i.jobSeekerExternalIDRepository.GetByJobSeekerID(jobSeeker.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	personalData.TaskGroupList, err = i.taskGroupRepository.GetByJobSeekerID(jobSeeker.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	personalData.ScheduleList, err = i.jobSeekerScheduleRepository.GetByJobSeekerID(jobSeeker.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	personalData.EmailList, err = i.emailWithJobSeekerRepository.GetByJobSeekerID(jobSeeker.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	chatGroup, err := i.chatGroupWithJobSeekerRepository.FindByAgentIDAndJobSeekerID(jobSeeker.AgentID, jobSeeker.ID)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		fmt.Println(err)
+		return output, err
+	} else if err == nil {
+		personalData.ChatMessageList, err = i.chatMessageWithJobSeekerRepository.GetByGroupID(chatGroup.ID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	fileURLList, err := i.jobSeekerPersonalDataRepository.GetFileURLListByJobSeekerID(jobSeeker.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// zipファイルを作成
+	filePath := ("./personal-data-" + fmt.Sprint(utility.CreateUUID()) + ".zip")
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	defer file.Close()
+
+	zw := zip.NewWriter(file)
+
+	// 取得できなかったファイルはURLのみを記載する
+	for index, fileURL := range fileURLList {
+		personalDataFile := entity.JobSeekerPersonalDataFile{
+			URL: fileURL,
+		}
+
+		data, err := i.firebase.DownloadFromStorage(fileURL)
+		if err != nil {
+			fmt.Println(err)
+			personalData.FileList = append(personalData.FileList, personalDataFile)
+			continue
+		}
+
+		personalDataFile.FileName = fmt.Sprintf("files/%03d_%s", index+1, getPersonalDataFileName(fileURL))
+
+		w, err := zw.Create(personalDataFile.FileName)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		_, err = w.Write(data)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		personalData.FileList = append(personalData.FileList, personalDataFile)
+	}
+
+	personalDataJSON, err := json.MarshalIndent(personalData, "", "  ")
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	w, err := zw.Create("personal_data.json")
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	_, err = w.Write(personalDataJSON)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = zw.Close()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.FilePath = entity.NewFilePath(filePath)
+
+	return output, nil
+}
+
+// 求職者の個人情報を削除し、匿名化する（自社の管理者のみ）
+// 選考・売上の集計に使うため求職者のレコードは残し、個人情報の項目を消去する
+type DeleteJobSeekerPersonalDataInput struct {
+	Operator    *entity.AgentStaff
+	JobSeekerID uint
+}
+
+type DeleteJobSeekerPersonalDataOutput struct {
+	OK bool
+}
+
+func (i *JobSeekerInteractorImpl) DeleteJobSeekerPersonalData(input DeleteJobSeekerPersonalDataInput) (DeleteJobSeekerPersonalDataOutput, error) {
+	var (
+		output DeleteJobSeekerPersonalDataOutput
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		return output, err
+	}
+
+	jobSeeker, err := i.jobSeekerRepository.FindByID(input.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, jobSeeker.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	err = anonymizeJobSeeker(jobSeeker.ID, i)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+// Batch処理 API
+//
+// 暗号化前に登録された求職者の個人情報を暗号化し、ブラインドインデックス（電話番号・メールアドレス・氏名・フリガナ）を作成する
+type BatchEncryptJobSeekerPersonalInformationInput struct{}
+
+type BatchEncryptJobSeekerPersonalInformationOutput struct {
+	OK bool
+}
+
+func (i *JobSeekerInteractorImpl) BatchEncryptJobSeekerPersonalInformation(input BatchEncryptJobSeekerPersonalInformationInput) (BatchEncryptJobSeekerPersonalInformationOutput, error) {
+	var (
+		output BatchEncryptJobSeekerPersonalInformationOutput
+		limit  uint = 500
+		count  int
+	)
+
+	for {
+		jobSeekerList, err := i.jobSeekerRepository.GetUnencryptedPersonalInformation(limit)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		for _, jobSeeker := range jobSeekerList {
+			err = i.jobSeekerRepository.UpdatePersonalInformation(jobSeeker.ID, jobSeeker)
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+		}
+
+		count += len(jobSeekerList)
+
+		if uint(len(jobSeekerList)) < limit {
+			break
+		}
+	}
+
+	fmt.Println("個人情報を暗号化した求職者数:", count)
+
+	output.OK = true
+
+	return output, nil
+}
+
+// 保存期間（RetentionYears年）のあいだ求職者・選考・LINEの更新がない求職者を匿名化する
+// RetentionYearsが0の場合は何もしない
+type BatchAnonymizeInactiveJobSeekerInput struct {
+	Now            time.Time
+	RetentionYears uint
+}
+
+type BatchAnonymizeInactiveJobSeekerOutput struct {
+	OK bool
+}
+
+func (i *JobSeekerInteractorImpl) BatchAnonymizeInactiveJobSeeker(input BatchAnonymizeInactiveJobSeekerInput) (BatchAnonymizeInactiveJobSeekerOutput, error) {
+	var (
+		output         BatchAnonymizeInactiveJobSeekerOutput
+		limit          uint = 100
+		afterID        uint
+		count          int
+		failedCount    int
+		inactiveBefore = input.Now.AddDate(-int(input.RetentionYears), 0, 0)
+	)
+
+	if input.RetentionYears == 0 {
+		output.OK = true
+		return output, nil
+	}
+
+	for {
+		idList, err := i.jobSeekerRepository.GetInactiveIDListForRetention(inactiveBefore, afterID, limit)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		// 失敗した求職者は匿名化日時が入らないため、次回のバッチで再度処理される
+		for _, id := range idList {
+			afterID = id
+
+			err = anonymizeJobSeeker(id, i)
+			if err != nil {
+				fmt.Println(err)
+				failedCount++
+				continue
+			}
+
+			count++
+		}
+
+		if uint(len(idList)) < limit {
+			break
+		}
+	}
+
+	fmt.Println("保存期間を過ぎて匿名化した求職者数:", count, "失敗:", failedCount)
+
+	output.OK = true
+
+	return output, nil
+}
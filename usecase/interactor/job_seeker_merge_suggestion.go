@@ -0,0 +1,226 @@
+package interactor
+
+import (
+	"fmt"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+)
+
+type JobSeekerMergeSuggestionInteractor interface {
+	// 汎用系 API
+	MergeJobSeekerBySuggestion(input MergeJobSeekerBySuggestionInput) (MergeJobSeekerBySuggestionOutput, error)
+	DismissJobSeekerMergeSuggestion(input DismissJobSeekerMergeSuggestionInput) (DismissJobSeekerMergeSuggestionOutput, error)
+	GetUnhandledJobSeekerMergeSuggestionListByAgentID(input GetUnhandledJobSeekerMergeSuggestionListByAgentIDInput) (GetUnhandledJobSeekerMergeSuggestionListByAgentIDOutput, error)
+}
+
+type JobSeekerMergeSuggestionInteractorImpl struct {
+	firebase                           usecase.Firebase
+	agentStaffRepository               usecase.AgentStaffRepository
+	jobSeekerRepository                usecase.JobSeekerRepository
+	jobSeekerExternalIDRepository      usecase.JobSeekerExternalIDRepository
+	jobSeekerMergeSuggestionRepository usecase.JobSeekerMergeSuggestionRepository
+	taskGroupRepository                usecase.TaskGroupRepository
+}
+
+// JobSeekerMergeSuggestionInteractorImpl is an implementation of JobSeekerMergeSuggestionInteractor
+func NewJobSeekerMergeSuggestionInteractorImpl(
+	fb usecase.Firebase,
+	asR usecase.AgentStaffRepository,
+	jsR usecase.JobSeekerRepository,
+	jseiR usecase.JobSeekerExternalIDRepository,
+	jsmsR usecase.JobSeekerMergeSuggestionRepository,
+	tgR usecase.TaskGroupRepository,
+) JobSeekerMergeSuggestionInteractor {
+	return &JobSeekerMergeSuggestionInteractorImpl{
+		firebase:                           fb,
+		agentStaffRepository:               asR,
+		jobSeekerRepository:                jsR,
+		jobSeekerExternalIDRepository:      jseiR,
+		jobSeekerMergeSuggestionRepository: jsmsR,
+		taskGroupRepository:                tgR,
+	}
+}
+
+/****************************************************************************************/
+// 汎用系 API
+//
+// 統合候補の求職者を既存の求職者に統合する
+// 流入元（媒体IDと流入経路）は既存の求職者に付け替え、メモを追記した上で重複した求職者を削除する
+type MergeJobSeekerBySuggestionInput struct {
+	Token             string
+	MergeSuggestionID uint
+}
+
+type MergeJobSeekerBySuggestionOutput struct {
+	OK bool
+}
+
+func (i *JobSeekerMergeSuggestionInteractorImpl) MergeJobSeekerBySuggestion(input MergeJobSeekerBySuggestionInput) (MergeJobSeekerBySuggestionOutput, error) {
+	var (
+		output MergeJobSeekerBySuggestionOutput
+	)
+
+	agentStaff, mergeSuggestion, err := i.getUnhandledMergeSuggestion(input.Token, input.MergeSuggestionID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if !mergeSuggestion.DuplicateJobSeekerID.Valid {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "統合元の求職者が既に削除されています")
+		return output, err
+	}
+
+	duplicateJobSeekerID := uint(mergeSuggestion.DuplicateJobSeekerID.Int64)
+
+	// 統合元ですでに選考が進んでいる場合は統合しない
+	taskGroupList, err := i.taskGroupRepository.GetByJobSeekerID(duplicateJobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if len(taskGroupList) > 0 {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "統合元の求職者に選考中の求人があるため統合できません")
+		return output, err
+	}
+
+	jobSeeker, err := i.jobSeekerRepository.FindByID(mergeSuggestion.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	duplicateJobSeeker, err := i.jobSeekerRepository.FindByID(duplicateJobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 媒体IDと流入経路を統合先に付け替え
+	err = i.jobSeekerExternalIDRepository.UpdateJobSeekerIDByJobSeekerID(duplicateJobSeeker.ID, jobSeeker.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if duplicateJobSeeker.SecretMemo != "" {
+		secretMemo := fmt.Sprintf("%s\n\n【重複登録から統合】\n%s", jobSeeker.SecretMemo, duplicateJobSeeker.SecretMemo)
+
+		err = i.jobSeekerRepository.UpdateSecretMemo(jobSeeker.ID, secretMemo)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	err = i.jobSeekerRepository.Delete(duplicateJobSeeker.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.jobSeekerMergeSuggestionRepository.UpdateStatus(mergeSuggestion.ID, entity.JobSeekerMergeSuggestionStatusMerged, agentStaff.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// 統合候補を別人として処理する（求職者はそのまま残す）
+type DismissJobSeekerMergeSuggestionInput struct {
+	Token             string
+	MergeSuggestionID uint
+}
+
+type DismissJobSeekerMergeSuggestionOutput struct {
+	OK bool
+}
+
+func (i *JobSeekerMergeSuggestionInteractorImpl) DismissJobSeekerMergeSuggestion(input DismissJobSeekerMergeSuggestionInput) (DismissJobSeekerMergeSuggestionOutput, error) {
+	var (
+		output DismissJobSeekerMergeSuggestionOutput
+	)
+
+	agentStaff, mergeSuggestion, err := i.getUnhandledMergeSuggestion(input.Token, input.MergeSuggestionID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.jobSeekerMergeSuggestionRepository.UpdateStatus(mergeSuggestion.ID, entity.JobSeekerMergeSuggestionStatusDismissed, agentStaff.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// 指定エージェントの未対応の統合候補を取得
+type GetUnhandledJobSeekerMergeSuggestionListByAgentIDInput struct {
+	AgentID uint
+}
+
+type GetUnhandledJobSeekerMergeSuggestionListByAgentIDOutput struct {
+	MergeSuggestionList []*entity.JobSeekerMergeSuggestion
+}
+
+func (i *JobSeekerMergeSuggestionInteractorImpl) GetUnhandledJobSeekerMergeSuggestionListByAgentID(input GetUnhandledJobSeekerMergeSuggestionListByAgentIDInput) (GetUnhandledJobSeekerMergeSuggestionListByAgentIDOutput, error) {
+	var (
+		output GetUnhandledJobSeekerMergeSuggestionListByAgentIDOutput
+	)
+
+	mergeSuggestionList, err := i.jobSeekerMergeSuggestionRepository.GetByAgentIDAndStatus(input.AgentID, entity.JobSeekerMergeSuggestionStatusUnhandled)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.MergeSuggestionList = mergeSuggestionList
+
+	return output, nil
+}
+
+/****************************************************************************************/
+// 共通処理
+//
+// 実行した担当者と、担当者のエージェントに属する未対応の統合候補を取得
+func (i *JobSeekerMergeSuggestionInteractorImpl) getUnhandledMergeSuggestion(token string, mergeSuggestionID uint) (*entity.AgentStaff, *entity.JobSeekerMergeSuggestion, error) {
+	firebaseID, err := i.firebase.VerifyIDToken(token)
+	if err != nil {
+		fmt.Println(err)
+		return nil, nil, err
+	}
+
+	agentStaff, err := i.agentStaffRepository.FindByFirebaseID(firebaseID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, nil, err
+	}
+
+	mergeSuggestion, err := i.jobSeekerMergeSuggestionRepository.FindByID(mergeSuggestionID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, nil, err
+	}
+
+	if mergeSuggestion.AgentID != agentStaff.AgentID {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "他のエージェントの統合候補は操作できません")
+		return nil, nil, err
+	}
+
+	if mergeSuggestion.Status.Int64 != entity.JobSeekerMergeSuggestionStatusUnhandled {
+		err = fmt.Errorf("%w:%s", entity.ErrRequestError, "この統合候補は対応済みです")
+		return nil, nil, err
+	}
+
+	return agentStaff, mergeSuggestion, nil
+}
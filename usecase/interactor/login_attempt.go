@@ -0,0 +1,302 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+)
+
+type LoginAttemptInteractor interface {
+	// 汎用系 API
+	CheckLoginAttempt(input CheckLoginAttemptInput) (CheckLoginAttemptOutput, error)
+	RecordLoginAttempt(input RecordLoginAttemptInput) (RecordLoginAttemptOutput, error)
+
+	// Admin API
+	GetLockedLoginAttemptList(input GetLockedLoginAttemptListInput) (GetLockedLoginAttemptListOutput, error)
+	UnlockLoginAttempt(input UnlockLoginAttemptInput) (UnlockLoginAttemptOutput, error)
+}
+
+type LoginAttemptInteractorImpl struct {
+	captcha                usecase.Captcha
+	loginAttemptRepository usecase.LoginAttemptRepository
+}
+
+// LoginAttemptInteractorImpl is an implementation of LoginAttemptInteractor
+func NewLoginAttemptInteractorImpl(
+	ca usecase.Captcha,
+	laR usecase.LoginAttemptRepository,
+) LoginAttemptInteractor {
+	return &LoginAttemptInteractorImpl{
+		captcha:                ca,
+		loginAttemptRepository: laR,
+	}
+}
+
+// ロック中・CAPTCHAの誤りの場合に返すメッセージ（アカウントの有無が分からないよう共通にする）
+const loginAttemptErrorMessage = "試行回数が上限に達しました。しばらく時間をおいてから再度お試しください。"
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// ログイン前にロック中でないか、CAPTCHAが必要な場合は正しいかを確認する
+type CheckLoginAttemptInput struct {
+	Scope          string
+	IPAddress      string
+	Account        string // メールアドレス・ゲストの対象のUUID（取得できない場合は空文字）
+	IsEmailAccount bool
+	CaptchaToken   string
+}
+
+type CheckLoginAttemptOutput struct {
+	OK bool
+}
+
+func (i *LoginAttemptInteractorImpl) CheckLoginAttempt(input CheckLoginAttemptInput) (CheckLoginAttemptOutput, error) {
+	var (
+		output          CheckLoginAttemptOutput
+		requiresCaptcha bool
+		now             = time.Now().In(time.UTC)
+	)
+
+	for _, key := range getLoginAttemptKeyList(input.IPAddress, input.Account, input.IsEmailAccount) {
+		rule, ok := policy.GetLoginAttemptRule(input.Scope, key.keyType)
+		if !ok {
+			continue
+		}
+
+		attempt, err := i.loginAttemptRepository.FindByKey(input.Scope, key.keyType, key.keyValue)
+		if errors.Is(err, entity.ErrNotFound) {
+			continue
+		} else if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		if policy.IsLoginAttemptLocked(attempt, now) {
+			return output, fmt.Errorf("%w:%s", entity.ErrTooManyRequests, loginAttemptErrorMessage)
+		}
+
+		if policy.RequiresLoginAttemptCaptcha(attempt, rule, now) {
+			requiresCaptcha = true
+		}
+	}
+
+	if requiresCaptcha && i.captcha.IsEnabled() {
+		ok, err := i.captcha.Verify(input.CaptchaToken, input.IPAddress)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		if !ok {
+			return output, fmt.Errorf("%w:%s", entity.ErrCaptchaRequired, "画像認証を行ってください。")
+		}
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// ログインの結果を記録する（トランザクション内で実行する）
+// 失敗した場合は回数を数えて上限に達したらロックし、成功した場合はアカウントの回数を戻す
+// メール送信など成功した場合も回数に含める操作はCountsSuccessを指定する
+type RecordLoginAttemptInput struct {
+	Scope          string
+	IPAddress      string
+	Account        string
+	IsEmailAccount bool
+	Succeeded      bool
+	CountsSuccess  bool
+}
+
+type RecordLoginAttemptOutput struct {
+	OK bool
+}
+
+func (i *LoginAttemptInteractorImpl) RecordLoginAttempt(input RecordLoginAttemptInput) (RecordLoginAttemptOutput, error) {
+	var (
+		output RecordLoginAttemptOutput
+		now    = time.Now().In(time.UTC)
+	)
+
+	for _, key := range getLoginAttemptKeyList(input.IPAddress, input.Account, input.IsEmailAccount) {
+		if _, ok := policy.GetLoginAttemptRule(input.Scope, key.keyType); !ok {
+			continue
+		}
+
+		// 成功した場合はアカウントの回数のみ戻す（IPアドレスは他のアカウントへの試行を続けられないよう戻さない）
+		if input.Succeeded && !input.CountsSuccess {
+			if key.keyType != entity.LoginAttemptKeyAccount {
+				continue
+			}
+
+			err := i.loginAttemptRepository.DeleteByKey(input.Scope, key.keyType, key.keyValue)
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+			continue
+		}
+
+		err := i.applyLoginAttempt(input.Scope, key.keyType, key.keyValue, now)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// Admin API
+//
+// ロック中の試行状態を取得する（管理者のみ）
+type GetLockedLoginAttemptListInput struct {
+	Operator *entity.AgentStaff
+}
+
+type GetLockedLoginAttemptListOutput struct {
+	LoginAttemptList []*entity.LoginAttempt
+}
+
+func (i *LoginAttemptInteractorImpl) GetLockedLoginAttemptList(input GetLockedLoginAttemptListInput) (GetLockedLoginAttemptListOutput, error) {
+	var (
+		output GetLockedLoginAttemptListOutput
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	loginAttemptList, err := i.loginAttemptRepository.GetLockedList(time.Now().In(time.UTC))
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.LoginAttemptList = loginAttemptList
+
+	return output, nil
+}
+
+// ロックを解除する（管理者のみ）
+// IDを指定した場合はその試行状態、メールアドレスを指定した場合はそのアカウントの全ての操作のロックを解除する
+type UnlockLoginAttemptInput struct {
+	Operator *entity.AgentStaff
+	Param    entity.UnlockLoginAttemptParam
+}
+
+type UnlockLoginAttemptOutput struct {
+	OK bool
+}
+
+func (i *LoginAttemptInteractorImpl) UnlockLoginAttempt(input UnlockLoginAttemptInput) (UnlockLoginAttemptOutput, error) {
+	var (
+		output UnlockLoginAttemptOutput
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if input.Param.LoginAttemptID != 0 {
+		attempt, err := i.loginAttemptRepository.FindByID(input.Param.LoginAttemptID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		err = i.loginAttemptRepository.Unlock(attempt.ID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	} else if input.Param.Email != "" {
+		err = i.loginAttemptRepository.UnlockByKey(entity.LoginAttemptKeyAccount, getLoginAttemptAccountKey(input.Param.Email, true))
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	} else {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, "解除する対象を指定してください")
+		return output, wrapped
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 非公開
+//
+// 試行を1回数えてロック状態を更新する（同時に記録しても回数がずれないよう行をロックする）
+func (i *LoginAttemptInteractorImpl) applyLoginAttempt(scope, keyType, keyValue string, now time.Time) error {
+	rule, _ := policy.GetLoginAttemptRule(scope, keyType)
+
+	err := i.loginAttemptRepository.CreateIfNotExists(entity.NewLoginAttempt(scope, keyType, keyValue, now))
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	attempt, err := i.loginAttemptRepository.FindByKeyForUpdate(scope, keyType, keyValue)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	policy.ApplyLoginAttempt(attempt, rule, now)
+
+	err = i.loginAttemptRepository.Update(attempt)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+type loginAttemptKey struct {
+	keyType  string
+	keyValue string
+}
+
+// 制限の単位ごとの値（アカウントが取得できない場合はIPアドレスのみ）
+func getLoginAttemptKeyList(ipAddress, account string, isEmailAccount bool) []loginAttemptKey {
+	var keyList []loginAttemptKey
+
+	if ipAddress != "" {
+		keyList = append(keyList, loginAttemptKey{entity.LoginAttemptKeyIP, ipAddress})
+	}
+
+	accountKey := getLoginAttemptAccountKey(account, isEmailAccount)
+	if accountKey != "" {
+		keyList = append(keyList, loginAttemptKey{entity.LoginAttemptKeyAccount, accountKey})
+	}
+
+	return keyList
+}
+
+// アカウントの値（メールアドレスは平文で保存しないようブラインドインデックスにする）
+func getLoginAttemptAccountKey(account string, isEmailAccount bool) string {
+	if isEmailAccount {
+		return utility.BlindIndexEmail(account)
+	}
+
+	return strings.ToLower(strings.TrimSpace(account))
+}
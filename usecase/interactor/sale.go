@@ -28,6 +28,7 @@ type SaleInteractorImpl struct {
 	chatGroupWithJobSeekerRepository   usecase.ChatGroupWithJobSeekerRepository
 	agentStaffRepository               usecase.AgentStaffRepository
 	chatMessageWithJobSeekerRepository usecase.ChatMessageWithJobSeekerRepository
+	taskGroupEventRepository           usecase.TaskGroupEventRepository
 }
 
 // SaleInteractorImpl is an implementation of SaleInteractor
@@ -40,6 +41,7 @@ func NewSaleInteractorImpl(
 	cgR usecase.ChatGroupWithJobSeekerRepository,
 	asR usecase.AgentStaffRepository,
 	cmjR usecase.ChatMessageWithJobSeekerRepository,
+	tgeR usecase.TaskGroupEventRepository,
 ) SaleInteractor {
 	return &SaleInteractorImpl{
 		firebase:                           fb,
@@ -50,6 +52,7 @@ func NewSaleInteractorImpl(
 		chatGroupWithJobSeekerRepository:   cgR,
 		agentStaffRepository:               asR,
 		chatMessageWithJobSeekerRepository: cmjR,
+		taskGroupEventRepository:           tgeR,
 	}
 }
 
@@ -59,6 +62,7 @@ func NewSaleInteractorImpl(
 // 最終閲覧時間を更新
 type CreateSaleInput struct {
 	CreateParam entity.CreateOrUpdateSaleParam
+	Operator    *entity.AgentStaff // 活動履歴に記録する担当者
 }
 
 type CreateSaleOutput struct {
@@ -87,7 +91,7 @@ func (i *SaleInteractorImpl) CreateSale(input CreateSaleInput) (CreateSaleOutput
 		input.CreateParam.CaSalesRatio,
 	)
 
-	err = i.saleRepository.Create(sale)
+	err = createSaleWithEvent(i.saleRepository, i.taskGroupEventRepository, sale, getOperatorID(input.Operator))
 	if err != nil {
 		fmt.Println(err)
 		return output, err
@@ -102,6 +106,7 @@ func (i *SaleInteractorImpl) CreateSale(input CreateSaleInput) (CreateSaleOutput
 type UpdateSaleInput struct {
 	SaleID      uint
 	UpdateParam entity.CreateOrUpdateSaleParam
+	Operator    *entity.AgentStaff // 活動履歴に記録する担当者
 }
 
 type UpdateSaleOutput struct {
@@ -129,7 +134,14 @@ func (i *SaleInteractorImpl) UpdateSale(input UpdateSaleInput) (UpdateSaleOutput
 		input.UpdateParam.CaSalesRatio,
 	)
 
-	err = i.saleRepository.Update(input.SaleID, sale)
+	// 活動履歴に変更前の値を残すため、更新前の売上を取得する
+	before, err := i.saleRepository.FindByID(input.SaleID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = updateSaleWithEvent(i.saleRepository, i.taskGroupEventRepository, input.SaleID, before, sale, getOperatorID(input.Operator))
 	if err != nil {
 		fmt.Println(err)
 		return output, err
@@ -216,9 +228,6 @@ func (i *SaleInteractorImpl) GetSaleListByIDList(input GetSaleListByIDListInput)
 		return output, err
 	}
 
-	for _, s := range saleList {
-		fmt.Println("groupID: ", s.TaskGroupID, "phase: ", s.PhaseCategory, "sub: ", s.PhaseSubCategory)
-	}
 	output.SaleList = saleList
 
 	return output, nil
@@ -0,0 +1,563 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/sendgrid/sendgrid-go/helpers/mail"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+type SaleGuaranteeInteractor interface {
+	// 汎用系 API
+	GetSalePolicy(input GetSalePolicyInput) (GetSalePolicyOutput, error)
+	UpdateSalePolicy(input UpdateSalePolicyInput) (UpdateSalePolicyOutput, error)
+	GetSaleGuaranteeList(input GetSaleGuaranteeListInput) (GetSaleGuaranteeListOutput, error)
+	RecordSaleResignation(input RecordSaleResignationInput) (RecordSaleResignationOutput, error)
+
+	// Batch API
+	BatchNotifySaleGuarantee(input BatchNotifySaleGuaranteeInput) (BatchNotifySaleGuaranteeOutput, error)
+}
+
+type SaleGuaranteeInteractorImpl struct {
+	sendgrid                            config.Sendgrid
+	saleRepository                      usecase.SaleRepository
+	saleRefundRepository                usecase.SaleRefundRepository
+	saleGuaranteeNotificationRepository usecase.SaleGuaranteeNotificationRepository
+	agentRefundTierRepository           usecase.AgentRefundTierRepository
+	agentRepository                     usecase.AgentRepository
+	agentStaffRepository                usecase.AgentStaffRepository
+	taskGroupRepository                 usecase.TaskGroupRepository
+	taskGroupEventRepository            usecase.TaskGroupEventRepository
+}
+
+// SaleGuaranteeInteractorImpl is an implementation of SaleGuaranteeInteractor
+func NewSaleGuaranteeInteractorImpl(
+	sg config.Sendgrid,
+	sR usecase.SaleRepository,
+	srR usecase.SaleRefundRepository,
+	sgnR usecase.SaleGuaranteeNotificationRepository,
+	artR usecase.AgentRefundTierRepository,
+	aR usecase.AgentRepository,
+	asR usecase.AgentStaffRepository,
+	tgR usecase.TaskGroupRepository,
+	tgeR usecase.TaskGroupEventRepository,
+) SaleGuaranteeInteractor {
+	return &SaleGuaranteeInteractorImpl{
+		sendgrid:                            sg,
+		saleRepository:                      sR,
+		saleRefundRepository:                srR,
+		saleGuaranteeNotificationRepository: sgnR,
+		agentRefundTierRepository:           artR,
+		agentRepository:                     aR,
+		agentStaffRepository:                asR,
+		taskGroupRepository:                 tgR,
+		taskGroupEventRepository:            tgeR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// 自社の売上の自動作成・返金規定の設定を取得する
+type GetSalePolicyInput struct {
+	Operator *entity.AgentStaff
+}
+
+type GetSalePolicyOutput struct {
+	SalePolicy *entity.SalePolicy
+}
+
+func (i *SaleGuaranteeInteractorImpl) GetSalePolicy(input GetSalePolicyInput) (GetSalePolicyOutput, error) {
+	var (
+		output GetSalePolicyOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	salePolicy, err := i.getSalePolicy(input.Operator.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	output.SalePolicy = salePolicy
+
+	return output, nil
+}
+
+// 自社の売上の自動作成・返金規定の設定を更新する（管理者のみ）
+type UpdateSalePolicyInput struct {
+	Operator    *entity.AgentStaff
+	UpdateParam entity.UpdateSalePolicyParam
+}
+
+type UpdateSalePolicyOutput struct {
+	SalePolicy *entity.SalePolicy
+}
+
+func (i *SaleGuaranteeInteractorImpl) UpdateSalePolicy(input UpdateSalePolicyInput) (UpdateSalePolicyOutput, error) {
+	var (
+		output UpdateSalePolicyOutput
+		param  = input.UpdateParam
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		return output, err
+	}
+
+	err = policy.ValidateSalePolicyParam(param)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	agentID := input.Operator.AgentID
+
+	err = i.agentRepository.UpdateRASalesRatio(agentID, param.RASalesRatio)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 返金規定は洗い替える
+	err = i.agentRefundTierRepository.DeleteByAgentID(agentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, tierParam := range param.RefundTierList {
+		tier := entity.NewAgentRefundTier(agentID, tierParam.WithinDays, tierParam.RefundRate)
+
+		err = i.agentRefundTierRepository.Create(tier)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	salePolicy, err := i.getSalePolicy(agentID)
+	if err != nil {
+		return output, err
+	}
+
+	output.SalePolicy = salePolicy
+
+	return output, nil
+}
+
+// 自社（RA側・CA側）の返金保証期間の対象の売上一覧を取得する
+// 入社日が SaleGuaranteeMaxDays 日前以降の売上を入社日の古い順に返す
+type GetSaleGuaranteeListInput struct {
+	Operator *entity.AgentStaff
+	Now      time.Time
+}
+
+type GetSaleGuaranteeListOutput struct {
+	SaleGuaranteeList []*entity.SaleGuarantee
+}
+
+func (i *SaleGuaranteeInteractorImpl) GetSaleGuaranteeList(input GetSaleGuaranteeListInput) (GetSaleGuaranteeListOutput, error) {
+	var (
+		output GetSaleGuaranteeListOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	guaranteeList, err := i.saleRepository.GetGuaranteeByAgentID(input.Operator.AgentID, getSaleGuaranteeJoinedSince(input.Now))
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 返金規定はRA側のエージェントの設定を使用する
+	tierListMap := map[uint][]entity.AgentRefundTier{}
+
+	for _, guarantee := range guaranteeList {
+		tierList, ok := tierListMap[guarantee.RAAgentID]
+		if !ok {
+			tierList, err = i.getRefundTierList(guarantee.RAAgentID)
+			if err != nil {
+				return output, err
+			}
+			tierListMap[guarantee.RAAgentID] = tierList
+		}
+
+		policy.SetSaleGuaranteeStatus(guarantee, tierList, input.Now)
+	}
+
+	output.SaleGuaranteeList = guaranteeList
+
+	return output, nil
+}
+
+// 早期退職を記録し、返金規定に従って売上の請求金額を減額する（CA側・RA側のエージェントの担当者のみ）
+// 退職の記録は売上ごとに1件のみ
+type RecordSaleResignationInput struct {
+	SaleID      uint
+	Operator    *entity.AgentStaff
+	RecordParam entity.RecordSaleResignationParam
+}
+
+type RecordSaleResignationOutput struct {
+	SaleRefund *entity.SaleRefund
+}
+
+func (i *SaleGuaranteeInteractorImpl) RecordSaleResignation(input RecordSaleResignationInput) (RecordSaleResignationOutput, error) {
+	var (
+		output RecordSaleResignationOutput
+		param  = input.RecordParam
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	saleByID, err := i.saleRepository.FindByID(input.SaleID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 担当者のエージェントと選考を含めて取得し直す
+	sale, err := i.saleRepository.FindByJobSeekerID(saleByID.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, sale.CAAgentID)
+	if err != nil {
+		err = policy.RequireOwnAgent(input.Operator, sale.RAAgentID)
+		if err != nil {
+			return output, err
+		}
+	}
+
+	_, err = i.saleRefundRepository.FindBySaleID(sale.ID)
+	if err == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrDuplicateEntry, "この売上の退職は記録済みです")
+	} else if !errors.Is(err, entity.ErrNotFound) {
+		fmt.Println(err)
+		return output, err
+	}
+
+	taskGroup, err := i.taskGroupRepository.FindByID(sale.TaskGroupID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	tierList, err := i.getRefundTierList(sale.RAAgentID)
+	if err != nil {
+		return output, err
+	}
+
+	daysEmployed, refundRate, err := policy.GetSaleRefund(tierList, taskGroup.JoiningDate, param.ResignationDate)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	refundAmount := policy.GetSaleRefundAmount(sale.BillingAmount, refundRate)
+
+	refund := entity.NewSaleRefund(
+		sale.ID,
+		taskGroup.JoiningDate,
+		param.ResignationDate,
+		daysEmployed,
+		refundRate,
+		sale.BillingAmount,
+		refundAmount,
+		param.Remarks,
+		getTaskGroupEventStaffID(input.Operator.ID),
+	)
+
+	err = i.saleRefundRepository.Create(refund)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 返金がある場合は請求金額・粗利を減額する
+	if refundAmount.Valid && refundAmount.Float64 > 0 {
+		before := *sale
+
+		sale.BillingAmount = null.FloatFrom(sale.BillingAmount.Float64 - refundAmount.Float64)
+		if sale.GrossProfit.Valid {
+			sale.GrossProfit = null.FloatFrom(sale.GrossProfit.Float64 - refundAmount.Float64)
+		}
+
+		err = updateSaleWithEvent(i.saleRepository, i.taskGroupEventRepository, sale.ID, &before, sale, input.Operator.ID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	output.SaleRefund = refund
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// Batch API
+//
+/*
+	返金保証期間の通知
+	返金規定の段階が SaleGuaranteeAlertDays 日以内に終わる売上をCA・RAの担当者へ通知する
+	同じ入社日・同じ段階に対する通知は一度だけ送る（入社日が変更された場合は再度通知する）
+	1日1回実行
+*/
+type BatchNotifySaleGuaranteeInput struct {
+	Now time.Time
+}
+
+type BatchNotifySaleGuaranteeOutput struct {
+	OK bool
+}
+
+func (i *SaleGuaranteeInteractorImpl) BatchNotifySaleGuarantee(input BatchNotifySaleGuaranteeInput) (BatchNotifySaleGuaranteeOutput, error) {
+	var (
+		output BatchNotifySaleGuaranteeOutput
+	)
+
+	guaranteeList, err := i.saleRepository.GetActiveGuaranteeByJoinedSince(getSaleGuaranteeJoinedSince(input.Now))
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	if len(guaranteeList) == 0 {
+		output.OK = true
+		return output, nil
+	}
+
+	var (
+		saleIDList        []uint
+		sentMap           = map[string]bool{}
+		agentStaffMap     = map[uint]*entity.AgentStaff{}
+		agentTierListMap  = map[uint][]*entity.AgentRefundTier{}
+		refundTierListMap = map[uint][]entity.AgentRefundTier{}
+	)
+
+	for _, guarantee := range guaranteeList {
+		saleIDList = append(saleIDList, guarantee.SaleID)
+	}
+
+	notificationList, err := i.saleGuaranteeNotificationRepository.GetBySaleIDList(saleIDList)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, notification := range notificationList {
+		sentMap[getSaleGuaranteeNotificationKey(notification.SaleID, notification.AgentStaffID, notification.WithinDays, notification.JoiningDate)] = true
+	}
+
+	agentStaffList, err := i.agentStaffRepository.All()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, agentStaff := range agentStaffList {
+		agentStaffMap[agentStaff.ID] = agentStaff
+	}
+
+	allTierList, err := i.agentRefundTierRepository.All()
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, tier := range allTierList {
+		agentTierListMap[tier.AgentID] = append(agentTierListMap[tier.AgentID], tier)
+	}
+
+	// 通知先の担当者ごとにまとめて送る
+	type alertItem struct {
+		guarantee *entity.SaleGuarantee
+		tier      entity.AgentRefundTier
+	}
+
+	var (
+		recipientIDList []uint
+		itemListMap     = map[uint][]alertItem{}
+	)
+
+	for _, guarantee := range guaranteeList {
+		tierList, ok := refundTierListMap[guarantee.RAAgentID]
+		if !ok {
+			tierList, _ = policy.GetSaleRefundTierList(agentTierListMap[guarantee.RAAgentID])
+			refundTierListMap[guarantee.RAAgentID] = tierList
+		}
+
+		for _, tier := range policy.GetSaleGuaranteeAlertTierList(tierList, guarantee.JoiningDate, input.Now) {
+			for _, staffID := range []uint{guarantee.CAStaffID, guarantee.RAStaffID} {
+				recipient, ok := agentStaffMap[staffID]
+				// 通知の可否はタスクの期限のリマインドの設定に従う
+				if !ok || !policy.IsTaskSLANotifiable(recipient, false) {
+					continue
+				}
+
+				key := getSaleGuaranteeNotificationKey(guarantee.SaleID, recipient.ID, tier.WithinDays, guarantee.JoiningDate)
+				if sentMap[key] {
+					continue
+				}
+				sentMap[key] = true
+
+				if _, ok := itemListMap[recipient.ID]; !ok {
+					recipientIDList = append(recipientIDList, recipient.ID)
+				}
+				itemListMap[recipient.ID] = append(itemListMap[recipient.ID], alertItem{
+					guarantee: guarantee,
+					tier:      tier,
+				})
+			}
+		}
+	}
+
+	for _, recipientID := range recipientIDList {
+		var (
+			recipient = agentStaffMap[recipientID]
+			section   = fmt.Sprintf("返金規定の段階がまもなく終了する売上 %d件\nhttps://autoscout.spaceai.jp/\n", len(itemListMap[recipientID]))
+		)
+
+		// fmt:段階の最終日 / 企業名 求人タイトル / 求職者名 / 入社日・返金率
+		for _, item := range itemListMap[recipientID] {
+			joining, _ := time.ParseInLocation("2006-01-02", item.guarantee.JoiningDate, utility.Tokyo)
+
+			line := joining.AddDate(0, 0, int(item.tier.WithinDays)).Format("01/02") + "まで"
+			line += " / " + item.guarantee.CompanyName + " " + item.guarantee.Title
+			line += " / " + item.guarantee.LastName + " " + item.guarantee.FirstName + "様"
+			line += " / " + joining.Format("01/02") + "入社・返金率" + fmt.Sprint(item.tier.RefundRate) + "%"
+			section += "\t" + line + "\n"
+		}
+
+		mailBody := fmt.Sprintf(
+			"%s\n%s様\n\n平素よりautoscoutをご利用いただきありがとうございます。\nautoscout事務局でございます。\n\n返金保証期間についてお知らせいたします。\n\n%s\n以上でございます。\n入れ違いで処理済みでしたら申し訳ございません。\n\n引き続きどうぞよろしくお願い申し上げます。\n\n通知の設定は、autoscout内の「設定 / 基本情報」より変更できます。\nhttps://autoscout.spaceai.jp/account/?panel=basic_information",
+			recipient.AgentName,
+			recipient.StaffName,
+			section,
+		)
+
+		err = i.sendSaleGuaranteeMail(recipient, "返金保証期間のお知らせ", mailBody)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		// 送信した通知を記録する
+		for _, item := range itemListMap[recipientID] {
+			notification := entity.NewSaleGuaranteeNotification(
+				item.guarantee.SaleID,
+				recipient.ID,
+				item.tier.WithinDays,
+				item.guarantee.JoiningDate,
+			)
+
+			err = i.saleGuaranteeNotificationRepository.Create(notification)
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+		}
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+// エージェントの売上の自動作成・返金規定の設定を取得する
+func (i *SaleGuaranteeInteractorImpl) getSalePolicy(agentID uint) (*entity.SalePolicy, error) {
+	agent, err := i.agentRepository.FindByID(agentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	agentTierList, err := i.agentRefundTierRepository.GetByAgentID(agentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	tierList, isDefault := policy.GetSaleRefundTierList(agentTierList)
+
+	return &entity.SalePolicy{
+		RASalesRatio:        agent.RASalesRatio,
+		RefundTierList:      tierList,
+		IsDefaultRefundTier: isDefault,
+	}, nil
+}
+
+// エージェントの返金規定を取得する（設定がない場合は初期設定）
+func (i *SaleGuaranteeInteractorImpl) getRefundTierList(agentID uint) ([]entity.AgentRefundTier, error) {
+	agentTierList, err := i.agentRefundTierRepository.GetByAgentID(agentID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	tierList, _ := policy.GetSaleRefundTierList(agentTierList)
+
+	return tierList, nil
+}
+
+// 返金保証期間の通知メールを送る（本番環境のみ送信）
+func (i *SaleGuaranteeInteractorImpl) sendSaleGuaranteeMail(agentStaff *entity.AgentStaff, subject, mailBody string) error {
+	from := mail.Email{
+		Name:    "autoscout事務局",
+		Address: "info@spaceai.jp",
+	}
+
+	to := mail.Email{
+		Name:    agentStaff.StaffName,
+		Address: agentStaff.Email,
+	}
+
+	if os.Getenv("APP_ENV") == "prd" {
+		sendgrid := utility.NewSendGrid(i.sendgrid.APIKey)
+		err := sendgrid.SendMail(
+			&from,
+			&to,
+			subject,
+			mailBody,
+			"",
+		)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+	log.Println("メール送信成功。担当者ID:", agentStaff.ID, "件名:", subject)
+
+	return nil
+}
+
+// 返金保証期間の対象とする入社日の下限（2006-01-02）
+func getSaleGuaranteeJoinedSince(now time.Time) string {
+	return now.In(utility.Tokyo).AddDate(0, 0, -entity.SaleGuaranteeMaxDays).Format("2006-01-02")
+}
+
+func getSaleGuaranteeNotificationKey(saleID, agentStaffID, withinDays uint, joiningDate string) string {
+	return fmt.Sprint(saleID, "-", agentStaffID, "-", withinDays, "-", joiningDate)
+}
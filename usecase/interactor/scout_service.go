@@ -84,6 +84,16 @@ type ScoutServiceInteractorImpl struct {
 	googleAuthenticationRepository          usecase.GoogleAuthenticationRepository
 	emailWithJobSeekerRepository            usecase.EmailWithJobSeekerRepository
 	userEntryRepository                     usecase.UserEntryRepository
+	jobSeekerExternalIDRepository           usecase.JobSeekerExternalIDRepository
+	jobSeekerMergeSuggestionRepository      usecase.JobSeekerMergeSuggestionRepository
+	agentAssignmentRuleRepository           usecase.AgentAssignmentRuleRepository
+	agentAssignmentStaffRepository          usecase.AgentAssignmentStaffRepository
+	agentAssignmentStaffSpecialtyRepository usecase.AgentAssignmentStaffSpecialtyRepository
+	jobSeekerAssignmentLogRepository        usecase.JobSeekerAssignmentLogRepository
+	entryScreeningRuleRepository            usecase.EntryScreeningRuleRepository
+	entryScreeningRuleConditionRepository   usecase.EntryScreeningRuleConditionRepository
+	entryScreeningResultRepository          usecase.EntryScreeningResultRepository
+	interviewBookingLinkRepository          usecase.InterviewBookingLinkRepository
 }
 
 // ScoutServiceInteractorImpl is an implementation of ScoutServiceInteractor
@@ -125,6 +135,16 @@ func NewScoutServiceInteractorImpl(
 	gaR usecase.GoogleAuthenticationRepository,
 	ewjsR usecase.EmailWithJobSeekerRepository,
 	ueR usecase.UserEntryRepository,
+	jseidR usecase.JobSeekerExternalIDRepository,
+	jsmsR usecase.JobSeekerMergeSuggestionRepository,
+	aarR usecase.AgentAssignmentRuleRepository,
+	aasR usecase.AgentAssignmentStaffRepository,
+	aassR usecase.AgentAssignmentStaffSpecialtyRepository,
+	jsalR usecase.JobSeekerAssignmentLogRepository,
+	esrR usecase.EntryScreeningRuleRepository,
+	esrcR usecase.EntryScreeningRuleConditionRepository,
+	esresR usecase.EntryScreeningResultRepository,
+	iblR usecase.InterviewBookingLinkRepository,
 ) ScoutServiceInteractor {
 	return &ScoutServiceInteractorImpl{
 		firebase:                                fb,
@@ -164,6 +184,16 @@ func NewScoutServiceInteractorImpl(
 		googleAuthenticationRepository:          gaR,
 		emailWithJobSeekerRepository:            ewjsR,
 		userEntryRepository:                     ueR,
+		jobSeekerExternalIDRepository:           jseidR,
+		jobSeekerMergeSuggestionRepository:      jsmsR,
+		agentAssignmentRuleRepository:           aarR,
+		agentAssignmentStaffRepository:          aasR,
+		agentAssignmentStaffSpecialtyRepository: aassR,
+		jobSeekerAssignmentLogRepository:        jsalR,
+		entryScreeningRuleRepository:            esrR,
+		entryScreeningRuleConditionRepository:   esrcR,
+		entryScreeningResultRepository:          esresR,
+		interviewBookingLinkRepository:          iblR,
 	}
 }
 
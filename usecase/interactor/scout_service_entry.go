@@ -802,6 +802,11 @@ userLoop:
 				// 改行ごとにを分割
 				nameWithSpace := utility.RegexpForLineBreak.Split(nameWithLineBreak, -1)
 
+				// 会員番号を媒体の求職者IDとして記録
+				if matches := utility.RegexpForRanUserID.FindStringSubmatch(nameWithLineBreak); len(matches) >= 2 {
+					jobSeeker.ExternalID = matches[1]
+				}
+
 				// 空白ごとにを分割
 				furiganaSplited := strings.Split(nameWithSpace[0], " ")
 				if len(furiganaSplited) < 2 {
@@ -1024,97 +1029,16 @@ userLoop:
 		jobSeeker.InterviewDate = time.Now().UTC()
 		jobSeeker.InflowChannelID = input.ScoutService.InflowChannelID
 
-		err := i.jobSeekerRepository.Create(jobSeeker)
+		// 重複判定・選考ルール・担当CAの割り振りを行って登録し、面談調整を開始する
+		isCreated, err := registerScoutServiceEntryJobSeeker(i, jobSeeker, input.ScoutService, entity.ScoutServiceTypeRan)
 		if err != nil {
 			log.Println(err)
 			return output, err
 		}
 
-		// 開発環境の場合はユーザー名などを統一する
-		// if os.Getenv("APP_ENV") != "prd" {
-		// 	err = i.jobSeekerRepository.UpdateForDev(jobSeeker.ID)
-		// 	if err != nil {
-		// 		fmt.Println(err)
-		// 		return output, err
-		// 	}
-		// }
-
-		jobSeekerDocument := entity.NewJobSeekerDocument(
-			jobSeeker.ID,
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-		)
-
-		err = i.jobSeekerDocumentRepository.Create(jobSeekerDocument)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-
-		// 求職者作成時にメッセージグループ作成
-		// エージェントと求職者のチャットグループを作成
-		chatGroup := entity.NewChatGroupWithJobSeeker(
-			jobSeeker.AgentID,
-			jobSeeker.ID,
-			false, // 初めはLINE連携してないから false
-		)
-
-		err = i.chatGroupWithJobSeekerRepository.Create(chatGroup)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-
-		// 面談実施待ちより前のフェーズの場合は「面談調整タスク」を作成
-		var (
-			phaseSub null.Int
-			date     string
-		)
-
-		phaseSub = null.NewInt(0, true) //日程調整依頼
-
-		// タスクの期限は当日で登録
-		now := time.Now()
-		date = now.Format("2006-01-02")
-
-		// 面談調整タスクの作成
-		interviewTaskGroup := entity.NewInterviewTaskGroup(
-			jobSeeker.AgentID,
-			jobSeeker.ID,
-			jobSeeker.InterviewDate,
-			utility.EarliestTime(), // 初期値,
-		)
-
-		err = i.interviewTaskGroupRepository.Create(interviewTaskGroup)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-
-		interviewTask := entity.NewInterviewTask(
-			interviewTaskGroup.ID,
-			null.NewInt(0, false),
-			null.NewInt(0, false),
-			jobSeeker.Phase,
-			phaseSub,
-			"",
-			date,
-			null.NewInt(99, true),
-			getStrPhaseForJobSeeker(jobSeeker.Phase),
-		)
-
-		err = i.interviewTaskRepository.Create(interviewTask)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
+		// 他媒体から取り込み済みの求職者と同一人物の場合は新規の登録をスキップ
+		if !isCreated {
+			continue
 		}
 	}
 
@@ -3645,6 +3569,8 @@ userIDLoop:
 
 					}
 				}
+				jobSeeker.ExternalID = userID
+
 				jobSeekerList = append(jobSeekerList, &jobSeeker)
 
 				// モーダルを閉じる
@@ -3673,97 +3599,16 @@ userIDLoop:
 		jobSeeker.InterviewDate = time.Now().UTC()
 		jobSeeker.InflowChannelID = input.ScoutService.InflowChannelID
 
-		err := i.jobSeekerRepository.Create(jobSeeker)
+		// 重複判定・選考ルール・担当CAの割り振りを行って登録し、面談調整を開始する
+		isCreated, err := registerScoutServiceEntryJobSeeker(i, jobSeeker, input.ScoutService, entity.ScoutServiceTypeAmbi)
 		if err != nil {
 			log.Println(err)
 			return output, err
 		}
 
-		// 開発環境の場合はユーザー名などを統一する
-		// if os.Getenv("APP_ENV") != "prd" {
-		// 	err = i.jobSeekerRepository.UpdateForDev(jobSeeker.ID)
-		// 	if err != nil {
-		// 		fmt.Println(err)
-		// 		return output, err
-		// 	}
-		// }
-
-		jobSeekerDocument := entity.NewJobSeekerDocument(
-			jobSeeker.ID,
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-		)
-
-		err = i.jobSeekerDocumentRepository.Create(jobSeekerDocument)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-
-		// 求職者作成時にメッセージグループ作成
-		// エージェントと求職者のチャットグループを作成
-		chatGroup := entity.NewChatGroupWithJobSeeker(
-			jobSeeker.AgentID,
-			jobSeeker.ID,
-			false, // 初めはLINE連携してないから false
-		)
-
-		err = i.chatGroupWithJobSeekerRepository.Create(chatGroup)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-
-		// 面談実施待ちより前のフェーズの場合は「面談調整タスク」を作成
-		var (
-			phaseSub null.Int
-			date     string
-		)
-
-		phaseSub = null.NewInt(0, true) //日程調整依頼
-
-		// タスクの期限は当日で登録
-		now := time.Now()
-		date = now.Format("2006-01-02")
-
-		// 面談調整タスクの作成
-		interviewTaskGroup := entity.NewInterviewTaskGroup(
-			jobSeeker.AgentID,
-			jobSeeker.ID,
-			jobSeeker.InterviewDate,
-			utility.EarliestTime(), // 初期値,
-		)
-
-		err = i.interviewTaskGroupRepository.Create(interviewTaskGroup)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-
-		interviewTask := entity.NewInterviewTask(
-			interviewTaskGroup.ID,
-			null.NewInt(0, false),
-			null.NewInt(0, false),
-			jobSeeker.Phase,
-			phaseSub,
-			"",
-			date,
-			null.NewInt(99, true),
-			getStrPhaseForJobSeeker(jobSeeker.Phase),
-		)
-
-		err = i.interviewTaskRepository.Create(interviewTask)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
+		// 他媒体から取り込み済みの求職者と同一人物の場合は新規の登録をスキップ
+		if !isCreated {
+			continue
 		}
 
 		// 希望職種
@@ -4072,6 +3917,8 @@ func (i *ScoutServiceInteractorImpl) EntryOnMynaviAgentScout(input EntryOnMynavi
 			switch columnI {
 
 			// 0	求職者ID
+			case 0:
+				jobSeeker.ExternalID = column
 			// 1	求職者氏名（姓）
 			case 1:
 				jobSeeker.LastName = column
@@ -4339,97 +4186,16 @@ func (i *ScoutServiceInteractorImpl) EntryOnMynaviAgentScout(input EntryOnMynavi
 		// 	jobSeeker.Email = ""
 		// }
 
-		err := i.jobSeekerRepository.Create(jobSeeker)
+		// 重複判定・選考ルール・担当CAの割り振りを行って登録し、面談調整を開始する
+		isCreated, err := registerScoutServiceEntryJobSeeker(i, jobSeeker, scoutService, entity.ScoutServiceTypeMynaviAgentScout)
 		if err != nil {
 			log.Println(err)
 			return output, err
 		}
 
-		// 開発環境の場合はユーザー名などを統一する
-		// if os.Getenv("APP_ENV") != "prd" {
-		// 	err = i.jobSeekerRepository.UpdateForDev(jobSeeker.ID)
-		// 	if err != nil {
-		// 		fmt.Println(err)
-		// 		return output, err
-		// 	}
-		// }
-
-		jobSeekerDocument := entity.NewJobSeekerDocument(
-			jobSeeker.ID,
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-			"",
-		)
-
-		err = i.jobSeekerDocumentRepository.Create(jobSeekerDocument)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-
-		// 求職者作成時にメッセージグループ作成
-		// エージェントと求職者のチャットグループを作成
-		chatGroup := entity.NewChatGroupWithJobSeeker(
-			jobSeeker.AgentID,
-			jobSeeker.ID,
-			false, // 初めはLINE連携してないから false
-		)
-
-		err = i.chatGroupWithJobSeekerRepository.Create(chatGroup)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-
-		// 面談実施待ちより前のフェーズの場合は「面談調整タスク」を作成
-		var (
-			phaseSub null.Int
-			date     string
-		)
-
-		phaseSub = null.NewInt(0, true) //日程調整依頼
-
-		// タスクの期限は当日で登録
-		now := time.Now()
-		date = now.Format("2006-01-02")
-
-		// 面談調整タスクの作成
-		interviewTaskGroup := entity.NewInterviewTaskGroup(
-			jobSeeker.AgentID,
-			jobSeeker.ID,
-			jobSeeker.InterviewDate,
-			utility.EarliestTime(),
-		)
-
-		err = i.interviewTaskGroupRepository.Create(interviewTaskGroup)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-
-		interviewTask := entity.NewInterviewTask(
-			interviewTaskGroup.ID,
-			null.NewInt(0, false),
-			null.NewInt(0, false),
-			jobSeeker.Phase,
-			phaseSub,
-			"",
-			date,
-			null.NewInt(99, true),
-			getStrPhaseForJobSeeker(jobSeeker.Phase),
-		)
-
-		err = i.interviewTaskRepository.Create(interviewTask)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
+		// 他媒体から取り込み済みの求職者と同一人物の場合は新規の登録をスキップ
+		if !isCreated {
+			continue
 		}
 
 		// 希望勤務地
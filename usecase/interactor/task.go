@@ -15,6 +15,7 @@ import (
 	"github.com/spaceaiinc/autoscout-server/domain/entity"
 	"github.com/spaceaiinc/autoscout-server/domain/utility"
 	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -62,6 +63,8 @@ type TaskInteractor interface {
 
 	// 指定IDのタスク情報を取得する関数
 	GetTaskByID(input GetTaskByIDInput) (GetTaskByIDOutput, error)
+	GetTaskPhaseActionList(input GetTaskPhaseActionListInput) (GetTaskPhaseActionListOutput, error)
+	CheckDuplicateRecommendation(input CheckDuplicateRecommendationInput) (CheckDuplicateRecommendationOutput, error)
 	GetTaskListByAgentIDAndPage(input GetTaskListByAgentIDAndPageInput) (GetTaskListByAgentIDAndPageOutput, error)
 	GetSearchTaskListByAgentIDAndPage(input GetSearchTaskListByAgentIDAndPageInput) (GetSearchTaskListByAgentIDAndPageOutput, error)
 	GetTaskListAfterEntryByJobSeekerID(input GetTaskListAfterEntryByJobSeekerIDInput) (GetTaskListAfterEntryByJobSeekerIDOutput, error)
@@ -124,6 +127,9 @@ type TaskInteractorImpl struct {
 	chatMessageToUserWithAgentRepository      usecase.ChatMessageToUserWithAgentRepository
 	emailWithJobSeekerRepository              usecase.EmailWithJobSeekerRepository
 	jobSeekerInterestedJobListingRepository   usecase.JobSeekerInterestedJobListingRepository
+	taskGroupEventRepository                  usecase.TaskGroupEventRepository
+	jobOfferRepository                        usecase.JobOfferRepository
+	taskLossReasonRepository                  usecase.TaskLossReasonRepository
 }
 
 // TaskInteractorImpl is an implementation of TaskInteractor
@@ -172,6 +178,9 @@ func NewTaskInteractorImpl(
 	cmtuwaR usecase.ChatMessageToUserWithAgentRepository,
 	ewjsR usecase.EmailWithJobSeekerRepository,
 	jsijlR usecase.JobSeekerInterestedJobListingRepository,
+	tgeR usecase.TaskGroupEventRepository,
+	joR usecase.JobOfferRepository,
+	tlrR usecase.TaskLossReasonRepository,
 ) TaskInteractor {
 	return &TaskInteractorImpl{
 		firebase:                                  fb,
@@ -218,6 +227,9 @@ func NewTaskInteractorImpl(
 		chatMessageToUserWithAgentRepository:      cmtuwaR,
 		emailWithJobSeekerRepository:              ewjsR,
 		jobSeekerInterestedJobListingRepository:   jsijlR,
+		taskGroupEventRepository:                  tgeR,
+		jobOfferRepository:                        joR,
+		taskLossReasonRepository:                  tlrR,
 	}
 }
 
@@ -242,6 +254,12 @@ func (i *TaskInteractorImpl) SoundOutForJobInformation(input SoundOutForJobInfor
 		encountered = map[uint]bool{}
 	)
 
+	// 同じ企業への重複推薦を確認（重複がある場合は理由の入力が必要）
+	duplicateMap, err := guardDuplicateRecommendation(i, getSoundOutDuplicateRecommendationTargetList(input.SoundOutParam.GroupList), 0, input.SoundOutParam.DuplicateOverrideReason)
+	if err != nil {
+		return output, err
+	}
+
 	for _, group := range input.SoundOutParam.GroupList {
 		fmt.Println("RA: ", group.RAStaffID)
 		fmt.Println("CA: ", group.CAStaffID)
@@ -270,6 +288,18 @@ func (i *TaskInteractorImpl) SoundOutForJobInformation(input SoundOutForJobInfor
 			return output, err
 		}
 
+		// 重複推薦を理由付きで進めた場合は活動履歴に記録
+		err = createDuplicateOverrideEvent(
+			i,
+			taskGroup,
+			duplicateMap[duplicateRecommendationKey{group.JobSeekerID, group.JobInformationID}],
+			input.SoundOutParam.DuplicateOverrideReason,
+			input.SoundOutParam.AgentStaffID,
+		)
+		if err != nil {
+			return output, err
+		}
+
 		// 「エントリー（求人打診依頼）」のタスクを作成
 		task := entity.NewTask(
 			taskGroup.ID,
@@ -287,7 +317,7 @@ func (i *TaskInteractorImpl) SoundOutForJobInformation(input SoundOutForJobInfor
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(task)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -369,6 +399,12 @@ func (i *TaskInteractorImpl) SoundOutForSendJobListing(input SoundOutForSendJobL
 		caIDEncountered      = map[uint]bool{}
 	)
 
+	// 同じ企業への重複推薦を確認（重複がある場合は理由の入力が必要）
+	duplicateMap, err := guardDuplicateRecommendation(i, getSoundOutDuplicateRecommendationTargetList(input.SoundOutParam.GroupList), 0, input.SoundOutParam.DuplicateOverrideReason)
+	if err != nil {
+		return output, err
+	}
+
 	for _, group := range input.SoundOutParam.GroupList {
 		fmt.Println("RA: ", group.RAStaffID)
 		fmt.Println("CA: ", group.CAStaffID)
@@ -397,6 +433,18 @@ func (i *TaskInteractorImpl) SoundOutForSendJobListing(input SoundOutForSendJobL
 			return output, err
 		}
 
+		// 重複推薦を理由付きで進めた場合は活動履歴に記録
+		err = createDuplicateOverrideEvent(
+			i,
+			taskGroup,
+			duplicateMap[duplicateRecommendationKey{group.JobSeekerID, group.JobInformationID}],
+			input.SoundOutParam.DuplicateOverrideReason,
+			input.SoundOutParam.AgentStaffID,
+		)
+		if err != nil {
+			return output, err
+		}
+
 		// タスクを定義
 		var task *entity.Task
 
@@ -419,7 +467,7 @@ func (i *TaskInteractorImpl) SoundOutForSendJobListing(input SoundOutForSendJobL
 			)
 
 			// タスク作成
-			err = i.taskRepository.Create(task)
+			err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 			if err != nil {
 				return output, err
 			}
@@ -465,7 +513,7 @@ func (i *TaskInteractorImpl) SoundOutForSendJobListing(input SoundOutForSendJobL
 			)
 
 			// タスク作成
-			err = i.taskRepository.Create(task)
+			err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 			if err != nil {
 				return output, err
 			}
@@ -652,6 +700,12 @@ func (i *TaskInteractorImpl) SoundOutForMaskResume(input SoundOutForMaskResumeIn
 		encountered = map[uint]bool{}
 	)
 
+	// 同じ企業への重複推薦を確認（重複がある場合は理由の入力が必要）
+	duplicateMap, err := guardDuplicateRecommendation(i, getSoundOutDuplicateRecommendationTargetList(input.SoundOutParam.GroupList), 0, input.SoundOutParam.DuplicateOverrideReason)
+	if err != nil {
+		return output, err
+	}
+
 	for _, group := range input.SoundOutParam.GroupList {
 		fmt.Println("RA: ", group.RAStaffID)
 		fmt.Println("CA: ", group.CAStaffID)
@@ -680,6 +734,18 @@ func (i *TaskInteractorImpl) SoundOutForMaskResume(input SoundOutForMaskResumeIn
 			return output, err
 		}
 
+		// 重複推薦を理由付きで進めた場合は活動履歴に記録
+		err = createDuplicateOverrideEvent(
+			i,
+			taskGroup,
+			duplicateMap[duplicateRecommendationKey{group.JobSeekerID, group.JobInformationID}],
+			input.SoundOutParam.DuplicateOverrideReason,
+			input.SoundOutParam.AgentStaffID,
+		)
+		if err != nil {
+			return output, err
+		}
+
 		task := entity.NewTask(
 			taskGroup.ID,
 			null.NewInt(int64(entity.Entry), true),        // エントリー
@@ -696,7 +762,7 @@ func (i *TaskInteractorImpl) SoundOutForMaskResume(input SoundOutForMaskResumeIn
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(task)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -777,6 +843,12 @@ func (i *TaskInteractorImpl) SoundOutForRequestShareJobSeeker(input SoundOutForR
 	)
 
 	// 「エントリー/求職者シェア依頼」のタスクを作成
+	// 同じ企業への重複推薦を確認（重複がある場合は理由の入力が必要）
+	duplicateMap, err := guardDuplicateRecommendation(i, getSoundOutDuplicateRecommendationTargetList(input.SoundOutParam.GroupList), 0, input.SoundOutParam.DuplicateOverrideReason)
+	if err != nil {
+		return output, err
+	}
+
 	for _, group := range input.SoundOutParam.GroupList {
 		var IsDoubleSided = false
 
@@ -802,6 +874,18 @@ func (i *TaskInteractorImpl) SoundOutForRequestShareJobSeeker(input SoundOutForR
 			return output, err
 		}
 
+		// 重複推薦を理由付きで進めた場合は活動履歴に記録
+		err = createDuplicateOverrideEvent(
+			i,
+			taskGroup,
+			duplicateMap[duplicateRecommendationKey{group.JobSeekerID, group.JobInformationID}],
+			input.SoundOutParam.DuplicateOverrideReason,
+			input.SoundOutParam.AgentStaffID,
+		)
+		if err != nil {
+			return output, err
+		}
+
 		task := entity.NewTask(
 			taskGroup.ID,
 			null.NewInt(int64(entity.Entry), true), // エントリー
@@ -818,7 +902,7 @@ func (i *TaskInteractorImpl) SoundOutForRequestShareJobSeeker(input SoundOutForR
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(task)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -899,6 +983,12 @@ func (i *TaskInteractorImpl) SoundOutForRequestShareJobInformation(input SoundOu
 	)
 
 	// 「エントリー/求人シェア依頼」のタスクを作成
+	// 同じ企業への重複推薦を確認（重複がある場合は理由の入力が必要）
+	duplicateMap, err := guardDuplicateRecommendation(i, getSoundOutDuplicateRecommendationTargetList(input.SoundOutParam.GroupList), 0, input.SoundOutParam.DuplicateOverrideReason)
+	if err != nil {
+		return output, err
+	}
+
 	for _, group := range input.SoundOutParam.GroupList {
 		fmt.Println("RA: ", group.RAStaffID)
 		fmt.Println("CA: ", group.CAStaffID)
@@ -927,6 +1017,18 @@ func (i *TaskInteractorImpl) SoundOutForRequestShareJobInformation(input SoundOu
 			return output, err
 		}
 
+		// 重複推薦を理由付きで進めた場合は活動履歴に記録
+		err = createDuplicateOverrideEvent(
+			i,
+			taskGroup,
+			duplicateMap[duplicateRecommendationKey{group.JobSeekerID, group.JobInformationID}],
+			input.SoundOutParam.DuplicateOverrideReason,
+			input.SoundOutParam.AgentStaffID,
+		)
+		if err != nil {
+			return output, err
+		}
+
 		task := entity.NewTask(
 			taskGroup.ID,
 			null.NewInt(int64(entity.Entry), true), // エントリー
@@ -943,7 +1045,7 @@ func (i *TaskInteractorImpl) SoundOutForRequestShareJobInformation(input SoundOu
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(task)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -1026,6 +1128,12 @@ func (i *TaskInteractorImpl) SoundOutGroupForJobInformation(input SoundOutGroupF
 		encountered = map[uint]bool{}
 	)
 
+	// 同じ企業への重複推薦を確認（重複がある場合は理由の入力が必要）
+	duplicateMap, err := guardDuplicateRecommendation(i, getSoundOutDuplicateRecommendationTargetList(input.SoundOutParam.GroupList), 0, input.SoundOutParam.DuplicateOverrideReason)
+	if err != nil {
+		return output, err
+	}
+
 	for _, group := range input.SoundOutParam.GroupList {
 		fmt.Println("RA: ", group.RAStaffID)
 		fmt.Println("CA: ", group.CAStaffID)
@@ -1068,6 +1176,18 @@ func (i *TaskInteractorImpl) SoundOutGroupForJobInformation(input SoundOutGroupF
 			return output, err
 		}
 
+		// 重複推薦を理由付きで進めた場合は活動履歴に記録
+		err = createDuplicateOverrideEvent(
+			i,
+			taskGroup,
+			duplicateMap[duplicateRecommendationKey{group.JobSeekerID, group.JobInformationID}],
+			input.SoundOutParam.DuplicateOverrideReason,
+			input.SoundOutParam.AgentStaffID,
+		)
+		if err != nil {
+			return output, err
+		}
+
 		// 「エントリー（求人打診依頼）」のタスクを作成
 		task := entity.NewTask(
 			taskGroup.ID,
@@ -1085,7 +1205,7 @@ func (i *TaskInteractorImpl) SoundOutGroupForJobInformation(input SoundOutGroupF
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(task)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -1167,6 +1287,12 @@ func (i *TaskInteractorImpl) SoundOutGroupForSendJobListing(input SoundOutGroupF
 		caIDEncountered      = map[uint]bool{}
 	)
 
+	// 同じ企業への重複推薦を確認（重複がある場合は理由の入力が必要）
+	duplicateMap, err := guardDuplicateRecommendation(i, getSoundOutDuplicateRecommendationTargetList(input.SoundOutParam.GroupList), 0, input.SoundOutParam.DuplicateOverrideReason)
+	if err != nil {
+		return output, err
+	}
+
 	for _, group := range input.SoundOutParam.GroupList {
 		fmt.Println("RA: ", group.RAStaffID)
 		fmt.Println("CA: ", group.CAStaffID)
@@ -1209,6 +1335,18 @@ func (i *TaskInteractorImpl) SoundOutGroupForSendJobListing(input SoundOutGroupF
 			return output, err
 		}
 
+		// 重複推薦を理由付きで進めた場合は活動履歴に記録
+		err = createDuplicateOverrideEvent(
+			i,
+			taskGroup,
+			duplicateMap[duplicateRecommendationKey{group.JobSeekerID, group.JobInformationID}],
+			input.SoundOutParam.DuplicateOverrideReason,
+			input.SoundOutParam.AgentStaffID,
+		)
+		if err != nil {
+			return output, err
+		}
+
 		// タスクを定義
 		var task *entity.Task
 
@@ -1231,7 +1369,7 @@ func (i *TaskInteractorImpl) SoundOutGroupForSendJobListing(input SoundOutGroupF
 			)
 
 			// タスク作成
-			err = i.taskRepository.Create(task)
+			err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 			if err != nil {
 				return output, err
 			}
@@ -1277,7 +1415,7 @@ func (i *TaskInteractorImpl) SoundOutGroupForSendJobListing(input SoundOutGroupF
 			)
 
 			// タスク作成
-			err = i.taskRepository.Create(task)
+			err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 			if err != nil {
 				return output, err
 			}
@@ -1489,6 +1627,14 @@ func (i *TaskInteractorImpl) CreateTaskInBatchProcessing(input CreateTaskInBatch
 		agentStaff.StaffName, agentStaff.ID, len(param.TaskList),
 	)
 	fmt.Println(beforeActionLog)
+
+	/************ 重複推薦の確認（エントリーフェーズのタスクを作成する場合） **************/
+
+	err = guardDuplicateRecommendationInBatchProcessing(i, param, agentStaff.ID)
+	if err != nil {
+		return output, err
+	}
+
 	/************ 2. Taskを1つずつ実行 **************/
 
 	for index, task := range param.TaskList {
@@ -1519,7 +1665,7 @@ func (i *TaskInteractorImpl) CreateTaskInBatchProcessing(input CreateTaskInBatch
 
 		// エントリーフェーズの処理
 		if IsNextEntryPhase {
-			err = createEntryPhaseTask(i, param, task)
+			err = createEntryPhaseTask(i, param, task, agentStaff)
 			if err != nil {
 				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60)).Format("2006/01/02 15:04:05")
 				phaseStr, phaseSubStr := getStrTaskPhaseAndPhaseSub(task.PhaseCategory, task.PhaseSubCategory)
@@ -1539,7 +1685,7 @@ func (i *TaskInteractorImpl) CreateTaskInBatchProcessing(input CreateTaskInBatch
 
 		// 書類選考フェーズの処理
 		if IsNextDocumentSelectionPhase {
-			err = createDocumentSelectionPhaseTask(i, param, task)
+			err = createDocumentSelectionPhaseTask(i, param, task, agentStaff)
 			if err != nil {
 				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60)).Format("2006/01/02 15:04:05")
 				phaseStr, phaseSubStr := getStrTaskPhaseAndPhaseSub(task.PhaseCategory, task.PhaseSubCategory)
@@ -1559,7 +1705,7 @@ func (i *TaskInteractorImpl) CreateTaskInBatchProcessing(input CreateTaskInBatch
 
 		// 選考フェーズの処理
 		if IsNextSelectionPhase {
-			err = createSelectionPhaseTask(i, param, task)
+			err = createSelectionPhaseTask(i, param, task, agentStaff)
 			if err != nil {
 				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60)).Format("2006/01/02 15:04:05")
 				phaseStr, phaseSubStr := getStrTaskPhaseAndPhaseSub(task.PhaseCategory, task.PhaseSubCategory)
@@ -1579,7 +1725,7 @@ func (i *TaskInteractorImpl) CreateTaskInBatchProcessing(input CreateTaskInBatch
 
 		// 内定保留フェーズの処理
 		if IsNextHoldJobOfferPhase {
-			err = createHoldJobOfferPhaseTask(i, param, task)
+			err = createHoldJobOfferPhaseTask(i, param, task, agentStaff)
 			if err != nil {
 				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60)).Format("2006/01/02 15:04:05")
 				phaseStr, phaseSubStr := getStrTaskPhaseAndPhaseSub(task.PhaseCategory, task.PhaseSubCategory)
@@ -1599,7 +1745,7 @@ func (i *TaskInteractorImpl) CreateTaskInBatchProcessing(input CreateTaskInBatch
 
 		// 内定承諾フェーズの処理
 		if IsNextAcceptJobOfferhase {
-			err = createAcceptJobOfferPhaseTask(i, param, task)
+			err = createAcceptJobOfferPhaseTask(i, param, task, agentStaff)
 			if err != nil {
 				now := time.Now().In(time.FixedZone("Asia/Tokyo", 9*60*60)).Format("2006/01/02 15:04:05")
 				phaseStr, phaseSubStr := getStrTaskPhaseAndPhaseSub(task.PhaseCategory, task.PhaseSubCategory)
@@ -1740,8 +1886,9 @@ func (i *TaskInteractorImpl) CreateTaskInBatchProcessing(input CreateTaskInBatch
 					return output, err
 				}
 			} else {
+				beforeSale := *sale
 				sale.Accuracy = null.NewInt(entity.AccuracyFailure, true) // ヨミのみ「失注: 5」に更新
-				err = i.saleRepository.Update(sale.ID, sale)
+				err = updateSaleWithEvent(i.saleRepository, i.taskGroupEventRepository, sale.ID, &beforeSale, sale, agentStaff.ID)
 				if err != nil {
 					fmt.Println(err)
 					return output, err
@@ -1870,7 +2017,7 @@ func (i *TaskInteractorImpl) GetSoundOutGroupList(input GetSoundOutGroupListInpu
 
 type CreateNextTaskAfterEntryPhaseInput struct {
 	NextTaskParam entity.NextTaskParam
-	AgentStaffID  uint
+	Operator      *entity.AgentStaff
 }
 
 type CreateNextTaskAfterEntryPhaseOutput struct {
@@ -1887,7 +2034,6 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterEntryPhase(input CreateNextTaskA
 		nextPhase    = nextTask.PhaseCategory
 		nextPhaseSub = nextTask.PhaseSubCategory
 		prevPhase    = nextTask.PrevPhaseCategory
-		prevPhaseSub = nextTask.PrevPhaseSubCategory
 		// urlText      = ""
 	)
 
@@ -1895,6 +2041,13 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterEntryPhase(input CreateNextTaskA
 		return output, errors.New("現在のフェーズがエントリーではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, nextTask.TaskGroupID, input.Operator, nextTask.PhaseCategory, nextTask.PhaseSubCategory, nextTask.StaffType, nextTask.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	/************ タスク情報の作成（選考継続時のタスク作成 or 通常タスク作成） **************/
 
 	// アクション: 選考継続処理
@@ -1957,7 +2110,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterEntryPhase(input CreateNextTaskA
 				)
 
 				// タスク作成
-				err = i.taskRepository.Create(confirmApplicationIntentionTask)
+				err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, confirmApplicationIntentionTask)
 				if err != nil {
 					fmt.Println(err)
 					return output, err
@@ -1983,62 +2136,35 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterEntryPhase(input CreateNextTaskA
 	}
 
 	// タスク作成
-	err = i.taskRepository.Create(task)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 	if err != nil {
 		fmt.Println(err)
 		return output, err
 	}
 
-	/************ 評価点の登録 **************/
-
-	// 次が「マスクレジュメ合格」の場合
-	var IsMaskResumePassNext = (prevPhase == null.NewInt(int64(entity.Entry), true) &&
-		prevPhaseSub == null.NewInt(int64(entity.CollectResultOfMask), true)) &&
-		(nextPhase == null.NewInt(int64(entity.Entry), true) &&
-			nextPhaseSub == null.NewInt(int64(entity.SoundOutJobInformation), true))
-
-	// 次が「マスクレジュメ不合格」の場合
-	var IsMaskResumeFailNext = (prevPhase == null.NewInt(int64(entity.Entry), true) &&
-		prevPhaseSub == null.NewInt(int64(entity.CollectResultOfMask), true)) &&
-		(nextPhase == null.NewInt(int64(entity.Entry), true) &&
-			nextPhaseSub == null.NewInt(int64(entity.EnterpriseNG), true))
-
-	if IsMaskResumePassNext {
-		// マスクレジュメ合格の場合　合格時の評価点を作成
-		evaluationPoint := entity.NewEvaluationPoint(
-			task.ID,
-			nextTask.SelectionInformationID, // エントリーフェーズの時は選考フローを選択していないためnullが入る
-			nextTask.JobSeekerID,
-			nextTask.JobInformationID,
-			nextTask.GoodPoint,
-			nextTask.NGPoint,
-			true,  // 選考通過のためtrue
-			false, // 再面接は発生しないためfalse
-		)
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, task, nextTask.LossReason, nextTask.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
 
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-	} else if IsMaskResumeFailNext {
-		// マスクレジュメ不合格の場合 不合格時の評価点の作成
-		evaluationPoint := entity.NewEvaluationPoint(
-			task.ID,
-			nextTask.SelectionInformationID, // エントリーフェーズの時は選考フローを選択していないためnullが入る
-			nextTask.JobSeekerID,
-			nextTask.JobInformationID,
-			nextTask.GoodPoint,
-			nextTask.NGPoint,
-			false, // 選考不合格のためfalse
-			false, // 再面接は発生しないためfalse
-		)
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
 
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		newTaskPhaseSideEffectParam(task, nextTask),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
 	/************ マスクレジュメ打診時のメール送付 **************/
@@ -2322,113 +2448,20 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterEntryPhase(input CreateNextTaskA
 
 	/************ タスクの最終依頼時間と最終閲覧時間の更新 **************/
 
-	// 依頼時間の更新
-	if nextTask.PrevStaffType == null.NewInt(int64(entity.CA), true) {
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			// CAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// CAからRAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateCALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
-	} else {
-		// RAからRAにタスク作成する場合
-		if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// RAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateRALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
+	err = updateTaskLastRequestAt(i.taskGroupRepository, nextTask.TaskGroupID, nextTask.PrevStaffType, nextTask.StaffType)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
 	/************ タスク作成のプッシュ通知 **************/
 
-	// エントリーフェーズの終了タスク以外
-	var IsNotClosePhase = nextPhaseSub != null.NewInt(entity.CloseEntryPhaseForUnlikely, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseEntryPhaseForWithoutSoundOut, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseEntryPhaseForDeclineEntry, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseEntryPhaseForRequestDecline, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseEntryPhase, true)
-
-	// 終了タスク以外なら
-	if IsNotClosePhase {
-		// プッシュ通知
-		firebaseID := ""
-		contents := ""
-		topic := ""
-		redirectURL := os.Getenv("BASE_DOMAIN")
-
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			contents = "新着CAタスクの依頼があります。"
-			topic = "TaskCA"
-
-			caStaff, err := i.agentStaffRepository.FindByID(nextTask.CAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-
-			firebaseID = caStaff.FirebaseID
-
-		} else if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			contents = "新着RAタスクの依頼があります。"
-			topic = "TaskRA"
-
-			raStaff, err := i.agentStaffRepository.FindByID(nextTask.RAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-
-			firebaseID = raStaff.FirebaseID
-		}
-
-		err = utility.WebPush(
-			i.oneSignal.AppID,
-			i.oneSignal.APIKey,
-			firebaseID,
-			"タスク通知",
-			contents,
-			topic,
-			redirectURL,
-		)
+	// 終了タスク以外
+	if !(entity.TaskPhaseState{Phase: nextTask.PhaseCategory.Int64, PhaseSub: nextTask.PhaseSubCategory.Int64}).IsClosed() {
+		err = pushTaskRequestNotification(i.agentStaffRepository, i.oneSignal, nextTask.StaffType, nextTask.CAStaffID, nextTask.RAStaffID)
 		if err != nil {
-			fmt.Println("WebPushの通知")
 			fmt.Println(err)
-		}
-	} else {
-		// タスクが終了した場合　ヨミを「失注」に更新
-		sale, err := i.saleRepository.FindByJobSeekerIDAndJobInformationID(nextTask.JobSeekerID, nextTask.JobInformationID)
-		if err != nil {
-			if errors.Is(err, entity.ErrNotFound) {
-				fmt.Println("ヨミの登録がありません")
-			} else {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			sale.Accuracy = null.NewInt(entity.AccuracyFailure, true) // ヨミのみ「失注: 5」に更新
-			err = i.saleRepository.Update(sale.ID, sale)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
+			return output, err
 		}
 	}
 
@@ -2439,7 +2472,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterEntryPhase(input CreateNextTaskA
 
 type CreateNextTaskAfterDocumentSelectionPhaseInput struct {
 	NextTaskParam entity.NextTaskParam
-	AgentStaffID  uint
+	Operator      *entity.AgentStaff
 }
 
 type CreateNextTaskAfterDocumentSelectionPhaseOutput struct {
@@ -2463,6 +2496,13 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterDocumentSelectionPhase(input Cre
 		return output, errors.New("現在のフェーズが書類選考ではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, nextTask.TaskGroupID, input.Operator, nextTask.PhaseCategory, nextTask.PhaseSubCategory, nextTask.StaffType, nextTask.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	/************ タスク情報の作成（選考継続時のタスク作成 or 通常タスク作成） **************/
 
 	if nextPhase == null.NewInt(int64(entity.DocumentSelection), true) && nextPhaseSub == null.NewInt(int64(entity.ContinueSelection), true) {
@@ -2507,29 +2547,26 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterDocumentSelectionPhase(input Cre
 	}
 
 	// タスク作成
-	err = i.taskRepository.Create(task)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 	if err != nil {
 		fmt.Println(err)
 		return output, err
 	}
 
-	/************ 評価点の登録 **************/
-
-	// 次が「書類選考合格」の場合
-	var IsDocumentSelectionPassNext = (prevPhase == null.NewInt(int64(entity.DocumentSelection), true) &&
-		prevPhaseSub == null.NewInt(int64(entity.CollectResultOfDocumentSelection), true)) &&
-		(isPhaseSelection(nextPhase) && (nextPhaseSub == null.NewInt(int64(entity.RequestGuidance), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestCollectionOfSchedule), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestConfirmScheduleAndSelectionDetail), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestConfirmScheduleForSelection), true)))
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, task, nextTask.LossReason, nextTask.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
 
-		// 次が「書類選考不合格」の場合
-	var IsDocumentSelectionFailNext = (nextPhase == null.NewInt(int64(entity.DocumentSelection), true) &&
-		nextPhaseSub == null.NewInt(int64(entity.FailingNotification), true))
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
 
-	if IsDocumentSelectionPassNext {
-		var selectionInformationID null.Int
+	sideEffectParam := newTaskPhaseSideEffectParam(task, nextTask)
+	sideEffectParam.SelectionInformationID = null.NewInt(0, false)
 
+	// 書類選考合格の場合は「書類選考の選考情報の紐付け + 選考フローパターンの紐付け」
+	if policy.HasTaskPhaseSideEffect(transition, entity.TaskPhaseSideEffectEvaluationPass) {
 		if nextTask.SelectionFlowPatternID.Valid {
 			selectionInformation, err := i.jobInfoSelectionInformationRepository.FindBySelectionFlowIDAndSelectionType(uint(nextTask.SelectionFlowPatternID.Int64), uint(entity.DocumentSelection))
 			if err != nil {
@@ -2537,52 +2574,30 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterDocumentSelectionPhase(input Cre
 				return output, err
 			}
 
-			selectionInformationID = null.NewInt(int64(selectionInformation.ID), true)
-		}
-
-		// 書類選考合格の場合　「合格時の評価点を作成 + 選考フローパターンの紐付け」
-		evaluationPoint := entity.NewEvaluationPoint(
-			task.ID,
-			selectionInformationID, // エントリーフェーズの時は選考フローを選択していないためnullが入る
-			nextTask.JobSeekerID,
-			nextTask.JobInformationID,
-			nextTask.GoodPoint,
-			nextTask.NGPoint,
-			true,  // 選考通過のためtrue
-			false, // 再面接は発生しないためfalse
-		)
-
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
+			sideEffectParam.SelectionInformationID = null.NewInt(int64(selectionInformation.ID), true)
 		}
 
-		// 選考フローパターンの紐付け
 		err = i.taskGroupRepository.UpdateSelectionFlowPatternID(nextTask.TaskGroupID, nextTask.SelectionFlowPatternID)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
 		}
+	}
 
-	} else if IsDocumentSelectionFailNext {
-		// 書類選考不合格の場合 「不合格時の評価点の作成」
-		evaluationPoint := entity.NewEvaluationPoint(
-			task.ID,
-			null.NewInt(0, false), // エントリーフェーズの時は選考フローを選択していないためnullが入る
-			nextTask.JobSeekerID,
-			nextTask.JobInformationID,
-			nextTask.GoodPoint,
-			nextTask.NGPoint,
-			false, // 選考不合格のためfalse
-			false, // 再面接は発生しないためfalse
-		)
-
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		sideEffectParam,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
 	/************ 推薦メールの送信 **************/
@@ -2701,6 +2716,12 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterDocumentSelectionPhase(input Cre
 			fmt.Println(err)
 			return output, err
 		}
+
+		err = createSelectionDateEvent(i.taskGroupEventRepository, nextTask.TaskGroupID, jobSeekerSchedule, nextTask.ExecutedStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
 	}
 
 	/************ 両面タスクに更新する **************/
@@ -2850,112 +2871,20 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterDocumentSelectionPhase(input Cre
 
 	/************ タスクの最終依頼時間と最終閲覧時間の更新 **************/
 
-	// 依頼時間の更新
-	if nextTask.PrevStaffType == null.NewInt(int64(entity.CA), true) {
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			// CAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// CAからRAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateCALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
-	} else {
-		// RAからRAにタスク作成する場合
-		if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// RAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateRALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
+	err = updateTaskLastRequestAt(i.taskGroupRepository, nextTask.TaskGroupID, nextTask.PrevStaffType, nextTask.StaffType)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
 	/************ タスク作成のプッシュ通知 **************/
 
-	// エントリーフェーズの終了タスク以外
-	var IsNotClosePhase = nextPhaseSub != null.NewInt(entity.CloseDocumentPhaseForDeclineEntry, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseDocumentPhaseForFailingNotice, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseDocumentPhaseForDrop, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseDocumentPhaseForRequestDecline, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseDocumentPhase, true)
-
-	if IsNotClosePhase {
-		// プッシュ通知
-		firebaseID := ""
-		contents := ""
-		topic := ""
-		redirectURL := os.Getenv("BASE_DOMAIN")
-
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			contents = "新着CAタスクの依頼があります。"
-			topic = "TaskCA"
-
-			caStaff, err := i.agentStaffRepository.FindByID(nextTask.CAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-
-			firebaseID = caStaff.FirebaseID
-
-		} else if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			contents = "新着RAタスクの依頼があります。"
-			topic = "TaskRA"
-
-			raStaff, err := i.agentStaffRepository.FindByID(nextTask.RAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-
-			firebaseID = raStaff.FirebaseID
-		}
-
-		err = utility.WebPush(
-			i.oneSignal.AppID,
-			i.oneSignal.APIKey,
-			firebaseID,
-			"タスク通知",
-			contents,
-			topic,
-			redirectURL,
-		)
+	// 終了タスク以外
+	if !(entity.TaskPhaseState{Phase: nextTask.PhaseCategory.Int64, PhaseSub: nextTask.PhaseSubCategory.Int64}).IsClosed() {
+		err = pushTaskRequestNotification(i.agentStaffRepository, i.oneSignal, nextTask.StaffType, nextTask.CAStaffID, nextTask.RAStaffID)
 		if err != nil {
-			fmt.Println("WebPushの通知")
 			fmt.Println(err)
-		}
-	} else {
-		// タスクが終了した場合　ヨミを「失注」に更新
-		sale, err := i.saleRepository.FindByJobSeekerIDAndJobInformationID(nextTask.JobSeekerID, nextTask.JobInformationID)
-		if err != nil {
-			if errors.Is(err, entity.ErrNotFound) {
-				fmt.Println("ヨミの登録がありません")
-			} else {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			sale.Accuracy = null.NewInt(entity.AccuracyFailure, true) // ヨミのみ「失注: 5」に更新
-			err = i.saleRepository.Update(sale.ID, sale)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
+			return output, err
 		}
 	}
 
@@ -2966,7 +2895,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterDocumentSelectionPhase(input Cre
 
 type CreateNextTaskAfterSelectionPhaseInput struct {
 	NextTaskParam entity.NextTaskParam
-	AgentStaffID  uint
+	Operator      *entity.AgentStaff
 }
 
 type CreateNextTaskAfterSelectionPhaseOutput struct {
@@ -2991,10 +2920,17 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterSelectionPhase(input CreateNextT
 		return output, errors.New("現在フェーズが選考フェーズではありません")
 	}
 
-	/************ 新リスケ処理（リスケ + 削除） 元のリスケ処理も残しておく **************/
-
-	if nextTask.TaskOption == null.NewInt(entity.OptionRescheduleAndDelete, true) {
-		// 直前の結果回収依頼までの日程を削除 + リスケタスクの作成
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, nextTask.TaskGroupID, input.Operator, nextTask.PhaseCategory, nextTask.PhaseSubCategory, nextTask.StaffType, nextTask.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	/************ 新リスケ処理（リスケ + 削除） 元のリスケ処理も残しておく **************/
+
+	if nextTask.TaskOption == null.NewInt(entity.OptionRescheduleAndDelete, true) {
+		// 直前の結果回収依頼までの日程を削除 + リスケタスクの作成
 		latestSelectTask, err := i.taskRepository.FindLatestByGroupIDAndCollectResultPhase(nextTask.TaskGroupID)
 		if err != nil {
 			fmt.Println(err)
@@ -3025,7 +2961,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterSelectionPhase(input CreateNextT
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(rescheduleTask)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, rescheduleTask)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -3109,7 +3045,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterSelectionPhase(input CreateNextT
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(skipTask)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, skipTask)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -3117,57 +3053,35 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterSelectionPhase(input CreateNextT
 	}
 
 	// タスク作成
-	err = i.taskRepository.Create(task)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 	if err != nil {
 		fmt.Println(err)
 		return output, err
 	}
 
-	/************ 評価点の登録（※再設定の場合は合格として扱う） **************/
-
-	// 現在が「⚪︎次選考の結果回収中」（次が「⚪︎次選考の合格・不合格」）
-	var IsSelectionCollectResultNow = prevPhaseSub == null.NewInt(int64(entity.RequestCollectSelectionThought), true)
-
-	// 再選考の場合
-	var IsReSelection = nextPhase == prevPhase &&
-		(nextPhaseSub == null.NewInt(int64(entity.RequestGuidance), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestCollectionOfSchedule), true) ||
-			nextPhaseSub == null.NewInt(int64(entity.RequestConfirmScheduleForSelection), true))
-
-	if IsSelectionCollectResultNow {
-		// 再設定の有無
-		var isReInterview = false
-
-		// 合格可否
-		var isPass = false
-
-		// 再設定のタスクの場合は「true」に変更
-		if IsReSelection {
-			isReInterview = true
-		}
-
-		// 現在のフェーズと次のフェーズが同一でなければisPassを「true」に変更
-		if !(nextPhase == prevPhase) {
-			isPass = true
-		}
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, task, nextTask.LossReason, nextTask.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
 
-		// 評価点（合格）
-		evaluationPoint := entity.NewEvaluationPoint(
-			task.ID,
-			nextTask.SelectionInformationID,
-			nextTask.JobSeekerID,
-			nextTask.JobInformationID,
-			nextTask.GoodPoint,
-			nextTask.NGPoint,
-			isPass,        // 選考通過のためtrue
-			isReInterview, // 再面接かどうかで値が変化
-		)
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
 
-		err = i.evaluationPointRepository.Create(evaluationPoint)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		newTaskPhaseSideEffectParam(task, nextTask),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
 	/************ 選考の候補日時を登録する **************/
@@ -3251,6 +3165,12 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterSelectionPhase(input CreateNextT
 			fmt.Println(err)
 			return output, err
 		}
+
+		err = createSelectionDateEvent(i.taskGroupEventRepository, nextTask.TaskGroupID, jobSeekerSchedule, nextTask.ExecutedStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
 	}
 
 	/************ 選考の確定日時と当日詳細を更新する **************/
@@ -3278,6 +3198,12 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterSelectionPhase(input CreateNextT
 			fmt.Println(err)
 			return output, err
 		}
+
+		err = createSelectionDateEvent(i.taskGroupEventRepository, nextTask.TaskGroupID, jobSeekerSchedule, nextTask.ExecutedStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
 	}
 
 	/************ リスケ時の処理（確定日時と同じ日時にリスケ日程を作成） **************/
@@ -3478,111 +3404,20 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterSelectionPhase(input CreateNextT
 
 	/************ タスクの最終依頼時間と最終閲覧時間の更新 **************/
 
-	// 依頼時間の更新
-	if nextTask.PrevStaffType == null.NewInt(int64(entity.CA), true) {
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			// CAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// CAからRAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateCALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
-	} else {
-		// RAからRAにタスク作成する場合
-		if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// RAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateRALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
+	err = updateTaskLastRequestAt(i.taskGroupRepository, nextTask.TaskGroupID, nextTask.PrevStaffType, nextTask.StaffType)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
 	/************ タスク作成のプッシュ通知 **************/
 
-	// 選考フェーズの終了タスク以外
-	var IsNotClosePhase = nextPhaseSub != null.NewInt(entity.CloseSelectionPhaseForFailingNoticeOfSelection, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseSelectionPhaseForDrop, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseSelectionPhaseForRequestDecline, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseSelectionPhase, true)
-
-	if IsNotClosePhase {
-		// プッシュ通知
-		firebaseID := ""
-		contents := ""
-		topic := ""
-		redirectURL := os.Getenv("BASE_DOMAIN")
-
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			contents = "新着CAタスクの依頼があります。"
-			topic = "TaskCA"
-
-			caStaff, err := i.agentStaffRepository.FindByID(nextTask.CAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-
-			firebaseID = caStaff.FirebaseID
-
-		} else if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			contents = "新着RAタスクの依頼があります。"
-			topic = "TaskRA"
-
-			raStaff, err := i.agentStaffRepository.FindByID(nextTask.RAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-
-			firebaseID = raStaff.FirebaseID
-		}
-
-		err = utility.WebPush(
-			i.oneSignal.AppID,
-			i.oneSignal.APIKey,
-			firebaseID,
-			"タスク通知",
-			contents,
-			topic,
-			redirectURL,
-		)
+	// 終了タスク以外
+	if !(entity.TaskPhaseState{Phase: nextTask.PhaseCategory.Int64, PhaseSub: nextTask.PhaseSubCategory.Int64}).IsClosed() {
+		err = pushTaskRequestNotification(i.agentStaffRepository, i.oneSignal, nextTask.StaffType, nextTask.CAStaffID, nextTask.RAStaffID)
 		if err != nil {
-			fmt.Println("WebPushの通知")
 			fmt.Println(err)
-		}
-	} else {
-		// タスクが終了した場合　ヨミを「失注」に更新
-		sale, err := i.saleRepository.FindByJobSeekerIDAndJobInformationID(nextTask.JobSeekerID, nextTask.JobInformationID)
-		if err != nil {
-			if errors.Is(err, entity.ErrNotFound) {
-				fmt.Println("ヨミの登録がありません")
-			} else {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			sale.Accuracy = null.NewInt(entity.AccuracyFailure, true) // ヨミのみ「失注: 5」に更新
-			err = i.saleRepository.Update(sale.ID, sale)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
+			return output, err
 		}
 	}
 
@@ -3594,7 +3429,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterSelectionPhase(input CreateNextT
 // 内定辞退フェーズのタスク処理
 type CreateNextTaskAfterDeclinePhaseInput struct {
 	NextTaskParam entity.NextTaskParam
-	AgentStaffID  uint
+	Operator      *entity.AgentStaff
 }
 
 type CreateNextTaskAfterDeclinePhaseOutput struct {
@@ -3614,6 +3449,13 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterDeclinePhase(input CreateNextTas
 		return output, errors.New("現在フェーズが辞退フェーズではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, nextTask.TaskGroupID, input.Operator, nextTask.PhaseCategory, nextTask.PhaseSubCategory, nextTask.StaffType, nextTask.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	task := entity.NewTask(
 		nextTask.TaskGroupID,
 		nextTask.PhaseCategory,
@@ -3630,55 +3472,46 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterDeclinePhase(input CreateNextTas
 	)
 
 	// タスク作成
-	err = i.taskRepository.Create(task)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 	if err != nil {
 		fmt.Println(err)
 		return output, err
 	}
 
-	// プッシュ通知
-	firebaseID := ""
-	contents := ""
-	topic := ""
-	redirectURL := os.Getenv("BASE_DOMAIN")
-
-	if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-		contents = "新着CAタスクの依頼があります。"
-		topic = "TaskCA"
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, task, nextTask.LossReason, nextTask.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
 
-		caStaff, err := i.agentStaffRepository.FindByID(nextTask.CAStaffID)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
 
-		firebaseID = caStaff.FirebaseID
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		newTaskPhaseSideEffectParam(task, nextTask),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
 
-	} else if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-		contents = "新着RAタスクの依頼があります。"
-		topic = "TaskRA"
+	/************ タスク作成のプッシュ通知 **************/
 
-		raStaff, err := i.agentStaffRepository.FindByID(nextTask.RAStaffID)
+	// 終了タスク以外
+	if !(entity.TaskPhaseState{Phase: nextTask.PhaseCategory.Int64, PhaseSub: nextTask.PhaseSubCategory.Int64}).IsClosed() {
+		err = pushTaskRequestNotification(i.agentStaffRepository, i.oneSignal, nextTask.StaffType, nextTask.CAStaffID, nextTask.RAStaffID)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
 		}
-
-		firebaseID = raStaff.FirebaseID
-	}
-
-	err = utility.WebPush(
-		i.oneSignal.AppID,
-		i.oneSignal.APIKey,
-		firebaseID,
-		"タスク通知",
-		contents,
-		topic,
-		redirectURL,
-	)
-	if err != nil {
-		fmt.Println("WebPushの通知")
-		fmt.Println(err)
 	}
 
 	output.OK = true
@@ -3689,7 +3522,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterDeclinePhase(input CreateNextTas
 // 内定保留フェーズのタスク処理
 type CreateNextTaskAfterHoldJobOfferPhaseInput struct {
 	NextTaskParam entity.NextTaskParam
-	AgentStaffID  uint
+	Operator      *entity.AgentStaff
 }
 
 type CreateNextTaskAfterHoldJobOfferPhaseOutput struct {
@@ -3713,6 +3546,13 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterHoldJobOfferPhase(input CreateNe
 		return output, errors.New("現在フェーズが内定保留フェーズではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, nextTask.TaskGroupID, input.Operator, nextTask.PhaseCategory, nextTask.PhaseSubCategory, nextTask.StaffType, nextTask.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	/************ 新リスケ処理（リスケ + 削除） 元のリスケ処理も残しておく **************/
 
 	if nextTask.TaskOption == null.NewInt(entity.OptionRescheduleAndDelete, true) {
@@ -3747,7 +3587,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterHoldJobOfferPhase(input CreateNe
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(rescheduleTask)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, rescheduleTask)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -3770,30 +3610,35 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterHoldJobOfferPhase(input CreateNe
 	)
 
 	// タスク作成
-	err = i.taskRepository.Create(task)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 	if err != nil {
 		fmt.Println(err)
 		return output, err
 	}
 
-	// 内定承諾/内定承諾の場合はヨミ情報を更新する
-	if nextPhase == null.NewInt(int64(entity.AcceptJobOffer), true) && nextPhaseSub == null.NewInt(int64(entity.Accept), true) {
-		sale, err := i.saleRepository.FindByJobSeekerIDAndJobInformationID(nextTask.JobSeekerID, nextTask.JobInformationID)
-		if err != nil {
-			if errors.Is(err, entity.ErrNotFound) {
-				fmt.Println("ヨミの登録がありません")
-			} else {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			sale.Accuracy = null.NewInt(entity.AccuracyAccept, true) // ヨミのみ「内定承諾: 0」に更新
-			err = i.saleRepository.Update(sale.ID, sale)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, task, nextTask.LossReason, nextTask.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
+
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		newTaskPhaseSideEffectParam(task, nextTask),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
 	/************ 選考の候補日時を登録する **************/
@@ -3875,6 +3720,12 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterHoldJobOfferPhase(input CreateNe
 			fmt.Println(err)
 			return output, err
 		}
+
+		err = createSelectionDateEvent(i.taskGroupEventRepository, nextTask.TaskGroupID, jobSeekerSchedule, nextTask.ExecutedStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
 	}
 
 	/************ メール or LINEの送付 **************/
@@ -4008,110 +3859,20 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterHoldJobOfferPhase(input CreateNe
 		}
 	}
 
-	// 依頼時間の更新
-	if nextTask.PrevStaffType == null.NewInt(int64(entity.CA), true) {
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			// CAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// CAからRAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateCALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
-	} else {
-		// RAからRAにタスク作成する場合
-		if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// RAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateRALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
+	err = updateTaskLastRequestAt(i.taskGroupRepository, nextTask.TaskGroupID, nextTask.PrevStaffType, nextTask.StaffType)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
 	/************ タスク作成のプッシュ通知 **************/
 
-	// エントリーフェーズの終了タスク以外
-	var IsNotClosePhase = nextPhaseSub != null.NewInt(entity.CloseHoldJobOfferPhaseForDeclineJobOffer, true) &&
-		nextPhaseSub != null.NewInt(entity.CloseHoldJobOfferPhaseForRequestDeclineOfSelection, true) &&
-		nextPhaseSub != null.NewInt(entity.HoldJobOfferPhaseClose, true)
-
-	if IsNotClosePhase {
-		// プッシュ通知
-		firebaseID := ""
-		contents := ""
-		topic := ""
-		redirectURL := os.Getenv("BASE_DOMAIN")
-
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			contents = "新着CAタスクの依頼があります。"
-			topic = "TaskCA"
-
-			caStaff, err := i.agentStaffRepository.FindByID(nextTask.CAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-
-			firebaseID = caStaff.FirebaseID
-
-		} else if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			contents = "新着RAタスクの依頼があります。"
-			topic = "TaskRA"
-
-			raStaff, err := i.agentStaffRepository.FindByID(nextTask.RAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-
-			firebaseID = raStaff.FirebaseID
-		}
-
-		err = utility.WebPush(
-			i.oneSignal.AppID,
-			i.oneSignal.APIKey,
-			firebaseID,
-			"タスク通知",
-			contents,
-			topic,
-			redirectURL,
-		)
+	// 終了タスク以外
+	if !(entity.TaskPhaseState{Phase: nextTask.PhaseCategory.Int64, PhaseSub: nextTask.PhaseSubCategory.Int64}).IsClosed() {
+		err = pushTaskRequestNotification(i.agentStaffRepository, i.oneSignal, nextTask.StaffType, nextTask.CAStaffID, nextTask.RAStaffID)
 		if err != nil {
-			fmt.Println("WebPushの通知")
 			fmt.Println(err)
-		}
-	} else {
-		// タスクが終了した場合　ヨミを「失注」に更新
-		sale, err := i.saleRepository.FindByJobSeekerIDAndJobInformationID(nextTask.JobSeekerID, nextTask.JobInformationID)
-		if err != nil {
-			if errors.Is(err, entity.ErrNotFound) {
-				fmt.Println("ヨミの登録がありません")
-			} else {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			sale.Accuracy = null.NewInt(entity.AccuracyFailure, true) // ヨミのみ「失注: 5」に更新
-			err = i.saleRepository.Update(sale.ID, sale)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
+			return output, err
 		}
 	}
 
@@ -4123,7 +3884,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterHoldJobOfferPhase(input CreateNe
 // 内定承諾フェーズのタスク処理
 type CreateNextTaskAfterAcceptJobOfferPhaseInput struct {
 	NextTaskParam entity.NextTaskParam
-	AgentStaffID  uint
+	Operator      *entity.AgentStaff
 }
 
 type CreateNextTaskAfterAcceptJobOfferPhaseOutput struct {
@@ -4143,6 +3904,13 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterAcceptJobOfferPhase(input Create
 		return output, errors.New("現在フェーズが内定承諾フェーズではありません")
 	}
 
+	// フェーズの遷移を確認
+	transition, err := validateNextTaskPhase(i, nextTask.TaskGroupID, input.Operator, nextTask.PhaseCategory, nextTask.PhaseSubCategory, nextTask.StaffType, nextTask.LossReason)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	task := entity.NewTask(
 		nextTask.TaskGroupID,
 		nextTask.PhaseCategory,
@@ -4159,123 +3927,53 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterAcceptJobOfferPhase(input Create
 	)
 
 	// タスク作成
-	err = i.taskRepository.Create(task)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 	if err != nil {
 		fmt.Println(err)
 		return output, err
 	}
 
-	// 内定承諾/入社確認
-	if nextTask.PhaseCategory == null.NewInt(int64(entity.AcceptJobOffer), true) && nextTask.PhaseSubCategory == null.NewInt(int64(entity.ConfirmJoiningCompany), true) {
-
-		// 入社日の登録
-		err = i.taskGroupRepository.UpdateJoiningDate(nextTask.TaskGroupID, nextTask.JoiningDate)
-		if err != nil {
-			fmt.Println(err)
-			return output, err
-		}
-	}
-
-	// 依頼時間の更新
-	if nextTask.PrevStaffType == null.NewInt(int64(entity.CA), true) {
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			// CAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// CAからRAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateCALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
-	} else {
-		// RAからRAにタスク作成する場合
-		if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			err := i.taskGroupRepository.UpdateLastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		} else {
-			// RAからCAにタスク作成する場合
-			err := i.taskGroupRepository.UpdateRALastRequestAt(nextTask.TaskGroupID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-		}
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, task, nextTask.LossReason, nextTask.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
 	}
 
-	if nextTask.PhaseSubCategory != null.NewInt(entity.AcceptJobOfferPhaseClose, true) {
-		// プッシュ通知
-		firebaseID := ""
-		contents := ""
-		topic := ""
-		redirectURL := os.Getenv("BASE_DOMAIN")
-
-		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-			contents = "新着CAタスクの依頼があります。"
-			topic = "TaskCA"
-
-			caStaff, err := i.agentStaffRepository.FindByID(nextTask.CAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
-
-			firebaseID = caStaff.FirebaseID
+	/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
 
-		} else if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-			contents = "新着RAタスクの依頼があります。"
-			topic = "TaskRA"
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		newTaskPhaseSideEffectParam(task, nextTask),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
 
-			raStaff, err := i.agentStaffRepository.FindByID(nextTask.RAStaffID)
-			if err != nil {
-				fmt.Println(err)
-				return output, err
-			}
+	err = updateTaskLastRequestAt(i.taskGroupRepository, nextTask.TaskGroupID, nextTask.PrevStaffType, nextTask.StaffType)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
 
-			firebaseID = raStaff.FirebaseID
-		}
+	/************ タスク作成のプッシュ通知 **************/
 
-		err = utility.WebPush(
-			i.oneSignal.AppID,
-			i.oneSignal.APIKey,
-			firebaseID,
-			"タスク通知",
-			contents,
-			topic,
-			redirectURL,
-		)
+	// 終了タスク以外
+	if !(entity.TaskPhaseState{Phase: nextTask.PhaseCategory.Int64, PhaseSub: nextTask.PhaseSubCategory.Int64}).IsClosed() {
+		err = pushTaskRequestNotification(i.agentStaffRepository, i.oneSignal, nextTask.StaffType, nextTask.CAStaffID, nextTask.RAStaffID)
 		if err != nil {
-			fmt.Println("WebPushの通知")
 			fmt.Println(err)
+			return output, err
 		}
 	}
-	// else {
-	// 	// タスクが終了した場合　ヨミを「失注」に更新
-	// 	sale, err := i.saleRepository.FindByJobSeekerIDAndJobInformationID(nextTask.JobSeekerID, nextTask.JobInformationID)
-	// 	if err != nil {
-	// 		if errors.Is(err, entity.ErrNotFound) {
-	// 			fmt.Println("ヨミの登録がありません")
-	// 		} else {
-	// 			fmt.Println(err)
-	// 			return output, err
-	// 		}
-	// 	} else {
-	// 		sale.Accuracy = null.NewInt(entity.AccuracyFailure, true) // ヨミのみ「失注: 5」に更新
-	// 		err = i.saleRepository.Update(sale.ID, sale)
-	// 		if err != nil {
-	// 			fmt.Println(err)
-	// 			return output, err
-	// 		}
-	// 	}
-	// }
 
 	output.OK = true
 
@@ -4285,7 +3983,7 @@ func (i *TaskInteractorImpl) CreateNextTaskAfterAcceptJobOfferPhase(input Create
 // 内定承諾フェーズのタスク処理
 type CreateNextSameTaskListInput struct {
 	NextSameTaskListParam entity.NextSameTaskListParam
-	AgentStaffID          uint
+	Operator              *entity.AgentStaff
 }
 
 type CreateNextSameTaskListOutput struct {
@@ -4309,7 +4007,19 @@ func (i *TaskInteractorImpl) CreateNextSameTaskList(input CreateNextSameTaskList
 		return output, wrapped
 	}
 
+	// 途中まで作成されないよう、先に全てのタスクグループのフェーズの遷移を確認
+	var transitionList = make([]entity.TaskPhaseTransition, 0, len(sameTaskList))
 	for _, sameTask := range sameTaskList {
+		transition, err := validateNextTaskPhase(i, sameTask.TaskGroupID, input.Operator, nextTask.PhaseCategory, nextTask.PhaseSubCategory, nextTask.StaffType, nextTask.LossReason)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		transitionList = append(transitionList, transition)
+	}
+
+	for index, sameTask := range sameTaskList {
 		// 選考フェーズでなかった場合はエラー
 		// if nextTask.PrevPhaseCategory != null.NewInt(int64(entity.Entry), true) && nextTask.PrevPhaseCategory != null.NewInt(int64(entity.DocumentSelection), true) {
 		// 	return output, errors.New("現在フェーズが「エントリー」でも「書類選考」でもありません")
@@ -4331,7 +4041,36 @@ func (i *TaskInteractorImpl) CreateNextSameTaskList(input CreateNextSameTaskList
 		)
 
 		// タスク作成
-		err = i.taskRepository.Create(task)
+		err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		// 辞退・不合格の理由を記録
+		err = createTaskLossReason(i.taskLossReasonRepository, task, nextTask.LossReason, nextTask.Remarks)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		/************ 遷移に伴う処理（評価点の登録・ヨミの更新・入社日の登録・売上の下書きの作成） **************/
+
+		sideEffectParam := newTaskPhaseSideEffectParam(task, nextTask)
+		sideEffectParam.JobSeekerID = sameTask.JobSeekerID
+		sideEffectParam.JobInformationID = sameTask.JobInformationID
+
+		err = runTaskPhaseSideEffects(
+			i.taskGroupRepository,
+			i.taskGroupEventRepository,
+			i.evaluationPointRepository,
+			i.saleRepository,
+			i.jobOfferRepository,
+			i.jobInformationRepository,
+			i.agentRepository,
+			transitionList[index],
+			sideEffectParam,
+		)
 		if err != nil {
 			fmt.Println(err)
 			return output, err
@@ -4486,115 +4225,28 @@ func (i *TaskInteractorImpl) CreateNextSameTaskList(input CreateNextSameTaskList
 	/************ タスクの最終依頼時間と最終閲覧時間の更新 **************/
 
 	for index, sameTask := range sameTaskList {
-		// 依頼時間の更新
-		if nextTask.PrevStaffType == null.NewInt(int64(entity.CA), true) {
-			if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-				// CAからCAにタスク作成する場合
-				err := i.taskGroupRepository.UpdateLastRequestAt(sameTask.TaskGroupID)
-				if err != nil {
-					fmt.Println(err)
-					return output, err
-				}
-			} else {
-				// CAからRAにタスク作成する場合
-				err := i.taskGroupRepository.UpdateCALastRequestAt(sameTask.TaskGroupID)
-				if err != nil {
-					fmt.Println(err)
-					return output, err
-				}
-			}
-		} else {
-			// RAからRAにタスク作成する場合
-			if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-				err := i.taskGroupRepository.UpdateLastRequestAt(sameTask.TaskGroupID)
-				if err != nil {
-					fmt.Println(err)
-					return output, err
-				}
-			} else {
-				// RAからCAにタスク作成する場合
-				err := i.taskGroupRepository.UpdateRALastRequestAt(sameTask.TaskGroupID)
-				if err != nil {
-					fmt.Println(err)
-					return output, err
-				}
-			}
+		err = updateTaskLastRequestAt(i.taskGroupRepository, sameTask.TaskGroupID, nextTask.PrevStaffType, nextTask.StaffType)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
 		}
 
 		/************ タスク作成のプッシュ通知 **************/
 
-		if nextTask.PhaseSubCategory != null.NewInt(entity.AcceptJobOfferPhaseClose, true) {
-			// プッシュ通知
-			firebaseID := ""
-			contents := ""
-			topic := ""
-			redirectURL := os.Getenv("BASE_DOMAIN")
-
-			if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-				contents = "新着CAタスクの依頼があります。"
-				topic = "TaskCA"
-
-				caStaff, err := i.agentStaffRepository.FindByID(nextTask.CAStaffID)
-				if err != nil {
-					fmt.Println(err)
-					return output, err
-				}
-
-				firebaseID = caStaff.FirebaseID
-
-			} else if nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-				contents = "新着RAタスクの依頼があります。"
-				topic = "TaskRA"
-
-				raStaff, err := i.agentStaffRepository.FindByID(nextTask.RAStaffID)
-				if err != nil {
-					fmt.Println(err)
-					return output, err
-				}
+		// 終了タスク以外
+		if (entity.TaskPhaseState{Phase: nextTask.PhaseCategory.Int64, PhaseSub: nextTask.PhaseSubCategory.Int64}).IsClosed() {
+			continue
+		}
 
-				firebaseID = raStaff.FirebaseID
-			}
+		// CAタスクの場合でも「index」を使って通知は一回にする
+		if nextTask.StaffType == null.NewInt(int64(entity.CA), true) && index != 0 {
+			continue
+		}
 
-			// CAタスクの場合でも「index」を使って通知は一回にする
-			if (nextTask.StaffType == null.NewInt(int64(entity.CA), true) && index == 0) || nextTask.StaffType == null.NewInt(int64(entity.RA), true) {
-				err = utility.WebPush(
-					i.oneSignal.AppID,
-					i.oneSignal.APIKey,
-					firebaseID,
-					"タスク通知",
-					contents,
-					topic,
-					redirectURL,
-				)
-				if err != nil {
-					fmt.Println("WebPushの通知")
-					fmt.Println(err)
-				} else {
-					if nextTask.StaffType == null.NewInt(int64(entity.CA), true) {
-						fmt.Println("CAに通知しました")
-					} else {
-						fmt.Println("RAに通知しました")
-					}
-				}
-			}
-		} else {
-			// タスクが終了した場合　ヨミを「失注」に更新
-			sale, err := i.saleRepository.FindByJobSeekerIDAndJobInformationID(sameTask.JobSeekerID, sameTask.JobInformationID)
-			if err != nil {
-				if errors.Is(err, entity.ErrNotFound) {
-					fmt.Println("ヨミの登録がありません")
-				} else {
-					fmt.Println(err)
-					return output, err
-				}
-			} else {
-				sale.Accuracy = null.NewInt(entity.AccuracyFailure, true) // ヨミのみ「失注: 5」に更新
-				err = i.saleRepository.Update(sale.ID, sale)
-				if err != nil {
-					fmt.Println(err)
-					return output, err
-				}
-			}
+		err = pushTaskRequestNotification(i.agentStaffRepository, i.oneSignal, nextTask.StaffType, nextTask.CAStaffID, nextTask.RAStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
 		}
 	}
 
@@ -4631,6 +4283,19 @@ func (i *TaskInteractorImpl) CreateEntryTaskFromMatchingJob(input CreateEntryTas
 		return output, err
 	}
 
+	// 同じ企業への重複推薦を確認（求職者からのエントリーは理由を入力できないため、重複がある場合は受け付けない）
+	duplicateMap, err := getDuplicateRecommendationMap(i, []entity.DuplicateRecommendationTarget{{
+		JobSeekerID:      jobSeeker.ID,
+		JobInformationID: jobInformation.ID,
+	}}, 0)
+	if err != nil {
+		return output, err
+	}
+
+	if len(duplicateMap[duplicateRecommendationKey{jobSeeker.ID, jobInformation.ID}]) > 0 {
+		return output, fmt.Errorf("%w:%s", entity.ErrDuplicateEntry, "この企業の求人には既にエントリーしています。担当者にお問い合わせください")
+	}
+
 	// 両面タスクの判定
 	isDoubleSided := false
 	if jobInformation.AgentStaffID == defaultCAStaffID {
@@ -4710,7 +4375,7 @@ func (i *TaskInteractorImpl) CreateEntryTaskFromMatchingJob(input CreateEntryTas
 		false,
 	)
 
-	err = i.taskRepository.Create(task)
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
 	if err != nil {
 		fmt.Println(err)
 		return output, err
@@ -4730,7 +4395,8 @@ func (i *TaskInteractorImpl) CreateEntryTaskFromMatchingJob(input CreateEntryTas
 }
 
 type UpdateTaskGroupDocumentInput struct {
-	Param entity.UpdateTaskGroupDocumentParam
+	Param    entity.UpdateTaskGroupDocumentParam
+	Operator *entity.AgentStaff // 活動履歴に記録する担当者
 }
 
 type UpdateTaskGroupDocumentOutput struct {
@@ -4770,6 +4436,9 @@ func (i *TaskInteractorImpl) UpdateTaskGroupDocument(input UpdateTaskGroupDocume
 		document5,
 	)
 
+	// 活動履歴に記録する変更前の書類
+	var beforeURL string
+
 	taskDocument, err := i.taskGroupDocumentRepository.FindByGroupID(input.Param.TaskGroupID)
 	if err != nil {
 		if errors.Is(err, entity.ErrNotFound) {
@@ -4784,6 +4453,19 @@ func (i *TaskInteractorImpl) UpdateTaskGroupDocument(input UpdateTaskGroupDocume
 			return output, err
 		}
 	} else {
+		switch input.Param.DocumentType {
+		case 1:
+			beforeURL = taskDocument.Document1URL
+		case 2:
+			beforeURL = taskDocument.Document2URL
+		case 3:
+			beforeURL = taskDocument.Document3URL
+		case 4:
+			beforeURL = taskDocument.Document4URL
+		default:
+			beforeURL = taskDocument.Document5URL
+		}
+
 		// すでにレコードが存在する場合
 		if input.Param.DocumentType == 1 {
 			err := i.taskGroupDocumentRepository.UpdateDocument1URL(taskDocument.ID, input.Param.DocumentURL)
@@ -4820,6 +4502,18 @@ func (i *TaskInteractorImpl) UpdateTaskGroupDocument(input UpdateTaskGroupDocume
 
 	}
 
+	// 1~4以外は5番目の書類として保存しているため、活動履歴も5番目として記録する
+	documentType := input.Param.DocumentType
+	if documentType < 1 || documentType > 4 {
+		documentType = 5
+	}
+
+	err = createDocumentUpdateEvent(i.taskGroupEventRepository, input.Param.TaskGroupID, documentType, beforeURL, input.Param.DocumentURL, getOperatorID(input.Operator))
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
 	output.OK = true
 
 	return output, nil
@@ -5286,6 +4980,109 @@ func (i *TaskInteractorImpl) GetTaskByID(input GetTaskByIDInput) (GetTaskByIDOut
 	return output, nil
 }
 
+// タスクから次に実行できる操作の一覧を取得する
+// タスクグループの最新タスクでない場合は操作できないため空の一覧を返す
+type GetTaskPhaseActionListInput struct {
+	TaskID uint
+}
+
+type GetTaskPhaseActionListOutput struct {
+	Task       *entity.Task
+	IsLatest   bool
+	ActionList []entity.TaskPhaseAction
+}
+
+func (i *TaskInteractorImpl) GetTaskPhaseActionList(input GetTaskPhaseActionListInput) (GetTaskPhaseActionListOutput, error) {
+	var (
+		output GetTaskPhaseActionListOutput
+	)
+
+	task, err := i.taskRepository.FindByID(input.TaskID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	latestTask, err := i.taskRepository.FindLatestByGroupID(task.TaskGroupID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.Task = task
+	output.IsLatest = latestTask.ID == task.ID
+	output.ActionList = []entity.TaskPhaseAction{}
+
+	if output.IsLatest {
+		output.ActionList = policy.GetTaskPhaseActionList(entity.TaskPhaseState{
+			Phase:    task.PhaseCategory.Int64,
+			PhaseSub: task.PhaseSubCategory.Int64,
+		})
+	}
+
+	return output, nil
+}
+
+// 推薦前に同じ企業への重複推薦の可能性がある選考を確認する（求職者または求人が自社のもののみ）
+type CheckDuplicateRecommendationInput struct {
+	Operator *entity.AgentStaff
+	Param    entity.CheckDuplicateRecommendationParam
+}
+
+type CheckDuplicateRecommendationOutput struct {
+	ResultList []*entity.DuplicateRecommendationResult
+}
+
+func (i *TaskInteractorImpl) CheckDuplicateRecommendation(input CheckDuplicateRecommendationInput) (CheckDuplicateRecommendationOutput, error) {
+	var (
+		output = CheckDuplicateRecommendationOutput{
+			ResultList: []*entity.DuplicateRecommendationResult{},
+		}
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	for _, target := range input.Param.GroupList {
+		jobSeeker, err := i.jobSeekerRepository.FindByID(target.JobSeekerID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		jobInformation, err := i.jobInformationRepository.FindByID(target.JobInformationID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		if jobSeeker.AgentID != input.Operator.AgentID && jobInformation.AgentID != input.Operator.AgentID {
+			return output, fmt.Errorf("%w:%s", entity.ErrForbidden, "他社の求職者と求人の組み合わせは確認できません")
+		}
+	}
+
+	duplicateMap, err := getDuplicateRecommendationMap(i, input.Param.GroupList, input.Param.TaskGroupID)
+	if err != nil {
+		return output, err
+	}
+
+	for _, target := range input.Param.GroupList {
+		duplicateList := duplicateMap[duplicateRecommendationKey{target.JobSeekerID, target.JobInformationID}]
+		if duplicateList == nil {
+			duplicateList = []*entity.DuplicateRecommendation{}
+		}
+
+		output.ResultList = append(output.ResultList, &entity.DuplicateRecommendationResult{
+			JobSeekerID:      target.JobSeekerID,
+			JobInformationID: target.JobInformationID,
+			DuplicateList:    duplicateList,
+		})
+	}
+
+	return output, nil
+}
+
 // タスクグループの一覧取得（エージェントが関わっているタスク）
 type GetTaskListAfterEntryByJobSeekerIDInput struct {
 	JobSeekerID uint
@@ -5858,6 +5655,7 @@ func (i *TaskInteractorImpl) GetActiveTaskCountBySelectionID(input GetActiveTask
 // Taskの削除
 type DeleteTaskInput struct {
 	DeleteParam entity.DeleteTaskParam
+	Operator    *entity.AgentStaff // 活動履歴に記録する担当者
 }
 
 type DeleteTaskOutput struct {
@@ -5891,6 +5689,9 @@ func (i *TaskInteractorImpl) DeleteTask(input DeleteTaskInput) (DeleteTaskOutput
 		return output, err
 	}
 
+	// 活動履歴に記録する削除したタスク
+	deletedTaskList := []*entity.Task{task}
+
 	prevTask, err := i.taskRepository.FindLatestByGroupID(input.DeleteParam.TaskGroupID)
 	if err != nil {
 		if !errors.Is(err, entity.ErrNotFound) {
@@ -5907,6 +5708,8 @@ func (i *TaskInteractorImpl) DeleteTask(input DeleteTaskInput) (DeleteTaskOutput
 				fmt.Println(err)
 				return output, err
 			}
+
+			deletedTaskList = append(deletedTaskList, prevTask)
 		}
 	}
 
@@ -5917,6 +5720,20 @@ func (i *TaskInteractorImpl) DeleteTask(input DeleteTaskInput) (DeleteTaskOutput
 		return output, err
 	}
 
+	// タスクグループを削除する前にフェーズの取り消しを活動履歴に記録する
+	var latestTask *entity.Task
+	if len(taskList) > 0 {
+		latestTask = taskList[0]
+	}
+
+	for _, deletedTask := range deletedTaskList {
+		err = createTaskCancelEvent(i.taskGroupEventRepository, deletedTask, latestTask, getOperatorID(input.Operator))
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
 	if len(taskList) == 0 {
 		err := i.taskGroupRepository.Delete(input.DeleteParam.TaskGroupID)
 		if err != nil {
@@ -0,0 +1,622 @@
+package interactor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+type TaskBulkJobInteractor interface {
+	// 汎用系 API
+	CreateTaskBulkJob(input CreateTaskBulkJobInput) (CreateTaskBulkJobOutput, error)
+	GetTaskBulkJobByID(input GetTaskBulkJobByIDInput) (GetTaskBulkJobByIDOutput, error)
+	GetTaskBulkJobList(input GetTaskBulkJobListInput) (GetTaskBulkJobListOutput, error)
+
+	// Batch API
+	StartTaskBulkJobList(input StartTaskBulkJobListInput) (StartTaskBulkJobListOutput, error)
+	ProcessTaskBulkJobItem(input ProcessTaskBulkJobItemInput) (ProcessTaskBulkJobItemOutput, error)
+	FailTaskBulkJobItem(input FailTaskBulkJobItemInput) (FailTaskBulkJobItemOutput, error)
+	FinishTaskBulkJob(input FinishTaskBulkJobInput) (FinishTaskBulkJobOutput, error)
+}
+
+type TaskBulkJobInteractorImpl struct {
+	oneSignal                 config.OneSignal
+	taskBulkJobRepository     usecase.TaskBulkJobRepository
+	taskBulkJobItemRepository usecase.TaskBulkJobItemRepository
+	taskGroupRepository       usecase.TaskGroupRepository
+	taskRepository            usecase.TaskRepository
+	taskGroupEventRepository  usecase.TaskGroupEventRepository
+	evaluationPointRepository usecase.EvaluationPointRepository
+	saleRepository            usecase.SaleRepository
+	jobSeekerRepository       usecase.JobSeekerRepository
+	jobInformationRepository  usecase.JobInformationRepository
+	billingAddressRepository  usecase.BillingAddressRepository
+	agentStaffRepository      usecase.AgentStaffRepository
+	taskLossReasonRepository  usecase.TaskLossReasonRepository
+	jobOfferRepository        usecase.JobOfferRepository
+	agentRepository           usecase.AgentRepository
+}
+
+// TaskBulkJobInteractorImpl is an implementation of TaskBulkJobInteractor
+func NewTaskBulkJobInteractorImpl(
+	os config.OneSignal,
+	tbjR usecase.TaskBulkJobRepository,
+	tbjiR usecase.TaskBulkJobItemRepository,
+	tgR usecase.TaskGroupRepository,
+	tR usecase.TaskRepository,
+	tgeR usecase.TaskGroupEventRepository,
+	epR usecase.EvaluationPointRepository,
+	sR usecase.SaleRepository,
+	jsR usecase.JobSeekerRepository,
+	jiR usecase.JobInformationRepository,
+	baR usecase.BillingAddressRepository,
+	asR usecase.AgentStaffRepository,
+	tlrR usecase.TaskLossReasonRepository,
+	joR usecase.JobOfferRepository,
+	aR usecase.AgentRepository,
+) TaskBulkJobInteractor {
+	return &TaskBulkJobInteractorImpl{
+		oneSignal:                 os,
+		taskBulkJobRepository:     tbjR,
+		taskBulkJobItemRepository: tbjiR,
+		taskGroupRepository:       tgR,
+		taskRepository:            tR,
+		taskGroupEventRepository:  tgeR,
+		evaluationPointRepository: epR,
+		saleRepository:            sR,
+		jobSeekerRepository:       jsR,
+		jobInformationRepository:  jiR,
+		billingAddressRepository:  baR,
+		agentStaffRepository:      asR,
+		taskLossReasonRepository:  tlrR,
+		jobOfferRepository:        joR,
+		agentRepository:           aR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// タスクの一括操作を登録する（処理はバッチで行い、選考ごとの結果はジョブの詳細で確認する）
+type CreateTaskBulkJobInput struct {
+	Operator    *entity.AgentStaff
+	CreateParam entity.CreateTaskBulkJobParam
+}
+
+type CreateTaskBulkJobOutput struct {
+	Job *entity.TaskBulkJob
+}
+
+func (i *TaskBulkJobInteractorImpl) CreateTaskBulkJob(input CreateTaskBulkJobInput) (CreateTaskBulkJobOutput, error) {
+	var (
+		output CreateTaskBulkJobOutput
+		param  = input.CreateParam
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	err := policy.ValidateTaskBulkJobParam(param)
+	if err != nil {
+		return output, err
+	}
+
+	// 変更後の担当者は自社の担当者に限る
+	if param.ActionType == entity.TaskBulkActionReassign {
+		for _, staffID := range []null.Int{param.RAStaffID, param.CAStaffID} {
+			if !staffID.Valid {
+				continue
+			}
+
+			err = i.validateTaskBulkStaff(input.Operator, uint(staffID.Int64))
+			if err != nil {
+				return output, err
+			}
+		}
+	}
+
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	job := entity.NewTaskBulkJob(
+		input.Operator.AgentID,
+		input.Operator.ID,
+		param.ActionType,
+		string(paramJSON),
+		uint(len(param.TaskGroupIDList)),
+	)
+
+	err = i.taskBulkJobRepository.Create(job)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.taskBulkJobItemRepository.CreateMulti(job.ID, param.TaskGroupIDList)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.Job = job
+
+	return output, nil
+}
+
+// 一括操作のジョブと選考ごとの結果を取得する（自社のジョブのみ）
+type GetTaskBulkJobByIDInput struct {
+	Operator *entity.AgentStaff
+	JobID    uint
+}
+
+type GetTaskBulkJobByIDOutput struct {
+	Job *entity.TaskBulkJob
+}
+
+func (i *TaskBulkJobInteractorImpl) GetTaskBulkJobByID(input GetTaskBulkJobByIDInput) (GetTaskBulkJobByIDOutput, error) {
+	var (
+		output GetTaskBulkJobByIDOutput
+	)
+
+	job, err := i.taskBulkJobRepository.FindByID(input.JobID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, job.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	itemList, err := i.taskBulkJobItemRepository.GetByTaskBulkJobID(job.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	job.ItemList = itemList
+
+	output.Job = job
+
+	return output, nil
+}
+
+// ログイン中の担当者が登録した一括操作の一覧を取得する
+type GetTaskBulkJobListInput struct {
+	Operator *entity.AgentStaff
+}
+
+type GetTaskBulkJobListOutput struct {
+	JobList []*entity.TaskBulkJob
+}
+
+func (i *TaskBulkJobInteractorImpl) GetTaskBulkJobList(input GetTaskBulkJobListInput) (GetTaskBulkJobListOutput, error) {
+	var (
+		output GetTaskBulkJobListOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	jobList, err := i.taskBulkJobRepository.GetByAgentStaffID(input.Operator.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.JobList = jobList
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// Batch API
+//
+// 未完了の一括操作を選考ごとに処理し、結果を記録する
+// 選考1件ごとに呼び出し側でトランザクションを分けるため、1件の失敗で他の選考の処理は止めない
+// 途中で中断したジョブは次回のバッチで未処理の選考から再開する
+//
+// 未完了の一括操作を実行中にし、選考ごとの結果とあわせて取得する
+type StartTaskBulkJobListInput struct {
+	Now time.Time
+}
+
+type StartTaskBulkJobListOutput struct {
+	JobList []*entity.TaskBulkJob
+}
+
+func (i *TaskBulkJobInteractorImpl) StartTaskBulkJobList(input StartTaskBulkJobListInput) (StartTaskBulkJobListOutput, error) {
+	var (
+		output StartTaskBulkJobListOutput
+	)
+
+	jobList, err := i.taskBulkJobRepository.GetUnfinished(entity.TaskBulkJobBatchLimit)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, job := range jobList {
+		err = i.taskBulkJobRepository.Start(job.ID, input.Now)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		itemList, err := i.taskBulkJobItemRepository.GetByTaskBulkJobID(job.ID)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		job.ItemList = itemList
+	}
+
+	output.JobList = jobList
+
+	return output, nil
+}
+
+// 選考1件を処理し、成功した結果を記録する
+// エラーの場合は呼び出し側でロールバックし、FailTaskBulkJobItem で失敗を記録する
+type ProcessTaskBulkJobItemInput struct {
+	Job  *entity.TaskBulkJob
+	Item *entity.TaskBulkJobItem
+}
+
+type ProcessTaskBulkJobItemOutput struct {
+	OK bool
+}
+
+func (i *TaskBulkJobInteractorImpl) ProcessTaskBulkJobItem(input ProcessTaskBulkJobItemInput) (ProcessTaskBulkJobItemOutput, error) {
+	var (
+		output ProcessTaskBulkJobItemOutput
+		param  entity.CreateTaskBulkJobParam
+	)
+
+	// 依頼した担当者が削除された場合や内容が読み取れない場合は、全ての選考を失敗とする
+	operator, err := i.agentStaffRepository.FindByID(input.Job.AgentStaffID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return output, fmt.Errorf("%w:%s", entity.ErrRequestError, "依頼した担当者が見つかりません")
+		}
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = json.Unmarshal([]byte(input.Job.Param), &param)
+	if err != nil {
+		fmt.Println(err)
+		return output, fmt.Errorf("%w:%s", entity.ErrRequestError, "操作の内容が正しくありません")
+	}
+
+	taskID, err := i.processTaskBulkJobItem(operator, param, input.Item.TaskGroupID)
+	if err != nil {
+		return output, err
+	}
+
+	err = i.taskBulkJobItemRepository.UpdateResult(input.Item.ID, entity.TaskBulkJobItemStatusSuccess, taskID, "")
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// 選考1件の失敗を記録する（ロールバック後に呼び出す）
+type FailTaskBulkJobItemInput struct {
+	Item      *entity.TaskBulkJobItem
+	ItemError error
+}
+
+type FailTaskBulkJobItemOutput struct {
+	OK bool
+}
+
+func (i *TaskBulkJobInteractorImpl) FailTaskBulkJobItem(input FailTaskBulkJobItemInput) (FailTaskBulkJobItemOutput, error) {
+	var (
+		output FailTaskBulkJobItemOutput
+	)
+
+	err := i.taskBulkJobItemRepository.UpdateResult(
+		input.Item.ID,
+		entity.TaskBulkJobItemStatusFailure,
+		null.NewInt(0, false),
+		input.ItemError.Error(),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+// 選考ごとの結果を集計して一括操作を完了にする
+type FinishTaskBulkJobInput struct {
+	JobID uint
+	Now   time.Time
+}
+
+type FinishTaskBulkJobOutput struct {
+	OK bool
+}
+
+func (i *TaskBulkJobInteractorImpl) FinishTaskBulkJob(input FinishTaskBulkJobInput) (FinishTaskBulkJobOutput, error) {
+	var (
+		output       FinishTaskBulkJobOutput
+		successCount uint
+		failureCount uint
+	)
+
+	itemList, err := i.taskBulkJobItemRepository.GetByTaskBulkJobID(input.JobID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, item := range itemList {
+		switch item.Status {
+		case entity.TaskBulkJobItemStatusSuccess:
+			successCount++
+		case entity.TaskBulkJobItemStatusFailure:
+			failureCount++
+		}
+	}
+
+	err = i.taskBulkJobRepository.Finish(input.JobID, successCount, failureCount, input.Now)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+
+// 選考1件を処理し、作成・更新したタスクのIDを返す
+func (i *TaskBulkJobInteractorImpl) processTaskBulkJobItem(operator *entity.AgentStaff, param entity.CreateTaskBulkJobParam, taskGroupID uint) (null.Int, error) {
+	taskGroup, err := i.taskGroupRepository.FindByID(taskGroupID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return null.NewInt(0, false), fmt.Errorf("%w:%s", entity.ErrRequestError, "選考が見つかりません")
+		}
+		return null.NewInt(0, false), err
+	}
+
+	// CA側・RA側どちらかのエージェントの担当者のみ操作できる
+	isCAAgent := policy.RequireOwnAgent(operator, taskGroup.CAAgentID) == nil
+	isRAAgent := policy.RequireOwnAgent(operator, taskGroup.RAAgentID) == nil
+	if !isCAAgent && !isRAAgent {
+		return null.NewInt(0, false), fmt.Errorf("%w:%s", entity.ErrForbidden, "他社の選考は操作できません")
+	}
+
+	switch param.ActionType {
+	case entity.TaskBulkActionTransition, entity.TaskBulkActionClose:
+		return i.transitionTaskBulkItem(operator, param, taskGroup, isCAAgent)
+	case entity.TaskBulkActionReassign:
+		return null.NewInt(0, false), i.reassignTaskBulkItem(param, taskGroup, isCAAgent, isRAAgent)
+	case entity.TaskBulkActionDeadline:
+		return i.updateTaskBulkItemDeadline(operator, param, taskGroup)
+	}
+
+	return null.NewInt(0, false), fmt.Errorf("%w:%s", entity.ErrRequestError, "操作が正しくありません")
+}
+
+// 最新タスクから指定のフェーズへ遷移する
+// 個別のタスク作成と同様に、遷移に伴う処理・依頼時間の更新・プッシュ通知も行う
+func (i *TaskBulkJobInteractorImpl) transitionTaskBulkItem(operator *entity.AgentStaff, param entity.CreateTaskBulkJobParam, taskGroup *entity.TaskGroup, isCAAgent bool) (null.Int, error) {
+	latestTask, err := i.taskRepository.FindLatestByGroupID(taskGroup.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return null.NewInt(0, false), fmt.Errorf("%w:%s", entity.ErrRequestError, "タスクが見つかりません")
+		}
+		return null.NewInt(0, false), err
+	}
+
+	// 最新タスクを実行する側の担当者のみ進められる
+	err = policy.ValidateTaskPhaseOperator(operator, taskGroup, latestTask.StaffType)
+	if err != nil {
+		return null.NewInt(0, false), err
+	}
+
+	transition, err := policy.ValidateTaskBulkTransition(
+		entity.TaskPhaseState{Phase: latestTask.PhaseCategory.Int64, PhaseSub: latestTask.PhaseSubCategory.Int64},
+		entity.TaskPhaseState{Phase: param.PhaseCategory.Int64, PhaseSub: param.PhaseSubCategory.Int64},
+		param.StaffType,
+	)
+	if err != nil {
+		return null.NewInt(0, false), err
+	}
+
+	// 実行する担当者の指定がない場合は遷移の定義、どちらでもよい遷移は操作した側の担当者とする
+	staffType := param.StaffType
+	if !staffType.Valid {
+		switch {
+		case transition.StaffType != entity.TaskStaffTypeAny:
+			staffType = null.NewInt(int64(transition.StaffType), true)
+		case isCAAgent:
+			staffType = null.NewInt(int64(entity.CA), true)
+		default:
+			staffType = null.NewInt(int64(entity.RA), true)
+		}
+	}
+
+	task := entity.NewTask(
+		taskGroup.ID,
+		param.PhaseCategory,
+		param.PhaseSubCategory,
+		staffType,
+		operator.ID,
+		param.Remarks,
+		param.DeadlineDay,
+		param.DeadlineTime,
+		"",
+		"",
+		"",
+		false,
+	)
+
+	err = createTaskWithEvent(i.taskRepository, i.taskGroupEventRepository, task)
+	if err != nil {
+		fmt.Println(err)
+		return null.NewInt(0, false), err
+	}
+
+	// 辞退・不合格の理由を記録
+	err = createTaskLossReason(i.taskLossReasonRepository, task, param.LossReason, param.Remarks)
+	if err != nil {
+		fmt.Println(err)
+		return null.NewInt(0, false), err
+	}
+
+	taskID := null.NewInt(int64(task.ID), true)
+
+	// 遷移の定義に従って評価点の登録・ヨミの更新・売上の下書きの作成を行う
+	err = runTaskPhaseSideEffects(
+		i.taskGroupRepository,
+		i.taskGroupEventRepository,
+		i.evaluationPointRepository,
+		i.saleRepository,
+		i.jobOfferRepository,
+		i.jobInformationRepository,
+		i.agentRepository,
+		transition,
+		taskPhaseSideEffectParam{
+			Task:                   task,
+			JobSeekerID:            taskGroup.JobSeekerID,
+			JobInformationID:       taskGroup.JobInformationID,
+			SelectionInformationID: null.NewInt(0, false),
+			GoodPoint:              param.GoodPoint,
+			NGPoint:                param.NGPoint,
+			ExecutedStaffID:        operator.ID,
+		},
+	)
+	if err != nil {
+		fmt.Println(err)
+		return taskID, err
+	}
+
+	err = updateTaskLastRequestAt(i.taskGroupRepository, taskGroup.ID, latestTask.StaffType, staffType)
+	if err != nil {
+		fmt.Println(err)
+		return taskID, err
+	}
+
+	// 終了タスク以外は実行する担当者に通知
+	if !(entity.TaskPhaseState{Phase: param.PhaseCategory.Int64, PhaseSub: param.PhaseSubCategory.Int64}).IsClosed() {
+		err = pushTaskRequestNotification(i.agentStaffRepository, i.oneSignal, staffType, taskGroup.CAStaffID, taskGroup.RAStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return taskID, err
+		}
+	}
+
+	return taskID, nil
+}
+
+// 担当者を変更する
+// CAは求職者の担当者、RAは求人の請求先の担当者を変更するため、同じ求職者・請求先の他の選考にも反映される
+func (i *TaskBulkJobInteractorImpl) reassignTaskBulkItem(param entity.CreateTaskBulkJobParam, taskGroup *entity.TaskGroup, isCAAgent, isRAAgent bool) error {
+	if param.CAStaffID.Valid && !isCAAgent {
+		return fmt.Errorf("%w:%s", entity.ErrForbidden, "CA担当者は求職者を担当するエージェントのみ変更できます")
+	}
+
+	if param.RAStaffID.Valid && !isRAAgent {
+		return fmt.Errorf("%w:%s", entity.ErrForbidden, "RA担当者は求人を担当するエージェントのみ変更できます")
+	}
+
+	if param.CAStaffID.Valid {
+		err := i.jobSeekerRepository.UpdateStaffID(taskGroup.JobSeekerID, param.CAStaffID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	if param.RAStaffID.Valid {
+		jobInformation, err := i.jobInformationRepository.FindByID(taskGroup.JobInformationID)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+
+		err = i.billingAddressRepository.UpdateAgentStaffID(jobInformation.BillingAddressID, uint(param.RAStaffID.Int64))
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 最新タスクの期限を更新する（終了した選考は対象外）
+func (i *TaskBulkJobInteractorImpl) updateTaskBulkItemDeadline(operator *entity.AgentStaff, param entity.CreateTaskBulkJobParam, taskGroup *entity.TaskGroup) (null.Int, error) {
+	latestTask, err := i.taskRepository.FindLatestByGroupID(taskGroup.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return null.NewInt(0, false), fmt.Errorf("%w:%s", entity.ErrRequestError, "タスクが見つかりません")
+		}
+		return null.NewInt(0, false), err
+	}
+
+	// 最新タスクを実行する側の担当者のみ期限を変更できる
+	err = policy.ValidateTaskPhaseOperator(operator, taskGroup, latestTask.StaffType)
+	if err != nil {
+		return null.NewInt(0, false), err
+	}
+
+	state := entity.TaskPhaseState{Phase: latestTask.PhaseCategory.Int64, PhaseSub: latestTask.PhaseSubCategory.Int64}
+	if state.IsClosed() {
+		return null.NewInt(0, false), fmt.Errorf("%w:%s", entity.ErrRequestError, "終了した選考の期限は設定できません")
+	}
+
+	latestTask.DeadlineDay = param.DeadlineDay
+	latestTask.DeadlineTime = param.DeadlineTime
+
+	err = i.taskRepository.Update(latestTask.ID, latestTask)
+	if err != nil {
+		fmt.Println(err)
+		return null.NewInt(0, false), err
+	}
+
+	return null.NewInt(int64(latestTask.ID), true), nil
+}
+
+// 担当者が自社の担当者かを確認する
+func (i *TaskBulkJobInteractorImpl) validateTaskBulkStaff(operator *entity.AgentStaff, agentStaffID uint) error {
+	agentStaff, err := i.agentStaffRepository.FindByID(agentStaffID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "変更後の担当者が見つかりません")
+		}
+		fmt.Println(err)
+		return err
+	}
+
+	return policy.RequireOwnAgent(operator, agentStaff.AgentID)
+}
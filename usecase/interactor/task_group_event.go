@@ -0,0 +1,127 @@
+package interactor
+
+import (
+	"fmt"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+)
+
+type TaskGroupEventInteractor interface {
+	// 汎用系 API
+	GetTaskGroupTimeline(input GetTaskGroupTimelineInput) (GetTaskGroupTimelineOutput, error)
+	GetJobSeekerTimeline(input GetJobSeekerTimelineInput) (GetJobSeekerTimelineOutput, error)
+}
+
+type TaskGroupEventInteractorImpl struct {
+	taskGroupEventRepository usecase.TaskGroupEventRepository
+	taskGroupRepository      usecase.TaskGroupRepository
+	jobSeekerRepository      usecase.JobSeekerRepository
+}
+
+// TaskGroupEventInteractorImpl is an implementation of TaskGroupEventInteractor
+func NewTaskGroupEventInteractorImpl(
+	tgeR usecase.TaskGroupEventRepository,
+	tgR usecase.TaskGroupRepository,
+	jsR usecase.JobSeekerRepository,
+) TaskGroupEventInteractor {
+	return &TaskGroupEventInteractorImpl{
+		taskGroupEventRepository: tgeR,
+		taskGroupRepository:      tgR,
+		jobSeekerRepository:      jsR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// タスクグループの活動履歴を取得する（CA・RAどちらかのエージェントの担当者のみ）
+type GetTaskGroupTimelineInput struct {
+	TaskGroupID uint
+	Operator    *entity.AgentStaff
+}
+
+type GetTaskGroupTimelineOutput struct {
+	EventList []*entity.TaskGroupEvent
+}
+
+func (i *TaskGroupEventInteractorImpl) GetTaskGroupTimeline(input GetTaskGroupTimelineInput) (GetTaskGroupTimelineOutput, error) {
+	var (
+		output GetTaskGroupTimelineOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	taskGroup, err := i.taskGroupRepository.FindByID(input.TaskGroupID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// CA側のエージェントでない場合はRA側のエージェントかを確認する
+	err = policy.RequireOwnAgent(input.Operator, taskGroup.CAAgentID)
+	if err != nil {
+		err = policy.RequireOwnAgent(input.Operator, taskGroup.RAAgentID)
+		if err != nil {
+			return output, err
+		}
+	}
+
+	eventList, err := i.taskGroupEventRepository.GetByTaskGroupID(input.TaskGroupID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	setTaskGroupEventName(eventList)
+
+	output.EventList = eventList
+
+	return output, nil
+}
+
+// 求職者の全ての選考の活動履歴を取得する（求職者を担当するエージェントの担当者のみ）
+type GetJobSeekerTimelineInput struct {
+	JobSeekerID uint
+	Operator    *entity.AgentStaff
+}
+
+type GetJobSeekerTimelineOutput struct {
+	EventList []*entity.TaskGroupEvent
+}
+
+func (i *TaskGroupEventInteractorImpl) GetJobSeekerTimeline(input GetJobSeekerTimelineInput) (GetJobSeekerTimelineOutput, error) {
+	var (
+		output GetJobSeekerTimelineOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	jobSeeker, err := i.jobSeekerRepository.FindByID(input.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, jobSeeker.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	eventList, err := i.taskGroupEventRepository.GetByJobSeekerID(input.JobSeekerID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	setTaskGroupEventName(eventList)
+
+	output.EventList = eventList
+
+	return output, nil
+}
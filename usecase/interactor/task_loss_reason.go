@@ -0,0 +1,159 @@
+package interactor
+
+import (
+	"fmt"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+type TaskLossReasonInteractor interface {
+	// 汎用系 API
+	GetTaskLossReasonMasterList(input GetTaskLossReasonMasterListInput) (GetTaskLossReasonMasterListOutput, error)
+	GetTaskLossReasonListByTaskGroupID(input GetTaskLossReasonListByTaskGroupIDInput) (GetTaskLossReasonListByTaskGroupIDOutput, error)
+	GetTaskLossReasonAnalytics(input GetTaskLossReasonAnalyticsInput) (GetTaskLossReasonAnalyticsOutput, error)
+}
+
+type TaskLossReasonInteractorImpl struct {
+	taskLossReasonRepository usecase.TaskLossReasonRepository
+	taskGroupRepository      usecase.TaskGroupRepository
+}
+
+// TaskLossReasonInteractorImpl is an implementation of TaskLossReasonInteractor
+func NewTaskLossReasonInteractorImpl(
+	tlrR usecase.TaskLossReasonRepository,
+	tgR usecase.TaskGroupRepository,
+) TaskLossReasonInteractor {
+	return &TaskLossReasonInteractorImpl{
+		taskLossReasonRepository: tlrR,
+		taskGroupRepository:      tgR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// 辞退・不合格の理由の一覧（フェーズの指定がある場合は、その終了で選択できる理由のみ）
+type GetTaskLossReasonMasterListInput struct {
+	PhaseCategory    null.Int
+	PhaseSubCategory null.Int
+}
+
+type GetTaskLossReasonMasterListOutput struct {
+	MasterList []entity.TaskLossReasonMaster
+}
+
+func (i *TaskLossReasonInteractorImpl) GetTaskLossReasonMasterList(input GetTaskLossReasonMasterListInput) (GetTaskLossReasonMasterListOutput, error) {
+	var (
+		output GetTaskLossReasonMasterListOutput
+	)
+
+	if !input.PhaseCategory.Valid || !input.PhaseSubCategory.Valid {
+		output.MasterList = entity.TaskLossReasonMasterList
+		return output, nil
+	}
+
+	output.MasterList = policy.GetTaskLossReasonMasterList(entity.TaskPhaseState{
+		Phase:    input.PhaseCategory.Int64,
+		PhaseSub: input.PhaseSubCategory.Int64,
+	})
+
+	return output, nil
+}
+
+// 選考の辞退・不合格の理由を取得する（CA側・RA側のエージェントの担当者のみ）
+type GetTaskLossReasonListByTaskGroupIDInput struct {
+	TaskGroupID uint
+	Operator    *entity.AgentStaff
+}
+
+type GetTaskLossReasonListByTaskGroupIDOutput struct {
+	LossReasonList []*entity.TaskLossReason
+}
+
+func (i *TaskLossReasonInteractorImpl) GetTaskLossReasonListByTaskGroupID(input GetTaskLossReasonListByTaskGroupIDInput) (GetTaskLossReasonListByTaskGroupIDOutput, error) {
+	var (
+		output GetTaskLossReasonListByTaskGroupIDOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	taskGroup, err := i.taskGroupRepository.FindByID(input.TaskGroupID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// CA側のエージェントでない場合はRA側のエージェントかを確認する
+	err = policy.RequireOwnAgent(input.Operator, taskGroup.CAAgentID)
+	if err != nil {
+		err = policy.RequireOwnAgent(input.Operator, taskGroup.RAAgentID)
+		if err != nil {
+			return output, err
+		}
+	}
+
+	lossReasonList, err := i.taskLossReasonRepository.GetByTaskGroupID(taskGroup.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, lossReason := range lossReasonList {
+		lossReason.LossTypeName = entity.TaskLossTypeName[lossReason.LossType]
+		lossReason.LossReasonName = policy.GetTaskLossReasonName(lossReason.LossReason)
+	}
+
+	output.LossReasonList = lossReasonList
+
+	return output, nil
+}
+
+// 辞退・不合格の理由を企業・求人・担当者・フェーズごとに集計する（自社がCA・RAのいずれかで関わる選考のみ）
+type GetTaskLossReasonAnalyticsInput struct {
+	SearchParam entity.SearchTaskLossReasonParam
+	Operator    *entity.AgentStaff
+}
+
+type GetTaskLossReasonAnalyticsOutput struct {
+	AnalyticsList []*entity.TaskLossReasonAnalytics
+	Summary       *entity.TaskLossReasonAnalytics
+}
+
+func (i *TaskLossReasonInteractorImpl) GetTaskLossReasonAnalytics(input GetTaskLossReasonAnalyticsInput) (GetTaskLossReasonAnalyticsOutput, error) {
+	var (
+		output      GetTaskLossReasonAnalyticsOutput
+		searchParam = input.SearchParam
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	err := policy.ValidateTaskLossGroupBy(searchParam.GroupBy)
+	if err != nil {
+		return output, err
+	}
+
+	if searchParam.LossType.Valid {
+		if _, ok := entity.TaskLossTypeName[searchParam.LossType.Int64]; !ok {
+			return output, fmt.Errorf("%w:%s", entity.ErrRequestError, "失注の種類が正しくありません")
+		}
+	}
+
+	searchParam.AgentID = input.Operator.AgentID
+
+	lossReasonList, err := i.taskLossReasonRepository.GetForAnalytics(searchParam)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.AnalyticsList, output.Summary = policy.AggregateTaskLossReason(lossReasonList, searchParam.GroupBy)
+
+	return output, nil
+}
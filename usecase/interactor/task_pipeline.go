@@ -0,0 +1,507 @@
+package interactor
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+type TaskPipelineInteractor interface {
+	// 汎用系 API
+	GetTaskPipelineByAgentID(input GetTaskPipelineByAgentIDInput) (GetTaskPipelineOutput, error)
+	GetTaskPipelineByAgentStaffID(input GetTaskPipelineByAgentStaffIDInput) (GetTaskPipelineOutput, error)
+	GetTaskPipelineByJobInformationID(input GetTaskPipelineByJobInformationIDInput) (GetTaskPipelineOutput, error)
+	GetTaskPipelineCardList(input GetTaskPipelineCardListInput) (GetTaskPipelineCardListOutput, error)
+
+	// 求人・請求先ごとの応募者一覧（RA向け）
+	GetTaskPipelineApplicantListByJobInformationID(input GetTaskPipelineApplicantListByJobInformationIDInput) (GetTaskPipelineApplicantListOutput, error)
+	GetTaskPipelineApplicantListByBillingAddressID(input GetTaskPipelineApplicantListByBillingAddressIDInput) (GetTaskPipelineApplicantListOutput, error)
+	ExportTaskPipelineApplicantCSVByJobInformationID(input GetTaskPipelineApplicantListByJobInformationIDInput) (ExportTaskPipelineApplicantCSVOutput, error)
+	ExportTaskPipelineApplicantCSVByBillingAddressID(input GetTaskPipelineApplicantListByBillingAddressIDInput) (ExportTaskPipelineApplicantCSVOutput, error)
+}
+
+type TaskPipelineInteractorImpl struct {
+	taskRepository           usecase.TaskRepository
+	agentStaffRepository     usecase.AgentStaffRepository
+	jobInformationRepository usecase.JobInformationRepository
+	billingAddressRepository usecase.BillingAddressRepository
+}
+
+// TaskPipelineInteractorImpl is an implementation of TaskPipelineInteractor
+func NewTaskPipelineInteractorImpl(
+	tR usecase.TaskRepository,
+	asR usecase.AgentStaffRepository,
+	jiR usecase.JobInformationRepository,
+	baR usecase.BillingAddressRepository,
+) TaskPipelineInteractor {
+	return &TaskPipelineInteractorImpl{
+		taskRepository:           tR,
+		agentStaffRepository:     asR,
+		jobInformationRepository: jiR,
+		billingAddressRepository: baR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// パイプラインの集計結果（フェーズごとの件数とサブフェーズごとの内訳）
+type GetTaskPipelineOutput struct {
+	PhaseList  []*entity.TaskPipelinePhase
+	TotalCount uint
+}
+
+// エージェントのパイプラインを取得する（自社のみ）
+type GetTaskPipelineByAgentIDInput struct {
+	Operator    *entity.AgentStaff
+	AgentID     uint
+	SearchParam entity.SearchTaskPipelineParam
+}
+
+func (i *TaskPipelineInteractorImpl) GetTaskPipelineByAgentID(input GetTaskPipelineByAgentIDInput) (GetTaskPipelineOutput, error) {
+	var (
+		output      GetTaskPipelineOutput
+		searchParam = input.SearchParam
+	)
+
+	err := policy.RequireOwnAgent(input.Operator, input.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	searchParam.AgentID = input.AgentID
+
+	return i.getTaskPipeline(searchParam)
+}
+
+// 担当者がCA・RAのいずれかで担当する選考のパイプラインを取得する（自社の担当者のみ）
+type GetTaskPipelineByAgentStaffIDInput struct {
+	Operator     *entity.AgentStaff
+	AgentStaffID uint
+	SearchParam  entity.SearchTaskPipelineParam
+}
+
+func (i *TaskPipelineInteractorImpl) GetTaskPipelineByAgentStaffID(input GetTaskPipelineByAgentStaffIDInput) (GetTaskPipelineOutput, error) {
+	var (
+		output      GetTaskPipelineOutput
+		searchParam = input.SearchParam
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	agentStaff, err := i.agentStaffRepository.FindByID(input.AgentStaffID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, agentStaff.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	searchParam.AgentID = agentStaff.AgentID
+	searchParam.AgentStaffID = agentStaff.ID
+
+	return i.getTaskPipeline(searchParam)
+}
+
+// 求人のパイプラインを取得する（自社が関わる選考のみ集計する）
+type GetTaskPipelineByJobInformationIDInput struct {
+	Operator         *entity.AgentStaff
+	JobInformationID uint
+	SearchParam      entity.SearchTaskPipelineParam
+}
+
+func (i *TaskPipelineInteractorImpl) GetTaskPipelineByJobInformationID(input GetTaskPipelineByJobInformationIDInput) (GetTaskPipelineOutput, error) {
+	var (
+		output      GetTaskPipelineOutput
+		searchParam = input.SearchParam
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	searchParam.AgentID = input.Operator.AgentID
+	searchParam.JobInformationID = input.JobInformationID
+
+	return i.getTaskPipeline(searchParam)
+}
+
+// パイプラインの列（フェーズ・サブフェーズ）のカードを取得する（自社のみ）
+type GetTaskPipelineCardListInput struct {
+	Operator    *entity.AgentStaff
+	AgentID     uint
+	SearchParam entity.SearchTaskPipelineParam
+}
+
+type GetTaskPipelineCardListOutput struct {
+	CardList      []*entity.TaskPipelineCard
+	TotalCount    uint
+	MaxPageNumber uint
+}
+
+func (i *TaskPipelineInteractorImpl) GetTaskPipelineCardList(input GetTaskPipelineCardListInput) (GetTaskPipelineCardListOutput, error) {
+	var (
+		output      GetTaskPipelineCardListOutput
+		searchParam = input.SearchParam
+	)
+
+	err := policy.RequireOwnAgent(input.Operator, input.AgentID)
+	if err != nil {
+		return output, err
+	}
+
+	if !searchParam.PhaseCategory.Valid {
+		return output, fmt.Errorf("%w:%s", entity.ErrRequestError, "フェーズを指定してください")
+	}
+
+	searchParam.AgentID = input.AgentID
+
+	countList, err := i.taskRepository.GetPipelineCountList(searchParam)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	for _, count := range countList {
+		output.TotalCount += count.Count
+	}
+
+	output.MaxPageNumber = (output.TotalCount + entity.TaskPipelineCardPerPage - 1) / entity.TaskPipelineCardPerPage
+
+	cardList, err := i.taskRepository.GetPipelineCardList(searchParam)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.CardList = cardList
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 応募者一覧 API（RA向け）
+//
+// 応募者一覧（選考中の求職者の現在のフェーズ・滞留日数・次の対応者・CAのエージェント・最新の評価点）
+// 件数は GetActiveTaskCountByJobInformationID と同じく90番台のサブフェーズを除いた選考を対象とする
+type GetTaskPipelineApplicantListOutput struct {
+	ApplicantList []*entity.TaskPipelineApplicant
+	TotalCount    uint
+}
+
+type ExportTaskPipelineApplicantCSVOutput struct {
+	FilePath *entity.FilePath
+}
+
+// 求人の応募者一覧を取得する（自社の求人のみ）
+type GetTaskPipelineApplicantListByJobInformationIDInput struct {
+	Operator         *entity.AgentStaff
+	JobInformationID uint
+}
+
+func (i *TaskPipelineInteractorImpl) GetTaskPipelineApplicantListByJobInformationID(input GetTaskPipelineApplicantListByJobInformationIDInput) (GetTaskPipelineApplicantListOutput, error) {
+	var (
+		output GetTaskPipelineApplicantListOutput
+	)
+
+	applicantList, err := i.getTaskPipelineApplicantListByJobInformationID(input)
+	if err != nil {
+		return output, err
+	}
+
+	output.ApplicantList = applicantList
+	output.TotalCount = uint(len(applicantList))
+
+	return output, nil
+}
+
+// 請求先の求人の応募者一覧を取得する（自社の請求先のみ）
+type GetTaskPipelineApplicantListByBillingAddressIDInput struct {
+	Operator         *entity.AgentStaff
+	BillingAddressID uint
+}
+
+func (i *TaskPipelineInteractorImpl) GetTaskPipelineApplicantListByBillingAddressID(input GetTaskPipelineApplicantListByBillingAddressIDInput) (GetTaskPipelineApplicantListOutput, error) {
+	var (
+		output GetTaskPipelineApplicantListOutput
+	)
+
+	applicantList, err := i.getTaskPipelineApplicantListByBillingAddressID(input)
+	if err != nil {
+		return output, err
+	}
+
+	output.ApplicantList = applicantList
+	output.TotalCount = uint(len(applicantList))
+
+	return output, nil
+}
+
+// 求人の応募者一覧をCSVで出力する
+func (i *TaskPipelineInteractorImpl) ExportTaskPipelineApplicantCSVByJobInformationID(input GetTaskPipelineApplicantListByJobInformationIDInput) (ExportTaskPipelineApplicantCSVOutput, error) {
+	var (
+		output ExportTaskPipelineApplicantCSVOutput
+	)
+
+	applicantList, err := i.getTaskPipelineApplicantListByJobInformationID(input)
+	if err != nil {
+		return output, err
+	}
+
+	output.FilePath, err = writeTaskPipelineApplicantCSV(applicantList)
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+// 請求先の求人の応募者一覧をCSVで出力する
+func (i *TaskPipelineInteractorImpl) ExportTaskPipelineApplicantCSVByBillingAddressID(input GetTaskPipelineApplicantListByBillingAddressIDInput) (ExportTaskPipelineApplicantCSVOutput, error) {
+	var (
+		output ExportTaskPipelineApplicantCSVOutput
+	)
+
+	applicantList, err := i.getTaskPipelineApplicantListByBillingAddressID(input)
+	if err != nil {
+		return output, err
+	}
+
+	output.FilePath, err = writeTaskPipelineApplicantCSV(applicantList)
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+
+// 求人のRAが自社であることを確認して応募者一覧を取得する
+func (i *TaskPipelineInteractorImpl) getTaskPipelineApplicantListByJobInformationID(input GetTaskPipelineApplicantListByJobInformationIDInput) ([]*entity.TaskPipelineApplicant, error) {
+	jobInformation, err := i.jobInformationRepository.FindByID(input.JobInformationID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, jobInformation.AgentID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicantList, err := i.taskRepository.GetActiveApplicantListByJobInformationID(jobInformation.ID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	setTaskPipelineApplicantList(applicantList, time.Now().In(utility.Tokyo))
+
+	return applicantList, nil
+}
+
+// 請求先が自社であることを確認して応募者一覧を取得する
+func (i *TaskPipelineInteractorImpl) getTaskPipelineApplicantListByBillingAddressID(input GetTaskPipelineApplicantListByBillingAddressIDInput) ([]*entity.TaskPipelineApplicant, error) {
+	billingAddress, err := i.billingAddressRepository.FindByID(input.BillingAddressID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, billingAddress.AgentID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicantList, err := i.taskRepository.GetActiveApplicantListByBillingAddressID(billingAddress.ID)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	setTaskPipelineApplicantList(applicantList, time.Now().In(utility.Tokyo))
+
+	return applicantList, nil
+}
+
+// フェーズ名・滞留日数・アライアンスの有無・次の対応者を設定する
+func setTaskPipelineApplicantList(applicantList []*entity.TaskPipelineApplicant, now time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, utility.Tokyo)
+
+	for _, applicant := range applicantList {
+		applicant.PhaseName, applicant.PhaseSubName = getStrTaskPhaseAndPhaseSub(
+			null.NewInt(applicant.PhaseCategory, true),
+			null.NewInt(applicant.PhaseSubCategory, true),
+		)
+
+		// 滞留日数は日付単位で数える（当日にフェーズが変わった場合は0日）
+		changedAt := applicant.PhaseChangedAt.In(utility.Tokyo)
+		changedDay := time.Date(changedAt.Year(), changedAt.Month(), changedAt.Day(), 0, 0, 0, 0, utility.Tokyo)
+		if today.After(changedDay) {
+			applicant.DaysInPhase = uint(today.Sub(changedDay).Hours() / 24)
+		}
+
+		applicant.IsAlliance = applicant.CAAgentID != applicant.RAAgentID
+
+		if applicant.StaffType.Valid {
+			switch entity.StaffType(applicant.StaffType.Int64) {
+			case entity.CA, entity.CA_Boss:
+				applicant.NextActionStaffName = applicant.CAStaffName
+			case entity.RA, entity.RA_Boss:
+				applicant.NextActionStaffName = applicant.RAStaffName
+			}
+		}
+	}
+}
+
+// 応募者一覧のCSVファイルを作成する
+func writeTaskPipelineApplicantCSV(applicantList []*entity.TaskPipelineApplicant) (*entity.FilePath, error) {
+	var (
+		records [][]string
+	)
+
+	records = append(records, []string{
+		"選考ID", "求人ID", "求人タイトル", "求職者ID", "求職者名", "フェーズ", "サブフェーズ", "滞留日数", "次の対応者",
+		"CAエージェント", "アライアンス", "CA担当者", "RA担当者", "期限", "エントリー日", "評価", "再面接", "良い点", "懸念点", "評価日",
+	})
+
+	for _, applicant := range applicantList {
+		var (
+			evaluation    string
+			isReInterview string
+			evaluatedAt   string
+			isAlliance    string
+		)
+
+		if applicant.IsPassed.Valid {
+			evaluation = "不合格"
+			if applicant.IsPassed.Bool {
+				evaluation = "合格"
+			}
+		}
+
+		if applicant.IsReInterview.Valid && applicant.IsReInterview.Bool {
+			isReInterview = "有"
+		}
+
+		if applicant.EvaluatedAt.Valid {
+			evaluatedAt = applicant.EvaluatedAt.Time.In(utility.Tokyo).Format("2006-01-02")
+		}
+
+		if applicant.IsAlliance {
+			isAlliance = "○"
+		}
+
+		records = append(records, []string{
+			fmt.Sprint(applicant.TaskGroupID),
+			fmt.Sprint(applicant.JobInformationID),
+			applicant.Title,
+			fmt.Sprint(applicant.JobSeekerID),
+			applicant.LastName + " " + applicant.FirstName,
+			applicant.PhaseName,
+			applicant.PhaseSubName,
+			fmt.Sprint(applicant.DaysInPhase),
+			applicant.NextActionStaffName,
+			applicant.CAAgentName,
+			isAlliance,
+			applicant.CAStaffName,
+			applicant.RAStaffName,
+			applicant.DeadlineDay,
+			applicant.EntryAt.In(utility.Tokyo).Format("2006-01-02"),
+			evaluation,
+			isReInterview,
+			applicant.GoodPoint.String,
+			applicant.NGPoint.String,
+			evaluatedAt,
+		})
+	}
+
+	//CSVファイルを作成
+	filePath := ("./job-applicant-" + fmt.Sprint(utility.CreateUUID()) + ".csv")
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	defer file.Close()
+
+	cw := csv.NewWriter(file)
+	defer cw.Flush()
+
+	err = cw.WriteAll(records)
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
+	}
+
+	return entity.NewFilePath(filePath), nil
+}
+
+func (i *TaskPipelineInteractorImpl) getTaskPipeline(searchParam entity.SearchTaskPipelineParam) (GetTaskPipelineOutput, error) {
+	var (
+		output GetTaskPipelineOutput
+	)
+
+	// ボードは全ての列を集計する
+	searchParam.PhaseCategory = null.NewInt(0, false)
+	searchParam.PhaseSubCategory = null.NewInt(0, false)
+
+	countList, err := i.taskRepository.GetPipelineCountList(searchParam)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.PhaseList, output.TotalCount = getTaskPipelinePhaseList(countList)
+
+	return output, nil
+}
+
+// サブフェーズごとの件数をフェーズごとにまとめる（件数はフェーズ・サブフェーズの昇順で並んでいる）
+func getTaskPipelinePhaseList(countList []*entity.TaskPipelineCount) ([]*entity.TaskPipelinePhase, uint) {
+	var (
+		phaseList  = []*entity.TaskPipelinePhase{}
+		totalCount uint
+	)
+
+	for _, count := range countList {
+		phaseName, phaseSubName := getStrTaskPhaseAndPhaseSub(
+			null.NewInt(count.PhaseCategory, true),
+			null.NewInt(count.PhaseSubCategory, true),
+		)
+		count.PhaseSubName = phaseSubName
+
+		if len(phaseList) == 0 || phaseList[len(phaseList)-1].PhaseCategory != count.PhaseCategory {
+			phaseList = append(phaseList, &entity.TaskPipelinePhase{
+				PhaseCategory: count.PhaseCategory,
+				PhaseName:     phaseName,
+				SubList:       []*entity.TaskPipelineCount{},
+			})
+		}
+
+		phase := phaseList[len(phaseList)-1]
+		phase.Count += count.Count
+		phase.SubList = append(phase.SubList, count)
+
+		totalCount += count.Count
+	}
+
+	return phaseList, totalCount
+}
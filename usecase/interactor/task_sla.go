@@ -0,0 +1,745 @@
+package interactor
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/sendgrid/sendgrid-go/helpers/mail"
+	"github.com/spaceaiinc/autoscout-server/domain/config"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"github.com/spaceaiinc/autoscout-server/usecase"
+	"github.com/spaceaiinc/autoscout-server/usecase/policy"
+	"gopkg.in/guregu/null.v4"
+)
+
+type TaskSLAInteractor interface {
+	// 汎用系 API
+	GetTaskSLARuleList(input GetTaskSLARuleListInput) (GetTaskSLARuleListOutput, error)
+
+	// Admin API
+	CreateTaskSLARule(input CreateTaskSLARuleInput) (CreateTaskSLARuleOutput, error)
+	UpdateTaskSLARule(input UpdateTaskSLARuleInput) (UpdateTaskSLARuleOutput, error)
+	DeleteTaskSLARule(input DeleteTaskSLARuleInput) (DeleteTaskSLARuleOutput, error)
+
+	// Batch API
+	BatchNotifyTaskSLA(input BatchNotifyTaskSLAInput) (BatchNotifyTaskSLAOutput, error)
+	BatchNotifyTaskSLADigest(input BatchNotifyTaskSLADigestInput) (BatchNotifyTaskSLADigestOutput, error)
+}
+
+type TaskSLAInteractorImpl struct {
+	sendgrid                      config.Sendgrid
+	sla                           config.SLA
+	taskSLARuleRepository         usecase.TaskSLARuleRepository
+	taskSLANotificationRepository usecase.TaskSLANotificationRepository
+	taskRepository                usecase.TaskRepository
+	interviewTaskRepository       usecase.InterviewTaskRepository
+	agentStaffRepository          usecase.AgentStaffRepository
+}
+
+// TaskSLAInteractorImpl is an implementation of TaskSLAInteractor
+func NewTaskSLAInteractorImpl(
+	sg config.Sendgrid,
+	sla config.SLA,
+	tsrR usecase.TaskSLARuleRepository,
+	tsnR usecase.TaskSLANotificationRepository,
+	tR usecase.TaskRepository,
+	itR usecase.InterviewTaskRepository,
+	asR usecase.AgentStaffRepository,
+) TaskSLAInteractor {
+	return &TaskSLAInteractorImpl{
+		sendgrid:                      sg,
+		sla:                           sla,
+		taskSLARuleRepository:         tsrR,
+		taskSLANotificationRepository: tsnR,
+		taskRepository:                tR,
+		interviewTaskRepository:       itR,
+		agentStaffRepository:          asR,
+	}
+}
+
+/****************************************************************************************/
+/// 汎用系 API
+//
+// 自社の期限の設定と、設定がないフェーズに適用する初期設定を取得する
+type GetTaskSLARuleListInput struct {
+	Operator *entity.AgentStaff
+}
+
+type GetTaskSLARuleListOutput struct {
+	RuleList        []*entity.TaskSLARule
+	DefaultRuleList []entity.TaskSLARule
+}
+
+func (i *TaskSLAInteractorImpl) GetTaskSLARuleList(input GetTaskSLARuleListInput) (GetTaskSLARuleListOutput, error) {
+	var (
+		output GetTaskSLARuleListOutput
+	)
+
+	if input.Operator == nil {
+		return output, fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	ruleList, err := i.taskSLARuleRepository.GetByAgentID(input.Operator.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.RuleList = ruleList
+	output.DefaultRuleList = entity.TaskSLADefaultRuleList
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// Admin API
+//
+// 期限の設定を作成する（管理者のみ）
+type CreateTaskSLARuleInput struct {
+	Operator    *entity.AgentStaff
+	CreateParam entity.CreateOrUpdateTaskSLARuleParam
+}
+
+type CreateTaskSLARuleOutput struct {
+	Rule *entity.TaskSLARule
+}
+
+func (i *TaskSLAInteractorImpl) CreateTaskSLARule(input CreateTaskSLARuleInput) (CreateTaskSLARuleOutput, error) {
+	var (
+		output CreateTaskSLARuleOutput
+		param  = input.CreateParam
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.ValidateTaskSLARuleParam(param)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.checkDuplicateTaskSLARule(input.Operator.AgentID, 0, param)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	rule := entity.NewTaskSLARule(
+		input.Operator.AgentID,
+		param.TargetType,
+		param.PhaseCategory,
+		param.PhaseSubCategory,
+		param.DeadlineHours,
+		param.ReminderHours,
+		param.EscalationHours,
+	)
+
+	err = i.taskSLARuleRepository.Create(rule)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.Rule = rule
+
+	return output, nil
+}
+
+// 期限の設定を更新する（管理者のみ・自社の設定のみ）
+type UpdateTaskSLARuleInput struct {
+	Operator    *entity.AgentStaff
+	RuleID      uint
+	UpdateParam entity.CreateOrUpdateTaskSLARuleParam
+}
+
+type UpdateTaskSLARuleOutput struct {
+	Rule *entity.TaskSLARule
+}
+
+func (i *TaskSLAInteractorImpl) UpdateTaskSLARule(input UpdateTaskSLARuleInput) (UpdateTaskSLARuleOutput, error) {
+	var (
+		output UpdateTaskSLARuleOutput
+		param  = input.UpdateParam
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	rule, err := i.taskSLARuleRepository.FindByID(input.RuleID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, rule.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.ValidateTaskSLARuleParam(param)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.checkDuplicateTaskSLARule(rule.AgentID, rule.ID, param)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	rule.TargetType = param.TargetType
+	rule.PhaseCategory = param.PhaseCategory
+	rule.PhaseSubCategory = param.PhaseSubCategory
+	rule.DeadlineHours = param.DeadlineHours
+	rule.ReminderHours = param.ReminderHours
+	rule.EscalationHours = param.EscalationHours
+
+	err = i.taskSLARuleRepository.Update(rule.ID, rule)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.Rule = rule
+
+	return output, nil
+}
+
+// 期限の設定を削除する（管理者のみ・自社の設定のみ）
+// 削除したフェーズは初期設定の期限に戻る
+type DeleteTaskSLARuleInput struct {
+	Operator *entity.AgentStaff
+	RuleID   uint
+}
+
+type DeleteTaskSLARuleOutput struct {
+	OK bool
+}
+
+func (i *TaskSLAInteractorImpl) DeleteTaskSLARule(input DeleteTaskSLARuleInput) (DeleteTaskSLARuleOutput, error) {
+	var (
+		output DeleteTaskSLARuleOutput
+	)
+
+	err := policy.RequireAdmin(input.Operator)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	rule, err := i.taskSLARuleRepository.FindByID(input.RuleID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = policy.RequireOwnAgent(input.Operator, rule.AgentID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	err = i.taskSLARuleRepository.Delete(rule.ID)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// Batch API
+//
+/*
+	タスクの期限の通知
+	期限前のリマインド・期限切れを担当者へ、期限切れから一定時間が経過したタスクを上長へ通知する
+	同じ期限に対する同じ通知は一度だけ送る（期限が変更された場合は再度通知する）
+	1時間ごとに実行
+*/
+type BatchNotifyTaskSLAInput struct {
+	Now time.Time
+}
+
+type BatchNotifyTaskSLAOutput struct {
+	OK bool
+}
+
+func (i *TaskSLAInteractorImpl) BatchNotifyTaskSLA(input BatchNotifyTaskSLAInput) (BatchNotifyTaskSLAOutput, error) {
+	var (
+		output   BatchNotifyTaskSLAOutput
+		calendar = policy.NewTaskSLACalendar(i.sla.BusinessStartHour, i.sla.BusinessEndHour, i.sla.Holidays)
+	)
+
+	// 営業時間外は通知しない（営業時間外に期限を迎えたタスクは次の営業時間に通知する）
+	if !isTaskSLABusinessTime(calendar, input.Now) {
+		log.Println("営業時間外のためタスクの期限の通知をスキップ", input.Now)
+		output.OK = true
+		return output, nil
+	}
+
+	targetList, agentStaffMap, agentStaffList, err := i.getTaskSLATargetList(calendar)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	sentMap, err := i.getSentTaskSLANotificationMap(targetList)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	// 通知先の担当者ごとにまとめて送る
+	type notificationItem struct {
+		status           entity.TaskSLAStatus
+		notificationType int64
+	}
+
+	var (
+		recipientIDList []uint
+		itemListMap     = map[uint][]notificationItem{}
+	)
+
+	addItem := func(recipient *entity.AgentStaff, status entity.TaskSLAStatus, notificationType int64) {
+		if !policy.IsTaskSLANotifiable(recipient, false) {
+			return
+		}
+
+		key := getTaskSLANotificationKey(status.TargetType, status.TaskID, recipient.ID, notificationType, status.DueAt)
+		if sentMap[key] {
+			return
+		}
+		sentMap[key] = true
+
+		if _, ok := itemListMap[recipient.ID]; !ok {
+			recipientIDList = append(recipientIDList, recipient.ID)
+		}
+		itemListMap[recipient.ID] = append(itemListMap[recipient.ID], notificationItem{
+			status:           status,
+			notificationType: notificationType,
+		})
+	}
+
+	for _, target := range targetList {
+		agentStaff := agentStaffMap[target.status.AgentStaffID]
+
+		for _, notificationType := range policy.GetTaskSLANotificationTypeList(calendar, target.status.DueAt, target.rule, input.Now) {
+			if notificationType != entity.TaskSLANotificationEscalation {
+				addItem(agentStaff, target.status, notificationType)
+				continue
+			}
+
+			for _, manager := range policy.GetTaskSLAManagerList(agentStaff, agentStaffList) {
+				addItem(manager, target.status, notificationType)
+			}
+		}
+	}
+
+	for _, recipientID := range recipientIDList {
+		var (
+			recipient                                 = agentStaffMap[recipientID]
+			reminderList, overdueList, escalationList []entity.TaskSLAStatus
+		)
+
+		for _, item := range itemListMap[recipientID] {
+			switch item.notificationType {
+			case entity.TaskSLANotificationReminder:
+				reminderList = append(reminderList, item.status)
+			case entity.TaskSLANotificationOverdue:
+				overdueList = append(overdueList, item.status)
+			case entity.TaskSLANotificationEscalation:
+				escalationList = append(escalationList, item.status)
+			}
+		}
+
+		mailBody := fmt.Sprintf(
+			"%s\n%s様\n\n平素よりautoscoutをご利用いただきありがとうございます。\nautoscout事務局でございます。\n\nタスクの期限についてお知らせいたします。\n\n%s%s%s以上でございます。\n入れ違いで処理済みでしたら申し訳ございません。\n\n引き続きどうぞよろしくお願い申し上げます。\n\nタスクの期限の通知設定は、autoscout内の「設定 / 基本情報」より変更できます。\nhttps://autoscout.spaceai.jp/account/?panel=basic_information",
+			recipient.AgentName,
+			recipient.StaffName,
+			formatTaskSLAMailSection("まもなく期限のタスク", reminderList, calendar, false),
+			formatTaskSLAMailSection("期限切れのタスク", overdueList, calendar, false),
+			formatTaskSLAMailSection("期限切れが続いているメンバーのタスク", escalationList, calendar, true),
+		)
+
+		err = i.sendTaskSLAMail(recipient, "タスクの期限のお知らせ", mailBody)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+
+		// 送信した通知を記録する
+		for _, item := range itemListMap[recipientID] {
+			notification := entity.NewTaskSLANotification(
+				item.status.TargetType,
+				item.status.TaskID,
+				recipient.ID,
+				item.notificationType,
+				item.status.DueAt,
+			)
+
+			err = i.taskSLANotificationRepository.Create(notification)
+			if err != nil {
+				fmt.Println(err)
+				return output, err
+			}
+		}
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/*
+タスクの期限の日次まとめ
+担当者ごとに期限切れ・本日が期限のタスクと、上長には期限切れのメンバーのタスクを通知する
+営業日の朝に実行
+*/
+type BatchNotifyTaskSLADigestInput struct {
+	Now time.Time
+}
+
+type BatchNotifyTaskSLADigestOutput struct {
+	OK bool
+}
+
+func (i *TaskSLAInteractorImpl) BatchNotifyTaskSLADigest(input BatchNotifyTaskSLADigestInput) (BatchNotifyTaskSLADigestOutput, error) {
+	var (
+		output   BatchNotifyTaskSLADigestOutput
+		calendar = policy.NewTaskSLACalendar(i.sla.BusinessStartHour, i.sla.BusinessEndHour, i.sla.Holidays)
+		today    = input.Now.In(calendar.Location).Format("2006-01-02")
+	)
+
+	if !policy.IsTaskSLABusinessDay(calendar, input.Now) {
+		log.Println("休業日のためタスクの期限の日次まとめをスキップ", input.Now)
+		output.OK = true
+		return output, nil
+	}
+
+	targetList, agentStaffMap, agentStaffList, err := i.getTaskSLATargetList(calendar)
+	if err != nil {
+		fmt.Println(err)
+		return output, err
+	}
+
+	type digest struct {
+		overdueList     []entity.TaskSLAStatus // 期限切れ
+		dueTodayList    []entity.TaskSLAStatus // 本日が期限
+		teamOverdueList []entity.TaskSLAStatus // メンバーの期限切れ
+	}
+
+	digestMap := map[uint]*digest{}
+	getDigest := func(agentStaffID uint) *digest {
+		if _, ok := digestMap[agentStaffID]; !ok {
+			digestMap[agentStaffID] = &digest{}
+		}
+		return digestMap[agentStaffID]
+	}
+
+	for _, target := range targetList {
+		status := target.status
+
+		if !status.DueAt.After(input.Now) {
+			getDigest(status.AgentStaffID).overdueList = append(getDigest(status.AgentStaffID).overdueList, status)
+
+			for _, manager := range policy.GetTaskSLAManagerList(agentStaffMap[status.AgentStaffID], agentStaffList) {
+				getDigest(manager.ID).teamOverdueList = append(getDigest(manager.ID).teamOverdueList, status)
+			}
+			continue
+		}
+
+		if status.DueAt.In(calendar.Location).Format("2006-01-02") == today {
+			getDigest(status.AgentStaffID).dueTodayList = append(getDigest(status.AgentStaffID).dueTodayList, status)
+		}
+	}
+
+	for _, agentStaff := range agentStaffList {
+		digest, ok := digestMap[agentStaff.ID]
+		if !ok || !policy.IsTaskSLANotifiable(agentStaff, true) {
+			continue
+		}
+
+		mailBody := fmt.Sprintf(
+			"%s\n%s様\n\n平素よりautoscoutをご利用いただきありがとうございます。\nautoscout事務局でございます。\n\n本日のタスクの期限についてお知らせいたします。\n\n期限切れのタスク %d件\n本日が期限のタスク %d件\nメンバーの期限切れのタスク %d件\n\n%s%s%s以上でございます。\n\n引き続きどうぞよろしくお願い申し上げます。\n\nタスクの期限の通知設定は、autoscout内の「設定 / 基本情報」より変更できます。\nhttps://autoscout.spaceai.jp/account/?panel=basic_information",
+			agentStaff.AgentName,
+			agentStaff.StaffName,
+			len(digest.overdueList),
+			len(digest.dueTodayList),
+			len(digest.teamOverdueList),
+			formatTaskSLAMailSection("期限切れのタスク", digest.overdueList, calendar, false),
+			formatTaskSLAMailSection("本日が期限のタスク", digest.dueTodayList, calendar, false),
+			formatTaskSLAMailSection("メンバーの期限切れのタスク", digest.teamOverdueList, calendar, true),
+		)
+
+		err = i.sendTaskSLAMail(agentStaff, "本日のタスクの期限のお知らせ", mailBody)
+		if err != nil {
+			fmt.Println(err)
+			return output, err
+		}
+	}
+
+	output.OK = true
+
+	return output, nil
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+
+// 期限の判定対象のタスク
+type taskSLATarget struct {
+	status entity.TaskSLAStatus
+	rule   entity.TaskSLARule
+}
+
+// 対応中の選考タスク・面談調整タスクの期限を取得する
+// 担当者が利用できないタスクと期限がないタスクは対象外
+func (i *TaskSLAInteractorImpl) getTaskSLATargetList(calendar entity.TaskSLACalendar) ([]taskSLATarget, map[uint]*entity.AgentStaff, []*entity.AgentStaff, error) {
+	var (
+		targetList    []taskSLATarget
+		agentStaffMap = map[uint]*entity.AgentStaff{}
+		ruleListMap   = map[uint][]*entity.TaskSLARule{}
+	)
+
+	agentStaffList, err := i.agentStaffRepository.All()
+	if err != nil {
+		fmt.Println(err)
+		return nil, nil, nil, err
+	}
+
+	for _, agentStaff := range agentStaffList {
+		agentStaffMap[agentStaff.ID] = agentStaff
+	}
+
+	ruleList, err := i.taskSLARuleRepository.All()
+	if err != nil {
+		fmt.Println(err)
+		return nil, nil, nil, err
+	}
+
+	for _, rule := range ruleList {
+		ruleListMap[rule.AgentID] = append(ruleListMap[rule.AgentID], rule)
+	}
+
+	addTarget := func(status entity.TaskSLAStatus, deadlineDay string, deadlineTime null.Int, createdAt time.Time) {
+		agentStaff, ok := agentStaffMap[status.AgentStaffID]
+		if !ok || agentStaff.IsDeleted || agentStaff.UsageStatus.Int64 == int64(entity.UsageStatusNotAvailable) {
+			return
+		}
+
+		rule, ok := policy.FindTaskSLARule(ruleListMap[agentStaff.AgentID], status.TargetType, status.PhaseCategory, status.PhaseSubCategory)
+		if !ok && deadlineDay == "" {
+			return
+		}
+
+		dueAt, isDefault, ok := policy.GetTaskSLADueAt(calendar, deadlineDay, deadlineTime, createdAt, rule)
+		if !ok {
+			return
+		}
+
+		status.StaffName = agentStaff.StaffName
+		status.DueAt = dueAt
+		status.IsDefault = isDefault
+
+		targetList = append(targetList, taskSLATarget{
+			status: status,
+			rule:   rule,
+		})
+	}
+
+	// 選考タスク（終了したタスクは含まない）
+	taskList, err := i.taskRepository.GetLatest()
+	if err != nil {
+		fmt.Println(err)
+		return nil, nil, nil, err
+	}
+
+	for _, task := range taskList {
+		var agentStaffID uint
+		switch entity.StaffType(task.StaffType.Int64) {
+		case entity.CA, entity.CA_Boss:
+			agentStaffID = task.CAStaffID
+		case entity.RA, entity.RA_Boss:
+			agentStaffID = task.RAStaffID
+		default:
+			continue
+		}
+
+		phaseStr, phaseSubStr := getStrTaskPhaseAndPhaseSub(task.PhaseCategory, task.PhaseSubCategory)
+
+		addTarget(entity.TaskSLAStatus{
+			TargetType:       entity.TaskSLATargetTask,
+			TaskID:           task.ID,
+			AgentStaffID:     agentStaffID,
+			PhaseCategory:    task.PhaseCategory.Int64,
+			PhaseSubCategory: task.PhaseSubCategory.Int64,
+			PhaseName:        phaseStr + " " + phaseSubStr,
+			JobSeekerName:    task.LastName + task.FirstName,
+			Title:            task.Title,
+		}, task.DeadlineDay, task.DeadlineTime, task.CreatedAt)
+	}
+
+	// 面談調整タスク（担当者が未設定の場合は求職者の担当CA）
+	interviewTaskList, err := i.interviewTaskRepository.GetLatestActive()
+	if err != nil {
+		fmt.Println(err)
+		return nil, nil, nil, err
+	}
+
+	for _, interviewTask := range interviewTaskList {
+		agentStaffID := interviewTask.AgentStaffID
+		if !agentStaffID.Valid {
+			agentStaffID = interviewTask.CAStaffID
+		}
+		if !agentStaffID.Valid {
+			continue
+		}
+
+		addTarget(entity.TaskSLAStatus{
+			TargetType:       entity.TaskSLATargetInterviewTask,
+			TaskID:           interviewTask.ID,
+			AgentStaffID:     uint(agentStaffID.Int64),
+			PhaseCategory:    interviewTask.PhaseCategory.Int64,
+			PhaseSubCategory: interviewTask.PhaseSubCategory.Int64,
+			PhaseName:        "面談調整 " + entity.TaskSLAInterviewPhaseName[interviewTask.PhaseCategory.Int64],
+			JobSeekerName:    interviewTask.LastName + interviewTask.FirstName,
+		}, interviewTask.DeadlineDay, interviewTask.DeadlineTime, interviewTask.CreatedAt)
+	}
+
+	// 期限の早い順に通知する
+	sort.SliceStable(targetList, func(a, b int) bool {
+		return targetList[a].status.DueAt.Before(targetList[b].status.DueAt)
+	})
+
+	return targetList, agentStaffMap, agentStaffList, nil
+}
+
+// 送信済みの通知を取得する
+func (i *TaskSLAInteractorImpl) getSentTaskSLANotificationMap(targetList []taskSLATarget) (map[string]bool, error) {
+	var (
+		sentMap       = map[string]bool{}
+		taskIDListMap = map[int64][]uint{}
+	)
+
+	for _, target := range targetList {
+		taskIDListMap[target.status.TargetType] = append(taskIDListMap[target.status.TargetType], target.status.TaskID)
+	}
+
+	for _, targetType := range []int64{entity.TaskSLATargetTask, entity.TaskSLATargetInterviewTask} {
+		notificationList, err := i.taskSLANotificationRepository.GetByTargetTypeAndTaskIDList(targetType, taskIDListMap[targetType])
+		if err != nil {
+			fmt.Println(err)
+			return nil, err
+		}
+
+		for _, notification := range notificationList {
+			key := getTaskSLANotificationKey(notification.TargetType, notification.TaskID, notification.AgentStaffID, notification.NotificationType, notification.DueAt)
+			sentMap[key] = true
+		}
+	}
+
+	return sentMap, nil
+}
+
+// 期限の通知メールを送る（本番環境のみ送信）
+func (i *TaskSLAInteractorImpl) sendTaskSLAMail(agentStaff *entity.AgentStaff, subject, mailBody string) error {
+	from := mail.Email{
+		Name:    "autoscout事務局",
+		Address: "info@spaceai.jp",
+	}
+
+	to := mail.Email{
+		Name:    agentStaff.StaffName,
+		Address: agentStaff.Email,
+	}
+
+	if os.Getenv("APP_ENV") == "prd" {
+		sendgrid := utility.NewSendGrid(i.sendgrid.APIKey)
+		err := sendgrid.SendMail(
+			&from,
+			&to,
+			subject,
+			mailBody,
+			"",
+		)
+		if err != nil {
+			fmt.Println(err)
+			return err
+		}
+	}
+	log.Println("メール送信成功。担当者ID:", agentStaff.ID, "件名:", subject)
+
+	return nil
+}
+
+func (i *TaskSLAInteractorImpl) checkDuplicateTaskSLARule(agentID, ruleID uint, param entity.CreateOrUpdateTaskSLARuleParam) error {
+	ruleList, err := i.taskSLARuleRepository.GetByAgentID(agentID)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		fmt.Println(err)
+		return err
+	}
+
+	for _, rule := range ruleList {
+		if rule.ID == ruleID {
+			continue
+		}
+
+		if rule.TargetType == param.TargetType &&
+			rule.PhaseCategory == param.PhaseCategory &&
+			rule.PhaseSubCategory == param.PhaseSubCategory {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "同じフェーズの期限の設定が既にあります")
+		}
+	}
+
+	return nil
+}
+
+// 営業日の営業時間内かを判定する
+func isTaskSLABusinessTime(calendar entity.TaskSLACalendar, now time.Time) bool {
+	hour := now.In(calendar.Location).Hour()
+	return policy.IsTaskSLABusinessDay(calendar, now) && hour >= calendar.StartHour && hour < calendar.EndHour
+}
+
+func getTaskSLANotificationKey(targetType int64, taskID, agentStaffID uint, notificationType int64, dueAt time.Time) string {
+	return fmt.Sprint(targetType, "-", taskID, "-", agentStaffID, "-", notificationType, "-", dueAt.Unix())
+}
+
+// メールに記載するタスクの一覧。fmt:期限 / 求人タイトル / 求職者名 / フェーズ（/ 担当者）
+func formatTaskSLAMailSection(title string, statusList []entity.TaskSLAStatus, calendar entity.TaskSLACalendar, withStaffName bool) string {
+	if len(statusList) == 0 {
+		return ""
+	}
+
+	section := fmt.Sprintf("%s %d件\nhttps://autoscout.spaceai.jp/\n", title, len(statusList))
+	for _, status := range statusList {
+		line := status.DueAt.In(calendar.Location).Format("01/02 15:04") + "期限"
+		if status.Title != "" {
+			line += " / " + status.Title
+		}
+		line += " / " + status.JobSeekerName + "様 / " + status.PhaseName
+		if withStaffName {
+			line += " / 担当:" + status.StaffName
+		}
+		section += "\t" + line + "\n"
+	}
+
+	return section + "\n"
+}
@@ -4,7 +4,20 @@ import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
 	NewSessionInteractorImpl,
+	NewAuthorizationInteractorImpl,
+	NewAuditLogInteractorImpl,
+	NewGuestLinkInteractorImpl,
+	NewLoginAttemptInteractorImpl,
+	NewAPIKeyInteractorImpl,
+	NewEncryptionKeyInteractorImpl,
 	NewAdminInteractorImpl,
+	NewTaskSLAInteractorImpl,
+	NewTaskGroupEventInteractorImpl,
+	NewTaskBulkJobInteractorImpl,
+	NewTaskPipelineInteractorImpl,
+	NewJobOfferInteractorImpl,
+	NewTaskLossReasonInteractorImpl,
+	NewSaleGuaranteeInteractorImpl,
 	NewAgentInteractorImpl,
 	NewAgentStaffInteractorImpl,
 	NewAgentAllianceInteractorImpl,
@@ -36,4 +49,9 @@ var WireSet = wire.NewSet(
 	NewDeploymentReflectionInteractorImpl,
 	NewAgentInflowChannelOptionInteractorImpl,
 	NewGoogleAuthenticationInteractorImpl,
+	NewInboundEntryInteractorImpl,
+	NewJobSeekerMergeSuggestionInteractorImpl,
+	NewAgentAssignmentRuleInteractorImpl,
+	NewEntryScreeningRuleInteractorImpl,
+	NewInterviewBookingInteractorImpl,
 )
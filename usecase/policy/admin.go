@@ -0,0 +1,96 @@
+package policy
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+)
+
+/****************************************************************************************/
+/// 管理者サイトのポリシー
+//
+// パスワード認証の後に二要素認証（TOTP）を完了したセッションのみ管理者のAPIを利用できる
+// セッションは操作がない場合と、ログインから一定時間が経過した場合に失効する
+//
+
+const (
+	// パスワードの最小文字数
+	AdminPasswordMinLength = 12
+
+	// 二要素認証を完了するまでのセッションの有効期間
+	AdminPreMFASessionDuration = 10 * time.Minute
+
+	// ワンタイムパスワードの誤りがこの回数に達したセッションは無効にする
+	AdminMaxMFAFailedCount = 5
+
+	// 設定がない場合のセッションの有効期間
+	defaultAdminSessionIdle = 30 * time.Minute
+	defaultAdminSessionMax  = 8 * time.Hour
+)
+
+// 管理者のパスワードの強度を確認する
+func ValidateAdminPassword(password string) error {
+	if utf8.RuneCountInString(password) < AdminPasswordMinLength {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("パスワードは%d文字以上で設定してください", AdminPasswordMinLength))
+	}
+
+	return nil
+}
+
+// セッションで管理者のAPIを利用できるかを判定する
+// requireMFAがfalseの場合は二要素認証前のセッションも許可する（二要素認証の登録・検証API用）
+func VerifyAdminSession(adminUser *entity.AdminUser, session *entity.AdminSession, requireMFA bool, now time.Time) error {
+	if adminUser == nil || session == nil || adminUser.IsDeleted {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, "ログインしてください")
+	}
+
+	if session.RevokedAt.Valid || !now.Before(session.ExpiresAt) {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, "セッションの有効期限が切れました。再度ログインしてください")
+	}
+
+	if session.MFAFailedCount >= AdminMaxMFAFailedCount {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, "ワンタイムパスワードの誤りが上限に達しました。再度ログインしてください")
+	}
+
+	if requireMFA && !session.MFAVerifiedAt.Valid {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, "二要素認証を完了してください")
+	}
+
+	return nil
+}
+
+// ログイン後に必要な操作
+func GetAdminMFAStatus(adminUser *entity.AdminUser, session *entity.AdminSession) string {
+	if session != nil && session.MFAVerifiedAt.Valid {
+		return entity.AdminMFAStatusVerified
+	}
+
+	if !adminUser.TOTPEnabledAt.Valid {
+		return entity.AdminMFAStatusEnrollmentRequired
+	}
+
+	return entity.AdminMFAStatusVerificationRequired
+}
+
+// 二要素認証済みのセッションの新しい有効期限
+// 最後の操作から idleMinutes 後とし、ログインから maxHours を超えて延長しない
+func ExtendAdminSession(session *entity.AdminSession, idleMinutes, maxHours uint, now time.Time) time.Time {
+	idle := defaultAdminSessionIdle
+	if idleMinutes > 0 {
+		idle = time.Duration(idleMinutes) * time.Minute
+	}
+
+	max := defaultAdminSessionMax
+	if maxHours > 0 {
+		max = time.Duration(maxHours) * time.Hour
+	}
+
+	expiresAt := now.Add(idle)
+	if limit := session.CreatedAt.Add(max); expiresAt.After(limit) {
+		expiresAt = limit
+	}
+
+	return expiresAt
+}
@@ -0,0 +1,95 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+)
+
+/****************************************************************************************/
+/// APIキーのポリシー
+//
+// 外部連携のAPIキーで対象のAPIを利用できるかを判定する
+// 有効期限内・未無効化で、APIに必要な操作がキーに許可されている場合のみ許可する
+//
+
+// 発行時に指定できる操作
+var apiKeyScopeList = []string{
+	entity.APIKeyScopeJobSeekerRead,
+	entity.APIKeyScopeEntryWrite,
+	entity.APIKeyScopeSaleRead,
+	entity.APIKeyScopeGmailHookWrite,
+}
+
+// 最終利用日時を更新する間隔（リクエストごとに更新しないようにする）
+const APIKeyLastUsedInterval = time.Minute
+
+// APIキーで指定の操作を行えるかを判定する
+// requiredScopeが空の場合はAPIキーで利用できないAPIとして拒否する
+func VerifyAPIKey(apiKey *entity.APIKey, requiredScope string, now time.Time) error {
+	if apiKey == nil {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, "APIキーが正しくありません")
+	}
+
+	if apiKey.RevokedAt.Valid {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, "APIキーは無効化されています")
+	}
+
+	if !now.Before(apiKey.ExpiresAt) {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, "APIキーの有効期限が切れています")
+	}
+
+	if requiredScope == "" {
+		return fmt.Errorf("%w:%s", entity.ErrForbidden, "APIキーでは利用できないAPIです")
+	}
+
+	for _, scope := range apiKey.ScopeList() {
+		if scope == requiredScope {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w:%s", entity.ErrForbidden, "APIキーに許可されていない操作です")
+}
+
+// 最終利用日時を更新するか
+func ShouldUpdateAPIKeyLastUsed(apiKey *entity.APIKey, now time.Time) bool {
+	return !apiKey.LastUsedAt.Valid || !now.Before(apiKey.LastUsedAt.Time.Add(APIKeyLastUsedInterval))
+}
+
+// 発行時に指定された操作を検証し、保存用のカンマ区切りの文字列にする（重複は除く）
+func NormalizeAPIKeyScopes(scopes []string) (string, error) {
+	var (
+		normalized []string
+		exists     = map[string]bool{}
+	)
+
+	for _, scope := range scopes {
+		if !isAPIKeyScope(scope) {
+			return "", fmt.Errorf("%w:%s", entity.ErrRequestError, "許可する操作の指定が不正です")
+		}
+
+		if exists[scope] {
+			continue
+		}
+		exists[scope] = true
+		normalized = append(normalized, scope)
+	}
+
+	if len(normalized) == 0 {
+		return "", fmt.Errorf("%w:%s", entity.ErrRequestError, "許可する操作を指定してください")
+	}
+
+	return strings.Join(normalized, ","), nil
+}
+
+func isAPIKeyScope(scope string) bool {
+	for _, s := range apiKeyScopeList {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+)
+
+/****************************************************************************************/
+/// 重複推薦のポリシー
+//
+// 同じ企業へ同じ人物を推薦する前に、RA側のエージェントが設定した期間内の既存の選考を確認する
+// 重複がある場合は推薦を止め、理由が入力された場合のみ推薦を進める
+//
+
+// 重複を確認する期間の開始日時を返す（期間が0日の場合は確認しない）
+func GetDuplicateRecommendationSince(days uint, now time.Time) (time.Time, bool) {
+	if days == 0 {
+		return time.Time{}, false
+	}
+
+	return now.AddDate(0, 0, -int(days)), true
+}
+
+// 重複がある場合に理由の入力を求める
+func RequireDuplicateRecommendationOverride(duplicateList []*entity.DuplicateRecommendation, reason string) error {
+	if len(duplicateList) == 0 || strings.TrimSpace(reason) != "" {
+		return nil
+	}
+
+	var (
+		messageList []string
+	)
+
+	for _, duplicate := range duplicateList {
+		message := fmt.Sprintf("%s「%s」（%s）", duplicate.CompanyName, duplicate.Title, entity.DuplicateRecommendationMatchTypeName[duplicate.MatchType])
+
+		if duplicate.TaskGroupID != 0 {
+			message = fmt.Sprintf("選考ID: %d %s CA: %s", duplicate.TaskGroupID, message, duplicate.CAAgentName)
+		}
+
+		messageList = append(messageList, message)
+	}
+
+	return fmt.Errorf(
+		"%w:%s",
+		entity.ErrDuplicateEntry,
+		"同じ企業へ推薦済みの可能性があります。推薦する場合は理由を入力してください。"+strings.Join(messageList, " / "),
+	)
+}
@@ -0,0 +1,158 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+)
+
+/****************************************************************************************/
+/// ゲスト用リンクのポリシー
+//
+// 企業・求職者がゲスト用リンクでログインできるかを判定する
+// リンクはguest_linksの値（有効期限・無効化）を正とし、トークンの内容と対象が一致する場合のみ許可する
+//
+// UUIDのみのリンクは移行期間（config.GuestLink.LegacyUntil）の間だけ許可する
+//
+// ログイン後のゲスト用APIは、ログイン時に発行したトークン（GuestSessionClaims）の対象と操作の範囲でのみ許可する
+//
+
+// ゲスト用リンクの判定エラー
+const guestLinkErrorMessage = "URLの有効期限が切れているか、無効なURLです。\n担当者に新しいURLの発行をご依頼ください。"
+
+// 署名を検証済みのトークンとguest_linksの値から、指定の対象へのログイン可否を判定する
+// アクセスログに記録する結果と、拒否する場合は entity.ErrUnauthorized をラップしたエラーを返す
+func VerifyGuestLink(
+	guestLink *entity.GuestLink,
+	claims *utility.GuestLinkClaims,
+	resourceType string,
+	resourceUUID uuid.UUID,
+	now time.Time,
+) (string, error) {
+	if guestLink == nil || claims == nil {
+		return entity.GuestLinkAccessResultInvalidToken, fmt.Errorf("%w:%s", entity.ErrUnauthorized, guestLinkErrorMessage)
+	}
+
+	// トークンの対象・URLの対象・発行時の対象が全て一致すること
+	if claims.Id != guestLink.UUID.String() ||
+		claims.ResourceType != guestLink.ResourceType ||
+		claims.ResourceUUID != guestLink.ResourceUUID.String() ||
+		resourceType != guestLink.ResourceType ||
+		resourceUUID != guestLink.ResourceUUID {
+		return entity.GuestLinkAccessResultResourceMismatch, fmt.Errorf("%w:%s", entity.ErrUnauthorized, guestLinkErrorMessage)
+	}
+
+	return VerifyGuestLinkActive(guestLink, now)
+}
+
+// リンクが無効化されておらず、有効期限内かを判定する（ログイン後のゲスト用APIでも毎回確認する）
+func VerifyGuestLinkActive(guestLink *entity.GuestLink, now time.Time) (string, error) {
+	if guestLink.RevokedAt.Valid {
+		return entity.GuestLinkAccessResultRevoked, fmt.Errorf("%w:%s", entity.ErrUnauthorized, guestLinkErrorMessage)
+	}
+
+	if !now.Before(guestLink.ExpiresAt) {
+		return entity.GuestLinkAccessResultExpired, fmt.Errorf("%w:%s", entity.ErrUnauthorized, guestLinkErrorMessage)
+	}
+
+	return entity.GuestLinkAccessResultSuccess, nil
+}
+
+// UUIDのみのリンクの移行期限の設定を検証する
+// 期限はリリースごとに決めるため初期値は持たず、未設定・形式が不正な場合はエラーを返す
+func ValidateGuestLinkLegacyUntil(legacyUntil string) (time.Time, error) {
+	if legacyUntil == "" {
+		return time.Time{}, fmt.Errorf("%w:%s", entity.ErrServerError, "UUIDのみのリンクの移行期限（GUEST_LINK_LEGACY_UNTIL）が設定されていません")
+	}
+
+	until, err := time.ParseInLocation("2006-01-02", legacyUntil, utility.Tokyo)
+	if err != nil {
+		fmt.Println(err)
+		return time.Time{}, fmt.Errorf("%w:%s", entity.ErrServerError, "UUIDのみのリンクの移行期限（GUEST_LINK_LEGACY_UNTIL）の形式が不正です")
+	}
+
+	return until, nil
+}
+
+// UUIDのみのリンクでログインできるかを判定する
+// legacyUntil（2006-01-02形式、日本時間）の翌日0時以降は拒否する
+func VerifyLegacyGuestLink(legacyUntil string, now time.Time) (string, error) {
+	until, err := ValidateGuestLinkLegacyUntil(legacyUntil)
+	if err != nil {
+		return entity.GuestLinkAccessResultLegacyExpired, err
+	}
+
+	if !now.Before(until.AddDate(0, 0, 1)) {
+		return entity.GuestLinkAccessResultLegacyExpired, fmt.Errorf("%w:%s", entity.ErrUnauthorized, guestLinkErrorMessage)
+	}
+
+	return entity.GuestLinkAccessResultLegacy, nil
+}
+
+// 発行時に指定された操作を検証し、保存用のカンマ区切りの文字列にする（重複は除く）
+func NormalizeGuestLinkPermissions(permissions []string) (string, error) {
+	var (
+		normalized []string
+		exists     = map[string]bool{}
+	)
+
+	for _, permission := range permissions {
+		if permission != entity.GuestLinkPermissionRead && permission != entity.GuestLinkPermissionWrite {
+			return "", fmt.Errorf("%w:%s", entity.ErrRequestError, "許可する操作の指定が不正です")
+		}
+
+		if exists[permission] {
+			continue
+		}
+		exists[permission] = true
+		normalized = append(normalized, permission)
+	}
+
+	if len(normalized) == 0 {
+		return "", fmt.Errorf("%w:%s", entity.ErrRequestError, "許可する操作を指定してください")
+	}
+
+	return strings.Join(normalized, ","), nil
+}
+
+// ゲスト用APIのトークンの有効期限（リンクでログインした場合はリンクの有効期限を超えない）
+func GetGuestSessionExpiresAt(guestLink *entity.GuestLink, sessionHours uint, now time.Time) time.Time {
+	if sessionHours == 0 {
+		sessionHours = entity.GuestSessionDefaultHours
+	}
+
+	expiresAt := now.Add(time.Duration(sessionHours) * time.Hour)
+	if guestLink != nil && guestLink.ExpiresAt.Before(expiresAt) {
+		return guestLink.ExpiresAt
+	}
+
+	return expiresAt
+}
+
+// ゲスト用APIのトークンで、指定の対象への操作を許可するかを判定する
+// 対象の種類とUUIDがトークンの対象と一致し、更新系の場合は write が許可されていること
+func AuthorizeGuestSession(claims *utility.GuestSessionClaims, resourceType string, resourceUUID uuid.UUID, isWrite bool) error {
+	if claims == nil || claims.ResourceType != resourceType {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, guestLinkErrorMessage)
+	}
+
+	if resourceUUID != uuid.Nil && claims.ResourceUUID != resourceUUID.String() {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, guestLinkErrorMessage)
+	}
+
+	if !isWrite {
+		return nil
+	}
+
+	for _, permission := range claims.Permissions {
+		if permission == entity.GuestLinkPermissionWrite {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w:%s", entity.ErrForbidden, "このURLでは閲覧のみ可能です")
+}
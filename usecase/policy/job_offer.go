@@ -0,0 +1,111 @@
+package policy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+/// 内定のポリシー
+//
+// 内定は提示中・条件交渉中の間のみ回答待ちとして扱い、承諾・辞退・取り消しの後は状態を変更できない
+// 承諾した内定の入社日はタスクグループの入社日へ反映する
+//
+
+// 内定の条件を検証する
+func ValidateJobOfferParam(param entity.CreateOrUpdateJobOfferParam) error {
+	for _, amount := range []null.Int{
+		param.AnnualSalary,
+		param.BaseSalary,
+		param.FixedOvertimePay,
+		param.FixedOvertimeHours,
+		param.Allowance,
+		param.Bonus,
+	} {
+		if amount.Valid && amount.Int64 < 0 {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "給与・時間に負の値は設定できません")
+		}
+	}
+
+	if param.FixedOvertimePay.Valid && param.FixedOvertimePay.Int64 > 0 && !param.FixedOvertimeHours.Valid {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "固定残業代を設定する場合は固定残業時間を入力してください")
+	}
+
+	if param.StartDate != "" {
+		_, err := time.Parse("2006-01-02", param.StartDate)
+		if err != nil {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "入社日は2006-01-02の形式で入力してください")
+		}
+	}
+
+	return nil
+}
+
+// 回答待ち（提示中・条件交渉中）の内定かを判定する
+func IsJobOfferOpen(status int64) bool {
+	return status == entity.JobOfferStatusOffered || status == entity.JobOfferStatusNegotiating
+}
+
+// 条件を更新できる内定かを判定する（承諾後も入社日などの変更は受け付ける）
+func RequireJobOfferEditable(offer *entity.JobOffer) error {
+	if IsJobOfferOpen(offer.Status) || offer.Status == entity.JobOfferStatusAccepted {
+		return nil
+	}
+
+	return fmt.Errorf("%w:%s", entity.ErrRequestError, "辞退・取り消し済みの内定は更新できません")
+}
+
+// 状態の変更を検証する
+func ValidateJobOfferStatusTransition(offer *entity.JobOffer, status int64) error {
+	if _, ok := entity.JobOfferStatusName[status]; !ok {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "存在しない内定の状態です")
+	}
+
+	if offer.Status == status {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "内定は既に"+entity.JobOfferStatusName[status]+"の状態です")
+	}
+
+	if !IsJobOfferOpen(offer.Status) {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, entity.JobOfferStatusName[offer.Status]+"済みの内定の状態は変更できません")
+	}
+
+	if status == entity.JobOfferStatusAccepted && offer.StartDate == "" {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "承諾にする場合は入社日を入力してください")
+	}
+
+	return nil
+}
+
+// 比較に使う年収を返す
+// 提示年収がない場合は月額（基本給・固定残業代・諸手当）の12ヶ月分と賞与の合計とする
+func GetJobOfferEstimatedAnnualSalary(offer *entity.JobOffer) int64 {
+	if offer.AnnualSalary.Valid {
+		return offer.AnnualSalary.Int64
+	}
+
+	monthly := offer.BaseSalary.Int64 + offer.FixedOvertimePay.Int64 + offer.Allowance.Int64
+
+	return monthly*12 + offer.Bonus.Int64
+}
+
+// 回答期限の通知の種類を返す（回答待ちで期限が設定された内定のみ）
+func GetJobOfferReminderTypeList(offer *entity.JobOffer, now time.Time) []int64 {
+	if !IsJobOfferOpen(offer.Status) || !offer.AnswerDeadline.Valid {
+		return nil
+	}
+
+	deadline := offer.AnswerDeadline.Time
+
+	if !now.Before(deadline) {
+		return []int64{entity.JobOfferReminderOverdue}
+	}
+
+	if deadline.Sub(now) <= entity.JobOfferReminderHours*time.Hour {
+		return []int64{entity.JobOfferReminderBeforeDeadline}
+	}
+
+	return nil
+}
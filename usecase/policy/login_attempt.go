@@ -0,0 +1,107 @@
+package policy
+
+import (
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+/// ログイン試行の制限ポリシー
+//
+// LP・ゲスト・管理者サイトのログインとパスワード再設定メールの送信を、IPアドレスごと・アカウントごとに制限する
+// 期間内の回数が上限に達するとロックし、ロックのたびにロック時間を2倍にする（上限あり）
+// 失敗が続いている場合はロック前にCAPTCHAを求める
+//
+
+// ログイン試行の制限
+type LoginAttemptRule struct {
+	MaxAttempts      uint          // 期間内に許可する回数（上限に達した時点でロックする）
+	Window           time.Duration // 回数を数える期間
+	BaseLockDuration time.Duration // 初回のロック時間
+	MaxLockDuration  time.Duration // ロック時間の上限
+	CaptchaAfter     uint          // 期間内の回数がこれ以上の場合はCAPTCHAを求める（0の場合は求めない）
+}
+
+// 対象の操作・制限の単位ごとの制限
+// 共有IP（社内ネットワークなど）からの利用を考慮し、IPアドレスはアカウントより緩くする
+var loginAttemptRuleList = map[string]map[string]LoginAttemptRule{
+	entity.LoginAttemptScopeLPLogin: {
+		entity.LoginAttemptKeyAccount: {5, 15 * time.Minute, 15 * time.Minute, 24 * time.Hour, 3},
+		entity.LoginAttemptKeyIP:      {30, 15 * time.Minute, 15 * time.Minute, 24 * time.Hour, 10},
+	},
+	entity.LoginAttemptScopeGuestSignIn: {
+		entity.LoginAttemptKeyAccount: {5, 15 * time.Minute, 15 * time.Minute, 24 * time.Hour, 3},
+		entity.LoginAttemptKeyIP:      {30, 15 * time.Minute, 15 * time.Minute, 24 * time.Hour, 10},
+	},
+	entity.LoginAttemptScopeAdminLogin: {
+		entity.LoginAttemptKeyAccount: {5, 15 * time.Minute, 15 * time.Minute, 24 * time.Hour, 3},
+		entity.LoginAttemptKeyIP:      {20, 15 * time.Minute, 15 * time.Minute, 24 * time.Hour, 5},
+	},
+	// メール送信は成功した場合も回数に含める
+	entity.LoginAttemptScopeLPResetPasswordEmail: {
+		entity.LoginAttemptKeyAccount: {3, time.Hour, time.Hour, 24 * time.Hour, 0},
+		entity.LoginAttemptKeyIP:      {10, time.Hour, time.Hour, 24 * time.Hour, 5},
+	},
+	entity.LoginAttemptScopeResetPasswordEmail: {
+		entity.LoginAttemptKeyAccount: {3, time.Hour, time.Hour, 24 * time.Hour, 0},
+		entity.LoginAttemptKeyIP:      {10, time.Hour, time.Hour, 24 * time.Hour, 5},
+	},
+}
+
+// 対象の操作・制限の単位の制限を取得する（定義がない場合は制限しない）
+func GetLoginAttemptRule(scope, keyType string) (LoginAttemptRule, bool) {
+	rule, ok := loginAttemptRuleList[scope][keyType]
+	return rule, ok
+}
+
+// ロック中かを判定する
+func IsLoginAttemptLocked(attempt *entity.LoginAttempt, now time.Time) bool {
+	if attempt == nil || !attempt.LockedUntil.Valid {
+		return false
+	}
+
+	return now.Before(attempt.LockedUntil.Time)
+}
+
+// CAPTCHAを求めるかを判定する
+func RequiresLoginAttemptCaptcha(attempt *entity.LoginAttempt, rule LoginAttemptRule, now time.Time) bool {
+	if attempt == nil || rule.CaptchaAfter == 0 {
+		return false
+	}
+
+	// 期間を過ぎた回数は数えない
+	if !now.Before(attempt.WindowStartedAt.Add(rule.Window)) {
+		return false
+	}
+
+	return attempt.AttemptCount >= rule.CaptchaAfter
+}
+
+// 試行を1回数え、上限に達した場合はロックする
+// ロック後は回数を数え直し、ロックのたびにロック時間を2倍にする
+func ApplyLoginAttempt(attempt *entity.LoginAttempt, rule LoginAttemptRule, now time.Time) {
+	if !now.Before(attempt.WindowStartedAt.Add(rule.Window)) {
+		attempt.AttemptCount = 0
+		attempt.WindowStartedAt = now
+	}
+
+	attempt.AttemptCount++
+	if attempt.AttemptCount < rule.MaxAttempts {
+		return
+	}
+
+	lockDuration := rule.BaseLockDuration
+	for i := uint(0); i < attempt.LockCount && lockDuration < rule.MaxLockDuration; i++ {
+		lockDuration *= 2
+	}
+	if lockDuration > rule.MaxLockDuration {
+		lockDuration = rule.MaxLockDuration
+	}
+
+	attempt.LockCount++
+	attempt.LockedUntil = null.NewTime(now.Add(lockDuration), true)
+	attempt.AttemptCount = 0
+	attempt.WindowStartedAt = now
+}
@@ -0,0 +1,173 @@
+package policy
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+)
+
+/****************************************************************************************/
+/// 個人情報のマスキングポリシー
+//
+// ログイン中の担当者（operator）の権限と求職者との関係に応じて、レスポンスに含める個人情報を伏せる
+//
+//   自社の管理者:             全て表示
+//   自社の一般担当者:         連絡先は表示、社内限定メモ・既往歴備考は担当CAの場合のみ表示
+//   アライアンス先・他社:     電話番号は下4桁、メールアドレスは先頭1文字とドメインのみ表示し、住所・社内限定メモ・既往歴備考は伏せる
+//
+// operatorがnilの場合（求職者本人のゲストページなど）は伏せない
+//
+
+// 伏せた値の表示
+const maskedText = "********"
+
+// 求職者の個人情報を担当者に応じて伏せる
+func MaskJobSeeker(operator *entity.AgentStaff, jobSeeker *entity.JobSeeker) {
+	if operator == nil || jobSeeker == nil {
+		return
+	}
+
+	// アライアンス先・他社の求職者
+	if jobSeeker.AgentID != operator.AgentID {
+		jobSeeker.PhoneNumber = maskPhoneNumber(jobSeeker.PhoneNumber)
+		jobSeeker.Email = maskEmail(jobSeeker.Email)
+		jobSeeker.Address = maskText(jobSeeker.Address)
+		jobSeeker.SecretMemo = maskText(jobSeeker.SecretMemo)
+		jobSeeker.MedicalHistoryRemarks = maskText(jobSeeker.MedicalHistoryRemarks)
+		return
+	}
+
+	if RequireAdmin(operator) == nil {
+		return
+	}
+
+	// 自社の一般担当者は担当CAの場合のみ社内限定メモ・既往歴備考を表示する
+	if !jobSeeker.AgentStaffID.Valid || uint(jobSeeker.AgentStaffID.Int64) != operator.ID {
+		jobSeeker.SecretMemo = maskText(jobSeeker.SecretMemo)
+		jobSeeker.MedicalHistoryRemarks = maskText(jobSeeker.MedicalHistoryRemarks)
+	}
+}
+
+// レスポンスに含まれる全ての求職者の個人情報を担当者に応じて伏せる
+// 構造体・スライス・mapを辿り、*entity.JobSeeker を見つけるたびに MaskJobSeeker を適用する
+func MaskJobSeekerInResponse(operator *entity.AgentStaff, data interface{}) {
+	if operator == nil || data == nil {
+		return
+	}
+
+	maskJobSeekerInValue(operator, reflect.ValueOf(data), map[uintptr]bool{})
+}
+
+var jobSeekerPtrType = reflect.TypeOf(&entity.JobSeeker{})
+
+func maskJobSeekerInValue(operator *entity.AgentStaff, v reflect.Value, visited map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || visited[v.Pointer()] {
+			return
+		}
+		visited[v.Pointer()] = true
+
+		if v.Type() == jobSeekerPtrType {
+			MaskJobSeeker(operator, v.Interface().(*entity.JobSeeker))
+			return
+		}
+		maskJobSeekerInValue(operator, v.Elem(), visited)
+
+	case reflect.Interface:
+		if !v.IsNil() {
+			maskJobSeekerInValue(operator, v.Elem(), visited)
+		}
+
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				maskJobSeekerInValue(operator, v.Field(i), visited)
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			maskJobSeekerInValue(operator, v.Index(i), visited)
+		}
+
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			maskJobSeekerInValue(operator, iter.Value(), visited)
+		}
+	}
+}
+
+// 更新時に伏せた値がそのまま送られてきた項目を現在の値に戻す
+// 画面で伏せた値を表示したまま保存しても、元の値が上書きされないようにする
+func RestoreMaskedJobSeekerFields(current, updated *entity.JobSeeker) {
+	if current == nil || updated == nil {
+		return
+	}
+
+	for _, field := range []struct {
+		current string
+		updated *string
+		masked  string
+	}{
+		{current.PhoneNumber, &updated.PhoneNumber, maskPhoneNumber(current.PhoneNumber)},
+		{current.Email, &updated.Email, maskEmail(current.Email)},
+		{current.Address, &updated.Address, maskText(current.Address)},
+		{current.SecretMemo, &updated.SecretMemo, maskText(current.SecretMemo)},
+		{current.MedicalHistoryRemarks, &updated.MedicalHistoryRemarks, maskText(current.MedicalHistoryRemarks)},
+	} {
+		if field.current != "" && *field.updated == field.masked {
+			*field.updated = field.current
+		}
+	}
+}
+
+// 電話番号は下4桁以外の数字を伏せる（ハイフンの位置は残す）
+func maskPhoneNumber(phoneNumber string) string {
+	var (
+		digitCount int
+		runeList   = []rune(phoneNumber)
+	)
+
+	for _, r := range runeList {
+		if '0' <= r && r <= '9' {
+			digitCount++
+		}
+	}
+
+	for i, r := range runeList {
+		if '0' <= r && r <= '9' {
+			if digitCount > 4 {
+				runeList[i] = '*'
+			}
+			digitCount--
+		}
+	}
+
+	return string(runeList)
+}
+
+// メールアドレスは先頭1文字とドメインのみ残す
+func maskEmail(email string) string {
+	if email == "" {
+		return ""
+	}
+
+	local, domain, found := strings.Cut(email, "@")
+	if !found || local == "" {
+		return maskedText
+	}
+
+	return string([]rune(local)[:1]) + "***@" + domain
+}
+
+// 空でない値は全て伏せる
+func maskText(text string) string {
+	if text == "" {
+		return ""
+	}
+
+	return maskedText
+}
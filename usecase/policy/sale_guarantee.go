@@ -0,0 +1,212 @@
+package policy
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+/// 売上の自動作成と返金保証期間のポリシー
+//
+// 内定承諾時の売上の下書きは、求人の手数料（料率の場合は内定の年収に掛ける）とRA側のエージェントの売上比率から作成する
+// 返金規定は入社からの日数で段階を分け、在籍日数が within_days 日以内の最も短い段階の返金率を適用する
+// 日付は日本時間の日単位で扱う
+//
+
+// 売上の自動作成・返金規定の設定を検証する
+func ValidateSalePolicyParam(param entity.UpdateSalePolicyParam) error {
+	if param.RASalesRatio > 100 {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "RAの売上比率は100%以下で設定してください")
+	}
+
+	tierList := make([]entity.AgentRefundTierParam, len(param.RefundTierList))
+	copy(tierList, param.RefundTierList)
+	sort.SliceStable(tierList, func(a, b int) bool {
+		return tierList[a].WithinDays < tierList[b].WithinDays
+	})
+
+	for index, tier := range tierList {
+		if tier.WithinDays == 0 || tier.WithinDays > entity.SaleGuaranteeMaxDays {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("返金規定の日数は1〜%d日で設定してください", entity.SaleGuaranteeMaxDays))
+		}
+
+		if tier.RefundRate > 100 {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "返金率は100%以下で設定してください")
+		}
+
+		if index == 0 {
+			continue
+		}
+
+		prev := tierList[index-1]
+		if prev.WithinDays == tier.WithinDays {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "返金規定の日数が重複しています")
+		}
+
+		// 在籍期間が長いほど返金率が高くなる設定は受け付けない
+		if prev.RefundRate < tier.RefundRate {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "返金率は入社からの日数が長い段階ほど低く設定してください")
+		}
+	}
+
+	return nil
+}
+
+// 適用する返金規定を入社からの日数の短い順に返す（設定がない場合は初期設定を使用する）
+func GetSaleRefundTierList(tierList []*entity.AgentRefundTier) ([]entity.AgentRefundTier, bool) {
+	if len(tierList) == 0 {
+		return entity.DefaultAgentRefundTierList, true
+	}
+
+	var (
+		result []entity.AgentRefundTier
+	)
+
+	for _, tier := range tierList {
+		result = append(result, *tier)
+	}
+
+	sort.SliceStable(result, func(a, b int) bool {
+		return result[a].WithinDays < result[b].WithinDays
+	})
+
+	return result, false
+}
+
+// 売上の下書きの請求金額を返す
+// 料率が設定され年収が分かる場合は年収×料率、それ以外で固定の手数料が設定されている場合は固定の手数料とする
+func GetDraftSaleBillingAmount(commission, commissionRate null.Int, annualIncome int64) null.Float {
+	if commissionRate.Valid && commissionRate.Int64 > 0 && annualIncome > 0 {
+		return null.FloatFrom(math.Floor(float64(annualIncome) * float64(commissionRate.Int64) / 100))
+	}
+
+	if commission.Valid && commission.Int64 > 0 {
+		return null.FloatFrom(float64(commission.Int64))
+	}
+
+	return null.NewFloat(0, false)
+}
+
+// 売上の下書きのRA/CAの売上比率を返す
+func GetDraftSaleSalesRatio(raSalesRatio uint) (null.Float, null.Float) {
+	if raSalesRatio > 100 {
+		raSalesRatio = entity.DefaultRASalesRatio
+	}
+
+	return null.FloatFrom(float64(raSalesRatio)), null.FloatFrom(float64(100 - raSalesRatio))
+}
+
+// 在籍日数と適用する返金率を返す（保証期間外の場合は返金率0）
+func GetSaleRefund(tierList []entity.AgentRefundTier, joiningDate, resignationDate string) (uint, uint, error) {
+	joining, err := parseSaleGuaranteeDate(joiningDate)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w:%s", entity.ErrRequestError, "入社日が登録されていません")
+	}
+
+	resignation, err := parseSaleGuaranteeDate(resignationDate)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w:%s", entity.ErrRequestError, "退職日は2006-01-02の形式で入力してください")
+	}
+
+	if resignation.Before(joining) {
+		return 0, 0, fmt.Errorf("%w:%s", entity.ErrRequestError, "退職日は入社日以降の日付を入力してください")
+	}
+
+	daysEmployed := uint(resignation.Sub(joining).Hours() / 24)
+
+	for _, tier := range tierList {
+		if daysEmployed <= tier.WithinDays {
+			return daysEmployed, tier.RefundRate, nil
+		}
+	}
+
+	return daysEmployed, 0, nil
+}
+
+// 返金額を返す（円未満切り捨て）
+func GetSaleRefundAmount(billingAmount null.Float, refundRate uint) null.Float {
+	if !billingAmount.Valid {
+		return null.NewFloat(0, false)
+	}
+
+	return null.FloatFrom(math.Floor(billingAmount.Float64 * float64(refundRate) / 100))
+}
+
+// 返金保証期間の状態・保証期間の最終日・本日退職した場合の返金率を設定する
+func SetSaleGuaranteeStatus(guarantee *entity.SaleGuarantee, tierList []entity.AgentRefundTier, now time.Time) {
+	joining, err := parseSaleGuaranteeDate(guarantee.JoiningDate)
+	if err != nil {
+		return
+	}
+
+	today := getSaleGuaranteeToday(now)
+
+	if len(tierList) > 0 {
+		guarantee.GuaranteeEndDate = joining.AddDate(0, 0, int(tierList[len(tierList)-1].WithinDays)).Format("2006-01-02")
+	}
+
+	switch {
+	case guarantee.ResignationDate.Valid:
+		guarantee.Status = entity.SaleGuaranteeStatusResigned
+	case today.Before(joining):
+		guarantee.Status = entity.SaleGuaranteeStatusBeforeJoining
+	default:
+		guarantee.Status = entity.SaleGuaranteeStatusEnded
+
+		daysEmployed := uint(today.Sub(joining).Hours() / 24)
+		for _, tier := range tierList {
+			if daysEmployed <= tier.WithinDays {
+				guarantee.Status = entity.SaleGuaranteeStatusActive
+				guarantee.CurrentRefundRate = tier.RefundRate
+				guarantee.NextTierEndDate = joining.AddDate(0, 0, int(tier.WithinDays)).Format("2006-01-02")
+				break
+			}
+		}
+	}
+
+	guarantee.StatusName = entity.SaleGuaranteeStatusName[guarantee.Status]
+}
+
+// 終了が近い（SaleGuaranteeAlertDays 日以内に終わる）返金規定の段階を返す
+func GetSaleGuaranteeAlertTierList(tierList []entity.AgentRefundTier, joiningDate string, now time.Time) []entity.AgentRefundTier {
+	joining, err := parseSaleGuaranteeDate(joiningDate)
+	if err != nil {
+		return nil
+	}
+
+	var (
+		today  = getSaleGuaranteeToday(now)
+		result []entity.AgentRefundTier
+	)
+
+	for _, tier := range tierList {
+		tierEnd := joining.AddDate(0, 0, int(tier.WithinDays))
+		if tierEnd.Before(today) {
+			continue
+		}
+
+		if tierEnd.Sub(today) <= entity.SaleGuaranteeAlertDays*24*time.Hour {
+			result = append(result, tier)
+		}
+	}
+
+	return result
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+func parseSaleGuaranteeDate(date string) (time.Time, error) {
+	return time.ParseInLocation("2006-01-02", date, utility.Tokyo)
+}
+
+func getSaleGuaranteeToday(now time.Time) time.Time {
+	now = now.In(utility.Tokyo)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, utility.Tokyo)
+}
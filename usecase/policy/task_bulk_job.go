@@ -0,0 +1,132 @@
+package policy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+/// タスクの一括操作のポリシー
+//
+// フェーズの遷移・終了は選考ごとに最新タスクからの遷移として TaskPhaseTransitionList で検証する
+// 候補日時・確定日時の登録やメール・メッセージの送信など、選考ごとに入力が必要な処理を伴う遷移は一括では行わない
+//
+
+// 一括操作で実行できる遷移の処理（評価点の登録・ヨミの更新・売上の下書きの作成のみ）
+var taskBulkAllowedSideEffect = map[string]bool{
+	entity.TaskPhaseSideEffectEvaluationPass:        true,
+	entity.TaskPhaseSideEffectEvaluationFail:        true,
+	entity.TaskPhaseSideEffectEvaluationReInterview: true,
+	entity.TaskPhaseSideEffectSaleAccept:            true,
+	entity.TaskPhaseSideEffectSaleFailure:           true,
+	entity.TaskPhaseSideEffectDraftSale:             true,
+}
+
+// 一括操作の入力値を検証する
+func ValidateTaskBulkJobParam(param entity.CreateTaskBulkJobParam) error {
+	if len(param.TaskGroupIDList) == 0 {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "対象の選考を指定してください")
+	}
+
+	if len(param.TaskGroupIDList) > entity.TaskBulkJobMaxItemCount {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("対象の選考は%d件以内で指定してください", entity.TaskBulkJobMaxItemCount))
+	}
+
+	exists := map[uint]bool{}
+	for _, taskGroupID := range param.TaskGroupIDList {
+		if exists[taskGroupID] {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "対象の選考が重複しています")
+		}
+		exists[taskGroupID] = true
+	}
+
+	switch param.ActionType {
+	case entity.TaskBulkActionTransition, entity.TaskBulkActionClose:
+		if !param.PhaseCategory.Valid || !param.PhaseSubCategory.Valid {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "遷移先のフェーズを指定してください")
+		}
+
+		to := entity.TaskPhaseState{Phase: param.PhaseCategory.Int64, PhaseSub: param.PhaseSubCategory.Int64}
+		if param.ActionType == entity.TaskBulkActionClose && !to.IsClosed() {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "終了のフェーズを指定してください")
+		}
+
+		if param.ActionType == entity.TaskBulkActionTransition && to.IsClosed() {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "タスクを終了する場合は終了の操作を指定してください")
+		}
+
+		// 辞退・不合格で終了する場合は全ての選考に同じ理由を記録する
+		err := ValidateTaskLossReason(to, param.LossReason)
+		if err != nil {
+			return err
+		}
+	case entity.TaskBulkActionReassign:
+		if !param.RAStaffID.Valid && !param.CAStaffID.Valid {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "変更後のRA担当者またはCA担当者を指定してください")
+		}
+
+		// 担当者は選考ではなく求職者・請求先に紐づくため、反映範囲の確認を必須とする
+		if !param.IsConfirmedReassignScope {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "担当者の変更は同じ求職者・請求先の他の選考にも反映されます。反映範囲を確認してください")
+		}
+	case entity.TaskBulkActionDeadline:
+		if param.DeadlineDay == "" {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "期限日を指定してください")
+		}
+	default:
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "操作が正しくありません")
+	}
+
+	return validateTaskBulkDeadline(param.DeadlineDay, param.DeadlineTime)
+}
+
+// 一括操作でのフェーズの遷移を検証する
+// 遷移の定義にない遷移に加え、一括では実行できない処理を伴う遷移もエラーとする
+func ValidateTaskBulkTransition(from, to entity.TaskPhaseState, staffType null.Int) (entity.TaskPhaseTransition, error) {
+	err := ValidateTaskPhaseTransition(from, to, staffType)
+	if err != nil {
+		return entity.TaskPhaseTransition{}, err
+	}
+
+	transition, _ := FindTaskPhaseTransition(from, to)
+	for _, sideEffect := range transition.SideEffectList {
+		if !taskBulkAllowedSideEffect[sideEffect] {
+			wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("「%s」は一括操作では実行できません。個別に操作してください", transition.Label))
+			return entity.TaskPhaseTransition{}, wrapped
+		}
+	}
+
+	return transition, nil
+}
+
+// 遷移に指定の処理が含まれるか
+func HasTaskPhaseSideEffect(transition entity.TaskPhaseTransition, sideEffect string) bool {
+	for _, s := range transition.SideEffectList {
+		if s == sideEffect {
+			return true
+		}
+	}
+
+	return false
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+
+func validateTaskBulkDeadline(deadlineDay string, deadlineTime null.Int) error {
+	if deadlineDay != "" {
+		if _, err := time.Parse("2006-01-02", deadlineDay); err != nil {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "期限日はyyyy-mm-ddの形式で指定してください")
+		}
+	}
+
+	if deadlineTime.Valid && (deadlineTime.Int64 < 0 || deadlineTime.Int64 > 23) {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "期限の時刻は0~23時で指定してください")
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package policy
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+/// 辞退・不合格の理由のポリシー
+//
+// 辞退・不合格で終了するサブフェーズは entity.TaskLossCloseList で定義し、終了のタスクの作成時に理由の選択を必須とする
+// 選択できる理由は失注の種類（辞退 or 不合格・NG）と終了したフェーズで絞り込む
+//
+
+// 遷移先が辞退・不合格の終了の場合は失注の種類を返す
+func GetTaskLossType(to entity.TaskPhaseState) (int64, bool) {
+	for _, lossClose := range entity.TaskLossCloseList {
+		if lossClose.PhaseSub != to.PhaseSub {
+			continue
+		}
+
+		if matchTaskLossPhase(lossClose.Phase, to) {
+			return lossClose.LossType, true
+		}
+	}
+
+	return 0, false
+}
+
+// 遷移先の終了で選択できる理由の一覧（辞退・不合格の終了でない場合は空）
+func GetTaskLossReasonMasterList(to entity.TaskPhaseState) []entity.TaskLossReasonMaster {
+	masterList := []entity.TaskLossReasonMaster{}
+
+	lossType, ok := GetTaskLossType(to)
+	if !ok {
+		return masterList
+	}
+
+	for _, master := range entity.TaskLossReasonMasterList {
+		if master.LossType != lossType && master.LossType != entity.TaskLossTypeAny {
+			continue
+		}
+
+		if master.PhaseList != nil && !containsTaskLossPhase(master.PhaseList, to) {
+			continue
+		}
+
+		masterList = append(masterList, master)
+	}
+
+	return masterList
+}
+
+// 遷移先の終了に対して理由が選択されているかを検証する（辞退・不合格の終了でない場合は何もしない）
+func ValidateTaskLossReason(to entity.TaskPhaseState, lossReason null.Int) error {
+	if _, ok := GetTaskLossType(to); !ok {
+		return nil
+	}
+
+	if !lossReason.Valid {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("「%s」で終了する場合は理由を選択してください", getTaskPhaseStateName(to)))
+	}
+
+	for _, master := range GetTaskLossReasonMasterList(to) {
+		if master.LossReason == lossReason.Int64 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("「%s」では選択できない理由です", getTaskPhaseStateName(to)))
+}
+
+// 理由の名称
+func GetTaskLossReasonName(lossReason int64) string {
+	for _, master := range entity.TaskLossReasonMasterList {
+		if master.LossReason == lossReason {
+			return master.Name
+		}
+	}
+
+	return ""
+}
+
+// 集計の単位を検証する
+func ValidateTaskLossGroupBy(groupBy string) error {
+	switch groupBy {
+	case entity.TaskLossGroupByEnterprise,
+		entity.TaskLossGroupByJobInformation,
+		entity.TaskLossGroupByCAStaff,
+		entity.TaskLossGroupByRAStaff,
+		entity.TaskLossGroupByPhase:
+		return nil
+	}
+
+	return fmt.Errorf("%w:%s", entity.ErrRequestError, "集計の単位が正しくありません")
+}
+
+// 辞退・不合格の理由を集計単位ごとに集計する
+// 集計単位は件数の多い順、理由は件数の多い順に並べ、全体の内訳を合わせて返す
+func AggregateTaskLossReason(lossReasonList []*entity.TaskLossReason, groupBy string) ([]*entity.TaskLossReasonAnalytics, *entity.TaskLossReasonAnalytics) {
+	var (
+		analyticsList = []*entity.TaskLossReasonAnalytics{}
+		analyticsMap  = map[int64]*entity.TaskLossReasonAnalytics{}
+		countMap      = map[int64]map[int64]uint{}
+		summary       = &entity.TaskLossReasonAnalytics{GroupName: "全体"}
+		summaryCount  = map[int64]uint{}
+	)
+
+	for _, lossReason := range lossReasonList {
+		groupID, groupName := getTaskLossGroup(lossReason, groupBy)
+
+		analytics, ok := analyticsMap[groupID]
+		if !ok {
+			analytics = &entity.TaskLossReasonAnalytics{
+				GroupID:   groupID,
+				GroupName: groupName,
+			}
+			analyticsMap[groupID] = analytics
+			countMap[groupID] = map[int64]uint{}
+			analyticsList = append(analyticsList, analytics)
+		}
+
+		for _, target := range []*entity.TaskLossReasonAnalytics{analytics, summary} {
+			target.TotalCount++
+			switch lossReason.LossType {
+			case entity.TaskLossTypeDecline:
+				target.DeclineCount++
+			case entity.TaskLossTypeRejection:
+				target.RejectionCount++
+			}
+		}
+
+		countMap[groupID][lossReason.LossReason]++
+		summaryCount[lossReason.LossReason]++
+	}
+
+	for _, analytics := range analyticsList {
+		analytics.ReasonCountList = getTaskLossReasonCountList(countMap[analytics.GroupID], analytics.TotalCount)
+	}
+	summary.ReasonCountList = getTaskLossReasonCountList(summaryCount, summary.TotalCount)
+
+	sort.SliceStable(analyticsList, func(a, b int) bool {
+		if analyticsList[a].TotalCount != analyticsList[b].TotalCount {
+			return analyticsList[a].TotalCount > analyticsList[b].TotalCount
+		}
+		return analyticsList[a].GroupID < analyticsList[b].GroupID
+	})
+
+	return analyticsList, summary
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+
+func matchTaskLossPhase(phase entity.TaskCategory, to entity.TaskPhaseState) bool {
+	if phase == entity.TaskPhaseAnySelection {
+		return to.IsSelection()
+	}
+
+	return int64(phase) == to.Phase
+}
+
+func containsTaskLossPhase(phaseList []entity.TaskCategory, to entity.TaskPhaseState) bool {
+	for _, phase := range phaseList {
+		if matchTaskLossPhase(phase, to) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// 集計単位のIDと名称
+func getTaskLossGroup(lossReason *entity.TaskLossReason, groupBy string) (int64, string) {
+	switch groupBy {
+	case entity.TaskLossGroupByEnterprise:
+		return lossReason.EnterpriseID.Int64, lossReason.CompanyName
+	case entity.TaskLossGroupByJobInformation:
+		return int64(lossReason.JobInformationID), lossReason.CompanyName + " " + lossReason.Title
+	case entity.TaskLossGroupByCAStaff:
+		return lossReason.CAStaffID.Int64, lossReason.CAStaffName
+	case entity.TaskLossGroupByRAStaff:
+		return lossReason.RAStaffID.Int64, lossReason.RAStaffName
+	case entity.TaskLossGroupByPhase:
+		phaseName, _ := getTaskPhaseName(entity.TaskPhaseState{Phase: lossReason.PhaseCategory, PhaseSub: lossReason.PhaseSubCategory})
+		return lossReason.PhaseCategory, phaseName
+	}
+
+	return 0, ""
+}
+
+// 理由ごとの件数を件数の多い順（同数の場合は理由の定義順）に返す
+func getTaskLossReasonCountList(countMap map[int64]uint, totalCount uint) []*entity.TaskLossReasonCount {
+	countList := []*entity.TaskLossReasonCount{}
+
+	for _, master := range entity.TaskLossReasonMasterList {
+		count, ok := countMap[master.LossReason]
+		if !ok {
+			continue
+		}
+
+		var rate float64
+		if totalCount > 0 {
+			rate = math.Round(float64(count)/float64(totalCount)*1000) / 10
+		}
+
+		countList = append(countList, &entity.TaskLossReasonCount{
+			LossReason:     master.LossReason,
+			LossReasonName: master.Name,
+			Count:          count,
+			Rate:           rate,
+		})
+	}
+
+	sort.SliceStable(countList, func(a, b int) bool {
+		return countList[a].Count > countList[b].Count
+	})
+
+	return countList
+}
@@ -0,0 +1,261 @@
+package policy
+
+import (
+	"fmt"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+/// タスクのフェーズ遷移のポリシー
+//
+// 遷移できるフェーズ・サブフェーズと実行する担当者は entity.TaskPhaseTransitionList で定義する
+// 作成するタスクは、タスクグループの最新タスクからの遷移として定義にあるものに限る
+// タスクを進められるのは、最新タスクを実行する側（RA・CA）のエージェントの担当者に限る
+//
+
+// 遷移元から遷移先への定義を取得する
+func FindTaskPhaseTransition(from, to entity.TaskPhaseState) (entity.TaskPhaseTransition, bool) {
+	for _, transition := range entity.TaskPhaseTransitionList {
+		if !matchTaskPhaseFrom(transition, from) {
+			continue
+		}
+
+		if !matchTaskPhaseTo(transition, from, to) {
+			continue
+		}
+
+		return transition, true
+	}
+
+	return entity.TaskPhaseTransition{}, false
+}
+
+// 遷移元から遷移先へタスクを作成できるかを判定する
+func ValidateTaskPhaseTransition(from, to entity.TaskPhaseState, staffType null.Int) error {
+	if from.IsClosed() {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("「%s」は終了したタスクのため、次のタスクを作成できません", getTaskPhaseStateName(from)))
+		return wrapped
+	}
+
+	transition, ok := FindTaskPhaseTransition(from, to)
+	if !ok {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("「%s」から「%s」には進めません", getTaskPhaseStateName(from), getTaskPhaseStateName(to)))
+		return wrapped
+	}
+
+	if transition.StaffType != entity.TaskStaffTypeAny && staffType.Valid &&
+		normalizeTaskStaffType(entity.StaffType(staffType.Int64)) != transition.StaffType {
+		wrapped := fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("「%s」は%sのタスクです", getTaskPhaseStateName(to), getTaskStaffTypeName(transition.StaffType)))
+		return wrapped
+	}
+
+	return nil
+}
+
+// 操作者が遷移元のタスクを進められるかを判定する
+// 実行する側はリクエストの担当者タイプではなく、操作者の所属エージェントとタスクグループのRA・CAのエージェントから判定する
+// 遷移元のタスクの担当者タイプは、DBに保存されている最新タスクの値を使用する
+func ValidateTaskPhaseOperator(operator *entity.AgentStaff, taskGroup *entity.TaskGroup, fromStaffType null.Int) error {
+	if operator == nil {
+		return fmt.Errorf("%w:%s", entity.ErrUnauthorized, "担当者が認証されていません")
+	}
+
+	isRAAgent := operator.AgentID == taskGroup.RAAgentID
+	isCAAgent := operator.AgentID == taskGroup.CAAgentID
+	if !isRAAgent && !isCAAgent {
+		return fmt.Errorf("%w:%s", entity.ErrForbidden, "他社の選考は操作できません")
+	}
+
+	// 担当者タイプのないタスクはRA・CAのどちらでも進められる
+	if !fromStaffType.Valid {
+		return nil
+	}
+
+	switch normalizeTaskStaffType(entity.StaffType(fromStaffType.Int64)) {
+	case entity.RA:
+		if !isRAAgent {
+			return fmt.Errorf("%w:%s", entity.ErrForbidden, "RAのタスクのため、RA側の担当者のみ進められます")
+		}
+	case entity.CA:
+		if !isCAAgent {
+			return fmt.Errorf("%w:%s", entity.ErrForbidden, "CAのタスクのため、CA側の担当者のみ進められます")
+		}
+	}
+
+	return nil
+}
+
+// 遷移元から実行できる操作の一覧
+// 遷移先のフェーズが複数ある定義（○次選考のいずれか）は、フェーズごとに展開する
+func GetTaskPhaseActionList(from entity.TaskPhaseState) []entity.TaskPhaseAction {
+	actionList := []entity.TaskPhaseAction{}
+
+	if from.IsClosed() {
+		return actionList
+	}
+
+	for _, transition := range entity.TaskPhaseTransitionList {
+		if !matchTaskPhaseFrom(transition, from) {
+			continue
+		}
+
+		for _, phase := range getTaskPhaseToList(transition, from) {
+			to := entity.TaskPhaseState{Phase: phase, PhaseSub: transition.ToPhaseSub}
+
+			// 共通の定義と重複する遷移は先に定義したものを使用する
+			if hasTaskPhaseAction(actionList, to) {
+				continue
+			}
+
+			phaseName, phaseSubName := getTaskPhaseName(to)
+			sideEffectList := transition.SideEffectList
+			if sideEffectList == nil {
+				sideEffectList = []string{}
+			}
+
+			// 辞退・不合格の終了は理由の登録を伴う
+			if _, ok := GetTaskLossType(to); ok {
+				sideEffectList = append(append([]string{}, sideEffectList...), entity.TaskPhaseSideEffectLossReason)
+			}
+
+			actionList = append(actionList, entity.TaskPhaseAction{
+				PhaseCategory:    to.Phase,
+				PhaseSubCategory: to.PhaseSub,
+				PhaseName:        phaseName,
+				PhaseSubName:     phaseSubName,
+				StaffType:        int64(transition.StaffType),
+				Label:            transition.Label,
+				SideEffectList:   sideEffectList,
+			})
+		}
+	}
+
+	return actionList
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+
+func matchTaskPhaseFrom(transition entity.TaskPhaseTransition, from entity.TaskPhaseState) bool {
+	switch transition.FromPhase {
+	case entity.TaskPhaseAnySelection:
+		if !from.IsSelection() {
+			return false
+		}
+	default:
+		if int64(transition.FromPhase) != from.Phase {
+			return false
+		}
+	}
+
+	if transition.FromPhaseSub == entity.TaskPhaseSubAny {
+		return !from.IsClosed()
+	}
+
+	return transition.FromPhaseSub == from.PhaseSub
+}
+
+func matchTaskPhaseTo(transition entity.TaskPhaseTransition, from, to entity.TaskPhaseState) bool {
+	if transition.ToPhaseSub != to.PhaseSub {
+		return false
+	}
+
+	for _, phase := range getTaskPhaseToList(transition, from) {
+		if phase == to.Phase {
+			return true
+		}
+	}
+
+	return false
+}
+
+// 遷移先のフェーズの候補
+func getTaskPhaseToList(transition entity.TaskPhaseTransition, from entity.TaskPhaseState) []int64 {
+	var (
+		phaseList []int64
+		first     = int64(entity.FirstSelection)
+		last      = int64(entity.FinalSelection)
+	)
+
+	switch transition.ToPhase {
+	case entity.TaskPhaseSameAsFrom:
+		phaseList = append(phaseList, from.Phase)
+	case entity.TaskPhaseAnySelection:
+		for phase := first; phase <= last; phase++ {
+			phaseList = append(phaseList, phase)
+		}
+	case entity.TaskPhaseNextSelection:
+		for phase := from.Phase + 1; phase <= last; phase++ {
+			if phase >= first {
+				phaseList = append(phaseList, phase)
+			}
+		}
+	default:
+		phaseList = append(phaseList, int64(transition.ToPhase))
+	}
+
+	return phaseList
+}
+
+func hasTaskPhaseAction(actionList []entity.TaskPhaseAction, to entity.TaskPhaseState) bool {
+	for _, action := range actionList {
+		if action.PhaseCategory == to.Phase && action.PhaseSubCategory == to.PhaseSub {
+			return true
+		}
+	}
+
+	return false
+}
+
+// 上長はRA・CAと同じ担当者として扱う
+func normalizeTaskStaffType(staffType entity.StaffType) entity.StaffType {
+	switch staffType {
+	case entity.CA_Boss:
+		return entity.CA
+	case entity.RA_Boss:
+		return entity.RA
+	}
+
+	return staffType
+}
+
+func getTaskStaffTypeName(staffType entity.StaffType) string {
+	if staffType == entity.RA {
+		return "RA"
+	}
+
+	return "CA"
+}
+
+func getTaskPhaseName(state entity.TaskPhaseState) (string, string) {
+	if state.Phase < 0 || state.PhaseSub < 0 {
+		return "", ""
+	}
+
+	phaseName := entity.TaskPhase[uint(state.Phase)]
+
+	phaseSubName := ""
+	if phaseSubMap, ok := entity.TaskPhaseSub[uint(state.Phase)]; ok {
+		phaseSubName = phaseSubMap[uint(state.PhaseSub)]
+	}
+
+	// マスタにない特別なサブフェーズ
+	if phaseSubName == "" {
+		switch {
+		case state.PhaseSub == entity.ContinueSelection:
+			phaseSubName = "選考継続"
+		case state.Phase == int64(entity.AcceptJobOffer) && state.PhaseSub == entity.Decision:
+			phaseSubName = "決定終了"
+		}
+	}
+
+	return phaseName, phaseSubName
+}
+
+func getTaskPhaseStateName(state entity.TaskPhaseState) string {
+	phaseName, phaseSubName := getTaskPhaseName(state)
+	return phaseName + "/" + phaseSubName
+}
@@ -0,0 +1,294 @@
+package policy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spaceaiinc/autoscout-server/domain/entity"
+	"github.com/spaceaiinc/autoscout-server/domain/utility"
+	"gopkg.in/guregu/null.v4"
+)
+
+/****************************************************************************************/
+/// タスクの期限（SLA）のポリシー
+//
+// 期限はタスクに設定された期限日・期限時刻を優先し、未設定の場合はフェーズの設定から営業時間で算出する
+// リマインド・エスカレーションの時刻も営業時間（土日・休業日を除く）で数える
+//
+
+// 営業時間のカレンダーを作成する（0の場合は初期値を使用する）
+func NewTaskSLACalendar(startHour, endHour uint, holidays []string) entity.TaskSLACalendar {
+	calendar := entity.TaskSLACalendar{
+		Location:  utility.Tokyo,
+		StartHour: entity.TaskSLADefaultStartHour,
+		EndHour:   entity.TaskSLADefaultEndHour,
+		Holidays:  map[string]bool{},
+	}
+
+	if startHour > 0 {
+		calendar.StartHour = int(startHour)
+	}
+	if endHour > 0 {
+		calendar.EndHour = int(endHour)
+	}
+
+	// 営業時間がない設定の場合は初期値に戻す
+	if calendar.StartHour >= calendar.EndHour || calendar.EndHour > 24 {
+		calendar.StartHour = entity.TaskSLADefaultStartHour
+		calendar.EndHour = entity.TaskSLADefaultEndHour
+	}
+
+	for _, holiday := range holidays {
+		day, err := time.ParseInLocation("2006-01-02", holiday, calendar.Location)
+		if err != nil {
+			continue
+		}
+		calendar.Holidays[day.Format("2006-01-02")] = true
+	}
+
+	return calendar
+}
+
+// 営業日かを判定する
+func IsTaskSLABusinessDay(calendar entity.TaskSLACalendar, t time.Time) bool {
+	t = t.In(calendar.Location)
+
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+
+	return !calendar.Holidays[t.Format("2006-01-02")]
+}
+
+// 指定時刻に営業時間を加算する（負の値の場合は遡る）
+func AddTaskSLABusinessHours(calendar entity.TaskSLACalendar, t time.Time, hours int) time.Time {
+	t = t.In(calendar.Location)
+
+	remaining := time.Duration(hours) * time.Hour
+	if remaining < 0 {
+		return subTaskSLABusinessDuration(calendar, t, -remaining)
+	}
+
+	for remaining > 0 {
+		start, end := getTaskSLABusinessHours(calendar, t)
+
+		if !IsTaskSLABusinessDay(calendar, t) || !t.Before(end) {
+			t = start.AddDate(0, 0, 1)
+			continue
+		}
+
+		if t.Before(start) {
+			t = start
+		}
+
+		available := end.Sub(t)
+		if remaining <= available {
+			return t.Add(remaining)
+		}
+
+		remaining -= available
+		t = start.AddDate(0, 0, 1)
+	}
+
+	return t
+}
+
+// タスクに適用する期限の設定を取得する
+// エージェントの設定（サブフェーズ指定 > フェーズ全体）を優先し、ない場合は初期設定を使用する
+func FindTaskSLARule(ruleList []*entity.TaskSLARule, targetType, phase, phaseSub int64) (entity.TaskSLARule, bool) {
+	var phaseRule *entity.TaskSLARule
+
+	for _, rule := range ruleList {
+		if rule.TargetType != targetType || rule.PhaseCategory != phase {
+			continue
+		}
+
+		if rule.PhaseSubCategory.Valid {
+			if rule.PhaseSubCategory.Int64 == phaseSub {
+				return *rule, true
+			}
+			continue
+		}
+
+		if phaseRule == nil {
+			phaseRule = rule
+		}
+	}
+
+	if phaseRule != nil {
+		return *phaseRule, true
+	}
+
+	for _, rule := range entity.TaskSLADefaultRuleList {
+		if rule.TargetType == targetType && rule.PhaseCategory == phase {
+			return rule, true
+		}
+	}
+
+	return entity.TaskSLARule{}, false
+}
+
+// タスクの期限を取得する
+// 期限日が設定されている場合はその日時、未設定の場合は作成から設定の営業時間後を期限とする
+// isDefaultは設定から算出した期限か、okは期限があるか
+func GetTaskSLADueAt(
+	calendar entity.TaskSLACalendar,
+	deadlineDay string,
+	deadlineTime null.Int,
+	createdAt time.Time,
+	rule entity.TaskSLARule,
+) (dueAt time.Time, isDefault bool, ok bool) {
+	if deadlineDay != "" {
+		day, err := time.ParseInLocation("2006-01-02", deadlineDay, calendar.Location)
+		if err == nil {
+			// 時刻が不正な場合は0時として扱う（未処理・未読の通知と同じ扱い）
+			hour := deadlineTime.Int64
+			if !deadlineTime.Valid || hour < 0 || hour > 23 {
+				hour = 0
+			}
+			return day.Add(time.Duration(hour) * time.Hour), false, true
+		}
+	}
+
+	if rule.DeadlineHours == 0 || createdAt.IsZero() {
+		return time.Time{}, false, false
+	}
+
+	return AddTaskSLABusinessHours(calendar, createdAt, int(rule.DeadlineHours)), true, true
+}
+
+// 現時点で送るべき通知の種類を取得する
+// リマインドは期限前の指定時間内のみ、エスカレーションは期限切れから指定の営業時間が経過した後に送る
+func GetTaskSLANotificationTypeList(calendar entity.TaskSLACalendar, dueAt time.Time, rule entity.TaskSLARule, now time.Time) []int64 {
+	typeList := []int64{}
+
+	if now.Before(dueAt) {
+		if rule.ReminderHours > 0 {
+			reminderAt := AddTaskSLABusinessHours(calendar, dueAt, -int(rule.ReminderHours))
+			if !now.Before(reminderAt) {
+				typeList = append(typeList, entity.TaskSLANotificationReminder)
+			}
+		}
+		return typeList
+	}
+
+	typeList = append(typeList, entity.TaskSLANotificationOverdue)
+
+	escalationAt := AddTaskSLABusinessHours(calendar, dueAt, int(rule.EscalationHours))
+	if !now.Before(escalationAt) {
+		typeList = append(typeList, entity.TaskSLANotificationEscalation)
+	}
+
+	return typeList
+}
+
+// 期限切れのタスクをエスカレーションする上長を取得する
+// 上長が設定されている場合はその担当者、未設定の場合は自社の管理者（本人を除く）
+func GetTaskSLAManagerList(agentStaff *entity.AgentStaff, agentStaffList []*entity.AgentStaff) []*entity.AgentStaff {
+	managerList := []*entity.AgentStaff{}
+
+	for _, staff := range agentStaffList {
+		if staff.ID == agentStaff.ID || staff.AgentID != agentStaff.AgentID || !isTaskSLAStaffAvailable(staff) {
+			continue
+		}
+
+		if agentStaff.ManagerStaffID.Valid {
+			if uint(agentStaff.ManagerStaffID.Int64) == staff.ID {
+				managerList = append(managerList, staff)
+			}
+			continue
+		}
+
+		if staff.Authority.Valid && staff.Authority.Int64 == int64(entity.AuthorityAdmin) {
+			managerList = append(managerList, staff)
+		}
+	}
+
+	return managerList
+}
+
+// 期限の設定の入力値を検証する
+func ValidateTaskSLARuleParam(param entity.CreateOrUpdateTaskSLARuleParam) error {
+	switch param.TargetType {
+	case entity.TaskSLATargetTask:
+		if _, ok := entity.TaskPhase[uint(param.PhaseCategory)]; !ok || param.PhaseCategory < 0 {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "フェーズが正しくありません")
+		}
+		if param.PhaseSubCategory.Valid {
+			if _, ok := entity.TaskPhaseSub[uint(param.PhaseCategory)][uint(param.PhaseSubCategory.Int64)]; !ok || param.PhaseSubCategory.Int64 < 0 {
+				return fmt.Errorf("%w:%s", entity.ErrRequestError, "サブフェーズが正しくありません")
+			}
+		}
+	case entity.TaskSLATargetInterviewTask:
+		if param.PhaseCategory < int64(entity.EntryInterview) || param.PhaseCategory > int64(entity.WaitingInterview) {
+			return fmt.Errorf("%w:%s", entity.ErrRequestError, "面談調整タスクのフェーズが正しくありません")
+		}
+	default:
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "対象のタスクが正しくありません")
+	}
+
+	if param.DeadlineHours > entity.TaskSLAMaxHours || param.ReminderHours > entity.TaskSLAMaxHours || param.EscalationHours > entity.TaskSLAMaxHours {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, fmt.Sprintf("時間は%d営業時間以内で指定してください", entity.TaskSLAMaxHours))
+	}
+
+	if param.DeadlineHours > 0 && param.ReminderHours >= param.DeadlineHours {
+		return fmt.Errorf("%w:%s", entity.ErrRequestError, "リマインドは期限より短い時間で指定してください")
+	}
+
+	return nil
+}
+
+// 期限の通知の対象となる担当者かを判定する
+func IsTaskSLANotifiable(agentStaff *entity.AgentStaff, isDigest bool) bool {
+	if !isTaskSLAStaffAvailable(agentStaff) {
+		return false
+	}
+
+	if isDigest {
+		return agentStaff.NotificationSLADigest
+	}
+
+	return agentStaff.NotificationSLAReminder
+}
+
+/****************************************************************************************/
+/// 内部関数
+//
+
+// 指定時刻から営業時間を遡る
+func subTaskSLABusinessDuration(calendar entity.TaskSLACalendar, t time.Time, remaining time.Duration) time.Time {
+	for remaining > 0 {
+		start, end := getTaskSLABusinessHours(calendar, t)
+
+		if !IsTaskSLABusinessDay(calendar, t) || !t.After(start) {
+			t = end.AddDate(0, 0, -1)
+			continue
+		}
+
+		if t.After(end) {
+			t = end
+		}
+
+		available := t.Sub(start)
+		if remaining <= available {
+			return t.Add(-remaining)
+		}
+
+		remaining -= available
+		t = end.AddDate(0, 0, -1)
+	}
+
+	return t
+}
+
+// 指定日の営業開始・終了時刻
+func getTaskSLABusinessHours(calendar entity.TaskSLACalendar, t time.Time) (time.Time, time.Time) {
+	year, month, day := t.In(calendar.Location).Date()
+	start := time.Date(year, month, day, calendar.StartHour, 0, 0, 0, calendar.Location)
+	end := time.Date(year, month, day, calendar.EndHour, 0, 0, 0, calendar.Location)
+	return start, end
+}
+
+func isTaskSLAStaffAvailable(agentStaff *entity.AgentStaff) bool {
+	return !agentStaff.IsDeleted && agentStaff.UsageStatus.Int64 != int64(entity.UsageStatusNotAvailable)
+}
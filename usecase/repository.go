@@ -30,6 +30,12 @@ type AgentRepository interface {
 	// 同意書URLを更新する
 	UpdateAgreementFileURL(id uint, agentAgreement entity.AgentAgreementFileURLParam) error
 
+	// 重複推薦を確認する期間を更新する
+	UpdateDuplicateRecommendationDays(id uint, days uint) error
+
+	// 自動作成する売上のRAの売上比率を更新する
+	UpdateRASalesRatio(id uint, raSalesRatio uint) error
+
 	/** 単数取得 */
 	// IDからエージェントを取得する
 	FindByID(id uint) (*entity.Agent, error)
@@ -90,6 +96,9 @@ type AgentStaffRepository interface {
 	// スタッフの未読・未処理関連の通知を更新する
 	UpdateNotificationUnwatched(id uint, notificationUnwatched bool) error
 
+	// タスクの期限に関する通知設定（上長・リマインド・日次まとめ）を更新する
+	UpdateSLASetting(id uint, managerStaffID null.Int, notificationSLAReminder, notificationSLADigest bool) error
+
 	// スタッフの権限を更新する
 	UpdateAuthority(id uint, authority uint) error
 
@@ -221,6 +230,310 @@ type AgentInflowChannelOptionRepository interface {
 	GetByAgentID(agentID uint) ([]*entity.AgentInflowChannelOption, error)
 }
 
+/****************************************************************************************/
+// 担当CAの自動割り振り
+//
+type AgentAssignmentRuleRepository interface {
+	/** 作成 */
+	// 自動割り振りルールを作成する
+	Create(assignmentRule *entity.AgentAssignmentRule) error
+
+	/** 更新 */
+	// 自動割り振りルールを更新する
+	Update(id uint, assignmentRule *entity.AgentAssignmentRule) error
+
+	// 最後に割り振った担当者を更新する
+	UpdateLastAssignedStaffID(id, agentStaffID uint) error
+
+	/** 単数取得 */
+	// エージェントIDから自動割り振りルールを取得する
+	FindByAgentID(agentID uint) (*entity.AgentAssignmentRule, error)
+}
+
+type AgentAssignmentStaffRepository interface {
+	/** 作成 */
+	// 割り振り対象の担当者を作成する
+	Create(assignmentStaff *entity.AgentAssignmentStaff) error
+
+	/** 削除 */
+	// エージェントIDから割り振り対象の担当者を削除する
+	DeleteByAgentID(agentID uint) error
+
+	/** 複数取得 */
+	// エージェントIDから割り振り対象の担当者一覧を取得する
+	GetByAgentID(agentID uint) ([]*entity.AgentAssignmentStaff, error)
+}
+
+type AgentAssignmentStaffSpecialtyRepository interface {
+	/** 作成 */
+	// 担当者の得意分野を作成する
+	Create(specialty *entity.AgentAssignmentStaffSpecialty) error
+
+	/** 複数取得 */
+	// エージェントIDから担当者の得意分野一覧を取得する
+	GetByAgentID(agentID uint) ([]*entity.AgentAssignmentStaffSpecialty, error)
+}
+
+/****************************************************************************************/
+// 新規エントリーの選考ルール
+//
+type EntryScreeningRuleRepository interface {
+	/** 作成 */
+	// 選考ルールを作成する
+	Create(screeningRule *entity.EntryScreeningRule) error
+
+	/** 更新 */
+	// 選考ルールを更新する
+	Update(id uint, screeningRule *entity.EntryScreeningRule) error
+
+	/** 削除 */
+	// 選考ルールを論理削除する
+	Delete(id uint) error
+
+	/** 単数取得 */
+	// IDから選考ルールを取得する
+	FindByID(id uint) (*entity.EntryScreeningRule, error)
+
+	/** 複数取得 */
+	// エージェントIDから選考ルール一覧を取得する
+	GetByAgentID(agentID uint) ([]*entity.EntryScreeningRule, error)
+	// エージェントIDから有効な選考ルール一覧を取得する
+	GetActiveByAgentID(agentID uint) ([]*entity.EntryScreeningRule, error)
+}
+
+type EntryScreeningRuleConditionRepository interface {
+	/** 作成 */
+	// 選考ルールの条件を作成する
+	Create(condition *entity.EntryScreeningRuleCondition) error
+
+	/** 削除 */
+	// 選考ルールIDから条件を削除する
+	DeleteByRuleID(ruleID uint) error
+
+	/** 複数取得 */
+	// 選考ルールIDから条件一覧を取得する
+	GetByRuleID(ruleID uint) ([]*entity.EntryScreeningRuleCondition, error)
+	// エージェントIDから条件一覧を取得する
+	GetByAgentID(agentID uint) ([]*entity.EntryScreeningRuleCondition, error)
+}
+
+type EntryScreeningResultRepository interface {
+	/** 作成 */
+	// 選考ルールに該当した求職者を記録する
+	Create(screeningResult *entity.EntryScreeningResult) error
+
+	/** 複数取得 */
+	// エージェントIDから選考ルールごとの該当件数を取得する
+	GetHitCountByAgentID(agentID uint) ([]*entity.EntryScreeningRuleHitCount, error)
+}
+
+/****************************************************************************************/
+// 面談予約リンク
+//
+type InterviewBookingLinkRepository interface {
+	/** 作成 */
+	// 面談予約リンクを作成する
+	Create(bookingLink *entity.InterviewBookingLink) error
+
+	/** 更新 */
+	// 面談予約リンクを予約済みにする
+	UpdateBooked(id uint, interviewDate time.Time) error
+
+	/** 単数取得 */
+	// UUIDから面談予約リンクを取得する
+	FindByUUID(bookingUUID uuid.UUID) (*entity.InterviewBookingLink, error)
+
+	// UUIDから面談予約リンクを取得して行をロックする（トランザクション内で使用する）
+	FindByUUIDForUpdate(bookingUUID uuid.UUID) (*entity.InterviewBookingLink, error)
+}
+
+/****************************************************************************************/
+// 監査ログ（追記のみのため更新・削除は持たない）
+//
+type AuditLogRepository interface {
+	/** 作成 */
+	// 監査ログを作成する
+	Create(auditLog *entity.AuditLog) error
+
+	/** 複数取得 */
+	// 検索条件から監査ログを取得する
+	GetSearchByAgentID(searchParam entity.SearchAuditLogParam) ([]*entity.AuditLog, error)
+}
+
+/****************************************************************************************/
+// 暗号化の鍵（暗号化して保存している項目を横断して扱う）
+//
+type EncryptionKeyRepository interface {
+	/** 更新 */
+	// 再暗号化した値で更新する（取得後に値が変わっていない場合のみ）
+	UpdateEncryptedValue(column entity.EncryptedColumn, id uint, before, after string) error
+
+	/** 複数取得 */
+	// 保存されている暗号文が参照する鍵のバージョン一覧を取得する
+	GetReferencedKeyVersionList() ([]int, error)
+
+	// 指定バージョン以外の鍵で暗号化された値を、指定IDより後からlimit件取得する
+	GetValueListNotEncryptedWithVersion(column entity.EncryptedColumn, version int, afterID uint, limit uint) ([]*entity.EncryptedValue, error)
+}
+
+/****************************************************************************************/
+// ゲスト用リンク
+//
+type GuestLinkRepository interface {
+	/** 作成 */
+	// ゲスト用リンクを作成する
+	Create(guestLink *entity.GuestLink) error
+
+	/** 更新 */
+	// ゲスト用リンクを無効化する
+	Revoke(id, revokedStaffID uint) error
+
+	/** 単数取得 */
+	FindByID(id uint) (*entity.GuestLink, error)
+
+	// トークンのID（jti）から取得する
+	FindByUUID(linkUUID uuid.UUID) (*entity.GuestLink, error)
+
+	/** 複数取得 */
+	// エージェントIDからゲスト用リンク一覧を取得する（対象を指定した場合は絞り込む）
+	GetByAgentID(agentID uint, resourceType string, resourceUUID uuid.UUID) ([]*entity.GuestLink, error)
+}
+
+// ゲスト用リンクのアクセスログ（追記のみのため更新・削除は持たない）
+type GuestLinkAccessLogRepository interface {
+	/** 作成 */
+	// アクセスログを作成する
+	Create(accessLog *entity.GuestLinkAccessLog) error
+
+	/** 複数取得 */
+	// ゲスト用リンクIDからアクセスログを取得する
+	GetByGuestLinkID(guestLinkID uint) ([]*entity.GuestLinkAccessLog, error)
+}
+
+/****************************************************************************************/
+// ログイン試行の制限（複数台のサーバーで共有するためDBで管理する）
+//
+type LoginAttemptRepository interface {
+	/** 作成 */
+	// 試行状態を作成する（既に存在する場合は何もしない）
+	CreateIfNotExists(attempt *entity.LoginAttempt) error
+
+	/** 更新 */
+	// 試行回数とロック状態を更新する
+	Update(attempt *entity.LoginAttempt) error
+
+	// ロックを解除して回数を戻す
+	Unlock(id uint) error
+
+	// 指定の単位・値のロックを全ての操作で解除して回数を戻す
+	UnlockByKey(keyType, keyValue string) error
+
+	/** 削除 */
+	// ログインに成功した場合に試行状態を削除する
+	DeleteByKey(scope, keyType, keyValue string) error
+
+	/** 単数取得 */
+	FindByID(id uint) (*entity.LoginAttempt, error)
+
+	// 対象の操作・単位・値から取得する
+	FindByKey(scope, keyType, keyValue string) (*entity.LoginAttempt, error)
+
+	// 対象の操作・単位・値から取得して行をロックする（トランザクション内で使用する）
+	FindByKeyForUpdate(scope, keyType, keyValue string) (*entity.LoginAttempt, error)
+
+	/** 複数取得 */
+	// ロック中の試行状態を取得する
+	GetLockedList(now time.Time) ([]*entity.LoginAttempt, error)
+}
+
+/****************************************************************************************/
+// 外部連携用のAPIキー
+//
+type APIKeyRepository interface {
+	/** 作成 */
+	// APIキーを作成する
+	Create(apiKey *entity.APIKey) error
+
+	/** 更新 */
+	// APIキーを無効化する
+	Revoke(id, revokedStaffID uint) error
+
+	// 最終利用日時と接続元IPアドレスを更新する
+	UpdateLastUsed(id uint, lastUsedAt time.Time, ipAddress string) error
+
+	/** 単数取得 */
+	FindByID(id uint) (*entity.APIKey, error)
+
+	// キーのハッシュから取得する
+	FindByKeyHash(keyHash string) (*entity.APIKey, error)
+
+	/** 複数取得 */
+	// エージェントIDからAPIキー一覧を取得する
+	GetByAgentID(agentID uint) ([]*entity.APIKey, error)
+}
+
+/****************************************************************************************/
+// 管理者サイト
+//
+type AdminUserRepository interface {
+	/** 作成 */
+	// 管理者を作成する
+	Create(adminUser *entity.AdminUser) error
+
+	/** 更新 */
+	// 二要素認証の秘密鍵を登録する（登録の完了前）
+	UpdateTOTPSecret(id uint, totpSecret string) error
+
+	// 二要素認証の登録を完了する
+	EnableTOTP(id uint, lastStep int64) error
+
+	// 最後に使用したワンタイムパスワードの時間ステップを更新する
+	UpdateTOTPLastStep(id uint, lastStep int64) error
+
+	// 最終ログイン日時を更新する
+	UpdateLastLoginAt(id uint, lastLoginAt time.Time) error
+
+	/** 単数取得 */
+	FindByID(id uint) (*entity.AdminUser, error)
+
+	// ログインIDから取得する（削除済みは除く）
+	FindByLoginID(loginID string) (*entity.AdminUser, error)
+}
+
+type AdminSessionRepository interface {
+	/** 作成 */
+	// セッションを作成する
+	Create(session *entity.AdminSession) error
+
+	/** 更新 */
+	// 二要素認証を完了する
+	VerifyMFA(id uint, expiresAt time.Time) error
+
+	// ワンタイムパスワードの誤り回数を1増やす
+	IncrementMFAFailedCount(id uint) error
+
+	// 有効期限を更新する
+	UpdateExpiresAt(id uint, expiresAt time.Time) error
+
+	// ログアウトする
+	Revoke(id uint) error
+
+	/** 単数取得 */
+	// トークンのハッシュから取得する
+	FindByTokenHash(tokenHash string) (*entity.AdminSession, error)
+}
+
+// 管理者の操作ログ（追記のみのため更新・削除は持たない）
+type AdminActionLogRepository interface {
+	/** 作成 */
+	// 操作ログを作成する
+	Create(actionLog *entity.AdminActionLog) error
+
+	/** 複数取得 */
+	// 新しい順に1ページ分の操作ログを取得する
+	GetListByPage(page uint) ([]*entity.AdminActionLog, error)
+}
+
 /****************************************************************************************/
 
 /****************************************************************************************/
@@ -1189,6 +1502,9 @@ type JobSeekerRepository interface {
 	// 応募承諾のポイントを更新する処理 *求職者相談時に使用
 	UpdateAcceptancePoints(id uint, acceptancePoints string) error
 
+	// メモを更新する処理 *求職者の統合時に使用
+	UpdateSecretMemo(id uint, secretMemo string) error
+
 	// 個人情報同意の更新
 	UpdateAgreement(id uint, agreement bool) error
 
@@ -1209,6 +1525,12 @@ type JobSeekerRepository interface {
 	// おすすめ求人の閲覧権限の更新
 	UpdateCanViewMatchingJob(id uint, canView bool) error
 
+	// 個人情報を暗号化して更新し、ブラインドインデックスを作り直す（暗号化前に登録された求職者の移行に使用）
+	UpdatePersonalInformation(id uint, jobSeeker *entity.JobSeeker) error
+
+	// 個人情報の項目を消去して匿名化する（売上・選考の集計のためレコードは残す）
+	Anonymize(id uint) error
+
 	/** 削除 */
 	Delete(id uint) error
 
@@ -1230,6 +1552,9 @@ type JobSeekerRepository interface {
 	/** カウント */
 	CountByEmail(email string) (float64, error)
 
+	// 担当者ごとの未完了タスク数（面談調整中の求職者数）を取得
+	GetOpenTaskCountByAgentStaffIDList(agentStaffIDList []uint) ([]*entity.AgentStaffOpenTaskCount, error)
+
 	// 送客の重複登録判定
 	FindByNameAndPhoneNumberBySystemAgent(firstName, lastName, firstFurigana, lastFurigana, phoneNumber string) (*entity.JobSeeker, error)
 
@@ -1269,9 +1594,29 @@ type JobSeekerRepository interface {
 	// 引数の値で重複する求職者を取得する
 	GetDuplicateByNameAndFuriganaAndEmailAndPhoneNumber(agentID uint, lastName, firstName, lastFurigana, firstFurigana, email, phoneNumber string) ([]*entity.JobSeeker, error)
 
+	// 正規化した氏名・フリガナ・電話番号・メールアドレスのいずれかが一致する求職者を取得する *取り込み時の重複判定に使用
+	GetDuplicateCandidateByAgentID(agentID uint, name, furigana, phoneNumber, email string) ([]*entity.JobSeeker, error)
+
+	// 個人情報が暗号化されていない求職者を取得する（暗号化前に登録された求職者の移行に使用）
+	GetUnencryptedPersonalInformation(limit uint) ([]*entity.JobSeeker, error)
+
+	// 指定日時以降に求職者・選考・LINEの更新がない未匿名化の求職者IDを、指定IDより後からlimit件取得する（保存期間の経過による匿名化に使用）
+	GetInactiveIDListForRetention(inactiveBefore time.Time, afterID uint, limit uint) ([]uint, error)
+
 	All() ([]*entity.JobSeeker, error)
 }
 
+// 求職者の個人情報（開示・削除請求の対応）
+type JobSeekerPersonalDataRepository interface {
+	/** 削除 */
+	// 求職者に紐づく個人情報を含むテーブルのデータを削除する（選考・売上など集計に使うデータは残す）
+	DeleteByJobSeekerID(jobSeekerID uint) error
+
+	/** 複数取得 */
+	// 求職者の応募書類・選考の添付ファイル・LINEで送受信したファイルのURLを取得する
+	GetFileURLListByJobSeekerID(jobSeekerID uint) ([]string, error)
+}
+
 type JobSeekerDocumentRepository interface {
 	/** 作成 */
 	Create(document *entity.JobSeekerDocument) error
@@ -1600,6 +1945,50 @@ type JobSeekerInterestedJobListingRepository interface {
 	GetByJobSeekerUUIDAndInterestedType(jobSeekerUUID uuid.UUID, interestedType entity.InterestedType) ([]*entity.JobSeekerInterestedJobListing, error)
 }
 
+// 求職者の流入元媒体でのID
+type JobSeekerExternalIDRepository interface {
+	/** 作成 */
+	Create(externalID *entity.JobSeekerExternalID) error
+
+	/** 更新 */
+	// 求職者の統合時に流入元の紐付け先を変更する
+	UpdateJobSeekerIDByJobSeekerID(fromJobSeekerID, toJobSeekerID uint) error
+
+	/** 単数取得 */
+	// 指定エージェントの媒体タイプと媒体でのIDから取得する
+	FindByAgentIDAndExternalTypeAndExternalID(agentID uint, externalType null.Int, externalID string) (*entity.JobSeekerExternalID, error)
+
+	/** 複数取得 */
+	// 指定求職者IDの流入元媒体でのIDを取得する
+	GetByJobSeekerID(jobSeekerID uint) ([]*entity.JobSeekerExternalID, error)
+}
+
+// 求職者の統合候補
+type JobSeekerMergeSuggestionRepository interface {
+	/** 作成 */
+	Create(mergeSuggestion *entity.JobSeekerMergeSuggestion) error
+
+	/** 更新 */
+	// 対応状況を更新する
+	UpdateStatus(id uint, status int64, reviewedStaffID uint) error
+
+	/** 単数取得 */
+	FindByID(id uint) (*entity.JobSeekerMergeSuggestion, error)
+
+	/** 複数取得 */
+	// 指定エージェントの対応状況ごとの統合候補を取得する
+	GetByAgentIDAndStatus(agentID uint, status int64) ([]*entity.JobSeekerMergeSuggestion, error)
+}
+
+type JobSeekerAssignmentLogRepository interface {
+	/** 作成 */
+	Create(assignmentLog *entity.JobSeekerAssignmentLog) error
+
+	/** 複数取得 */
+	// 求職者IDから担当CAの割り振り履歴を取得する
+	GetByJobSeekerID(jobSeekerID uint) ([]*entity.JobSeekerAssignmentLog, error)
+}
+
 /****************************************************************************************/
 /****************************************************************************************/
 /// タスク関連
@@ -1636,6 +2025,9 @@ type TaskGroupRepository interface {
 	// agent_idが一致するtaskGroup
 	GetByAgentID(agentID uint) ([]*entity.TaskGroup, error)
 
+	// 同じ企業への重複推薦の可能性がある選考（自社・アライアンス先の同一人物の選考）
+	GetDuplicateRecommendationList(searchParam entity.SearchDuplicateRecommendationParam) ([]*entity.DuplicateRecommendation, error)
+
 	// GetByAgentIDAndSearchParam(agentID uint, searchParam entity.SearchTask) ([]*entity.TaskGroup, error)
 }
 
@@ -1763,6 +2155,16 @@ type TaskRepository interface {
 	GetInterviewSelectionPerformanceCountByStaffIDAndPeriod(staffID uint, startMonth, endMonth string) (float64, error)
 	GetInterviewRecommendationCompletionPerformanceCountByStaffIDAndPeriod(staffID uint, startMonth, endMonth string) (float64, error)
 	GetInterviewJobIntroductionPerformanceCountByStaffIDAndPeriod(staffID uint, startMonth, endMonth string) (float64, error)
+
+	// パイプライン（選考の進捗ボード）
+	// 最新タスクのフェーズ・サブフェーズごとの件数を集計する
+	GetPipelineCountList(searchParam entity.SearchTaskPipelineParam) ([]*entity.TaskPipelineCount, error)
+	// 指定の列（フェーズ・サブフェーズ）のカードを最新タスクの新しい順に1ページ分取得する
+	GetPipelineCardList(searchParam entity.SearchTaskPipelineParam) ([]*entity.TaskPipelineCard, error)
+
+	// 求人・請求先ごとの選考中の応募者一覧（最新タスクと最新の評価点）
+	GetActiveApplicantListByJobInformationID(jobInformationID uint) ([]*entity.TaskPipelineApplicant, error)
+	GetActiveApplicantListByBillingAddressID(billingAddressID uint) ([]*entity.TaskPipelineApplicant, error)
 }
 
 type TaskIsRecommendDocumentRepository interface {
@@ -2143,6 +2545,157 @@ type InterviewTaskRepository interface {
 
 	// エージェントIDから最新の参加確認のタスクを取得（phaseが「2 or 3」）
 	GetLatestConfirmationByAgentID(agentID uint) ([]*entity.InterviewTask, error)
+
+	// 全エージェントの対応中（phaseが「0 ~ 3」）の最新の面談調整のタスクを取得
+	GetLatestActive() ([]*entity.InterviewTask, error)
+}
+
+/****************************************************************************************/
+// タスクの期限（SLA）
+//
+type TaskSLARuleRepository interface {
+	/** 作成 */
+	// 期限の設定を作成する
+	Create(rule *entity.TaskSLARule) error
+
+	/** 更新 */
+	// 期限の設定を更新する
+	Update(id uint, rule *entity.TaskSLARule) error
+
+	/** 削除 */
+	// 期限の設定を削除する
+	Delete(id uint) error
+
+	/** 単数取得 */
+	FindByID(id uint) (*entity.TaskSLARule, error)
+
+	/** 複数取得 */
+	// エージェントIDから期限の設定一覧を取得する
+	GetByAgentID(agentID uint) ([]*entity.TaskSLARule, error)
+
+	// 全ての期限の設定を取得する
+	All() ([]*entity.TaskSLARule, error)
+}
+
+type TaskSLANotificationRepository interface {
+	/** 作成 */
+	// 送信した通知を記録する（記録済みの場合は何もしない）
+	Create(notification *entity.TaskSLANotification) error
+
+	/** 複数取得 */
+	// タスクIDリストから送信済みの通知を取得する
+	GetByTargetTypeAndTaskIDList(targetType int64, taskIDList []uint) ([]*entity.TaskSLANotification, error)
+}
+
+/****************************************************************************************/
+// タスクグループの活動履歴
+//
+type TaskGroupEventRepository interface {
+	/** 作成 */
+	// 活動履歴を記録する（タスクグループIDがない場合は求職者IDと求人IDからタスクグループを特定する）
+	Create(event *entity.TaskGroupEvent) error
+
+	/** 複数取得 */
+	// タスクグループの活動履歴を発生順に取得する
+	GetByTaskGroupID(taskGroupID uint) ([]*entity.TaskGroupEvent, error)
+	// 求職者の全ての選考の活動履歴を発生順に取得する
+	GetByJobSeekerID(jobSeekerID uint) ([]*entity.TaskGroupEvent, error)
+}
+
+/****************************************************************************************/
+// タスクの一括操作
+//
+type TaskBulkJobRepository interface {
+	/** 作成 */
+	// 一括操作のジョブを登録する
+	Create(job *entity.TaskBulkJob) error
+
+	/** 更新 */
+	// ジョブを実行中にする
+	Start(id uint, startedAt time.Time) error
+	// 実行結果の件数を記録して完了にする
+	Finish(id uint, successCount, failureCount uint, finishedAt time.Time) error
+
+	/** 単数取得 */
+	FindByID(id uint) (*entity.TaskBulkJob, error)
+
+	/** 複数取得 */
+	// 担当者が依頼したジョブを新しい順に取得する
+	GetByAgentStaffID(agentStaffID uint) ([]*entity.TaskBulkJob, error)
+	// 未完了（待機中・中断した実行中）のジョブを登録順に取得する
+	GetUnfinished(limit uint) ([]*entity.TaskBulkJob, error)
+}
+
+type TaskBulkJobItemRepository interface {
+	/** 作成 */
+	// 対象の選考を一括で登録する
+	CreateMulti(taskBulkJobID uint, taskGroupIDList []uint) error
+
+	/** 更新 */
+	// 選考ごとの結果を記録する
+	UpdateResult(id uint, status int64, taskID null.Int, errorMessage string) error
+
+	/** 複数取得 */
+	GetByTaskBulkJobID(taskBulkJobID uint) ([]*entity.TaskBulkJobItem, error)
+}
+
+/****************************************************************************************/
+// 内定
+//
+type JobOfferRepository interface {
+	/** 作成 */
+	// 内定を作成する（タスクグループごとに1件）
+	Create(offer *entity.JobOffer) error
+
+	/** 更新 */
+	// 内定の条件を更新する
+	Update(id uint, offer *entity.JobOffer) error
+	// 内定の状態を更新する
+	UpdateStatus(id uint, status int64) error
+
+	/** 単数取得 */
+	FindByID(id uint) (*entity.JobOffer, error)
+	FindByTaskGroupID(taskGroupID uint) (*entity.JobOffer, error)
+
+	/** 複数取得 */
+	// 求職者の全ての選考の内定を取得する
+	GetByJobSeekerID(jobSeekerID uint) ([]*entity.JobOffer, error)
+	// 回答期限が指定日時までの回答待ちの内定を取得する
+	GetOpenByAnswerDeadlineUntil(until time.Time) ([]*entity.JobOffer, error)
+}
+
+type JobOfferStatusHistoryRepository interface {
+	/** 作成 */
+	// 状態の変更を記録する
+	Create(history *entity.JobOfferStatusHistory) error
+
+	/** 複数取得 */
+	// 内定の状態の変更履歴を発生順に取得する
+	GetByJobOfferID(jobOfferID uint) ([]*entity.JobOfferStatusHistory, error)
+}
+
+type JobOfferReminderRepository interface {
+	/** 作成 */
+	// 送信した通知を記録する（記録済みの場合は何もしない）
+	Create(reminder *entity.JobOfferReminder) error
+
+	/** 複数取得 */
+	// 内定IDリストから送信済みの通知を取得する
+	GetByJobOfferIDList(jobOfferIDList []uint) ([]*entity.JobOfferReminder, error)
+}
+
+// 辞退・不合格の理由
+type TaskLossReasonRepository interface {
+	/** 作成 */
+	// 終了のタスクの理由を記録する
+	Create(lossReason *entity.TaskLossReason) error
+
+	/** 複数取得 */
+	// 選考の理由を記録順に取得する
+	GetByTaskGroupID(taskGroupID uint) ([]*entity.TaskLossReason, error)
+
+	// 集計条件に一致する理由を企業・求人・担当者と合わせて取得する
+	GetForAnalytics(searchParam entity.SearchTaskLossReasonParam) ([]*entity.TaskLossReason, error)
 }
 
 // 面談調整テンプレート
@@ -2275,6 +2828,46 @@ type SaleRepository interface {
 
 	// csv出力用
 	GetByAgentIDForCSV(agentID uint) ([]*entity.Sale, error)
+
+	// 返金保証期間
+	// エージェント（RA側・CA側）の入社日が指定日以降の売上を取得
+	GetGuaranteeByAgentID(agentID uint, joinedSince string) ([]*entity.SaleGuarantee, error)
+	// 全エージェントの入社日が指定日以降で退職の記録がない売上を取得
+	GetActiveGuaranteeByJoinedSince(joinedSince string) ([]*entity.SaleGuarantee, error)
+}
+
+// 返金規定の段階
+type AgentRefundTierRepository interface {
+	/** 作成 */
+	Create(tier *entity.AgentRefundTier) error
+
+	/** 削除 */
+	// エージェントの返金規定を全て削除する（初期設定に戻す）
+	DeleteByAgentID(agentID uint) error
+
+	/** 複数取得 */
+	GetByAgentID(agentID uint) ([]*entity.AgentRefundTier, error)
+	All() ([]*entity.AgentRefundTier, error)
+}
+
+// 早期退職による返金
+type SaleRefundRepository interface {
+	/** 作成 */
+	Create(refund *entity.SaleRefund) error
+
+	/** 単数取得 */
+	FindBySaleID(saleID uint) (*entity.SaleRefund, error)
+}
+
+// 返金保証期間の通知
+type SaleGuaranteeNotificationRepository interface {
+	/** 作成 */
+	// 送信した通知を記録する（記録済みの場合は何もしない）
+	Create(notification *entity.SaleGuaranteeNotification) error
+
+	/** 複数取得 */
+	// 売上IDリストから送信済みの通知を取得する
+	GetBySaleIDList(saleIDList []uint) ([]*entity.SaleGuaranteeNotification, error)
 }
 
 // Agent売上目標管理
@@ -2390,6 +2983,9 @@ type JobSeekerScheduleRepository interface {
 	DeleteByTaskGroupIDAndScheduleType(taskGroupID, scheduleType uint) error
 	DeleteScheduleInTaskGroupAboveTaskID(taskID, taskGroupID uint) error
 
+	/** 単数取得 */
+	FindByID(id uint) (*entity.JobSeekerSchedule, error)
+
 	/** 複数取得 */
 	GetByJobSeekerID(jobSeekerID uint) ([]*entity.JobSeekerSchedule, error)
 	GetByJobSeekerIDAndScheuldType(jobSeekerID, scheduleType uint) ([]*entity.JobSeekerSchedule, error)